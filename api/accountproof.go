@@ -0,0 +1,176 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/trie"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// emptyCodeHash is the code hash of an account without code.
+var emptyCodeHash = types.Hash{
+	0xc5, 0xd2, 0x46, 0x01, 0x86, 0xf7, 0x23, 0x3c, 0x92, 0x7e, 0x7d, 0xb2, 0xdc, 0xc7, 0x03, 0xc0,
+	0xe5, 0x00, 0xb6, 0x53, 0xca, 0x82, 0x27, 0x3b, 0x7b, 0xfa, 0xd8, 0x04, 0x5d, 0x85, 0xa4, 0x70,
+}
+
+// AccountProof contains the proof of an account and its storage, as returned by eth_getProof.
+type AccountProof struct {
+	Address      types.Address
+	Balance      *big.Int
+	Nonce        uint64
+	CodeHash     types.Hash
+	StorageHash  types.Root
+	AccountProof [][]byte
+	StorageProof []*StorageProof
+}
+
+// accountProofJSON is the spec representation of the struct.
+type accountProofJSON struct {
+	Address      string          `json:"address"`
+	Balance      string          `json:"balance"`
+	Nonce        string          `json:"nonce"`
+	CodeHash     string          `json:"codeHash"`
+	StorageHash  string          `json:"storageHash"`
+	AccountProof []string        `json:"accountProof"`
+	StorageProof []*StorageProof `json:"storageProof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AccountProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&accountProofJSON{
+		Address:      util.MarshalAddress(a.Address[:]),
+		Balance:      util.MarshalBigInt(a.Balance),
+		Nonce:        util.MarshalUint64(a.Nonce),
+		CodeHash:     fmt.Sprintf("%#x", a.CodeHash),
+		StorageHash:  fmt.Sprintf("%#x", a.StorageHash),
+		AccountProof: marshalProof(a.AccountProof),
+		StorageProof: a.StorageProof,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AccountProof) UnmarshalJSON(input []byte) error {
+	var data accountProofJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return a.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (a *AccountProof) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (a *AccountProof) unpack(data *accountProofJSON) error {
+	var err error
+
+	a.Address, err = util.StrToAddress("address", data.Address)
+	if err != nil {
+		return err
+	}
+
+	a.Balance, err = util.StrToBigInt("balance", data.Balance)
+	if err != nil {
+		return err
+	}
+
+	a.Nonce, err = util.StrToUint64("nonce", data.Nonce)
+	if err != nil {
+		return err
+	}
+
+	a.CodeHash, err = util.StrToHash("code hash", data.CodeHash)
+	if err != nil {
+		return err
+	}
+
+	a.StorageHash, err = util.StrToRoot("storage hash", data.StorageHash)
+	if err != nil {
+		return err
+	}
+
+	a.AccountProof, err = unpackProof(data.AccountProof)
+	if err != nil {
+		return err
+	}
+
+	for i := range data.StorageProof {
+		if data.StorageProof[i] == nil {
+			return fmt.Errorf("storage proof %d missing", i)
+		}
+	}
+
+	a.StorageProof = data.StorageProof
+
+	return nil
+}
+
+// Verify checks the account proof against the given state root, and the
+// storage proofs against the storage hash of the account.
+func (a *AccountProof) Verify(stateRoot types.Root) error {
+	key := util.Keccak256(a.Address[:])
+
+	account, err := trie.VerifyProof(stateRoot, key[:], a.AccountProof)
+	if err != nil {
+		return errors.Wrap(err, "invalid account proof")
+	}
+
+	if account == nil {
+		// Account is not present in the state, so must be empty.
+		if a.Nonce != 0 ||
+			(a.Balance != nil && a.Balance.Sign() != 0) ||
+			a.CodeHash != emptyCodeHash ||
+			a.StorageHash != trie.EmptyRoot {
+			return errors.New("account not present in state")
+		}
+	} else if !bytes.Equal(account, a.accountRLP()) {
+		return errors.New("account does not match state")
+	}
+
+	for i, storageProof := range a.StorageProof {
+		if err := storageProof.Verify(a.StorageHash); err != nil {
+			return errors.Wrapf(err, "storage proof %d", i)
+		}
+	}
+
+	return nil
+}
+
+// accountRLP returns the RLP encoding of the account, as held in the state trie.
+func (a *AccountProof) accountRLP() []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, 128))
+	util.RLPUint64(buf, a.Nonce)
+	util.RLPBigInt(buf, a.Balance)
+	util.RLPBytes(buf, a.StorageHash[:])
+	util.RLPBytes(buf, a.CodeHash[:])
+
+	res := bytes.NewBuffer(make([]byte, 0, buf.Len()+2))
+	util.RLPList(res, buf.Bytes())
+
+	return res.Bytes()
+}
@@ -0,0 +1,152 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountProofJSON tests JSON for AccountProof.
+func TestAccountProofJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.accountProofJSON",
+		},
+		{
+			name:  "AddressMissing",
+			input: []byte(`{"balance":"0x0","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":[],"storageProof":[]}`),
+			err:   "address missing",
+		},
+		{
+			name:  "StorageKeyTooLong",
+			input: []byte(`{"address":"0x2222222222222222222222222222222222222222","balance":"0x0","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":[],"storageProof":[{"key":"0x000000000000000000000000000000000000000000000000000000000000000001","value":"0x0","proof":[]}]}`),
+			err:   "invalid JSON: key too long",
+		},
+		{
+			name:  "AccountProofInvalid",
+			input: []byte(`{"address":"0x2222222222222222222222222222222222222222","balance":"0x0","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":["0xzz"],"storageProof":[]}`),
+			err:   "proof node 0 invalid: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:     "Good",
+			input:    []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			expected: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x0000000000000000000000000000000000000000000000000000000000000001","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000099","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+		},
+		{
+			name:  "Missing",
+			input: []byte(`{"address":"0x2222222222222222222222222222222222222222","balance":"0x0","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8b18080a0922af1d13a26da1a1d749d9e63cfccddffecc6811d941d35c44234cb84b567c8a036a553d93ca8e083dece0bb48cf559a7c649243928958ce517b9dcb0a27e950880808080a0c7bf8f1ba810070333e74372cad8b409624e48558d622499b9452db07ebf64e080a08dd72b89aeb16378c65f7f588ac8333b135011a6f1cb1e6ddfb4240fa2d8607780a0ebd442d876ca3049517bb20728c18cdd2ff197d43ef4dbc5cc20dfbb4526056880808080","0xf86ea02024c4e035c067fea3aa9a8c830e459821ecac5427f6df460f8a39b3287b0ac4b84bf84912850430e23400a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a0c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"],"storageProof":[]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.AccountProof
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+			}
+		})
+	}
+}
+
+// TestAccountProofVerify tests verification of account proofs.
+func TestAccountProofVerify(t *testing.T) {
+	stateRoot := types.Root(hexToBytes("0x8a357e31cda2adff4cd0faccb72bc92d39fed3816bdb8c17d8c5f093e120cc4a"))
+
+	tests := []struct {
+		name  string
+		input []byte
+		root  types.Root
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			root:  stateRoot,
+		},
+		{
+			name:  "GoodMissing",
+			input: []byte(`{"address":"0x2222222222222222222222222222222222222222","balance":"0x0","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8b18080a0922af1d13a26da1a1d749d9e63cfccddffecc6811d941d35c44234cb84b567c8a036a553d93ca8e083dece0bb48cf559a7c649243928958ce517b9dcb0a27e950880808080a0c7bf8f1ba810070333e74372cad8b409624e48558d622499b9452db07ebf64e080a08dd72b89aeb16378c65f7f588ac8333b135011a6f1cb1e6ddfb4240fa2d8607780a0ebd442d876ca3049517bb20728c18cdd2ff197d43ef4dbc5cc20dfbb4526056880808080","0xf86ea02024c4e035c067fea3aa9a8c830e459821ecac5427f6df460f8a39b3287b0ac4b84bf84912850430e23400a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a0c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"],"storageProof":[]}`),
+			root:  stateRoot,
+		},
+		{
+			name:  "WrongRoot",
+			input: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			root:  types.Root(hexToBytes("0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3")),
+			err:   "invalid account proof: proof node 0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3 missing",
+		},
+		{
+			name:  "BalanceIncorrect",
+			input: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd16","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			root:  stateRoot,
+			err:   "account does not match state",
+		},
+		{
+			name:  "MissingBalanceIncorrect",
+			input: []byte(`{"address":"0x2222222222222222222222222222222222222222","balance":"0x1","nonce":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8b18080a0922af1d13a26da1a1d749d9e63cfccddffecc6811d941d35c44234cb84b567c8a036a553d93ca8e083dece0bb48cf559a7c649243928958ce517b9dcb0a27e950880808080a0c7bf8f1ba810070333e74372cad8b409624e48558d622499b9452db07ebf64e080a08dd72b89aeb16378c65f7f588ac8333b135011a6f1cb1e6ddfb4240fa2d8607780a0ebd442d876ca3049517bb20728c18cdd2ff197d43ef4dbc5cc20dfbb4526056880808080","0xf86ea02024c4e035c067fea3aa9a8c830e459821ecac5427f6df460f8a39b3287b0ac4b84bf84912850430e23400a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a0c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"],"storageProof":[]}`),
+			root:  stateRoot,
+			err:   "account not present in state",
+		},
+		{
+			name:  "StorageValueIncorrect",
+			input: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e9","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x0","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			root:  stateRoot,
+			err:   "storage proof 0: value does not match storage",
+		},
+		{
+			name:  "AbsentStorageValueIncorrect",
+			input: []byte(`{"address":"0x1111111111111111111111111111111111111111","balance":"0x75bcd15","nonce":"0x5","codeHash":"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d","storageHash":"0x5a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33ed","accountProof":["0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180","0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80","0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"],"storageProof":[{"key":"0x1","value":"0x3e8","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf85180a0dee21cddee050d0e08fcd2e8cd2ac9685ff351f2935c8565a0f66744cb864056808080808080808080a077765bfb519144d4742628b63c0bf52bc74a04e952eb828af11908d56704a7fa8080808080","0xe5a0200e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6838203e8"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000014","value":"0x4e20","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780","0xf8718080a0c9e006e2644aee97d9d822122eba3e061aa46842bcf24c4d2e58f0f900043476808080a0155bf7c53192419ef9beea6a9a2c5920d5fc82e2a1fa8760cda56b4ee3ff335480808080808080a09ce7ab02c18f591ed7f0c03c3854e5b6883f2a044949536ea175bd7ccb8106678080","0xe5a0206d7b5282bd9a3661ae061feed1dbda4e52ab073b1f9285be6e155d9c38d4ec83824e20"]},{"key":"0x99","value":"0x1","proof":["0xf90171a04ace1125e6c42e0af612519c7190e5a9bca46e200f58c847fbc633d7271fc910a0d79d5848624830f812df2ce5b233c98d9e5c651d453b8531e131d5fbd5ccff7b80a02b0e512b63b31a3a2258bda2096095663d73051259b03622e45a9512a3fd72aea0863876ba344bbd8c23a93cd40af82bc516315e7d46676f0dbc7690d2a3fe2a8d80a00e286441f68620239ec31debc7762afe4e54c5a2d46e7b3cdc656b79935ea79c80a0f36ae8ad7aea5a2c41aecbf84ae8bac3ee929398084326ee7e8dd1a27242125480a0851da41b03cad4bb01152df2e093b889159aedf2837f159a3650a979a9a32687a0c552c4bdad7d45fe811dde5220263e2e1b985cd0391088dcfd64b7c0e5807aeba02c7b64c49c7394248a373435d1f2264c77590cf42af0452e4bbeaedf8ee96a86a0142b7db952baa437a4950070776cbca0ebac829a9dd4ee4f075a6521705486c080a029e8241db7cb7678af555bcfb03aaf91c15131e63ed26f5e997abd025729f9e780"]}]}`),
+			root:  stateRoot,
+			err:   "storage proof 2: value does not match storage",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var proof api.AccountProof
+			require.NoError(t, json.Unmarshal(test.input, &proof))
+			err := proof.Verify(test.root)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// CallFrame is a call within a transaction, as generated by the call tracer.
+type CallFrame struct {
+	Type         string
+	From         types.Address
+	To           *types.Address
+	Gas          uint64
+	GasUsed      uint64
+	Value        *big.Int
+	Input        []byte
+	Output       []byte
+	Error        string
+	RevertReason string
+	Calls        []*CallFrame
+	Logs         []*CallFrameLog
+}
+
+// callFrameJSON is the spec representation of the struct.
+type callFrameJSON struct {
+	Type         string          `json:"type"`
+	From         string          `json:"from"`
+	To           string          `json:"to,omitempty"`
+	Gas          string          `json:"gas"`
+	GasUsed      string          `json:"gasUsed"`
+	Value        string          `json:"value,omitempty"`
+	Input        string          `json:"input"`
+	Output       string          `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []*CallFrame    `json:"calls,omitempty"`
+	Logs         []*CallFrameLog `json:"logs,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *CallFrame) MarshalJSON() ([]byte, error) {
+	data := &callFrameJSON{
+		Type:         c.Type,
+		From:         util.MarshalAddress(c.From[:]),
+		Gas:          util.MarshalUint64(c.Gas),
+		GasUsed:      util.MarshalUint64(c.GasUsed),
+		Input:        util.MarshalByteArray(c.Input),
+		Error:        c.Error,
+		RevertReason: c.RevertReason,
+		Calls:        c.Calls,
+		Logs:         c.Logs,
+	}
+
+	if c.To != nil {
+		data.To = util.MarshalAddress(c.To[:])
+	}
+
+	if c.Value != nil {
+		data.Value = util.MarshalBigInt(c.Value)
+	}
+
+	if c.Output != nil {
+		data.Output = util.MarshalByteArray(c.Output)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CallFrame) UnmarshalJSON(input []byte) error {
+	var data callFrameJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return c.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (c *CallFrame) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (c *CallFrame) unpack(data *callFrameJSON) error {
+	var err error
+
+	if data.Type == "" {
+		return errors.New("type missing")
+	}
+
+	c.Type = data.Type
+
+	c.From, err = util.StrToAddress("from", data.From)
+	if err != nil {
+		return err
+	}
+
+	if data.To != "" {
+		to, err := util.StrToAddress("to", data.To)
+		if err != nil {
+			return err
+		}
+
+		c.To = &to
+	}
+
+	c.Gas, err = util.StrToUint64("gas", data.Gas)
+	if err != nil {
+		return err
+	}
+
+	c.GasUsed, err = util.StrToUint64("gas used", data.GasUsed)
+	if err != nil {
+		return err
+	}
+
+	if data.Value != "" {
+		c.Value, err = util.StrToBigInt("value", data.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.Input, err = util.StrToByteArray("input", data.Input)
+	if err != nil {
+		return err
+	}
+
+	if data.Output != "" {
+		c.Output, err = util.StrToByteArray("output", data.Output)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.Error = data.Error
+	c.RevertReason = data.RevertReason
+
+	for i := range data.Calls {
+		if data.Calls[i] == nil {
+			return fmt.Errorf("call %d missing", i)
+		}
+	}
+
+	c.Calls = data.Calls
+
+	for i := range data.Logs {
+		if data.Logs[i] == nil {
+			return fmt.Errorf("log %d missing", i)
+		}
+	}
+
+	c.Logs = data.Logs
+
+	return nil
+}
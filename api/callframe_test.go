@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCallFrameJSON tests JSON for CallFrame.
+func TestCallFrameJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.callFrameJSON",
+		},
+		{
+			name:  "TypeMissing",
+			input: []byte(`{"from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","gas":"0x1f3a0","gasUsed":"0x1b6b7","input":"0x"}`),
+			err:   "type missing",
+		},
+		{
+			name:  "FromInvalid",
+			input: []byte(`{"type":"CALL","from":"true","gas":"0x1f3a0","gasUsed":"0x1b6b7","input":"0x"}`),
+			err:   "from invalid: encoding/hex: invalid byte: U+0074 't'",
+		},
+		{
+			name:  "GasInvalid",
+			input: []byte(`{"type":"CALL","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","gas":"true","gasUsed":"0x1b6b7","input":"0x"}`),
+			err:   "gas invalid: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "CallMissing",
+			input: []byte(`{"type":"CALL","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","gas":"0x1f3a0","gasUsed":"0x1b6b7","input":"0x","calls":[null]}`),
+			err:   "call 0 missing",
+		},
+		{
+			name:  "Create",
+			input: []byte(`{"type":"CREATE","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","to":"0x5fbdb2315678afecb367f032d93f642f64180aa3","gas":"0x1f3a0","gasUsed":"0x1b6b7","value":"0x0","input":"0x6080604052","output":"0x6080"}`),
+		},
+		{
+			name:  "Nested",
+			input: []byte(`{"type":"CALL","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","gas":"0x1f3a0","gasUsed":"0x1b6b7","value":"0xde0b6b3a7640000","input":"0xd0e30db0","output":"0x","calls":[{"type":"STATICCALL","from":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","to":"0x0000000000000000000000000000000000000001","gas":"0xbb8","gasUsed":"0xbb8","input":"0x01","output":"0x02"}],"logs":[{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","topics":["0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c"],"data":"0x0000000000000000000000000000000000000000000000000de0b6b3a7640000","position":"0x1"}]}`),
+		},
+		{
+			name:  "Reverted",
+			input: []byte(`{"type":"CALL","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","gas":"0x1f3a0","gasUsed":"0x5208","value":"0x0","input":"0x2e1a7d4d","output":"0x08c379a0","error":"execution reverted","revertReason":"insufficient balance"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.CallFrame
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
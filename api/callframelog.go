@@ -0,0 +1,103 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// CallFrameLog is a log emitted within a call frame.
+type CallFrameLog struct {
+	Address  types.Address
+	Topics   []types.Hash
+	Data     []byte
+	Position uint64
+}
+
+// callFrameLogJSON is the spec representation of the struct.
+type callFrameLogJSON struct {
+	Address  string   `json:"address"`
+	Topics   []string `json:"topics"`
+	Data     string   `json:"data"`
+	Position string   `json:"position"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *CallFrameLog) MarshalJSON() ([]byte, error) {
+	topics := make([]string, len(c.Topics))
+	for i := range c.Topics {
+		topics[i] = fmt.Sprintf("%#x", c.Topics[i])
+	}
+
+	return json.Marshal(&callFrameLogJSON{
+		Address:  util.MarshalAddress(c.Address[:]),
+		Topics:   topics,
+		Data:     util.MarshalByteArray(c.Data),
+		Position: util.MarshalUint64(c.Position),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CallFrameLog) UnmarshalJSON(input []byte) error {
+	var data callFrameLogJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return c.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (c *CallFrameLog) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (c *CallFrameLog) unpack(data *callFrameLogJSON) error {
+	var err error
+
+	c.Address, err = util.StrToAddress("address", data.Address)
+	if err != nil {
+		return err
+	}
+
+	c.Topics = make([]types.Hash, len(data.Topics))
+	for i := range data.Topics {
+		c.Topics[i], err = util.StrToHash("topic", data.Topics[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	c.Data, err = util.StrToByteArray("data", data.Data)
+	if err != nil {
+		return err
+	}
+
+	c.Position, err = util.StrToUint64("position", data.Position)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
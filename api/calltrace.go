@@ -0,0 +1,355 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// CallTrace is a single action within a transaction, as generated by the trace module.
+type CallTrace struct {
+	// Type is the type of the action, for example "call", "create", "suicide" or "reward".
+	Type   string
+	Action *CallTraceAction
+	// Result is the result of the action; it is nil if the action failed or has no result.
+	Result    *CallTraceResult
+	Error     string
+	Subtraces uint32
+	// TraceAddress is the position of the action in the tree of calls made by the transaction.
+	TraceAddress []uint32
+}
+
+// CallTraceAction is the action of a call trace.
+// The fields that are present depend on the type of the trace.
+type CallTraceAction struct {
+	// CallType is the type of call for call actions, for example "call" or "delegatecall".
+	CallType string
+	From     *types.Address
+	To       *types.Address
+	// Gas is the gas supplied to call and create actions.
+	Gas   uint64
+	Value *big.Int
+	Input []byte
+	// Init is the initialisation code for create actions.
+	Init []byte
+	// Address is the address of the contract for suicide actions.
+	Address       *types.Address
+	RefundAddress *types.Address
+	Balance       *big.Int
+	// Author is the recipient for reward actions.
+	Author     *types.Address
+	RewardType string
+}
+
+// CallTraceResult is the result of a call trace.
+type CallTraceResult struct {
+	GasUsed uint64
+	Output  []byte
+	// Address is the address of the contract created by create actions.
+	Address *types.Address
+	// Code is the code of the contract created by create actions.
+	Code []byte
+}
+
+// callTraceJSON is the spec representation of the struct.
+type callTraceJSON struct {
+	Action       *callTraceActionJSON `json:"action"`
+	Error        string               `json:"error,omitempty"`
+	Result       *callTraceResultJSON `json:"result"`
+	Subtraces    uint32               `json:"subtraces"`
+	TraceAddress []uint32             `json:"traceAddress"`
+	Type         string               `json:"type"`
+}
+
+// callTraceActionJSON is the spec representation of the action.
+type callTraceActionJSON struct {
+	Address       string `json:"address,omitempty"`
+	RefundAddress string `json:"refundAddress,omitempty"`
+	Balance       string `json:"balance,omitempty"`
+	Author        string `json:"author,omitempty"`
+	From          string `json:"from,omitempty"`
+	CallType      string `json:"callType,omitempty"`
+	Gas           string `json:"gas,omitempty"`
+	Input         string `json:"input,omitempty"`
+	Init          string `json:"init,omitempty"`
+	RewardType    string `json:"rewardType,omitempty"`
+	To            string `json:"to,omitempty"`
+	Value         string `json:"value,omitempty"`
+}
+
+// callTraceResultJSON is the spec representation of the result.
+type callTraceResultJSON struct {
+	Address string `json:"address,omitempty"`
+	Code    string `json:"code,omitempty"`
+	GasUsed string `json:"gasUsed"`
+	Output  string `json:"output,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *CallTrace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.pack())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CallTrace) UnmarshalJSON(input []byte) error {
+	var data callTraceJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return c.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (c *CallTrace) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (c *CallTrace) pack() *callTraceJSON {
+	data := &callTraceJSON{
+		Error:        c.Error,
+		Subtraces:    c.Subtraces,
+		TraceAddress: c.TraceAddress,
+		Type:         c.Type,
+	}
+
+	if data.TraceAddress == nil {
+		data.TraceAddress = make([]uint32, 0)
+	}
+
+	if c.Action != nil {
+		data.Action = c.Action.pack()
+	}
+
+	if c.Result != nil {
+		data.Result = c.Result.pack()
+	}
+
+	return data
+}
+
+func (c *CallTrace) unpack(data *callTraceJSON) error {
+	if data.Type == "" {
+		return errors.New("type missing")
+	}
+
+	c.Type = data.Type
+
+	if data.Action == nil {
+		return errors.New("action missing")
+	}
+
+	c.Action = &CallTraceAction{}
+	if err := c.Action.unpack(data.Action); err != nil {
+		return errors.Wrap(err, "action invalid")
+	}
+
+	if data.Result != nil {
+		c.Result = &CallTraceResult{}
+		if err := c.Result.unpack(data.Result); err != nil {
+			return errors.Wrap(err, "result invalid")
+		}
+	}
+
+	c.Error = data.Error
+	c.Subtraces = data.Subtraces
+	c.TraceAddress = data.TraceAddress
+
+	return nil
+}
+
+func (a *CallTraceAction) pack() *callTraceActionJSON {
+	data := &callTraceActionJSON{
+		CallType:   a.CallType,
+		RewardType: a.RewardType,
+	}
+
+	if a.From != nil {
+		data.From = util.MarshalAddress(a.From[:])
+		// Gas is supplied to all actions that have a sender.
+		data.Gas = util.MarshalUint64(a.Gas)
+	}
+
+	if a.To != nil {
+		data.To = util.MarshalAddress(a.To[:])
+	}
+
+	if a.Value != nil {
+		data.Value = util.MarshalBigInt(a.Value)
+	}
+
+	if a.Input != nil {
+		data.Input = util.MarshalByteArray(a.Input)
+	}
+
+	if a.Init != nil {
+		data.Init = util.MarshalByteArray(a.Init)
+	}
+
+	if a.Address != nil {
+		data.Address = util.MarshalAddress(a.Address[:])
+	}
+
+	if a.RefundAddress != nil {
+		data.RefundAddress = util.MarshalAddress(a.RefundAddress[:])
+	}
+
+	if a.Balance != nil {
+		data.Balance = util.MarshalBigInt(a.Balance)
+	}
+
+	if a.Author != nil {
+		data.Author = util.MarshalAddress(a.Author[:])
+	}
+
+	return data
+}
+
+func (a *CallTraceAction) unpack(data *callTraceActionJSON) error {
+	var err error
+
+	a.CallType = data.CallType
+	a.RewardType = data.RewardType
+
+	if a.From, err = unpackOptionalAddress("from", data.From); err != nil {
+		return err
+	}
+
+	if a.To, err = unpackOptionalAddress("to", data.To); err != nil {
+		return err
+	}
+
+	if data.Gas != "" {
+		a.Gas, err = util.StrToUint64("gas", data.Gas)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.Value != "" {
+		a.Value, err = util.StrToBigInt("value", data.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.Input != "" {
+		a.Input, err = util.StrToByteArray("input", data.Input)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.Init != "" {
+		a.Init, err = util.StrToByteArray("init", data.Init)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.Address, err = unpackOptionalAddress("address", data.Address); err != nil {
+		return err
+	}
+
+	if a.RefundAddress, err = unpackOptionalAddress("refund address", data.RefundAddress); err != nil {
+		return err
+	}
+
+	if data.Balance != "" {
+		a.Balance, err = util.StrToBigInt("balance", data.Balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.Author, err = unpackOptionalAddress("author", data.Author); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CallTraceResult) pack() *callTraceResultJSON {
+	data := &callTraceResultJSON{
+		GasUsed: util.MarshalUint64(r.GasUsed),
+	}
+
+	if r.Output != nil {
+		data.Output = util.MarshalByteArray(r.Output)
+	}
+
+	if r.Address != nil {
+		data.Address = util.MarshalAddress(r.Address[:])
+	}
+
+	if r.Code != nil {
+		data.Code = util.MarshalByteArray(r.Code)
+	}
+
+	return data
+}
+
+func (r *CallTraceResult) unpack(data *callTraceResultJSON) error {
+	var err error
+
+	r.GasUsed, err = util.StrToUint64("gas used", data.GasUsed)
+	if err != nil {
+		return err
+	}
+
+	if data.Output != "" {
+		r.Output, err = util.StrToByteArray("output", data.Output)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Address, err = unpackOptionalAddress("address", data.Address); err != nil {
+		return err
+	}
+
+	if data.Code != "" {
+		r.Code, err = util.StrToByteArray("code", data.Code)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unpackOptionalAddress unpacks an address that may not be present.
+func unpackOptionalAddress(name string, input string) (*types.Address, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	res, err := util.StrToAddress(name, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCallTraceJSON tests JSON for CallTrace.
+func TestCallTraceJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.callTraceJSON",
+		},
+		{
+			name:  "TypeMissing",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[]}`),
+			err:   "type missing",
+		},
+		{
+			name:  "ActionMissing",
+			input: []byte(`{"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}`),
+			err:   "action missing",
+		},
+		{
+			name:  "FromInvalid",
+			input: []byte(`{"action":{"from":"true","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}`),
+			err:   "action invalid: from invalid: encoding/hex: invalid byte: U+0074 't'",
+		},
+		{
+			name:  "GasUsedInvalid",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"true","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}`),
+			err:   "result invalid: gas used invalid: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "Call",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}`),
+		},
+		{
+			name:  "CallReverted",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"delegatecall","gas":"0x1f3a0","input":"0x2e1a7d4d","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x0"},"error":"Reverted","result":null,"subtraces":0,"traceAddress":[1,0],"type":"call"}`),
+		},
+		{
+			name:  "Create",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gas":"0x1f3a0","init":"0x6080604052","value":"0x0"},"result":{"address":"0x5fbdb2315678afecb367f032d93f642f64180aa3","code":"0x6080","gasUsed":"0x1b6b7"},"subtraces":2,"traceAddress":[],"type":"create"}`),
+		},
+		{
+			name:  "Suicide",
+			input: []byte(`{"action":{"address":"0x5fbdb2315678afecb367f032d93f642f64180aa3","refundAddress":"0xa1e4380a3b1f749673e270229993ee55f35663b4","balance":"0xde0b6b3a7640000"},"result":null,"subtraces":0,"traceAddress":[0],"type":"suicide"}`),
+		},
+		{
+			name:  "Reward",
+			input: []byte(`{"action":{"author":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","rewardType":"block","value":"0x4563918244f40000"},"result":null,"subtraces":0,"traceAddress":[],"type":"reward"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.CallTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// DebugTrace is the debug trace of a transaction.
+// Only the field for the tracer that generated the trace is populated.  The
+// raw output of the tracer is always available, including for tracers that
+// are not decoded here.
+type DebugTrace struct {
+	Tracer       DebugTracer
+	Call         *CallFrame
+	Prestate     map[types.Address]*PrestateAccount
+	PrestateDiff *PrestateDiff
+	FourByte     map[string]uint64
+	Raw          json.RawMessage
+}
+
+// PrestateDiff is the state changed by a transaction, as generated by the
+// prestate tracer in diff mode.
+type PrestateDiff struct {
+	Pre  map[types.Address]*PrestateAccount
+	Post map[types.Address]*PrestateAccount
+}
+
+// prestateDiffJSON is the spec representation of the struct.
+type prestateDiffJSON struct {
+	Pre  map[string]*PrestateAccount `json:"pre"`
+	Post map[string]*PrestateAccount `json:"post"`
+}
+
+// DebugTransactionTrace is the debug trace of a transaction within a block.
+type DebugTransactionTrace struct {
+	// TransactionHash is the hash of the transaction, if supplied by the execution client.
+	TransactionHash *types.Hash
+	// Trace is the trace of the transaction, if tracing succeeded.
+	Trace *DebugTrace
+	// Error is the reason tracing failed, if it did so.
+	Error string
+}
+
+// NewDebugTrace decodes the output of the given tracer, which was run with
+// the given tracer configuration.
+func NewDebugTrace(tracer DebugTracer, tracerConfig map[string]any, input []byte) (*DebugTrace, error) {
+	res := &DebugTrace{
+		Tracer: tracer,
+		Raw:    json.RawMessage(input),
+	}
+
+	switch tracer {
+	case DebugTracerCall:
+		res.Call = &CallFrame{}
+		if err := json.Unmarshal(input, res.Call); err != nil {
+			return nil, errors.Wrap(err, "invalid call trace")
+		}
+	case DebugTracerPrestate:
+		var err error
+		if diffMode, _ := tracerConfig["diffMode"].(bool); diffMode {
+			res.PrestateDiff, err = unpackPrestateDiff(input)
+		} else {
+			res.Prestate, err = unpackPrestate(input)
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid prestate trace")
+		}
+	case DebugTracerFourByte:
+		if err := json.Unmarshal(input, &res.FourByte); err != nil {
+			return nil, errors.Wrap(err, "invalid 4byte trace")
+		}
+	}
+
+	return res, nil
+}
+
+// String returns a string version of the structure.
+func (d *DebugTrace) String() string {
+	return string(d.Raw)
+}
+
+// unpackPrestate unpacks the output of the prestate tracer in standard mode.
+func unpackPrestate(input []byte) (map[types.Address]*PrestateAccount, error) {
+	var data map[string]*PrestateAccount
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, err
+	}
+
+	return unpackPrestateAccounts(data)
+}
+
+// unpackPrestateDiff unpacks the output of the prestate tracer in diff mode.
+func unpackPrestateDiff(input []byte) (*PrestateDiff, error) {
+	var data prestateDiffJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	res := &PrestateDiff{}
+
+	res.Pre, err = unpackPrestateAccounts(data.Pre)
+	if err != nil {
+		return nil, errors.Wrap(err, "pre")
+	}
+
+	res.Post, err = unpackPrestateAccounts(data.Post)
+	if err != nil {
+		return nil, errors.Wrap(err, "post")
+	}
+
+	return res, nil
+}
+
+// unpackPrestateAccounts converts prestate accounts keyed by string to prestate accounts keyed by address.
+func unpackPrestateAccounts(data map[string]*PrestateAccount) (map[types.Address]*PrestateAccount, error) {
+	res := make(map[types.Address]*PrestateAccount, len(data))
+	for k, v := range data {
+		address, err := util.StrToAddress("address", k)
+		if err != nil {
+			return nil, err
+		}
+
+		if v == nil {
+			return nil, fmt.Errorf("account %s missing", k)
+		}
+
+		res[address] = v
+	}
+
+	return res, nil
+}
@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewDebugTrace tests decoding of debug traces.
+func TestNewDebugTrace(t *testing.T) {
+	address := types.Address(hexToBytes("0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a"))
+
+	tests := []struct {
+		name         string
+		tracer       api.DebugTracer
+		tracerConfig map[string]any
+		input        []byte
+		err          string
+		check        func(t *testing.T, res *api.DebugTrace)
+	}{
+		{
+			name:   "CallInvalid",
+			tracer: api.DebugTracerCall,
+			input:  []byte(`{"from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a"}`),
+			err:    "invalid call trace: type missing",
+		},
+		{
+			name:   "Call",
+			tracer: api.DebugTracerCall,
+			input:  []byte(`{"type":"CALL","from":"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a","gas":"0x5208","gasUsed":"0x5208","input":"0x"}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.NotNil(t, res.Call)
+				require.Equal(t, "CALL", res.Call.Type)
+				require.Equal(t, address, res.Call.From)
+			},
+		},
+		{
+			name:   "PrestateInvalid",
+			tracer: api.DebugTracerPrestate,
+			input:  []byte(`{"0x25caa6":{"balance":"0x1"}}`),
+			err:    "invalid prestate trace: address incorrect length",
+		},
+		{
+			name:   "Prestate",
+			tracer: api.DebugTracerPrestate,
+			input:  []byte(`{"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a":{"balance":"0x1bc16d674ec80000","nonce":12}}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Nil(t, res.PrestateDiff)
+				require.Len(t, res.Prestate, 1)
+				require.Equal(t, uint64(12), *res.Prestate[address].Nonce)
+			},
+		},
+		{
+			name:         "PrestateDiff",
+			tracer:       api.DebugTracerPrestate,
+			tracerConfig: map[string]any{"diffMode": true},
+			input:        []byte(`{"post":{"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a":{"nonce":13}},"pre":{"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a":{"balance":"0x1bc16d674ec80000","nonce":12}}}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Nil(t, res.Prestate)
+				require.NotNil(t, res.PrestateDiff)
+				require.Equal(t, uint64(12), *res.PrestateDiff.Pre[address].Nonce)
+				require.Equal(t, uint64(13), *res.PrestateDiff.Post[address].Nonce)
+				require.Nil(t, res.PrestateDiff.Post[address].Balance)
+			},
+		},
+		{
+			name:         "PrestateDiffInvalid",
+			tracer:       api.DebugTracerPrestate,
+			tracerConfig: map[string]any{"diffMode": true},
+			input:        []byte(`{"post":{"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a":null},"pre":{}}`),
+			err:          "invalid prestate trace: post: account 0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a missing",
+		},
+		{
+			name:         "PrestateDiffModeDisabled",
+			tracer:       api.DebugTracerPrestate,
+			tracerConfig: map[string]any{"diffMode": false},
+			input:        []byte(`{"0x25caa6a3e9d3d6bc8a4a4b1d9c4a7d0d3c3f6b0a":{"balance":"0x1bc16d674ec80000","nonce":12}}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Nil(t, res.PrestateDiff)
+				require.Len(t, res.Prestate, 1)
+			},
+		},
+		{
+			name:         "PrestateDiffUnchanged",
+			tracer:       api.DebugTracerPrestate,
+			tracerConfig: map[string]any{"diffMode": true},
+			input:        []byte(`{"post":{},"pre":{}}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Nil(t, res.Prestate)
+				require.NotNil(t, res.PrestateDiff)
+				require.Empty(t, res.PrestateDiff.Pre)
+				require.Empty(t, res.PrestateDiff.Post)
+			},
+		},
+		{
+			name:   "FourByte",
+			tracer: api.DebugTracerFourByte,
+			input:  []byte(`{"0x27dc297e-128":1,"0x38cc4831-0":2}`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Equal(t, map[string]uint64{"0x27dc297e-128": 1, "0x38cc4831-0": 2}, res.FourByte)
+			},
+		},
+		{
+			name:   "FourByteInvalid",
+			tracer: api.DebugTracerFourByte,
+			input:  []byte(`{"0x27dc297e-128":"one"}`),
+			err:    "invalid 4byte trace: json: cannot unmarshal string into Go struct field .0x27dc297e-128 of type uint64",
+		},
+		{
+			name:   "Other",
+			tracer: api.DebugTracer("flatCallTracer"),
+			input:  []byte(`[{"action":{}}]`),
+			check: func(t *testing.T, res *api.DebugTrace) {
+				t.Helper()
+				require.Nil(t, res.Call)
+				require.Equal(t, `[{"action":{}}]`, res.String())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.NewDebugTrace(test.tracer, test.tracerConfig, test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.tracer, res.Tracer)
+				require.Equal(t, string(test.input), string(res.Raw))
+				test.check(t, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// DebugTracer is a tracer built in to execution clients, used to generate debug traces.
+type DebugTracer string
+
+const (
+	// DebugTracerCall generates the tree of calls made by a transaction.
+	DebugTracerCall DebugTracer = "callTracer"
+	// DebugTracerPrestate generates the state accessed by a transaction.
+	// In diff mode it generates the state changed by the transaction.
+	DebugTracerPrestate DebugTracer = "prestateTracer"
+	// DebugTracerFourByte generates counts of the function selectors and
+	// call data sizes of the calls made by a transaction.
+	DebugTracerFourByte DebugTracer = "4byteTracer"
+)
@@ -0,0 +1,89 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// PendingTransactionsFilter contains the filter for pending transactions.
+// The filter is applied by the client, as execution clients do not support
+// filtering pending transactions.
+//
+// A transaction matches the filter if it matches all of the supplied
+// criteria.  A criterion with multiple values is matched by any of them.
+type PendingTransactionsFilter struct {
+	// To are the recipients of the transaction.  Contract creations do not
+	// have a recipient, so never match.
+	To []types.Address
+	// From are the senders of the transaction.
+	From []types.Address
+	// Selectors are the 4-byte function selectors at the start of the
+	// transaction's input.
+	Selectors [][4]byte
+}
+
+// FilterableTransaction is the information about a transaction used by the
+// filter.  It is satisfied by *spec.Transaction.
+type FilterableTransaction interface {
+	// To returns the recipient of the transaction, or nil for a contract creation.
+	To() *types.Address
+	// From returns the sender of the transaction.
+	From() types.Address
+	// Input returns the input data of the transaction.
+	Input() []byte
+}
+
+// Matches returns true if the transaction matches the filter.
+// A nil transaction never matches.
+func (f *PendingTransactionsFilter) Matches(tx FilterableTransaction) bool {
+	if tx == nil {
+		return false
+	}
+
+	// A nil pointer held in the interface is not caught by the check above.
+	if value := reflect.ValueOf(tx); value.Kind() == reflect.Pointer && value.IsNil() {
+		return false
+	}
+
+	if len(f.To) > 0 {
+		to := tx.To()
+		if to == nil || !slices.Contains(f.To, *to) {
+			return false
+		}
+	}
+
+	if len(f.From) > 0 && !slices.Contains(f.From, tx.From()) {
+		return false
+	}
+
+	if len(f.Selectors) > 0 {
+		input := tx.Input()
+		if len(input) < 4 {
+			return false
+		}
+
+		if !slices.ContainsFunc(f.Selectors, func(selector [4]byte) bool {
+			return bytes.Equal(selector[:], input[:4])
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
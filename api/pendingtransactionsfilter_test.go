@@ -0,0 +1,162 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingTransactionsFilterMatches tests matching of pending transactions.
+func TestPendingTransactionsFilterMatches(t *testing.T) {
+	from := types.Address{0x01}
+	to := types.Address{0x02}
+	other := types.Address{0x03}
+	transfer := [4]byte{0xa9, 0x05, 0x9c, 0xbb}
+	approve := [4]byte{0x09, 0x5e, 0xa7, 0xb3}
+
+	tx := &spec.Transaction{
+		Type: spec.TransactionType2,
+		Type2Transaction: &spec.Type2Transaction{
+			From:  from,
+			To:    &to,
+			Input: append(transfer[:], 0x00, 0x01),
+		},
+	}
+
+	creation := &spec.Transaction{
+		Type: spec.TransactionType2,
+		Type2Transaction: &spec.Type2Transaction{
+			From:  from,
+			Input: []byte{0x60, 0x80},
+		},
+	}
+
+	shortInput := &spec.Transaction{
+		Type: spec.TransactionType2,
+		Type2Transaction: &spec.Type2Transaction{
+			From:  from,
+			To:    &to,
+			Input: []byte{0xa9, 0x05},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *api.PendingTransactionsFilter
+		tx       *spec.Transaction
+		expected bool
+	}{
+		{
+			name:     "Empty",
+			filter:   &api.PendingTransactionsFilter{},
+			tx:       tx,
+			expected: true,
+		},
+		{
+			name: "To",
+			filter: &api.PendingTransactionsFilter{
+				To: []types.Address{other, to},
+			},
+			tx:       tx,
+			expected: true,
+		},
+		{
+			name: "ToMismatch",
+			filter: &api.PendingTransactionsFilter{
+				To: []types.Address{other},
+			},
+			tx: tx,
+		},
+		{
+			name: "ToCreation",
+			filter: &api.PendingTransactionsFilter{
+				To: []types.Address{to},
+			},
+			tx: creation,
+		},
+		{
+			name: "From",
+			filter: &api.PendingTransactionsFilter{
+				From: []types.Address{from},
+			},
+			tx:       tx,
+			expected: true,
+		},
+		{
+			name: "FromMismatch",
+			filter: &api.PendingTransactionsFilter{
+				From: []types.Address{other},
+			},
+			tx: tx,
+		},
+		{
+			name: "Selector",
+			filter: &api.PendingTransactionsFilter{
+				Selectors: [][4]byte{approve, transfer},
+			},
+			tx:       tx,
+			expected: true,
+		},
+		{
+			name: "SelectorMismatch",
+			filter: &api.PendingTransactionsFilter{
+				Selectors: [][4]byte{approve},
+			},
+			tx: tx,
+		},
+		{
+			name: "SelectorShortInput",
+			filter: &api.PendingTransactionsFilter{
+				Selectors: [][4]byte{transfer},
+			},
+			tx: shortInput,
+		},
+		{
+			name: "All",
+			filter: &api.PendingTransactionsFilter{
+				To:        []types.Address{to},
+				From:      []types.Address{from},
+				Selectors: [][4]byte{transfer},
+			},
+			tx:       tx,
+			expected: true,
+		},
+		{
+			name: "AllOneMismatch",
+			filter: &api.PendingTransactionsFilter{
+				To:        []types.Address{to},
+				From:      []types.Address{other},
+				Selectors: [][4]byte{transfer},
+			},
+			tx: tx,
+		},
+		{
+			name:   "Nil",
+			filter: &api.PendingTransactionsFilter{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.filter.Matches(test.tx))
+		})
+	}
+
+	require.False(t, (&api.PendingTransactionsFilter{}).Matches(nil))
+}
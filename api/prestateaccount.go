@@ -0,0 +1,163 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// PrestateAccount is the state of an account, as generated by the prestate tracer.
+// Fields that are not present in the trace are nil.
+type PrestateAccount struct {
+	Balance  *big.Int
+	Nonce    *uint64
+	Code     []byte
+	CodeHash *types.Hash
+	Storage  map[types.Hash]types.Hash
+}
+
+// prestateAccountJSON is the spec representation of the struct.
+type prestateAccountJSON struct {
+	Balance  string            `json:"balance,omitempty"`
+	Nonce    json.RawMessage   `json:"nonce,omitempty"`
+	Code     string            `json:"code,omitempty"`
+	CodeHash string            `json:"codeHash,omitempty"`
+	Storage  map[string]string `json:"storage,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PrestateAccount) MarshalJSON() ([]byte, error) {
+	data := &prestateAccountJSON{}
+
+	if p.Balance != nil {
+		data.Balance = util.MarshalBigInt(p.Balance)
+	}
+
+	if p.Nonce != nil {
+		data.Nonce = json.RawMessage(strconv.FormatUint(*p.Nonce, 10))
+	}
+
+	if p.Code != nil {
+		data.Code = util.MarshalByteArray(p.Code)
+	}
+
+	if p.CodeHash != nil {
+		data.CodeHash = fmt.Sprintf("%#x", *p.CodeHash)
+	}
+
+	if p.Storage != nil {
+		data.Storage = make(map[string]string, len(p.Storage))
+		for k, v := range p.Storage {
+			data.Storage[fmt.Sprintf("%#x", k)] = fmt.Sprintf("%#x", v)
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PrestateAccount) UnmarshalJSON(input []byte) error {
+	var data prestateAccountJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return p.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (p *PrestateAccount) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (p *PrestateAccount) unpack(data *prestateAccountJSON) error {
+	var err error
+
+	if data.Balance != "" {
+		p.Balance, err = util.StrToBigInt("balance", data.Balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data.Nonce) > 0 {
+		nonce, err := unpackPrestateNonce(data.Nonce)
+		if err != nil {
+			return err
+		}
+
+		p.Nonce = &nonce
+	}
+
+	if data.Code != "" {
+		p.Code, err = util.StrToByteArray("code", data.Code)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.CodeHash != "" {
+		codeHash, err := util.StrToHash("code hash", data.CodeHash)
+		if err != nil {
+			return err
+		}
+
+		p.CodeHash = &codeHash
+	}
+
+	if data.Storage != nil {
+		p.Storage = make(map[types.Hash]types.Hash, len(data.Storage))
+		for k, v := range data.Storage {
+			key, err := util.StrToHash("storage key", k)
+			if err != nil {
+				return err
+			}
+
+			p.Storage[key], err = util.StrToHash("storage value", v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unpackPrestateNonce unpacks a nonce, which some execution clients provide
+// as a number and others as a hex string.
+func unpackPrestateNonce(input json.RawMessage) (uint64, error) {
+	var str string
+	if err := json.Unmarshal(input, &str); err == nil {
+		return util.StrToUint64("nonce", str)
+	}
+
+	var res uint64
+	if err := json.Unmarshal(input, &res); err != nil {
+		return 0, errors.Wrap(err, "nonce invalid")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrestateAccountJSON tests JSON for PrestateAccount.
+func TestPrestateAccountJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.prestateAccountJSON",
+		},
+		{
+			name:  "None",
+			input: []byte(`{}`),
+		},
+		{
+			name:  "BalanceInvalid",
+			input: []byte(`{"balance":"true"}`),
+			err:   "balance invalid",
+		},
+		{
+			name:  "NonceInvalid",
+			input: []byte(`{"nonce":true}`),
+			err:   "nonce invalid: json: cannot unmarshal bool into Go value of type uint64",
+		},
+		{
+			name:  "StorageKeyInvalid",
+			input: []byte(`{"storage":{"0x01":"0x0000000000000000000000000000000000000000000000000000000000000001"}}`),
+			err:   "storage key incorrect length",
+		},
+		{
+			name:  "Full",
+			input: []byte(`{"balance":"0x1bc16d674ec80000","nonce":12,"code":"0x6080","codeHash":"0x4f0a5a8e9e0c7b0dbd3c19b2fd94fa1e6b3fa3d1a0cfa6c49d1c4ee3b08c1e5c","storage":{"0x0000000000000000000000000000000000000000000000000000000000000000":"0x0000000000000000000000000000000000000000000000000000000000000001"}}`),
+		},
+		{
+			name:     "NonceHex",
+			input:    []byte(`{"balance":"0x0","nonce":"0xc"}`),
+			expected: []byte(`{"balance":"0x0","nonce":12}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.PrestateAccount
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+			}
+		})
+	}
+}
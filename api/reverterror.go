@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// errorSelector is the selector for Error(string).
+	errorSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector for Panic(uint256).
+	panicSelector = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// panicReasons are the descriptions of the panic codes generated by the solidity compiler.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized internal function",
+}
+
+// RevertError is an error for execution that reverted, with its revert data decoded.
+type RevertError struct {
+	// Data is the raw revert data.
+	Data []byte
+	// Selector is the selector of the revert data, if present.
+	Selector *[4]byte
+	// Reason is the reason supplied by Error(string), if present.
+	Reason string
+	// PanicCode is the code supplied by Panic(uint256), if present.
+	PanicCode *big.Int
+	// Err is the error from which the revert was obtained, if any.
+	Err error
+}
+
+// NewRevertError decodes revert data.
+// Data that starts with a selector other than those for Error(string) and
+// Panic(uint256) is considered to be a custom error, and is not decoded
+// further.
+func NewRevertError(data []byte) (*RevertError, error) {
+	res := &RevertError{
+		Data: bytes.Clone(data),
+	}
+
+	if len(data) == 0 {
+		return res, nil
+	}
+
+	if len(data) < 4 {
+		return nil, errors.New("revert data too short for selector")
+	}
+
+	selector := [4]byte(data[:4])
+	res.Selector = &selector
+
+	switch selector {
+	case errorSelector:
+		reason, err := decodeABIString(data[4:])
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid Error(string) revert data")
+		}
+
+		res.Reason = reason
+	case panicSelector:
+		if len(data) != 4+32 {
+			return nil, errors.New("invalid Panic(uint256) revert data")
+		}
+
+		res.PanicCode = new(big.Int).SetBytes(data[4:])
+	}
+
+	return res, nil
+}
+
+// IsCustom returns true if the revert data is a custom error.
+func (e *RevertError) IsCustom() bool {
+	return e.Selector != nil && *e.Selector != errorSelector && *e.Selector != panicSelector
+}
+
+// Error implements the error interface.
+func (e *RevertError) Error() string {
+	switch {
+	case e.Selector == nil:
+		return "execution reverted"
+	case *e.Selector == errorSelector:
+		return "execution reverted: " + e.Reason
+	case *e.Selector == panicSelector:
+		if e.PanicCode.IsUint64() {
+			if reason, exists := panicReasons[e.PanicCode.Uint64()]; exists {
+				return fmt.Sprintf("execution reverted: panic %#x (%s)", e.PanicCode, reason)
+			}
+		}
+
+		return fmt.Sprintf("execution reverted: panic %#x", e.PanicCode)
+	default:
+		return fmt.Sprintf("execution reverted: custom error %#x", e.Selector[:])
+	}
+}
+
+// Unwrap returns the error from which the revert was obtained.
+func (e *RevertError) Unwrap() error {
+	return e.Err
+}
+
+// decodeABIString decodes the ABI encoding of a single string.
+func decodeABIString(data []byte) (string, error) {
+	if len(data) < 64 {
+		return "", errors.New("data too short")
+	}
+
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-32) {
+		return "", errors.New("offset out of range")
+	}
+
+	start := offset.Uint64() + 32
+
+	length := new(big.Int).SetBytes(data[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data))-start {
+		return "", errors.New("length out of range")
+	}
+
+	return string(data[start : start+length.Uint64()]), nil
+}
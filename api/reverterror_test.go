@@ -0,0 +1,120 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// hexToBytes is a helper to create a byte slice given a hex string.
+func hexToBytes(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// TestNewRevertError tests decoding of revert data.
+func TestNewRevertError(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []byte
+		err       string
+		errString string
+		reason    string
+		panicCode uint64
+		custom    bool
+	}{
+		{
+			name:      "Empty",
+			errString: "execution reverted",
+		},
+		{
+			name: "SelectorShort",
+			data: hexToBytes("0x08c379"),
+			err:  "revert data too short for selector",
+		},
+		{
+			name:      "Error",
+			data:      hexToBytes("0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000014696e73756666696369656e742062616c616e6365000000000000000000000000"),
+			errString: "execution reverted: insufficient balance",
+			reason:    "insufficient balance",
+		},
+		{
+			name: "ErrorShort",
+			data: hexToBytes("0x08c379a00000000000000000000000000000000000000000000000000000000000000020"),
+			err:  "invalid Error(string) revert data: data too short",
+		},
+		{
+			name: "ErrorOffsetInvalid",
+			data: hexToBytes("0x08c379a000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000014"),
+			err:  "invalid Error(string) revert data: offset out of range",
+		},
+		{
+			name: "ErrorLengthInvalid",
+			data: hexToBytes("0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000040696e73756666696369656e742062616c616e6365000000000000000000000000"),
+			err:  "invalid Error(string) revert data: length out of range",
+		},
+		{
+			name:      "Panic",
+			data:      hexToBytes("0x4e487b710000000000000000000000000000000000000000000000000000000000000011"),
+			errString: "execution reverted: panic 0x11 (arithmetic underflow or overflow)",
+			panicCode: 0x11,
+		},
+		{
+			name:      "PanicUnknown",
+			data:      hexToBytes("0x4e487b710000000000000000000000000000000000000000000000000000000000000099"),
+			errString: "execution reverted: panic 0x99",
+			panicCode: 0x99,
+		},
+		{
+			name: "PanicShort",
+			data: hexToBytes("0x4e487b7100000000000000000000000000000000000000000000000000000000000011"),
+			err:  "invalid Panic(uint256) revert data",
+		},
+		{
+			name:      "Custom",
+			data:      hexToBytes("0xe450d38c0000000000000000000000000000000000000000000000000000000000000001"),
+			errString: "execution reverted: custom error 0xe450d38c",
+			custom:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.NewRevertError(test.data)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualError(t, res, test.errString)
+				require.Equal(t, test.data, res.Data)
+				require.Equal(t, test.reason, res.Reason)
+				require.Equal(t, test.custom, res.IsCustom())
+				if test.panicCode != 0 {
+					require.Equal(t, test.panicCode, res.PanicCode.Uint64())
+				} else {
+					require.Nil(t, res.PanicCode)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// Error codes returned by execution clients.
+const (
+	// RPCErrorCodeExecutionReverted is returned when execution of a call reverts.
+	RPCErrorCodeExecutionReverted = 3
+	// RPCErrorCodeInvalidRequest is returned when the request is not a valid JSON-RPC request.
+	RPCErrorCodeInvalidRequest = -32600
+	// RPCErrorCodeMethodNotFound is returned when the method is not supported.
+	RPCErrorCodeMethodNotFound = -32601
+	// RPCErrorCodeInvalidParams is returned when the parameters of the request are invalid.
+	RPCErrorCodeInvalidParams = -32602
+	// RPCErrorCodeInternal is returned when the execution client fails internally.
+	RPCErrorCodeInternal = -32603
+	// RPCErrorCodeLimitExceeded is returned when a request exceeds a limit of the execution client.
+	RPCErrorCodeLimitExceeded = -32005
+	// RPCErrorCodeHistoryPruned is returned when the requested history has been pruned.
+	RPCErrorCodeHistoryPruned = 4444
+)
+
+// RPCError is an error returned by the execution client in response to a JSON-RPC request.
+type RPCError struct {
+	Code    int
+	Message string
+	// Data is the raw JSON of the data supplied with the error, if any.
+	Data json.RawMessage
+}
+
+// Error implements the error interface.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// RevertData returns the revert data supplied with the error.
+// It returns false if the error does not contain revert data.
+func (e *RPCError) RevertData() ([]byte, bool) {
+	var data string
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, false
+	}
+
+	if !strings.HasPrefix(data, "0x") {
+		return nil, false
+	}
+
+	res, err := hex.DecodeString(data[2:])
+	if err != nil {
+		return nil, false
+	}
+
+	return res, true
+}
+
+// IsExecutionReverted returns true if the error states that execution reverted.
+func (e *RPCError) IsExecutionReverted() bool {
+	return e.Code == RPCErrorCodeExecutionReverted ||
+		strings.HasPrefix(strings.ToLower(e.Message), "execution reverted")
+}
+
+// RevertError returns the decoded revert for an error that states execution reverted.
+// It returns false if the error does not state that execution reverted.
+func (e *RPCError) RevertError() (*RevertError, bool) {
+	if !e.IsExecutionReverted() {
+		return nil, false
+	}
+
+	data, _ := e.RevertData()
+
+	res, err := NewRevertError(data)
+	if err != nil {
+		// Retain the raw data even if it cannot be decoded.
+		res = &RevertError{
+			Data: data,
+		}
+	}
+
+	res.Err = e
+
+	return res, true
+}
+
+// HTTPError is an error returned when the execution client responds with an HTTP error status.
+type HTTPError struct {
+	StatusCode int
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *HTTPError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("HTTP status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("HTTP status %d: %v", e.StatusCode, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// IsMethodNotFound returns true if the error states that the requested method is not supported.
+func IsMethodNotFound(err error) bool {
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	if rpcErr.Code == RPCErrorCodeMethodNotFound {
+		return true
+	}
+
+	// Some execution clients return a generic error code for unknown methods.
+	msg := strings.ToLower(rpcErr.Message)
+
+	return strings.Contains(msg, "method not found") ||
+		(strings.Contains(msg, "method") && strings.Contains(msg, "does not exist"))
+}
+
+// IsRateLimited returns true if the error states that the request was rejected due to rate limiting.
+func IsRateLimited(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	msg := strings.ToLower(rpcErr.Message)
+
+	return strings.Contains(msg, "rate limit") ||
+		strings.Contains(msg, "too many requests") ||
+		(rpcErr.Code == RPCErrorCodeLimitExceeded && strings.Contains(msg, "request"))
+}
+
+// IsHistoryPruned returns true if the error states that the requested history has been pruned.
+func IsHistoryPruned(err error) bool {
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.Code == RPCErrorCodeHistoryPruned ||
+		strings.Contains(strings.ToLower(rpcErr.Message), "pruned history")
+}
+
+// IsExecutionReverted returns true if the error states that execution reverted.
+func IsExecutionReverted(err error) bool {
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return true
+	}
+
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.IsExecutionReverted()
+}
+
+// IsTransient returns true if the error is transient, so a repeat of the
+// request may succeed.
+func IsTransient(err error) bool {
+	if IsRateLimited(err) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	if IsUndelivered(err) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsUndelivered returns true if the error states that the request was not
+// processed by the execution client, so it can be sent again even if it is
+// not idempotent.
+// Any other error, for example a timeout, may have occurred after the
+// execution client received the request.
+func IsUndelivered(err error) bool {
+	if IsRateLimited(err) {
+		// Rejected without being processed.
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		// Connection not established, so the request was not sent.
+		return true
+	}
+
+	return false
+}
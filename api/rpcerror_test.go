@@ -0,0 +1,181 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRPCErrorChecks tests the checks for RPC errors.
+func TestRPCErrorChecks(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		methodNotFound   bool
+		rateLimited      bool
+		historyPruned    bool
+		executionReverts bool
+		transient        bool
+		undelivered      bool
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "Plain",
+			err:  errors.New("connection refused"),
+		},
+		{
+			name:           "MethodNotFound",
+			err:            &api.RPCError{Code: -32601, Message: "the method trace_block does not exist/is not available"},
+			methodNotFound: true,
+		},
+		{
+			name:           "MethodNotFoundGenericCode",
+			err:            &api.RPCError{Code: -32000, Message: "Method not found"},
+			methodNotFound: true,
+		},
+		{
+			name:           "MethodNotFoundWrapped",
+			err:            errors.Wrap(&api.RPCError{Code: -32601, Message: "Method not found"}, "call failed"),
+			methodNotFound: true,
+		},
+		{
+			name:        "RateLimitedHTTP",
+			err:         errors.Wrap(&api.HTTPError{StatusCode: 429}, "call failed"),
+			rateLimited: true,
+			transient:   true,
+			undelivered: true,
+		},
+		{
+			name:      "HTTPServerError",
+			err:       &api.HTTPError{StatusCode: 503},
+			transient: true,
+		},
+		{
+			name: "HTTPInternalServerError",
+			err:  &api.HTTPError{StatusCode: 500},
+		},
+		{
+			name:        "RateLimitedCode",
+			err:         &api.RPCError{Code: -32005, Message: "daily request count exceeded, request rate limited"},
+			rateLimited: true,
+			transient:   true,
+			undelivered: true,
+		},
+		{
+			name:        "DialFailure",
+			err:         errors.Wrap(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, "call failed"),
+			transient:   true,
+			undelivered: true,
+		},
+		{
+			name:      "ReadFailure",
+			err:       &net.OpError{Op: "read", Net: "tcp", Err: io.EOF},
+			transient: true,
+		},
+		{
+			name:      "DeadlineExceeded",
+			err:       errors.Wrap(context.DeadlineExceeded, "call failed"),
+			transient: true,
+		},
+		{
+			name: "LimitExceededResults",
+			err:  &api.RPCError{Code: -32005, Message: "query returned more than 10000 results"},
+		},
+		{
+			name:          "HistoryPruned",
+			err:           &api.RPCError{Code: 4444, Message: "pruned history unavailable"},
+			historyPruned: true,
+		},
+		{
+			name:             "ExecutionReverted",
+			err:              &api.RPCError{Code: 3, Message: "execution reverted", Data: json.RawMessage(`"0x"`)},
+			executionReverts: true,
+		},
+		{
+			name:             "ExecutionRevertedMessage",
+			err:              &api.RPCError{Code: -32000, Message: "execution reverted"},
+			executionReverts: true,
+		},
+		{
+			name:             "RevertError",
+			err:              errors.Wrap(&api.RevertError{}, "eth_call failed"),
+			executionReverts: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.methodNotFound, api.IsMethodNotFound(test.err))
+			require.Equal(t, test.rateLimited, api.IsRateLimited(test.err))
+			require.Equal(t, test.historyPruned, api.IsHistoryPruned(test.err))
+			require.Equal(t, test.executionReverts, api.IsExecutionReverted(test.err))
+			require.Equal(t, test.transient, api.IsTransient(test.err))
+			require.Equal(t, test.undelivered, api.IsUndelivered(test.err))
+		})
+	}
+}
+
+// TestRPCErrorRevertError tests obtaining revert errors from RPC errors.
+func TestRPCErrorRevertError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *api.RPCError
+		isRevert bool
+		expected string
+	}{
+		{
+			name: "NotReverted",
+			err:  &api.RPCError{Code: -32000, Message: "nonce too low"},
+		},
+		{
+			name:     "NoData",
+			err:      &api.RPCError{Code: -32000, Message: "execution reverted"},
+			isRevert: true,
+			expected: "execution reverted",
+		},
+		{
+			name:     "Panic",
+			err:      &api.RPCError{Code: 3, Message: "execution reverted: assert(false)", Data: json.RawMessage(`"0x4e487b710000000000000000000000000000000000000000000000000000000000000001"`)},
+			isRevert: true,
+			expected: "execution reverted: panic 0x1 (assertion failed)",
+		},
+		{
+			name:     "DataInvalid",
+			err:      &api.RPCError{Code: 3, Message: "execution reverted", Data: json.RawMessage(`"0x0102"`)},
+			isRevert: true,
+			expected: "execution reverted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, isRevert := test.err.RevertError()
+			require.Equal(t, test.isRevert, isRevert)
+			if isRevert {
+				require.EqualError(t, res, test.expected)
+				require.ErrorIs(t, res, test.err)
+			}
+		})
+	}
+}
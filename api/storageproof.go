@@ -0,0 +1,150 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/trie"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// StorageProof contains the proof of the value of a storage slot.
+type StorageProof struct {
+	Key   types.Hash
+	Value *big.Int
+	Proof [][]byte
+}
+
+// storageProofJSON is the spec representation of the struct.
+type storageProofJSON struct {
+	Key   string   `json:"key"`
+	Value string   `json:"value"`
+	Proof []string `json:"proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *StorageProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&storageProofJSON{
+		Key:   fmt.Sprintf("%#x", s.Key),
+		Value: util.MarshalBigInt(s.Value),
+		Proof: marshalProof(s.Proof),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *StorageProof) UnmarshalJSON(input []byte) error {
+	var data storageProofJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return s.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (s *StorageProof) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (s *StorageProof) unpack(data *storageProofJSON) error {
+	var err error
+
+	if data.Key == "" {
+		return errors.New("key missing")
+	}
+	// Keys are returned as supplied in the request, so may be shorter than 32 bytes.
+	key, err := hex.DecodeString(util.PreUnmarshalHexString(data.Key))
+	if err != nil {
+		return errors.Wrap(err, "key invalid")
+	}
+
+	if len(key) > len(s.Key) {
+		return errors.New("key too long")
+	}
+
+	copy(s.Key[len(s.Key)-len(key):], key)
+
+	s.Value, err = util.StrToBigInt("value", data.Value)
+	if err != nil {
+		return err
+	}
+
+	s.Proof, err = unpackProof(data.Proof)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Verify checks the storage proof against the given storage hash.
+func (s *StorageProof) Verify(storageHash types.Root) error {
+	key := util.Keccak256(s.Key[:])
+
+	value, err := trie.VerifyProof(storageHash, key[:], s.Proof)
+	if err != nil {
+		return errors.Wrap(err, "invalid proof")
+	}
+
+	expected := new(big.Int)
+	if value != nil {
+		// Values are held in the trie as RLP-encoded integers.
+		expected, err = util.NewRLPDecoder(value).BigInt()
+		if err != nil {
+			return errors.Wrap(err, "invalid value in storage")
+		}
+	}
+
+	if s.Value == nil || s.Value.Cmp(expected) != 0 {
+		return errors.New("value does not match storage")
+	}
+
+	return nil
+}
+
+// marshalProof returns the spec representation of a proof.
+func marshalProof(proof [][]byte) []string {
+	res := make([]string, len(proof))
+	for i := range proof {
+		res[i] = util.MarshalByteArray(proof[i])
+	}
+
+	return res
+}
+
+// unpackProof unpacks the spec representation of a proof.
+func unpackProof(data []string) ([][]byte, error) {
+	res := make([][]byte, len(data))
+	for i := range data {
+		var err error
+
+		res[i], err = util.StrToByteArray(fmt.Sprintf("proof node %d", i), data[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
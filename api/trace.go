@@ -0,0 +1,127 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// Trace is a single action within a block, as generated by the trace module.
+type Trace struct {
+	CallTrace
+	BlockHash   types.Hash
+	BlockNumber uint32
+	// TransactionHash is the hash of the transaction that carried out the
+	// action; it is nil for actions that are not part of a transaction, such
+	// as block rewards.
+	TransactionHash     *types.Hash
+	TransactionPosition *uint32
+}
+
+// traceJSON is the spec representation of the struct.
+type traceJSON struct {
+	Action              *callTraceActionJSON `json:"action"`
+	BlockHash           string               `json:"blockHash"`
+	BlockNumber         uint32               `json:"blockNumber"`
+	Error               string               `json:"error,omitempty"`
+	Result              *callTraceResultJSON `json:"result"`
+	Subtraces           uint32               `json:"subtraces"`
+	TraceAddress        []uint32             `json:"traceAddress"`
+	TransactionHash     string               `json:"transactionHash,omitempty"`
+	TransactionPosition *uint32              `json:"transactionPosition,omitempty"`
+	Type                string               `json:"type"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *Trace) MarshalJSON() ([]byte, error) {
+	callTrace := t.CallTrace.pack()
+
+	data := &traceJSON{
+		Action:              callTrace.Action,
+		BlockHash:           fmt.Sprintf("%#x", t.BlockHash),
+		BlockNumber:         t.BlockNumber,
+		Error:               callTrace.Error,
+		Result:              callTrace.Result,
+		Subtraces:           callTrace.Subtraces,
+		TraceAddress:        callTrace.TraceAddress,
+		TransactionPosition: t.TransactionPosition,
+		Type:                callTrace.Type,
+	}
+
+	if t.TransactionHash != nil {
+		data.TransactionHash = fmt.Sprintf("%#x", *t.TransactionHash)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Trace) UnmarshalJSON(input []byte) error {
+	var data traceJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return t.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (t *Trace) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (t *Trace) unpack(data *traceJSON) error {
+	var err error
+
+	if err := t.CallTrace.unpack(&callTraceJSON{
+		Action:       data.Action,
+		Error:        data.Error,
+		Result:       data.Result,
+		Subtraces:    data.Subtraces,
+		TraceAddress: data.TraceAddress,
+		Type:         data.Type,
+	}); err != nil {
+		return err
+	}
+
+	t.BlockHash, err = util.StrToHash("block hash", data.BlockHash)
+	if err != nil {
+		return err
+	}
+
+	t.BlockNumber = data.BlockNumber
+
+	if data.TransactionHash != "" {
+		transactionHash, err := util.StrToHash("transaction hash", data.TransactionHash)
+		if err != nil {
+			return err
+		}
+
+		t.TransactionHash = &transactionHash
+	}
+
+	t.TransactionPosition = data.TransactionPosition
+
+	return nil
+}
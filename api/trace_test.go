@@ -0,0 +1,98 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTraceJSON tests JSON for Trace.
+func TestTraceJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.traceJSON",
+		},
+		{
+			name:  "TypeMissing",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","transactionPosition":0}`),
+			err:   "type missing",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"blockNumber":46147,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","transactionPosition":0,"type":"call"}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "TransactionHashInvalid",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"transactionHash":"true","transactionPosition":0,"type":"call"}`),
+			err:   "transaction hash invalid: encoding/hex: invalid byte: U+0074 't'",
+		},
+		{
+			name:  "Call",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","transactionPosition":0,"type":"call"}`),
+		},
+		{
+			name:  "Create",
+			input: []byte(`{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gas":"0x1f3a0","init":"0x6080604052","value":"0x0"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":{"address":"0x5fbdb2315678afecb367f032d93f642f64180aa3","code":"0x6080","gasUsed":"0x1b6b7"},"subtraces":0,"traceAddress":[0],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","transactionPosition":3,"type":"create"}`),
+		},
+		{
+			name:  "Suicide",
+			input: []byte(`{"action":{"address":"0x5fbdb2315678afecb367f032d93f642f64180aa3","refundAddress":"0xa1e4380a3b1f749673e270229993ee55f35663b4","balance":"0xde0b6b3a7640000"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":null,"subtraces":0,"traceAddress":[1],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","transactionPosition":3,"type":"suicide"}`),
+		},
+		{
+			name:  "Reward",
+			input: []byte(`{"action":{"author":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","rewardType":"block","value":"0x4563918244f40000"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":null,"subtraces":0,"traceAddress":[],"type":"reward"}`),
+		},
+		{
+			name:     "RewardNulls",
+			input:    []byte(`{"action":{"author":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","rewardType":"uncle","value":"0x3782dace9d900000"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":null,"subtraces":0,"traceAddress":[],"transactionHash":null,"transactionPosition":null,"type":"reward"}`),
+			expected: []byte(`{"action":{"author":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","rewardType":"uncle","value":"0x3782dace9d900000"},"blockHash":"0x6c5e6b0a2d5a0b3bd0e3f5c5b3a8e8e4d4e7a0d5cf6b2c4e0b1e1c7c6b0e6a5f","blockNumber":46147,"result":null,"subtraces":0,"traceAddress":[],"type":"reward"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.Trace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
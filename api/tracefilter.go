@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// TraceFilter contains the trace filter.
+type TraceFilter struct {
+	FromBlock   string
+	ToBlock     string
+	FromAddress []types.Address
+	ToAddress   []types.Address
+	// After is the number of matching traces to skip.
+	After uint32
+	// Count is the maximum number of traces to return; 0 means no limit.
+	Count uint32
+}
+
+// traceFilterJSON is the spec representation of the struct.
+type traceFilterJSON struct {
+	FromBlock   string   `json:"fromBlock,omitempty"`
+	ToBlock     string   `json:"toBlock,omitempty"`
+	FromAddress []string `json:"fromAddress,omitempty"`
+	ToAddress   []string `json:"toAddress,omitempty"`
+	After       uint32   `json:"after,omitempty"`
+	Count       uint32   `json:"count,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *TraceFilter) MarshalJSON() ([]byte, error) {
+	traceFilterJSON := &traceFilterJSON{
+		FromBlock: t.FromBlock,
+		ToBlock:   t.ToBlock,
+		After:     t.After,
+		Count:     t.Count,
+	}
+
+	for _, address := range t.FromAddress {
+		traceFilterJSON.FromAddress = append(traceFilterJSON.FromAddress, util.MarshalAddress(address[:]))
+	}
+
+	for _, address := range t.ToAddress {
+		traceFilterJSON.ToAddress = append(traceFilterJSON.ToAddress, util.MarshalAddress(address[:]))
+	}
+
+	return json.Marshal(traceFilterJSON)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TraceFilter) UnmarshalJSON(input []byte) error {
+	var traceFilterJSON traceFilterJSON
+	if err := json.Unmarshal(input, &traceFilterJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return t.unpack(&traceFilterJSON)
+}
+
+// String returns a string version of the structure.
+func (t *TraceFilter) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (t *TraceFilter) unpack(data *traceFilterJSON) error {
+	switch strings.ToLower(data.FromBlock) {
+	case "":
+		// Nothing to do.
+	case "earliest", "pending", "latest", "safe", "finalized":
+		// State name.
+		t.FromBlock = data.FromBlock
+	default:
+		// Block number.
+		if _, err := util.StrToUint32("from block", data.FromBlock); err != nil {
+			return err
+		}
+
+		t.FromBlock = data.FromBlock
+	}
+
+	switch strings.ToLower(data.ToBlock) {
+	case "":
+		// Nothing to do.
+	case "earliest", "pending", "latest", "safe", "finalized":
+		// State name.
+		t.ToBlock = data.ToBlock
+	default:
+		// Block number.
+		if _, err := util.StrToUint32("to block", data.ToBlock); err != nil {
+			return err
+		}
+
+		t.ToBlock = data.ToBlock
+	}
+
+	if data.FromAddress != nil {
+		t.FromAddress = make([]types.Address, len(data.FromAddress))
+		for i := range data.FromAddress {
+			var err error
+
+			t.FromAddress[i], err = util.StrToAddress("from address", data.FromAddress[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if data.ToAddress != nil {
+		t.ToAddress = make([]types.Address, len(data.ToAddress))
+		for i := range data.ToAddress {
+			var err error
+
+			t.ToAddress[i], err = util.StrToAddress("to address", data.ToAddress[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	t.After = data.After
+	t.Count = data.Count
+
+	return nil
+}
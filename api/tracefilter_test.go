@@ -0,0 +1,94 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTraceFilterJSON tests JSON for TraceFilter.
+func TestTraceFilterJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.traceFilterJSON",
+		},
+		{
+			name:  "FromBlockInvalid",
+			input: []byte(`{"fromBlock":"true","toBlock":"0x7d0"}`),
+			err:   "from block invalid: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "ToBlockInvalid",
+			input: []byte(`{"fromBlock":"0x3e8","toBlock":"true"}`),
+			err:   "to block invalid: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "FromAddressInvalid",
+			input: []byte(`{"fromBlock":"0x3e8","toBlock":"0x7d0","fromAddress":["true"]}`),
+			err:   "from address invalid: encoding/hex: invalid byte: U+0074 't'",
+		},
+		{
+			name:  "ToAddressInvalid",
+			input: []byte(`{"fromBlock":"0x3e8","toBlock":"0x7d0","toAddress":["true"]}`),
+			err:   "to address invalid: encoding/hex: invalid byte: U+0074 't'",
+		},
+		{
+			name:  "Minimal",
+			input: []byte(`{}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"fromBlock":"0x3e8","toBlock":"0x7d0","fromAddress":["0xa1e4380a3b1f749673e270229993ee55f35663b4"],"toAddress":["0x5df9b87991262f6ba471f09758cde1c0fc1de734","0xa700f2b3d8ebe35cef86fcc3c2105daff41617be"],"after":10,"count":100}`),
+		},
+		{
+			name:  "GoodTextBlocks",
+			input: []byte(`{"fromBlock":"earliest","toBlock":"latest","toAddress":["0x5df9b87991262f6ba471f09758cde1c0fc1de734"]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.TraceFilter
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 type TransactionResult struct {
 	Output          []byte
 	StateDiff       map[types.Address]*TransactionStateDiff
+	Trace           []*CallTrace
+	VMTrace         *VMTrace
 	TransactionHash types.Hash
 }
 
@@ -33,7 +35,9 @@ type TransactionResult struct {
 type transactionResultJSON struct {
 	Output          string                           `json:"output,omitempty"`
 	StateDiff       map[string]*TransactionStateDiff `json:"stateDiff,omitempty"`
-	TransactionHash string                           `json:"transactionHash"`
+	Trace           []*CallTrace                     `json:"trace,omitempty"`
+	VMTrace         *VMTrace                         `json:"vmTrace,omitempty"`
+	TransactionHash string                           `json:"transactionHash,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -46,6 +50,8 @@ func (t *TransactionResult) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&transactionResultJSON{
 		Output:          fmt.Sprintf("%#x", t.Output),
 		StateDiff:       stateDiff,
+		Trace:           t.Trace,
+		VMTrace:         t.VMTrace,
 		TransactionHash: fmt.Sprintf("%#x", t.TransactionHash),
 	})
 }
@@ -93,9 +99,21 @@ func (t *TransactionResult) unpack(data *transactionResultJSON) error {
 
 	t.StateDiff = stateDiff
 
-	t.TransactionHash, err = util.StrToHash("transaction hash", data.TransactionHash)
-	if err != nil {
-		return err
+	for i := range data.Trace {
+		if data.Trace[i] == nil {
+			return fmt.Errorf("trace %d missing", i)
+		}
+	}
+
+	t.Trace = data.Trace
+	t.VMTrace = data.VMTrace
+
+	// The transaction hash is not supplied when replaying a single transaction.
+	if data.TransactionHash != "" {
+		t.TransactionHash, err = util.StrToHash("transaction hash", data.TransactionHash)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -62,6 +62,16 @@ func TestTransactionResult(t *testing.T) {
 			input:    []byte(`{"output":"0x0000000000000000000000000000000000000000000000000000000000000001","stateDiff":{"0x4581be18cd63c562cd28f4fb82ac6a4e51f7b93f":{"balance":{"*":{"from":"0x278f482045b836c3","to":"0x2769512f672330ed"}},"code":"=","nonce":{"*":{"from":"0xf4","to":"0xf5"}},"storage":{}},"0xa700f2b3d8ebe35cef86fcc3c2105daff41617be":{"balance":"=","code":"=","nonce":"=","storage":{"0x060ac38f43eaa9a6ea5d69fb296993be6072bafed76748ba5e27dd187da0b70f":{"*":{"from":"0x0000000000000000000000000000000000000000000000000000000000000000","to":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"}}}},"0xea674fdde714fd979de3edf0f56aa9716b898ec8":{"balance":{"*":{"from":"0x361b7b53e39f6d12cc","to":"0x361b7b937f90ad50cc"}},"code":"=","nonce":"=","storage":{}}},"trace":[],"vmTrace":null,"transactionHash":"0xb6efb3d07c9c5f193903b75784dcb5e2dfc18ed35b1a61e7a08c24c9f5a50ea1"}`),
 			expected: []byte(`{"output":"0x0000000000000000000000000000000000000000000000000000000000000001","stateDiff":{"0x4581be18cd63c562cd28f4fb82ac6a4e51f7b93f":{"balance":{"*":{"from":"0x278f482045b836c3","to":"0x2769512f672330ed"}},"nonce":{"*":{"from":"0xf4","to":"0xf5"}}},"0xa700f2b3d8ebe35cef86fcc3c2105daff41617be":{"storage":{"0x060ac38f43eaa9a6ea5d69fb296993be6072bafed76748ba5e27dd187da0b70f":{"*":{"from":"0x0000000000000000000000000000000000000000000000000000000000000000","to":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"}}}},"0xea674fdde714fd979de3edf0f56aa9716b898ec8":{"balance":{"*":{"from":"0x361b7b53e39f6d12cc","to":"0x361b7b937f90ad50cc"}}}},"transactionHash":"0xb6efb3d07c9c5f193903b75784dcb5e2dfc18ed35b1a61e7a08c24c9f5a50ea1"}`),
 		},
+		{
+			name:  "TraceMissing",
+			input: []byte(`{"output":"0x","trace":[null],"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"}`),
+			err:   "trace 0 missing",
+		},
+		{
+			name:     "Traces",
+			input:    []byte(`{"output":"0x","trace":[{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}],"vmTrace":{"code":"0x","ops":[]},"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"}`),
+			expected: []byte(`{"trace":[{"action":{"from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","callType":"call","gas":"0x0","input":"0x","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","value":"0x7a69"},"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],"type":"call"}],"vmTrace":{"code":"0x","ops":[]},"transactionHash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"}`),
+		},
 	}
 
 	for _, test := range tests {
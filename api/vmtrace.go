@@ -0,0 +1,247 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// VMTrace is the virtual machine execution of code, as generated by the trace module.
+type VMTrace struct {
+	Code []byte
+	Ops  []*VMTraceOp
+}
+
+// VMTraceOp is a single operation executed by the virtual machine.
+type VMTraceOp struct {
+	// Op is the name of the operation; not all execution clients supply it.
+	Op   string
+	PC   uint64
+	Cost uint64
+	// Ex is the result of executing the operation; it is nil if the operation failed.
+	Ex *VMTraceEx
+	// Sub is the execution of the code called by the operation, if any.
+	Sub *VMTrace
+}
+
+// VMTraceEx is the result of executing an operation.
+type VMTraceEx struct {
+	// Used is the gas remaining after the operation.
+	Used uint64
+	// Push are the values pushed to the stack by the operation.
+	Push []*big.Int
+	// Mem is the memory written by the operation, if any.
+	Mem *VMTraceMem
+	// Store is the storage written by the operation, if any.
+	Store *VMTraceStore
+}
+
+// VMTraceMem is memory written by an operation.
+type VMTraceMem struct {
+	Offset uint64
+	Data   []byte
+}
+
+// VMTraceStore is storage written by an operation.
+type VMTraceStore struct {
+	Key   *big.Int
+	Value *big.Int
+}
+
+// vmTraceJSON is the spec representation of the struct.
+type vmTraceJSON struct {
+	Code string           `json:"code"`
+	Ops  []*vmTraceOpJSON `json:"ops"`
+}
+
+// vmTraceOpJSON is the spec representation of an operation.
+type vmTraceOpJSON struct {
+	Cost uint64         `json:"cost"`
+	Ex   *vmTraceExJSON `json:"ex"`
+	PC   uint64         `json:"pc"`
+	Sub  *VMTrace       `json:"sub"`
+	Op   string         `json:"op,omitempty"`
+}
+
+// vmTraceExJSON is the spec representation of the result of an operation.
+type vmTraceExJSON struct {
+	Mem   *vmTraceMemJSON   `json:"mem"`
+	Push  []string          `json:"push"`
+	Store *vmTraceStoreJSON `json:"store"`
+	Used  uint64            `json:"used"`
+}
+
+// vmTraceMemJSON is the spec representation of memory written by an operation.
+type vmTraceMemJSON struct {
+	Data string `json:"data"`
+	Off  uint64 `json:"off"`
+}
+
+// vmTraceStoreJSON is the spec representation of storage written by an operation.
+type vmTraceStoreJSON struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *VMTrace) MarshalJSON() ([]byte, error) {
+	data := &vmTraceJSON{
+		Code: util.MarshalByteArray(v.Code),
+		Ops:  make([]*vmTraceOpJSON, len(v.Ops)),
+	}
+
+	for i, op := range v.Ops {
+		if op == nil {
+			return nil, fmt.Errorf("op %d missing", i)
+		}
+
+		data.Ops[i] = &vmTraceOpJSON{
+			Cost: op.Cost,
+			PC:   op.PC,
+			Sub:  op.Sub,
+			Op:   op.Op,
+		}
+
+		if op.Ex != nil {
+			data.Ops[i].Ex = op.Ex.pack()
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VMTrace) UnmarshalJSON(input []byte) error {
+	var data vmTraceJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return v.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (v *VMTrace) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+func (v *VMTrace) unpack(data *vmTraceJSON) error {
+	var err error
+
+	v.Code, err = util.StrToByteArray("code", data.Code)
+	if err != nil {
+		return err
+	}
+
+	v.Ops = make([]*VMTraceOp, len(data.Ops))
+	for i, opData := range data.Ops {
+		if opData == nil {
+			return fmt.Errorf("op %d missing", i)
+		}
+
+		v.Ops[i] = &VMTraceOp{
+			Op:   opData.Op,
+			PC:   opData.PC,
+			Cost: opData.Cost,
+			Sub:  opData.Sub,
+		}
+
+		if opData.Ex != nil {
+			v.Ops[i].Ex = &VMTraceEx{}
+			if err := v.Ops[i].Ex.unpack(opData.Ex); err != nil {
+				return errors.Wrapf(err, "op %d", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *VMTraceEx) pack() *vmTraceExJSON {
+	data := &vmTraceExJSON{
+		Push: make([]string, len(e.Push)),
+		Used: e.Used,
+	}
+
+	for i := range e.Push {
+		data.Push[i] = util.MarshalBigInt(e.Push[i])
+	}
+
+	if e.Mem != nil {
+		data.Mem = &vmTraceMemJSON{
+			Data: util.MarshalByteArray(e.Mem.Data),
+			Off:  e.Mem.Offset,
+		}
+	}
+
+	if e.Store != nil {
+		data.Store = &vmTraceStoreJSON{
+			Key: util.MarshalBigInt(e.Store.Key),
+			Val: util.MarshalBigInt(e.Store.Value),
+		}
+	}
+
+	return data
+}
+
+func (e *VMTraceEx) unpack(data *vmTraceExJSON) error {
+	var err error
+
+	e.Used = data.Used
+
+	e.Push = make([]*big.Int, len(data.Push))
+	for i := range data.Push {
+		e.Push[i], err = util.StrToBigInt("push", data.Push[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.Mem != nil {
+		e.Mem = &VMTraceMem{
+			Offset: data.Mem.Off,
+		}
+
+		e.Mem.Data, err = util.StrToByteArray("mem data", data.Mem.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.Store != nil {
+		e.Store = &VMTraceStore{}
+
+		e.Store.Key, err = util.StrToBigInt("store key", data.Store.Key)
+		if err != nil {
+			return err
+		}
+
+		e.Store.Value, err = util.StrToBigInt("store value", data.Store.Val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVMTraceJSON tests JSON for VMTrace.
+func TestVMTraceJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.vmTraceJSON",
+		},
+		{
+			name:  "CodeMissing",
+			input: []byte(`{"ops":[]}`),
+			err:   "code missing",
+		},
+		{
+			name:  "OpMissing",
+			input: []byte(`{"code":"0x","ops":[null]}`),
+			err:   "op 0 missing",
+		},
+		{
+			name:  "PushInvalid",
+			input: []byte(`{"code":"0x6080","ops":[{"cost":3,"ex":{"mem":null,"push":["true"],"store":null,"used":78997},"pc":0,"sub":null}]}`),
+			err:   "op 0: push invalid",
+		},
+		{
+			name:  "NoOps",
+			input: []byte(`{"code":"0x","ops":[]}`),
+		},
+		{
+			name:  "Ops",
+			input: []byte(`{"code":"0x608060405260016000556000f1","ops":[{"cost":3,"ex":{"mem":null,"push":["0x80"],"store":null,"used":78997},"pc":0,"sub":null,"op":"PUSH1"},{"cost":12,"ex":{"mem":{"data":"0x0000000000000000000000000000000000000000000000000000000000000080","off":64},"push":[],"store":null,"used":78985},"pc":4,"sub":null,"op":"MSTORE"},{"cost":20000,"ex":{"mem":null,"push":[],"store":{"key":"0x0","val":"0x1"},"used":58985},"pc":9,"sub":null,"op":"SSTORE"},{"cost":700,"ex":{"mem":null,"push":["0x1"],"store":null,"used":58000},"pc":12,"sub":{"code":"0x00","ops":[{"cost":0,"ex":{"mem":null,"push":[],"store":null,"used":57000},"pc":0,"sub":null,"op":"STOP"}]},"op":"CALL"},{"cost":3,"ex":null,"pc":13,"sub":null,"op":"INVALID"}]}`),
+		},
+		{
+			name:  "NoOpNames",
+			input: []byte(`{"code":"0x6080","ops":[{"cost":3,"ex":{"mem":null,"push":["0x80"],"store":null,"used":78997},"pc":0,"sub":null}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.VMTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if test.expected != nil {
+					require.Equal(t, string(test.expected), string(rt))
+				} else {
+					require.Equal(t, string(test.input), string(rt))
+				}
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bloom provides calculation and checking of logs blooms.
+package bloom
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// Length is the length of a logs bloom, in bytes.
+const Length = 256
+
+// Bloom is a 2048-bit logs bloom, as defined in section 4.3.1 of the yellow paper.
+type Bloom [Length]byte
+
+// New creates a bloom from its byte representation.
+func New(input []byte) (Bloom, error) {
+	var res Bloom
+	if len(input) != Length {
+		return res, fmt.Errorf("incorrect length %d for bloom", len(input))
+	}
+
+	copy(res[:], input)
+
+	return res, nil
+}
+
+// FromLogs calculates the bloom for a set of logs.
+func FromLogs(logs []*spec.BerlinTransactionEvent) Bloom {
+	var res Bloom
+	for _, log := range logs {
+		res.AddAddress(log.Address)
+
+		for _, topic := range log.Topics {
+			res.AddTopic(topic)
+		}
+	}
+
+	return res
+}
+
+// Add adds data to the bloom.
+func (b *Bloom) Add(data []byte) {
+	for _, bit := range bits(data) {
+		b[Length-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// AddAddress adds an address to the bloom.
+func (b *Bloom) AddAddress(address types.Address) {
+	b.Add(address[:])
+}
+
+// AddTopic adds a topic to the bloom.
+func (b *Bloom) AddTopic(topic types.Hash) {
+	b.Add(topic[:])
+}
+
+// Or merges the supplied bloom into the bloom.
+func (b *Bloom) Or(other Bloom) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+// Test returns true if the data may be present in the bloom.
+// A false result means that the data is definitely not present.
+func (b *Bloom) Test(data []byte) bool {
+	for _, bit := range bits(data) {
+		if b[Length-1-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TestAddress returns true if the address may be present in the bloom.
+// A false result means that the address is definitely not present.
+func (b *Bloom) TestAddress(address types.Address) bool {
+	return b.Test(address[:])
+}
+
+// TestTopic returns true if the topic may be present in the bloom.
+// A false result means that the topic is definitely not present.
+func (b *Bloom) TestTopic(topic types.Hash) bool {
+	return b.Test(topic[:])
+}
+
+// VerifyReceipt checks that the bloom calculated from the logs of the
+// receipt matches the logs bloom reported by the receipt.
+func VerifyReceipt(receipt *spec.TransactionReceipt) error {
+	if receipt == nil {
+		return errors.New("no receipt specified")
+	}
+
+	reported, err := New(receipt.LogsBloom())
+	if err != nil {
+		return errors.Wrap(err, "invalid receipt logs bloom")
+	}
+
+	if FromLogs(receipt.Logs()) != reported {
+		return errors.New("logs bloom mismatch")
+	}
+
+	return nil
+}
+
+// VerifyBlock checks that the bloom calculated from the logs of the receipts
+// matches the logs bloom reported by the block.
+// The receipts must be all of the receipts for the block.
+func VerifyBlock(block *spec.Block, receipts []*spec.TransactionReceipt) error {
+	if block == nil {
+		return errors.New("no block specified")
+	}
+
+	reported, err := New(block.LogsBloom())
+	if err != nil {
+		return errors.Wrap(err, "invalid block logs bloom")
+	}
+
+	var calculated Bloom
+	for i, receipt := range receipts {
+		if receipt == nil {
+			return fmt.Errorf("receipt %d missing", i)
+		}
+
+		calculated.Or(FromLogs(receipt.Logs()))
+	}
+
+	if calculated != reported {
+		return errors.New("logs bloom mismatch")
+	}
+
+	return nil
+}
+
+// bits returns the three bits of the bloom that are set by the data.
+func bits(data []byte) [3]uint {
+	hash := util.Keccak256(data)
+
+	var res [3]uint
+	for i := range res {
+		res[i] = (uint(hash[i*2])<<8 | uint(hash[i*2+1])) & (Length*8 - 1)
+	}
+
+	return res
+}
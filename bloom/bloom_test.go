@@ -0,0 +1,141 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bloom_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/bloom"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// byteslice is a helper to create a byte slice from a hex string.
+func byteslice(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+func TestNew(t *testing.T) {
+	_, err := bloom.New(make([]byte, 255))
+	require.EqualError(t, err, "incorrect length 255 for bloom")
+
+	_, err = bloom.New(make([]byte, 256))
+	require.NoError(t, err)
+}
+
+func TestVerifyReceipt(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "NoLogs",
+			input: []byte(`{"blockHash":"0x249ea54eada07708b29d7c424b8466dec9f1d98067b0be1b89c7ee660cca858d","blockNumber":"0xb542","contractAddress":"0x9a049f5d18c239efaa258af9f3e7002949a977a0","cumulativeGasUsed":"0x5dc0","from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gasUsed":"0x5dc0","logs":[],"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","status":"0x0","to":null,"transactionHash":"0x6c929e1c3d860ee225d7f3a7addf9e3f740603d243260536dfa2f3cf02b51de4","transactionIndex":"0x0","type":"0x0"}`),
+		},
+		{
+			name:  "Logs",
+			input: []byte(`{"blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","blockNumber":"0xdc5deb","contractAddress":null,"cumulativeGasUsed":"0x10b8b8","effectiveGasPrice":"0x40eb6e398","from":"0x3e74d7a29db9c136fff150ac61a3ff6e56818774","gasUsed":"0x168c0","logs":[{"address":"0x40875223d61a688954263892d0f76c94fd6b3d4a","topics":["0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62","0x0000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6e","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x13","removed":false},{"address":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000003041"],"data":"0x","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x14","removed":false}],"logsBloom":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000","status":"0x1","to":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","type":"0x2"}`),
+		},
+		{
+			name:  "Mismatch",
+			input: []byte(`{"blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","blockNumber":"0xdc5deb","contractAddress":null,"cumulativeGasUsed":"0x10b8b8","effectiveGasPrice":"0x40eb6e398","from":"0x3e74d7a29db9c136fff150ac61a3ff6e56818774","gasUsed":"0x168c0","logs":[{"address":"0x40875223d61a688954263892d0f76c94fd6b3d4a","topics":["0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62","0x0000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6e","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x13","removed":false},{"address":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000003041"],"data":"0x","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x14","removed":false}],"logsBloom":"0x000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000","status":"0x1","to":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","type":"0x2"}`),
+			err:   "logs bloom mismatch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var receipt spec.TransactionReceipt
+			require.NoError(t, json.Unmarshal(test.input, &receipt))
+			err := bloom.VerifyReceipt(&receipt)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyBlock(t *testing.T) {
+	var berlinReceipt spec.TransactionReceipt
+	require.NoError(t, json.Unmarshal([]byte(`{"blockHash":"0x249ea54eada07708b29d7c424b8466dec9f1d98067b0be1b89c7ee660cca858d","blockNumber":"0xb542","contractAddress":"0x9a049f5d18c239efaa258af9f3e7002949a977a0","cumulativeGasUsed":"0x5dc0","from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gasUsed":"0x5dc0","logs":[],"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","status":"0x0","to":null,"transactionHash":"0x6c929e1c3d860ee225d7f3a7addf9e3f740603d243260536dfa2f3cf02b51de4","transactionIndex":"0x0","type":"0x0"}`), &berlinReceipt))
+	var londonReceipt spec.TransactionReceipt
+	require.NoError(t, json.Unmarshal([]byte(`{"blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","blockNumber":"0xdc5deb","contractAddress":null,"cumulativeGasUsed":"0x10b8b8","effectiveGasPrice":"0x40eb6e398","from":"0x3e74d7a29db9c136fff150ac61a3ff6e56818774","gasUsed":"0x168c0","logs":[{"address":"0x40875223d61a688954263892d0f76c94fd6b3d4a","topics":["0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62","0x0000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6e","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x13","removed":false},{"address":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000003041"],"data":"0x","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x14","removed":false}],"logsBloom":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000","status":"0x1","to":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","type":"0x2"}`), &londonReceipt))
+
+	block := &spec.Block{
+		Fork: spec.ForkLondon,
+		London: &spec.LondonBlock{
+			LogsBloom: londonReceipt.LogsBloom(),
+		},
+	}
+
+	require.NoError(t, bloom.VerifyBlock(block, []*spec.TransactionReceipt{&berlinReceipt, &londonReceipt}))
+	require.EqualError(t, bloom.VerifyBlock(block, []*spec.TransactionReceipt{&berlinReceipt}), "logs bloom mismatch")
+	require.EqualError(t, bloom.VerifyBlock(block, []*spec.TransactionReceipt{nil}), "receipt 0 missing")
+}
+
+func TestMembership(t *testing.T) {
+	b, err := bloom.New(byteslice("0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		address  *types.Address
+		topic    *types.Hash
+		expected bool
+	}{
+		{
+			name:     "AddressPresent",
+			address:  &types.Address{0x40, 0x87, 0x52, 0x23, 0xd6, 0x1a, 0x68, 0x89, 0x54, 0x26, 0x38, 0x92, 0xd0, 0xf7, 0x6c, 0x94, 0xfd, 0x6b, 0x3d, 0x4a},
+			expected: true,
+		},
+		{
+			name:     "AddressAbsent",
+			address:  &types.Address{0x01},
+			expected: false,
+		},
+		{
+			name:     "TopicPresent",
+			topic:    (*types.Hash)(byteslice("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")),
+			expected: true,
+		},
+		{
+			name:     "TopicAbsent",
+			topic:    (*types.Hash)(byteslice("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.address != nil {
+				require.Equal(t, test.expected, b.TestAddress(*test.address))
+			}
+			if test.topic != nil {
+				require.Equal(t, test.expected, b.TestTopic(*test.topic))
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+)
+
+// Block returns the block given an ID.
+func (s *Service) Block(ctx context.Context, blockID string) (*spec.Block, error) {
+	key := ""
+	switch {
+	case strings.HasPrefix(blockID, "0x"):
+		key = blockHashKey(strings.ToLower(blockID))
+	case isHeight(blockID):
+		key = "block:height:" + blockID
+	}
+
+	if key != "" {
+		if block, exists := s.lookup(key); exists {
+			return block.(*spec.Block), nil
+		}
+	}
+
+	res, err := s.fetch(ctx, "block:"+blockID, func(ctx context.Context) (any, error) {
+		return s.service.(execclient.BlocksProvider).Block(ctx, blockID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	block := res.(*spec.Block)
+	if block == nil {
+		return nil, nil
+	}
+
+	// The block is immutable given its hash, however it was requested.
+	s.cache.set(blockHashKey(fmt.Sprintf("%#x", block.Hash())), block)
+
+	if s.isFinalized(ctx, block.Number()) {
+		s.cache.set(fmt.Sprintf("block:height:%d", block.Number()), block)
+	}
+
+	return block, nil
+}
+
+// blockHashKey returns the cache key for a block given its hash.
+func blockHashKey(hash string) string {
+	return "block:hash:" + hash
+}
+
+// isHeight returns true if the block ID is a height.
+func isHeight(blockID string) bool {
+	_, err := strconv.ParseUint(blockID, 10, 32)
+
+	return err == nil
+}
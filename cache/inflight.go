@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// inflight coalesces concurrent identical requests, so that only one is sent
+// to the execution client.
+type inflight struct {
+	mu       sync.Mutex
+	requests map[string]*inflightRequest
+	timeout  time.Duration
+}
+
+// inflightRequest is a request that is being sent to the execution client.
+type inflightRequest struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+// newInflight creates a new set of in-flight requests, each of which is
+// bounded by the given timeout.
+func newInflight(timeout time.Duration) *inflight {
+	return &inflight{
+		requests: make(map[string]*inflightRequest),
+		timeout:  timeout,
+	}
+}
+
+// do runs the request for the key, or waits for the result of the request
+// for the key that is already running.
+func (f *inflight) do(ctx context.Context, key string, request func(ctx context.Context) (any, error)) (any, error) {
+	f.mu.Lock()
+	req, exists := f.requests[key]
+	if !exists {
+		req = &inflightRequest{
+			done: make(chan struct{}),
+		}
+		f.requests[key] = req
+		go f.run(ctx, key, req, request)
+	}
+	f.mu.Unlock()
+
+	select {
+	case <-req.done:
+		return req.value, req.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run runs the request.  The request is shared by all callers, so it is not
+// cancelled when the context of the caller that started it ends.
+func (f *inflight) run(ctx context.Context,
+	key string,
+	req *inflightRequest,
+	request func(ctx context.Context) (any, error),
+) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), f.timeout)
+	defer cancel()
+
+	req.value, req.err = request(ctx)
+
+	f.mu.Lock()
+	delete(f.requests, key)
+	f.mu.Unlock()
+	close(req.done)
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lru is a bounded cache that evicts the least recently used entry.
+type lru struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+	evictions  uint64
+}
+
+// lruEntry is an entry in the cache.
+type lruEntry struct {
+	key   string
+	value any
+}
+
+// newLRU creates a cache holding up to the given number of entries.
+func newLRU(maxEntries int) *lru {
+	return &lru{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element, maxEntries),
+		order:      list.New(),
+	}
+}
+
+// get returns the value for the key, marking it as recently used.
+func (c *lru) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return element.Value.(*lruEntry).value, true
+}
+
+// set sets the value for the key, evicting the least recently used entry if
+// the cache is full.
+func (c *lru) set(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, exists := c.entries[key]; exists {
+		element.Value.(*lruEntry).value = value
+		c.order.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{
+		key:   key,
+		value: value,
+	})
+
+	if c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+		c.evictions++
+	}
+}
+
+// len returns the number of entries in the cache, and the number of evictions.
+func (c *lru) len() (int, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len(), c.evictions
+}
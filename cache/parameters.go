@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel        zerolog.Level
+	service         execclient.Service
+	maxEntries      int
+	finalityRefresh time.Duration
+	timeout         time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithService sets the service for which requests are cached.
+// The service must provide blocks, and block heights which are used to obtain
+// finality.
+func WithService(service execclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithMaxEntries sets the maximum number of entries held in the cache.
+func WithMaxEntries(maxEntries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxEntries = maxEntries
+	})
+}
+
+// WithFinalityRefreshInterval sets the interval after which the finalized
+// block is obtained again.
+func WithFinalityRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityRefresh = interval
+	})
+}
+
+// WithTimeout sets the maximum duration for requests to the service.  As
+// requests are shared between concurrent callers they continue when the
+// context of an individual caller ends, up to this duration.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:        zerolog.GlobalLevel(),
+		maxEntries:      1024,
+		finalityRefresh: time.Minute,
+		timeout:         30 * time.Second,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+
+	if _, isProvider := parameters.service.(execclient.BlocksProvider); !isProvider {
+		return nil, errors.New("service does not provide blocks")
+	}
+
+	if _, isProvider := parameters.service.(execclient.BlockHeightProvider); !isProvider {
+		return nil, errors.New("service does not provide block heights")
+	}
+
+	if parameters.maxEntries < 1 {
+		return nil, errors.New("max entries must be at least 1")
+	}
+
+	if parameters.finalityRefresh == 0 {
+		return nil, errors.New("no finality refresh interval specified")
+	}
+
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+
+	return &parameters, nil
+}
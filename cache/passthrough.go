@@ -0,0 +1,411 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"math/big"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// errNotSupported is returned when the underlying service does not support a request.
+var errNotSupported = errors.New("service does not support request")
+
+// AccountProof obtains the proof of the account and the given storage slots
+// for the given address at the given block ID.
+// The proof is not verified; use the Verify() method of the result to do so.
+func (s *Service) AccountProof(ctx context.Context,
+	address types.Address,
+	slots []types.Hash,
+	blockID string,
+) (
+	*api.AccountProof,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.AccountProofProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.AccountProof(ctx, address, slots, blockID)
+}
+
+// Balance obtains the balance for the given address at the given block ID.
+func (s *Service) Balance(ctx context.Context, address types.Address, blockID string) (*big.Int, error) {
+	provider, isProvider := s.service.(execclient.BalancesProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Balance(ctx, address, blockID)
+}
+
+// BaseFee provides the base fee of the chain at the given block ID.
+func (s *Service) BaseFee(ctx context.Context,
+	blockID string,
+) (
+	*big.Int,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.BaseFeeProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.BaseFee(ctx, blockID)
+}
+
+// BlockHeight returns the height of the block with the given ID.
+func (s *Service) BlockHeight(ctx context.Context, blockID string) (uint32, error) {
+	provider, isProvider := s.service.(execclient.BlockHeightProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+
+	return provider.BlockHeight(ctx, blockID)
+}
+
+// Call makes a call to the execution client.
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) Call(ctx context.Context, opts *execclient.CallOpts) ([]byte, error) {
+	provider, isProvider := s.service.(execclient.CallProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Call(ctx, opts)
+}
+
+// ChainHeight returns the height of the chain as understood by the node.
+func (s *Service) ChainHeight(ctx context.Context) (uint32, error) {
+	provider, isProvider := s.service.(execclient.ChainHeightProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+
+	return provider.ChainHeight(ctx)
+}
+
+// ChainID returns the chain ID of the node.
+func (s *Service) ChainID(ctx context.Context) (uint64, error) {
+	provider, isProvider := s.service.(execclient.ChainIDProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+
+	return provider.ChainID(ctx)
+}
+
+// Code obtains the code for the given address at the given block ID.
+func (s *Service) Code(ctx context.Context, address types.Address, blockID string) ([]byte, error) {
+	provider, isProvider := s.service.(execclient.CodeProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Code(ctx, address, blockID)
+}
+
+// DebugTraceBlock traces all transactions in the block with the given ID.
+func (s *Service) DebugTraceBlock(ctx context.Context,
+	blockID string,
+	opts *execclient.DebugTraceOpts,
+) (
+	[]*api.DebugTransactionTrace,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.DebugTraceProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.DebugTraceBlock(ctx, blockID, opts)
+}
+
+// DebugTraceTransaction traces the transaction with the given hash.
+func (s *Service) DebugTraceTransaction(ctx context.Context,
+	hash types.Hash,
+	opts *execclient.DebugTraceOpts,
+) (
+	*api.DebugTrace,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.DebugTraceProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.DebugTraceTransaction(ctx, hash, opts)
+}
+
+// EstimateGas estimates the gas required for a transaction.
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) EstimateGas(ctx context.Context,
+	tx *spec.TransactionSubmission,
+) (
+	*big.Int,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.GasEstimationProvide)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.EstimateGas(ctx, tx)
+}
+
+// Events returns the events matching the filter.
+func (s *Service) Events(ctx context.Context, filter *api.EventsFilter) ([]*spec.BerlinTransactionEvent, error) {
+	provider, isProvider := s.service.(execclient.EventsProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Events(ctx, filter)
+}
+
+// Issuance returns the issuance of a block.
+func (s *Service) Issuance(ctx context.Context, blockID string) (*api.Issuance, error) {
+	provider, isProvider := s.service.(execclient.IssuanceProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Issuance(ctx, blockID)
+}
+
+// NetworkID returns the network ID of the node.
+func (s *Service) NetworkID(ctx context.Context) (uint64, error) {
+	provider, isProvider := s.service.(execclient.NetworkIDProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+
+	return provider.NetworkID(ctx)
+}
+
+// NewHeads returns a subscription for new block headers.
+// The blocks sent to the channel contain only header information.
+func (s *Service) NewHeads(ctx context.Context,
+	ch chan *spec.Block,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.NewHeadsProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.NewHeads(ctx, ch, opts)
+}
+
+// NewPendingTransactionHashes returns a subscription for the hashes of pending transactions.
+func (s *Service) NewPendingTransactionHashes(ctx context.Context,
+	ch chan types.Hash,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.NewPendingTransactionHashesProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.NewPendingTransactionHashes(ctx, ch, opts)
+}
+
+// NewPendingTransactions returns a subscription for pending transactions.
+func (s *Service) NewPendingTransactions(ctx context.Context, ch chan *spec.Transaction) (*util.Subscription, error) {
+	provider, isProvider := s.service.(execclient.NewPendingTransactionsProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.NewPendingTransactions(ctx, ch)
+}
+
+// NewPendingTransactionsWithOpts returns a subscription for pending transactions,
+// using the supplied options.
+func (s *Service) NewPendingTransactionsWithOpts(ctx context.Context,
+	ch chan *spec.Transaction,
+	opts *execclient.PendingTransactionsOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.NewPendingTransactionsProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.NewPendingTransactionsWithOpts(ctx, ch, opts)
+}
+
+// Nonce obtains the nonce for the given address at the given block ID.
+func (s *Service) Nonce(ctx context.Context, address types.Address, blockID string) (uint64, error) {
+	provider, isProvider := s.service.(execclient.NonceProvider)
+	if !isProvider {
+		return 0, errNotSupported
+	}
+
+	return provider.Nonce(ctx, address, blockID)
+}
+
+// ReplayBlockTransactions obtains traces for all transactions in a block.
+func (s *Service) ReplayBlockTransactions(ctx context.Context, blockID string) ([]*api.TransactionResult, error) {
+	provider, isProvider := s.service.(execclient.BlockReplaysProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.ReplayBlockTransactions(ctx, blockID)
+}
+
+// ReplayBlockTransactionsWithOpts obtains traces for all transactions in a block,
+// using the supplied options.
+func (s *Service) ReplayBlockTransactionsWithOpts(ctx context.Context,
+	blockID string,
+	opts *execclient.ReplayOpts,
+) (
+	[]*api.TransactionResult,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.BlockReplaysProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.ReplayBlockTransactionsWithOpts(ctx, blockID, opts)
+}
+
+// ReplayTransaction obtains traces for a transaction.
+func (s *Service) ReplayTransaction(ctx context.Context,
+	hash types.Hash,
+	opts *execclient.ReplayOpts,
+) (
+	*api.TransactionResult,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.TransactionReplaysProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.ReplayTransaction(ctx, hash, opts)
+}
+
+// SendRawTransaction submits a signed, encoded transaction to the network.
+func (s *Service) SendRawTransaction(ctx context.Context, tx []byte) (types.Hash, error) {
+	provider, isProvider := s.service.(execclient.TransactionSubmitter)
+	if !isProvider {
+		return types.Hash{}, errNotSupported
+	}
+
+	return provider.SendRawTransaction(ctx, tx)
+}
+
+// SendTransaction submits a signed transaction to the network.
+func (s *Service) SendTransaction(ctx context.Context, tx *spec.Transaction) (types.Hash, error) {
+	provider, isProvider := s.service.(execclient.TransactionSubmitter)
+	if !isProvider {
+		return types.Hash{}, errNotSupported
+	}
+
+	return provider.SendTransaction(ctx, tx)
+}
+
+// Storage obtains the value of the given storage slot for the given address at the given block ID.
+func (s *Service) Storage(ctx context.Context,
+	address types.Address,
+	slot types.Hash,
+	blockID string,
+) (
+	types.Hash,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.StorageProvider)
+	if !isProvider {
+		return types.Hash{}, errNotSupported
+	}
+
+	return provider.Storage(ctx, address, slot, blockID)
+}
+
+// SubscribeEvents returns a subscription for events matching the filter.
+// Events that are removed from the chain by a reorganisation are sent again
+// with Removed set.
+func (s *Service) SubscribeEvents(ctx context.Context,
+	filter *api.EventsFilter,
+	ch chan *spec.BerlinTransactionEvent,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	provider, isProvider := s.service.(execclient.EventsSubscriptionProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.SubscribeEvents(ctx, filter, ch, opts)
+}
+
+// Syncing obtains information about the sync state of the node.
+func (s *Service) Syncing(ctx context.Context) (*api.SyncState, error) {
+	provider, isProvider := s.service.(execclient.SyncingProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.Syncing(ctx)
+}
+
+// TraceBlock returns the traces for all actions in a block.
+func (s *Service) TraceBlock(ctx context.Context, blockID string) ([]*api.Trace, error) {
+	provider, isProvider := s.service.(execclient.TracesProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.TraceBlock(ctx, blockID)
+}
+
+// TraceFilter returns the traces matching the filter.
+func (s *Service) TraceFilter(ctx context.Context, filter *api.TraceFilter) ([]*api.Trace, error) {
+	provider, isProvider := s.service.(execclient.TracesProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.TraceFilter(ctx, filter)
+}
+
+// TraceTransaction returns the traces for all actions in a transaction.
+func (s *Service) TraceTransaction(ctx context.Context, hash types.Hash) ([]*api.Trace, error) {
+	provider, isProvider := s.service.(execclient.TracesProvider)
+	if !isProvider {
+		return nil, errNotSupported
+	}
+
+	return provider.TraceTransaction(ctx, hash)
+}
@@ -0,0 +1,172 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides an execution client service that caches data that
+// cannot change, to avoid repeated requests to the execution client.
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is an Ethereum execution client service that caches data that
+// cannot change.
+//
+// Blocks requested by hash, and transactions requested by block hash and
+// index, are always cached.  Blocks requested by height, and transactions
+// and receipts requested by hash, are cached only once they are at or below
+// the finalized block, as until then they can be changed by a reorganisation.
+//
+// Concurrent identical requests are sent to the execution client once.
+//
+// Cached values are shared between callers, so must not be modified.
+type Service struct {
+	service  execclient.Service
+	cache    *lru
+	inflight *inflight
+
+	finalizedMu      sync.Mutex
+	finalizedHeight  uint32
+	finalizedAt      time.Time
+	finalizedRefresh time.Duration
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// Stats are the statistics of the cache.
+type Stats struct {
+	// Hits is the number of requests served from the cache.
+	Hits uint64
+	// Misses is the number of requests sent to the execution client.
+	Misses uint64
+	// Entries is the number of entries in the cache.
+	Entries int
+	// Evictions is the number of entries evicted to make space for others.
+	Evictions uint64
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new caching execution client service.
+func New(_ context.Context, params ...Parameter) (execclient.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "client").Str("impl", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		service:          parameters.service,
+		cache:            newLRU(parameters.maxEntries),
+		inflight:         newInflight(parameters.timeout),
+		finalizedRefresh: parameters.finalityRefresh,
+	}, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "cache"
+}
+
+// Address provides the address of the underlying service.
+func (s *Service) Address() string {
+	return s.service.Address()
+}
+
+// Stats returns the statistics of the cache.
+func (s *Service) Stats() *Stats {
+	entries, evictions := s.cache.len()
+
+	return &Stats{
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		Entries:   entries,
+		Evictions: evictions,
+	}
+}
+
+// lookup returns the cached value for the key, updating the statistics.
+func (s *Service) lookup(key string) (any, bool) {
+	value, exists := s.cache.get(key)
+	if exists {
+		s.hits.Add(1)
+	}
+
+	return value, exists
+}
+
+// fetch sends the request to the execution client, coalescing it with
+// identical requests that are already in flight.
+func (s *Service) fetch(ctx context.Context, key string, request func(ctx context.Context) (any, error)) (any, error) {
+	s.misses.Add(1)
+
+	return s.inflight.do(ctx, key, request)
+}
+
+// isFinalized returns true if the block at the given height is finalized.
+func (s *Service) isFinalized(ctx context.Context, height uint32) bool {
+	finalizedHeight, known := s.finalized(ctx)
+
+	return known && height <= finalizedHeight
+}
+
+// finalized returns the height of the finalized block, obtaining it from the
+// execution client if it is out of date.  It returns false if the height is
+// not known.
+func (s *Service) finalized(ctx context.Context) (uint32, bool) {
+	s.finalizedMu.Lock()
+	finalizedHeight := s.finalizedHeight
+	finalizedAt := s.finalizedAt
+	s.finalizedMu.Unlock()
+
+	if time.Since(finalizedAt) < s.finalizedRefresh {
+		return finalizedHeight, true
+	}
+
+	// Concurrent refreshes are coalesced, and the lock is not held whilst
+	// waiting so that callers with a fresh height are not blocked.
+	res, err := s.inflight.do(ctx, "finalized", func(ctx context.Context) (any, error) {
+		return s.service.(execclient.BlockHeightProvider).BlockHeight(ctx, "finalized")
+	})
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain finalized height")
+
+		// Use the previous height if there is one, as finality only moves forward.
+		return finalizedHeight, !finalizedAt.IsZero()
+	}
+
+	s.finalizedMu.Lock()
+	defer s.finalizedMu.Unlock()
+
+	if height, isHeight := res.(uint32); isHeight && height > s.finalizedHeight {
+		s.finalizedHeight = height
+	}
+	s.finalizedAt = time.Now()
+	log.Trace().Uint32("height", s.finalizedHeight).Msg("Updated finalized height")
+
+	return s.finalizedHeight, true
+}
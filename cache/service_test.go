@@ -0,0 +1,430 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-execution-client/cache"
+	"github.com/attestantio/go-execution-client/mock"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testClient is a client with a chain of blocks, counting its requests.
+type testClient struct {
+	mock.Service
+	head         uint32
+	finalized    uint32
+	delay        time.Duration
+	blockCalls   atomic.Int32
+	heightCalls  atomic.Int32
+	txCalls      atomic.Int32
+	pendingBlock bool
+}
+
+func (*testClient) block(height uint32) *spec.Block {
+	return &spec.Block{
+		Fork: spec.ForkLondon,
+		London: &spec.LondonBlock{
+			Hash:   blockHash(height),
+			Number: height,
+		},
+	}
+}
+
+// blockHash returns the hash of the test block at the given height.
+func blockHash(height uint32) types.Hash {
+	return types.Hash{0x01, byte(height >> 8), byte(height)}
+}
+
+// blockHashID returns the block ID of the test block at the given height.
+func blockHashID(height uint32) string {
+	return fmt.Sprintf("%#x", blockHash(height))
+}
+
+func (c *testClient) Block(ctx context.Context, blockID string) (*spec.Block, error) {
+	if blockID == "latest" {
+		c.blockCalls.Add(1)
+
+		return c.block(c.head), nil
+	}
+
+	c.blockCalls.Add(1)
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	height, err := strconv.ParseUint(blockID, 10, 32)
+	if err != nil {
+		// Hashes encode the height.
+		hash, err := hex.DecodeString(strings.TrimPrefix(blockID, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		height = uint64(hash[1])<<8 | uint64(hash[2])
+	}
+	if uint32(height) > c.head {
+		return nil, nil
+	}
+
+	return c.block(uint32(height)), nil
+}
+
+func (c *testClient) BlockHeight(ctx context.Context, blockID string) (uint32, error) {
+	if blockID != "finalized" {
+		return 0, fmt.Errorf("unexpected block ID %s", blockID)
+	}
+
+	c.heightCalls.Add(1)
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	return c.finalized, nil
+}
+
+func (c *testClient) Transaction(_ context.Context, hash types.Hash) (*spec.Transaction, error) {
+	c.txCalls.Add(1)
+
+	tx := &spec.Transaction{
+		Type: spec.TransactionType0,
+		Type0Transaction: &spec.Type0Transaction{
+			Hash: hash,
+		},
+	}
+	if !c.pendingBlock {
+		height := uint32(hash[0])
+		tx.Type0Transaction.BlockNumber = &height
+	}
+
+	return tx, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no service specified",
+		},
+		{
+			name: "MaxEntriesZero",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(&testClient{}),
+				cache.WithMaxEntries(0),
+			},
+			err: "max entries must be at least 1",
+		},
+		{
+			name: "FinalityRefreshIntervalZero",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(&testClient{}),
+				cache.WithFinalityRefreshInterval(0),
+			},
+			err: "no finality refresh interval specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(&testClient{}),
+				cache.WithTimeout(0),
+			},
+			err: "no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(&testClient{}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		blockIDs   []string
+		blockCalls int32
+		hits       uint64
+	}{
+		{
+			name:       "Hash",
+			blockIDs:   []string{blockHashID(120), blockHashID(120)},
+			blockCalls: 1,
+			hits:       1,
+		},
+		{
+			name:       "HashCase",
+			blockIDs:   []string{blockHashID(119), "0x" + strings.ToUpper(blockHashID(119)[2:])},
+			blockCalls: 1,
+			hits:       1,
+		},
+		{
+			name:       "HeightFinalized",
+			blockIDs:   []string{"90", "90"},
+			blockCalls: 1,
+			hits:       1,
+		},
+		{
+			name:       "HeightUnfinalized",
+			blockIDs:   []string{"110", "110"},
+			blockCalls: 2,
+		},
+		{
+			name:       "HeightUnfinalizedThenHash",
+			blockIDs:   []string{"110", blockHashID(110)},
+			blockCalls: 1,
+			hits:       1,
+		},
+		{
+			name:       "LatestThenHash",
+			blockIDs:   []string{"latest", blockHashID(120)},
+			blockCalls: 1,
+			hits:       1,
+		},
+		{
+			name:       "Latest",
+			blockIDs:   []string{"latest", "latest"},
+			blockCalls: 2,
+		},
+		{
+			name:       "Unknown",
+			blockIDs:   []string{"200", "200"},
+			blockCalls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &testClient{head: 120, finalized: 100}
+			s, err := cache.New(ctx,
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(client),
+			)
+			require.NoError(t, err)
+
+			for _, blockID := range test.blockIDs {
+				_, err := s.(*cache.Service).Block(ctx, blockID)
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.blockCalls, client.blockCalls.Load())
+			require.Equal(t, test.hits, s.(*cache.Service).Stats().Hits)
+		})
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		hash    types.Hash
+		pending bool
+		txCalls int32
+	}{
+		{
+			name:    "Finalized",
+			hash:    types.Hash{90},
+			txCalls: 1,
+		},
+		{
+			name:    "Unfinalized",
+			hash:    types.Hash{110},
+			txCalls: 2,
+		},
+		{
+			name:    "Pending",
+			hash:    types.Hash{90},
+			pending: true,
+			txCalls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &testClient{head: 120, finalized: 100, pendingBlock: test.pending}
+			s, err := cache.New(ctx,
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(client),
+			)
+			require.NoError(t, err)
+
+			for range 2 {
+				tx, err := s.(*cache.Service).Transaction(ctx, test.hash)
+				require.NoError(t, err)
+				require.Equal(t, test.hash, tx.Hash())
+			}
+			require.Equal(t, test.txCalls, client.txCalls.Load())
+		})
+	}
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+
+	// Nothing is finalized, so blocks are only cached by hash.
+	client := &testClient{head: 120}
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithService(client),
+		cache.WithMaxEntries(2),
+	)
+	require.NoError(t, err)
+	service := s.(*cache.Service)
+
+	for _, blockID := range []string{blockHashID(1), blockHashID(2), blockHashID(3), blockHashID(1)} {
+		_, err := service.Block(ctx, blockID)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(4), client.blockCalls.Load())
+
+	// Most recently used entry should still be present.
+	_, err = service.Block(ctx, blockHashID(1))
+	require.NoError(t, err)
+	require.Equal(t, int32(4), client.blockCalls.Load())
+
+	require.Equal(t, &cache.Stats{
+		Hits:      1,
+		Misses:    4,
+		Entries:   2,
+		Evictions: 2,
+	}, service.Stats())
+}
+
+func TestCoalescing(t *testing.T) {
+	ctx := context.Background()
+
+	client := &testClient{head: 120, finalized: 100, delay: 50 * time.Millisecond}
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithService(client),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			block, err := s.(*cache.Service).Block(ctx, "110")
+			require.NoError(t, err)
+			require.Equal(t, uint32(110), block.Number())
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), client.blockCalls.Load())
+}
+
+func TestCoalescingCallerDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	client := &testClient{head: 120, finalized: 100, delay: 100 * time.Millisecond}
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithService(client),
+	)
+	require.NoError(t, err)
+
+	// The first caller gives up before the request completes.
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := s.(*cache.Service).Block(shortCtx, "110")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}()
+
+	// Ensure that the first caller starts the request.
+	time.Sleep(5 * time.Millisecond)
+
+	block, err := s.(*cache.Service).Block(ctx, "110")
+	require.NoError(t, err)
+	require.Equal(t, uint32(110), block.Number())
+	wg.Wait()
+
+	require.Equal(t, int32(1), client.blockCalls.Load())
+}
+
+func TestFinalizedCoalescing(t *testing.T) {
+	ctx := context.Background()
+
+	client := &testClient{head: 120, finalized: 100, delay: 50 * time.Millisecond}
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithService(client),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for height := 91; height <= 100; height++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.(*cache.Service).Block(ctx, strconv.Itoa(height))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), client.heightCalls.Load())
+
+	// Finalized blocks are cached.
+	_, err = s.(*cache.Service).Block(ctx, "95")
+	require.NoError(t, err)
+	require.Equal(t, int32(10), client.blockCalls.Load())
+}
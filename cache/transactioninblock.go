@@ -0,0 +1,53 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// TransactionInBlock returns the transaction for the given transaction in a block at the given index.
+func (s *Service) TransactionInBlock(ctx context.Context, blockHash types.Hash, index uint32) (*spec.Transaction, error) {
+	key := fmt.Sprintf("transactioninblock:%#x:%d", blockHash, index)
+	if tx, exists := s.lookup(key); exists {
+		return tx.(*spec.Transaction), nil
+	}
+
+	res, err := s.fetch(ctx, key, func(ctx context.Context) (any, error) {
+		provider, isProvider := s.service.(execclient.TransactionsProvider)
+		if !isProvider {
+			return nil, errNotSupported
+		}
+
+		return provider.TransactionInBlock(ctx, blockHash, index)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tx := res.(*spec.Transaction)
+	if tx == nil {
+		return nil, nil
+	}
+
+	// The transaction is immutable given its block hash.
+	s.cache.set(key, tx)
+
+	return tx, nil
+}
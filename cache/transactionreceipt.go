@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// TransactionReceipt returns the transaction receipt for the given transaction hash.
+func (s *Service) TransactionReceipt(ctx context.Context, hash types.Hash) (*spec.TransactionReceipt, error) {
+	key := fmt.Sprintf("receipt:%#x", hash)
+	if receipt, exists := s.lookup(key); exists {
+		return receipt.(*spec.TransactionReceipt), nil
+	}
+
+	res, err := s.fetch(ctx, key, func(ctx context.Context) (any, error) {
+		provider, isProvider := s.service.(execclient.TransactionReceiptsProvider)
+		if !isProvider {
+			return nil, errNotSupported
+		}
+
+		return provider.TransactionReceipt(ctx, hash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := res.(*spec.TransactionReceipt)
+	if receipt == nil {
+		return nil, nil
+	}
+
+	if s.isFinalized(ctx, receipt.BlockNumber()) {
+		s.cache.set(key, receipt)
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProof(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	block, err := s.(execclient.BlocksProvider).Block(ctx, "latest")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		address types.Address
+		slots   []types.Hash
+	}{
+		{
+			name: "Account",
+		},
+		{
+			name:  "Storage",
+			slots: []types.Hash{{}, {0x01}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proof, err := s.(execclient.AccountProofProvider).AccountProof(ctx, test.address, test.slots, block.Hash().String())
+			require.NoError(t, err)
+			require.Len(t, proof.StorageProof, len(test.slots))
+			require.NoError(t, proof.Verify(block.StateRoot()))
+		})
+	}
+}
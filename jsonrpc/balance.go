@@ -17,69 +17,20 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strconv"
-	"strings"
 
-	"github.com/attestantio/go-execution-client/spec"
 	"github.com/attestantio/go-execution-client/types"
 	"github.com/attestantio/go-execution-client/util"
-	"github.com/pkg/errors"
 )
 
 // Balance obtains the balance for the given address at the given block ID.
 func (s *Service) Balance(ctx context.Context, address types.Address, blockID string) (*big.Int, error) {
-	if blockID == "" || blockID == "latest" {
-		return s.balanceAtHeight(ctx, address, -1)
-	}
-
-	if strings.HasPrefix(blockID, "0x") {
-		return s.balanceAtHash(ctx, address, blockID)
-	}
-
-	height, err := strconv.ParseInt(blockID, 10, 64)
+	block, err := stateBlockParam(blockID)
 	if err != nil {
-		return nil, errors.Wrap(err, "unhandled block ID")
-	}
-
-	return s.balanceAtHeight(ctx, address, height)
-}
-
-//nolint:unparam
-func (s *Service) balanceAtHash(ctx context.Context,
-	address types.Address,
-	hash string,
-) (
-	*big.Int,
-	error,
-) {
-	var block spec.Block
-
-	if err := s.client.CallFor(&block, "eth_getBlockByHash", hash, false); err != nil {
 		return nil, err
 	}
 
-	return s.balanceAtHash(ctx, address, fmt.Sprintf("%#x", block.Hash()))
-}
-
-func (s *Service) balanceAtHeight(_ context.Context,
-	address types.Address,
-	height int64,
-) (
-	*big.Int,
-	error,
-) {
-	var (
-		balanceStr string
-		err        error
-	)
-
-	if height == -1 {
-		err = s.client.CallFor(&balanceStr, "eth_getBalance", fmt.Sprintf("%#x", address), "latest")
-	} else {
-		err = s.client.CallFor(&balanceStr, "eth_getBalance", fmt.Sprintf("%#x", address), fmt.Sprintf("%#x", height))
-	}
-
-	if err != nil {
+	var balanceStr string
+	if err := s.callFor(ctx, &balanceStr, "eth_getBalance", fmt.Sprintf("%#x", address), block); err != nil {
 		return nil, err
 	}
 
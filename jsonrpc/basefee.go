@@ -28,7 +28,7 @@ type feeHistory struct {
 }
 
 // BaseFee provides the base fee of the chain at the given block ID.
-func (s *Service) BaseFee(_ context.Context,
+func (s *Service) BaseFee(ctx context.Context,
 	blockID string,
 ) (
 	*big.Int,
@@ -56,7 +56,7 @@ func (s *Service) BaseFee(_ context.Context,
 	}
 
 	res := feeHistory{}
-	if err := s.client.CallFor(&res, "eth_feeHistory", "0x1", blockID, []float64{0}); err != nil {
+	if err := s.callFor(ctx, &res, "eth_feeHistory", "0x1", blockID, []float64{0}); err != nil {
 		return nil, errors.Wrap(err, "call to eth_feeHistory failed")
 	}
 
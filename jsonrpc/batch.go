@@ -0,0 +1,350 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+	"github.com/ybbus/jsonrpc/v3"
+)
+
+// Batch is a set of requests that are sent to the execution client in a single call.
+//
+// Requests are queued with the methods of the batch, each of which returns a
+// result that is populated when the batch is executed.  Failure of an
+// individual request is reported by its result, and does not affect the other
+// requests in the batch.
+type Batch struct {
+	ctx      context.Context
+	service  *Service
+	items    []*batchItem
+	executed bool
+}
+
+// batchItem is a single request in a batch.
+type batchItem struct {
+	request *jsonrpc.RPCRequest
+	handle  func(response *jsonrpc.RPCResponse, err error)
+}
+
+// BatchResult is the result of a single request in a batch.
+type BatchResult[T any] struct {
+	value T
+	err   error
+}
+
+// Result returns the result of the request.
+// If the requested item was not found then the zero value is returned without an error.
+func (r *BatchResult[T]) Result() (T, error) {
+	return r.value, r.err
+}
+
+// Batch creates a new batch of requests.
+func (s *Service) Batch(ctx context.Context) *Batch {
+	return &Batch{
+		ctx:     ctx,
+		service: s,
+	}
+}
+
+// Len returns the number of requests queued in the batch.
+func (b *Batch) Len() int {
+	return len(b.items)
+}
+
+// Block queues a request for the block given an ID.
+func (b *Batch) Block(blockID string) *BatchResult[*spec.Block] {
+	param, isHash, err := blockParam(blockID)
+	if err != nil {
+		return failedBatchResult[*spec.Block](err)
+	}
+
+	method := "eth_getBlockByNumber"
+	if isHash {
+		method = "eth_getBlockByHash"
+	}
+
+	return queue(b, decodeBatchObject[spec.Block], method, param, true)
+}
+
+// Transaction queues a request for the transaction given its hash.
+func (b *Batch) Transaction(hash types.Hash) *BatchResult[*spec.Transaction] {
+	return queue(b, decodeBatchObject[spec.Transaction], "eth_getTransactionByHash", fmt.Sprintf("%#x", hash))
+}
+
+// TransactionReceipt queues a request for the transaction receipt given its transaction hash.
+func (b *Batch) TransactionReceipt(hash types.Hash) *BatchResult[*spec.TransactionReceipt] {
+	return queue(b, decodeBatchObject[spec.TransactionReceipt], "eth_getTransactionReceipt", fmt.Sprintf("%#x", hash))
+}
+
+// Balance queues a request for the balance of the given address at the given block ID.
+func (b *Batch) Balance(address types.Address, blockID string) *BatchResult[*big.Int] {
+	param, err := stateBlockParam(blockID)
+	if err != nil {
+		return failedBatchResult[*big.Int](err)
+	}
+
+	return queue(b, decodeBatchBigInt, "eth_getBalance", fmt.Sprintf("%#x", address), param)
+}
+
+// Nonce queues a request for the nonce of the given address at the given block ID.
+func (b *Batch) Nonce(address types.Address, blockID string) *BatchResult[uint64] {
+	param, err := stateBlockParam(blockID)
+	if err != nil {
+		return failedBatchResult[uint64](err)
+	}
+
+	return queue(b, decodeBatchUint64, "eth_getTransactionCount", fmt.Sprintf("%#x", address), param)
+}
+
+// Code queues a request for the code of the given address at the given block ID.
+func (b *Batch) Code(address types.Address, blockID string) *BatchResult[[]byte] {
+	param, err := stateBlockParam(blockID)
+	if err != nil {
+		return failedBatchResult[[]byte](err)
+	}
+
+	return queue(b, decodeBatchBytes, "eth_getCode", fmt.Sprintf("%#x", address), param)
+}
+
+// Storage queues a request for the value of the given storage slot for the given address at the given block ID.
+func (b *Batch) Storage(address types.Address, slot types.Hash, blockID string) *BatchResult[types.Hash] {
+	param, err := stateBlockParam(blockID)
+	if err != nil {
+		return failedBatchResult[types.Hash](err)
+	}
+
+	return queue(b, decodeBatchHash, "eth_getStorageAt", fmt.Sprintf("%#x", address), fmt.Sprintf("%#x", slot), param)
+}
+
+// Call queues a call to the execution client.
+func (b *Batch) Call(opts *execclient.CallOpts) *BatchResult[[]byte] {
+	if opts == nil {
+		return failedBatchResult[[]byte](errors.New("no options specified"))
+	}
+
+	callOpts, block := callParams(opts)
+
+	return queue(b, decodeBatchBytes, "eth_call", callOpts, block)
+}
+
+// ChainHeight queues a request for the height of the chain as understood by the node.
+func (b *Batch) ChainHeight() *BatchResult[uint32] {
+	return queue(b, decodeBatchUint32, "eth_blockNumber")
+}
+
+// Execute sends all queued requests to the execution client in a single call,
+// populating their results.
+// An error is returned only if the batch as a whole fails, in which case the
+// same error is also set on each result.
+func (b *Batch) Execute() error {
+	if b.executed {
+		return errors.New("batch already executed")
+	}
+
+	b.executed = true
+
+	if len(b.items) == 0 {
+		return nil
+	}
+
+	requests := make(jsonrpc.RPCRequests, 0, len(b.items))
+	for _, item := range b.items {
+		requests = append(requests, item.request)
+	}
+
+	var responses jsonrpc.RPCResponses
+	err := b.service.withRetries(b.ctx, "batch", func(ctx context.Context) error {
+		ctx, cancel := b.service.requestContext(ctx)
+		defer cancel()
+
+		var err error
+		responses, err = b.service.client.CallBatch(ctx, requests)
+
+		return convertError(err)
+	})
+	if err != nil {
+		err = errors.Wrap(err, "batch call failed")
+		b.fail(err)
+
+		return err
+	}
+
+	// Responses can be returned in any order, so match them to requests by ID.
+	responseMap := responses.AsMap()
+	for i, item := range b.items {
+		response, exists := responseMap[i]
+		if !exists {
+			item.handle(nil, fmt.Errorf("no response for %s request", item.request.Method))
+
+			continue
+		}
+
+		if response.Error != nil {
+			var err error = newRPCError(response.Error)
+			if item.request.Method == "eth_call" {
+				err = convertCallError(err)
+			}
+
+			item.handle(nil, errors.Wrapf(err, "%s failed", item.request.Method))
+
+			continue
+		}
+
+		item.handle(response, nil)
+	}
+
+	return nil
+}
+
+// fail sets the error on all results in the batch.
+func (b *Batch) fail(err error) {
+	for _, item := range b.items {
+		item.handle(nil, err)
+	}
+}
+
+// queue adds a request to the batch, returning the result that will be
+// populated by the supplied decoder when the batch is executed.
+func queue[T any](b *Batch,
+	decode func(response *jsonrpc.RPCResponse) (T, error),
+	method string,
+	params ...any,
+) *BatchResult[T] {
+	res := &BatchResult[T]{
+		err: errors.New("batch not executed"),
+	}
+
+	if b.executed {
+		res.err = errors.New("batch already executed")
+
+		return res
+	}
+
+	b.items = append(b.items, &batchItem{
+		request: jsonrpc.NewRequest(method, params...),
+		handle: func(response *jsonrpc.RPCResponse, err error) {
+			if err != nil {
+				res.err = err
+
+				return
+			}
+
+			res.value, res.err = decode(response)
+		},
+	})
+
+	return res
+}
+
+// failedBatchResult returns a result for a request that could not be queued.
+func failedBatchResult[T any](err error) *BatchResult[T] {
+	return &BatchResult[T]{
+		err: err,
+	}
+}
+
+// decodeBatchObject decodes a JSON object response, returning nil if the result is null.
+func decodeBatchObject[T any](response *jsonrpc.RPCResponse) (*T, error) {
+	if response.Result == nil {
+		return nil, nil
+	}
+
+	var res T
+	if err := response.GetObject(&res); err != nil {
+		return nil, errors.Wrap(err, "invalid response")
+	}
+
+	return &res, nil
+}
+
+// decodeBatchString decodes a JSON string response.
+func decodeBatchString(response *jsonrpc.RPCResponse) (string, error) {
+	var res string
+	if err := response.GetObject(&res); err != nil {
+		return "", errors.Wrap(err, "invalid response")
+	}
+
+	return res, nil
+}
+
+// decodeBatchBigInt decodes a hex quantity response.
+func decodeBatchBigInt(response *jsonrpc.RPCResponse) (*big.Int, error) {
+	str, err := decodeBatchString(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StrToBigInt("result", str)
+}
+
+// decodeBatchUint64 decodes a hex quantity response.
+func decodeBatchUint64(response *jsonrpc.RPCResponse) (uint64, error) {
+	str, err := decodeBatchString(response)
+	if err != nil {
+		return 0, err
+	}
+
+	return util.StrToUint64("result", str)
+}
+
+// decodeBatchHash decodes a 32-byte hex data response.
+func decodeBatchHash(response *jsonrpc.RPCResponse) (types.Hash, error) {
+	str, err := decodeBatchString(response)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return util.StrToHash("result", str)
+}
+
+// decodeBatchUint32 decodes a hex quantity response.
+func decodeBatchUint32(response *jsonrpc.RPCResponse) (uint32, error) {
+	str, err := decodeBatchString(response)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := strconv.ParseUint(strings.TrimPrefix(str, "0x"), 16, 32)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid response")
+	}
+
+	return uint32(res), nil
+}
+
+// decodeBatchBytes decodes a hex data response.
+func decodeBatchBytes(response *jsonrpc.RPCResponse) ([]byte, error) {
+	str, err := decodeBatchString(response)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid response")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	batch := s.(*jsonrpc.Service).Batch(ctx)
+	height := batch.ChainHeight()
+	block := batch.Block("15100")
+	balance := batch.Balance(types.Address{}, "latest")
+	invalid := batch.Block("invalid")
+	missing := batch.TransactionReceipt(types.Hash{})
+	require.Equal(t, 4, batch.Len())
+
+	_, err = block.Result()
+	require.EqualError(t, err, "batch not executed")
+
+	require.NoError(t, batch.Execute())
+	require.EqualError(t, batch.Execute(), "batch already executed")
+
+	chainHeight, err := height.Result()
+	require.NoError(t, err)
+	require.Positive(t, chainHeight)
+
+	blk, err := block.Result()
+	require.NoError(t, err)
+	require.NotNil(t, blk)
+	require.Equal(t, uint32(15100), blk.Number())
+
+	_, err = balance.Result()
+	require.NoError(t, err)
+
+	_, err = invalid.Result()
+	require.ErrorContains(t, err, "unhandled block ID")
+
+	receipt, err := missing.Result()
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+}
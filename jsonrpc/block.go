@@ -15,7 +15,6 @@ package jsonrpc
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"strings"
 
@@ -55,20 +54,20 @@ func (s *Service) Block(ctx context.Context, blockID string) (*spec.Block, error
 	return s.blockAtHeight(ctx, height)
 }
 
-func (s *Service) blockAtHash(_ context.Context, hash string) (*spec.Block, error) {
+func (s *Service) blockAtHash(ctx context.Context, hash string) (*spec.Block, error) {
 	var block spec.Block
 
-	if err := s.client.CallFor(&block, "eth_getBlockByHash", hash, true); err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("eth_getBlockByHash for %#x failed", hash))
+	if err := s.callFor(ctx, &block, "eth_getBlockByHash", hash, true); err != nil {
+		return nil, errors.Wrapf(err, "eth_getBlockByHash for %s failed", hash)
 	}
 
 	return &block, nil
 }
 
-func (s *Service) blockAtIdentifier(_ context.Context, id string) (*spec.Block, error) {
+func (s *Service) blockAtIdentifier(ctx context.Context, id string) (*spec.Block, error) {
 	var block spec.Block
 
-	if err := s.client.CallFor(&block, "eth_getBlockByNumber", id, true); err != nil {
+	if err := s.callFor(ctx, &block, "eth_getBlockByNumber", id, true); err != nil {
 		return nil, errors.Wrapf(err, "eth_getBlockByNumber for %s failed", id)
 	}
 
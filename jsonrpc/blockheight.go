@@ -0,0 +1,51 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// blockNumberJSON is the part of a block required to obtain its number.
+type blockNumberJSON struct {
+	Number string `json:"number"`
+}
+
+// BlockHeight returns the height of the block with the given ID.
+func (s *Service) BlockHeight(ctx context.Context, blockID string) (uint32, error) {
+	param, isHash, err := blockParam(blockID)
+	if err != nil {
+		return 0, err
+	}
+
+	method := "eth_getBlockByNumber"
+	if isHash {
+		method = "eth_getBlockByHash"
+	}
+
+	// Only the number is required, so request the block without full transactions.
+	var block *blockNumberJSON
+	if err := s.callFor(ctx, &block, method, param, false); err != nil {
+		return 0, errors.Wrapf(err, "%s for %s failed", method, param)
+	}
+
+	if block == nil {
+		return 0, errors.New("block not found")
+	}
+
+	return util.StrToUint32("number", block.Number)
+}
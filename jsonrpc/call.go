@@ -25,11 +25,31 @@ import (
 )
 
 // Call makes a call to the execution client.
-func (s *Service) Call(_ context.Context, opts *execclient.CallOpts) ([]byte, error) {
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) Call(ctx context.Context, opts *execclient.CallOpts) ([]byte, error) {
 	if opts == nil {
 		return nil, errors.New("no options specified")
 	}
 
+	callOpts, block := callParams(opts)
+
+	var callResults string
+
+	err := s.callFor(ctx, &callResults, "eth_call", callOpts, block)
+	if err != nil {
+		return nil, errors.Wrap(convertCallError(err), "eth_call failed")
+	}
+
+	res, err := hex.DecodeString(strings.TrimPrefix(callResults, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid response")
+	}
+
+	return res, nil
+}
+
+// callParams returns the call object and block parameters for an eth_call request.
+func callParams(opts *execclient.CallOpts) (map[string]string, string) {
 	callOpts := make(map[string]string)
 	if opts.From != nil {
 		callOpts["from"] = opts.From.String()
@@ -60,17 +80,5 @@ func (s *Service) Call(_ context.Context, opts *execclient.CallOpts) ([]byte, er
 		block = opts.Block
 	}
 
-	var callResults string
-
-	err := s.client.CallFor(&callResults, "eth_call", callOpts, block)
-	if err != nil {
-		return nil, errors.Wrap(err, "eth_call failed")
-	}
-
-	res, err := hex.DecodeString(strings.TrimPrefix(callResults, "0x"))
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid response")
-	}
-
-	return res, nil
+	return callOpts, block
 }
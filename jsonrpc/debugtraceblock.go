@@ -0,0 +1,90 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// debugTransactionTraceJSON is the spec representation of a transaction trace within a block.
+type debugTransactionTraceJSON struct {
+	TxHash string          `json:"txHash"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// DebugTraceBlock traces all transactions in the block with the given ID.
+func (s *Service) DebugTraceBlock(ctx context.Context,
+	blockID string,
+	opts *execclient.DebugTraceOpts,
+) (
+	[]*api.DebugTransactionTrace,
+	error,
+) {
+	traceOpts, timeout, err := s.debugTraceParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	block, isHash, err := blockParam(blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	method := "debug_traceBlockByNumber"
+	if isHash {
+		method = "debug_traceBlockByHash"
+	}
+
+	var data []*debugTransactionTraceJSON
+	if err := s.callForWithTimeout(ctx, timeout, &data, method, block, traceOpts); err != nil {
+		return nil, errors.Wrapf(err, "%s failed", method)
+	}
+
+	res := make([]*api.DebugTransactionTrace, len(data))
+	for i, traceData := range data {
+		if traceData == nil {
+			return nil, fmt.Errorf("trace %d missing", i)
+		}
+
+		res[i] = &api.DebugTransactionTrace{
+			Error: traceData.Error,
+		}
+
+		if traceData.TxHash != "" {
+			hash, err := util.StrToHash("transaction hash", traceData.TxHash)
+			if err != nil {
+				return nil, errors.Wrapf(err, "trace %d", i)
+			}
+
+			res[i].TransactionHash = &hash
+		}
+
+		if len(traceData.Result) > 0 && string(traceData.Result) != "null" {
+			res[i].Trace, err = api.NewDebugTrace(opts.Tracer, opts.TracerConfig, traceData.Result)
+			if err != nil {
+				return nil, errors.Wrapf(err, "trace %d", i)
+			}
+		}
+	}
+
+	return res, nil
+}
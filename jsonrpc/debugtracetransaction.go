@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// DebugTraceTransaction traces the transaction with the given hash.
+func (s *Service) DebugTraceTransaction(ctx context.Context,
+	hash types.Hash,
+	opts *execclient.DebugTraceOpts,
+) (
+	*api.DebugTrace,
+	error,
+) {
+	traceOpts, timeout, err := s.debugTraceParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res json.RawMessage
+	if err := s.callForWithTimeout(ctx,
+		timeout,
+		&res,
+		"debug_traceTransaction",
+		fmt.Sprintf("%#x", hash),
+		traceOpts,
+	); err != nil {
+		return nil, errors.Wrap(err, "debug_traceTransaction failed")
+	}
+
+	if len(res) == 0 || string(res) == "null" {
+		return nil, errors.New("no trace returned")
+	}
+
+	return api.NewDebugTrace(opts.Tracer, opts.TracerConfig, res)
+}
+
+// debugTraceParams returns the tracer options for a debug trace request, and
+// the timeout for the request.
+func (s *Service) debugTraceParams(opts *execclient.DebugTraceOpts) (map[string]any, time.Duration, error) {
+	if opts == nil {
+		return nil, 0, errors.New("no options specified")
+	}
+
+	if opts.Tracer == "" {
+		return nil, 0, errors.New("no tracer specified")
+	}
+
+	traceOpts := map[string]any{
+		"tracer": string(opts.Tracer),
+	}
+
+	if opts.TracerConfig != nil {
+		traceOpts["tracerConfig"] = opts.TracerConfig
+	}
+
+	// The request must remain open for as long as the execution client runs the trace.
+	timeout := s.timeout
+	if opts.Timeout > 0 {
+		traceOpts["timeout"] = opts.Timeout.String()
+		timeout += opts.Timeout
+	}
+
+	return traceOpts, timeout, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugTraceTransaction(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	txHash := strToHash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060")
+	from := strToAddress("0xa1e4380a3b1f749673e270229993ee55f35663b4")
+	to := strToAddress("0x5df9b87991262f6ba471f09758cde1c0fc1de734")
+
+	tests := []struct {
+		name  string
+		opts  *execclient.DebugTraceOpts
+		err   string
+		check func(t *testing.T, trace *api.DebugTrace)
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name: "TracerMissing",
+			opts: &execclient.DebugTraceOpts{},
+			err:  "no tracer specified",
+		},
+		{
+			name: "Call",
+			opts: &execclient.DebugTraceOpts{
+				Tracer:  api.DebugTracerCall,
+				Timeout: 10 * time.Second,
+			},
+			check: func(t *testing.T, trace *api.DebugTrace) {
+				t.Helper()
+				require.NotNil(t, trace.Call)
+				require.Equal(t, "CALL", trace.Call.Type)
+				require.Equal(t, from, trace.Call.From)
+				require.Equal(t, to, *trace.Call.To)
+				require.Equal(t, uint64(0x7a69), trace.Call.Value.Uint64())
+			},
+		},
+		{
+			name: "Prestate",
+			opts: &execclient.DebugTraceOpts{
+				Tracer: api.DebugTracerPrestate,
+			},
+			check: func(t *testing.T, trace *api.DebugTrace) {
+				t.Helper()
+				require.NotNil(t, trace.Prestate)
+				require.Contains(t, trace.Prestate, from)
+			},
+		},
+		{
+			name: "PrestateDiff",
+			opts: &execclient.DebugTraceOpts{
+				Tracer: api.DebugTracerPrestate,
+				TracerConfig: map[string]any{
+					"diffMode": true,
+				},
+			},
+			check: func(t *testing.T, trace *api.DebugTrace) {
+				t.Helper()
+				require.NotNil(t, trace.PrestateDiff)
+				require.Equal(t, uint64(0), *trace.PrestateDiff.Pre[from].Nonce)
+				require.Equal(t, uint64(1), *trace.PrestateDiff.Post[from].Nonce)
+			},
+		},
+		{
+			name: "FourByte",
+			opts: &execclient.DebugTraceOpts{
+				Tracer: api.DebugTracerFourByte,
+			},
+			check: func(t *testing.T, trace *api.DebugTrace) {
+				t.Helper()
+				require.Empty(t, trace.FourByte)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trace, err := s.(execclient.DebugTraceProvider).DebugTraceTransaction(ctx, txHash, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.opts.Tracer, trace.Tracer)
+				test.check(t, trace)
+			}
+		})
+	}
+}
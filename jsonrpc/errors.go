@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/pkg/errors"
+	"github.com/ybbus/jsonrpc/v3"
+)
+
+// convertError converts errors from the JSON-RPC client to their API equivalents.
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		return newRPCError(rpcErr)
+	}
+
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return &api.HTTPError{
+			StatusCode: httpErr.Code,
+			Err:        err,
+		}
+	}
+
+	return err
+}
+
+// newRPCError creates an API RPC error from a JSON-RPC client RPC error.
+func newRPCError(rpcErr *jsonrpc.RPCError) *api.RPCError {
+	res := &api.RPCError{
+		Code:    rpcErr.Code,
+		Message: rpcErr.Message,
+	}
+
+	if rpcErr.Data != nil {
+		// Data was unmarshalled from JSON, so will marshal without error.
+		res.Data, _ = json.Marshal(rpcErr.Data)
+	}
+
+	return res
+}
+
+// convertCallError converts errors from calls that execute code, decoding
+// revert data where execution reverted.
+func convertCallError(err error) error {
+	err = convertError(err)
+
+	var rpcErr *api.RPCError
+	if errors.As(err, &rpcErr) {
+		if revertErr, isRevert := rpcErr.RevertError(); isRevert {
+			return revertErr
+		}
+	}
+
+	return err
+}
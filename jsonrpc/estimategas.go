@@ -25,7 +25,8 @@ import (
 )
 
 // EstimateGas estimates the gas required for a transaction.
-func (s *Service) EstimateGas(_ context.Context,
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) EstimateGas(ctx context.Context,
 	tx *spec.TransactionSubmission,
 ) (
 	*big.Int,
@@ -107,8 +108,8 @@ func (s *Service) EstimateGas(_ context.Context,
 	}
 
 	var gasStr string
-	if err := s.client.CallFor(&gasStr, "eth_estimateGas", opts, "latest"); err != nil {
-		return nil, errors.Wrap(err, "call to eth_estimateGas failed")
+	if err := s.callFor(ctx, &gasStr, "eth_estimateGas", opts, "latest"); err != nil {
+		return nil, errors.Wrap(convertCallError(err), "call to eth_estimateGas failed")
 	}
 
 	gas, err := util.StrToBigInt("gas", gasStr)
@@ -22,14 +22,14 @@ import (
 )
 
 // Events returns the events matching the filter.
-func (s *Service) Events(_ context.Context, filter *api.EventsFilter) ([]*spec.BerlinTransactionEvent, error) {
+func (s *Service) Events(ctx context.Context, filter *api.EventsFilter) ([]*spec.BerlinTransactionEvent, error) {
 	if filter == nil {
 		return nil, errors.New("filter not specified")
 	}
 
 	var events []*spec.BerlinTransactionEvent
 
-	if err := s.client.CallFor(&events, "eth_getLogs", []*api.EventsFilter{filter}); err != nil {
+	if err := s.callFor(ctx, &events, "eth_getLogs", []*api.EventsFilter{filter}); err != nil {
 		return nil, err
 	}
 
@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-execution-client/util"
 	"github.com/pkg/errors"
 )
 
@@ -28,16 +29,12 @@ func (s *Service) blockIDToHeight(ctx context.Context, blockID string) (int64, e
 	case blockID == "":
 		height = -1
 	case strings.HasPrefix(blockID, "0x"):
-		block, err := s.blockAtHash(ctx, blockID)
+		blockHeight, err := s.BlockHeight(ctx, blockID)
 		if err != nil {
 			return -1, err
 		}
 
-		if block == nil {
-			return -1, errors.New("block not found")
-		}
-
-		height = int64(block.Berlin.Number)
+		height = int64(blockHeight)
 	default:
 		var err error
 
@@ -49,3 +46,41 @@ func (s *Service) blockIDToHeight(ctx context.Context, blockID string) (int64, e
 
 	return height, nil
 }
+
+// blockParam converts a block ID to the block parameter of a request,
+// also returning true if the parameter is a block hash.
+func blockParam(blockID string) (string, bool, error) {
+	if blockID == "" {
+		return "latest", false, nil
+	}
+
+	if _, isIdentifier := blockIdentifiers[blockID]; isIdentifier {
+		return blockID, false, nil
+	}
+
+	if strings.HasPrefix(blockID, "0x") {
+		return blockID, true, nil
+	}
+
+	height, err := strconv.ParseInt(blockID, 10, 64)
+	if err != nil {
+		return "", false, errors.Wrap(err, "unhandled block ID")
+	}
+
+	return util.MarshalInt64(height), false, nil
+}
+
+// stateBlockParam converts a block ID to the block parameter of a state
+// request, using the EIP-1898 form for block hashes.
+func stateBlockParam(blockID string) (any, error) {
+	param, isHash, err := blockParam(blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isHash {
+		return map[string]string{"blockHash": param}, nil
+	}
+
+	return param, nil
+}
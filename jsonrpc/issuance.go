@@ -35,15 +35,15 @@ func (s *Service) Issuance(ctx context.Context, blockID string) (*api.Issuance,
 	return s.issuanceAtHeight(ctx, height)
 }
 
-func (s *Service) issuanceAtHeight(_ context.Context, height int64) (*api.Issuance, error) {
+func (s *Service) issuanceAtHeight(ctx context.Context, height int64) (*api.Issuance, error) {
 	var issuance api.Issuance
 
 	if height == -1 {
-		if err := s.client.CallFor(&issuance, "erigon_issuance", "latest"); err != nil {
+		if err := s.callFor(ctx, &issuance, "erigon_issuance", "latest"); err != nil {
 			return nil, err
 		}
 	} else {
-		if err := s.client.CallFor(&issuance, "erigon_issuance", fmt.Sprintf("0x%x", height)); err != nil {
+		if err := s.callFor(ctx, &issuance, "erigon_issuance", fmt.Sprintf("0x%x", height)); err != nil {
 			return nil, err
 		}
 	}
@@ -46,3 +46,16 @@ func strToHash(input string) types.Hash {
 
 	return hash
 }
+
+// strToAddress is a helper to create an address given a string representation.
+func strToAddress(input string) types.Address {
+	bytes, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	var address types.Address
+	copy(address[:], bytes)
+
+	return address
+}
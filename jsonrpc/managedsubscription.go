@@ -0,0 +1,311 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// managedSubscription is a subscription that is re-established if the
+// connection to the execution client is lost.
+type managedSubscription struct {
+	service *Service
+	params  []any
+	handler func(ctx context.Context, result json.RawMessage) error
+	sub     *util.Subscription
+	// cancel ends the subscription.
+	cancel context.CancelFunc
+	// deliveryCtx is passed to the handler, and is cancelled when
+	// unsubscribing so that the handler does not block the response.
+	deliveryCtx    context.Context
+	stopDeliveries context.CancelFunc
+	// connMu protects conn, and writes to it other than control messages.
+	connMu    sync.Mutex
+	conn      *websocket.Conn
+	responses chan *subscriptionResponse
+}
+
+// run receives notifications for the subscription, reconnecting with
+// exponential backoff whenever the connection is lost, until the context is done.
+func (m *managedSubscription) run(ctx context.Context) {
+	defer m.cancel()
+	defer m.stopDeliveries()
+
+	m.connMu.Lock()
+	conn := m.conn
+	m.connMu.Unlock()
+
+	for {
+		err := m.receive(ctx, conn)
+		m.setConn(nil)
+
+		if ctx.Err() != nil {
+			log.Trace().Msg("Context done; subscription closed")
+			m.sub.Notify(util.SubscriptionStateClosed, nil)
+
+			return
+		}
+
+		log.Warn().Err(err).Msg("Subscription connection lost; reconnecting")
+		m.sub.Notify(util.SubscriptionStateDisconnected, err)
+		m.sub.ReportError(err)
+
+		var id []byte
+		conn, id, err = m.service.reconnectSubscription(ctx, m.params)
+		if err != nil {
+			// Only returns an error when the context is done.
+			log.Trace().Msg("Context done; subscription closed")
+			m.sub.Notify(util.SubscriptionStateClosed, nil)
+
+			return
+		}
+
+		m.setConn(conn)
+		m.sub.SetID(id)
+		m.sub.Notify(util.SubscriptionStateReconnected, nil)
+		log.Debug().Str("subscription", fmt.Sprintf("%#x", id)).Msg("Subscription re-established")
+	}
+}
+
+// setConn sets the current connection of the subscription.
+func (m *managedSubscription) setConn(conn *websocket.Conn) {
+	m.connMu.Lock()
+	m.conn = conn
+	m.connMu.Unlock()
+}
+
+// receive reads notifications from the websocket, passing the result of each
+// to the handler.  It returns when the connection is lost or the context is
+// done, closing the connection.
+func (m *managedSubscription) receive(ctx context.Context, conn *websocket.Conn) error {
+	defer conn.Close()
+
+	// Any message or pong from the execution client shows that the connection
+	// is alive; if neither arrives before the deadline the read fails.
+	extendDeadline := func() error {
+		return conn.SetReadDeadline(time.Now().Add(subscriptionReadTimeout))
+	}
+	conn.SetPongHandler(func(string) error {
+		return extendDeadline()
+	})
+	if err := extendDeadline(); err != nil {
+		return errors.Wrap(err, "failed to set read deadline")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go keepSocketAlive(ctx, conn, done)
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return errors.Wrap(err, "failed to read message")
+		}
+
+		if err := extendDeadline(); err != nil {
+			return errors.Wrap(err, "failed to set read deadline")
+		}
+
+		log.Trace().Str("msg", string(msg)).Msg("Received message")
+
+		event := subscriptionEvent{}
+		if err := json.Unmarshal(msg, &event); err != nil {
+			log.Error().Err(err).Msg("Failed to unmarshal message")
+			m.sub.ReportError(errors.Wrap(err, "invalid message"))
+
+			continue
+		}
+
+		if event.Params == nil {
+			m.receiveResponse(msg)
+
+			continue
+		}
+
+		if m.deliveryCtx.Err() != nil {
+			// Unsubscribing; no further deliveries.
+			continue
+		}
+
+		// The connection is not read whilst the handler runs, which can be for
+		// some time if the consumer is slow, so suspend the read deadline to stop
+		// the connection being considered lost.
+		if err := conn.SetReadDeadline(time.Time{}); err != nil {
+			return errors.Wrap(err, "failed to clear read deadline")
+		}
+
+		if err := m.handler(m.deliveryCtx, event.Params.Result); err != nil {
+			log.Debug().Err(err).Msg("Failed to handle notification")
+			m.sub.ReportError(err)
+		}
+
+		if err := extendDeadline(); err != nil {
+			return errors.Wrap(err, "failed to set read deadline")
+		}
+	}
+}
+
+// receiveResponse passes a response to an unsubscription request to the
+// waiting caller, ignoring any other message.
+func (m *managedSubscription) receiveResponse(msg []byte) {
+	var res subscriptionResponse
+	if err := json.Unmarshal(msg, &res); err != nil || res.ID != unsubscribeRequestID {
+		log.Debug().Str("msg", string(msg)).Msg("Message is not a notification; ignoring")
+
+		return
+	}
+
+	select {
+	case m.responses <- &res:
+	default:
+		log.Debug().Str("msg", string(msg)).Msg("Unexpected unsubscription response; ignoring")
+	}
+}
+
+// unsubscribe sends eth_unsubscribe to the execution client and ends the subscription.
+func (m *managedSubscription) unsubscribe(ctx context.Context) error {
+	defer m.cancel()
+	m.stopDeliveries()
+
+	m.connMu.Lock()
+	conn := m.conn
+	if conn == nil {
+		// Disconnected, so the subscription has already ended on the execution client.
+		m.connMu.Unlock()
+
+		return nil
+	}
+
+	err := m.requestUnsubscription(conn)
+	m.connMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := m.service.requestContext(ctx)
+	defer cancel()
+
+	var res *subscriptionResponse
+	select {
+	case res = <-m.responses:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to obtain unsubscription response")
+	}
+
+	if res.Error != nil {
+		return errors.Wrap(&api.RPCError{
+			Code:    res.Error.Code,
+			Message: res.Error.Message,
+			Data:    res.Error.Data,
+		}, "eth_unsubscribe failed")
+	}
+
+	var unsubscribed bool
+	if err := json.Unmarshal(res.Result, &unsubscribed); err != nil {
+		return errors.Wrap(err, "invalid unsubscription response")
+	}
+
+	if !unsubscribed {
+		return errors.New("subscription not found")
+	}
+
+	return nil
+}
+
+// requestUnsubscription sends an unsubscription request over the connection.
+func (m *managedSubscription) requestUnsubscription(conn *websocket.Conn) error {
+	request, err := json.Marshal(&subscriptionRequest{
+		JSONRPC: "2.0",
+		ID:      unsubscribeRequestID,
+		Method:  "eth_unsubscribe",
+		Params:  []any{fmt.Sprintf("%#x", m.sub.ID())},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create unsubscription request")
+	}
+
+	if err := conn.SetWriteDeadline(time.Now().Add(m.service.timeout)); err != nil {
+		return errors.Wrap(err, "failed to set write deadline")
+	}
+
+	if err := conn.WriteMessage(websocket.TextMessage, request); err != nil {
+		return errors.Wrap(err, "failed to request unsubscription")
+	}
+
+	return nil
+}
+
+// overflowPolicy returns the overflow policy from the subscription options.
+func overflowPolicy(opts *execclient.SubscriptionOpts) util.OverflowPolicy {
+	if opts == nil {
+		return util.OverflowPolicyBlock
+	}
+
+	return opts.Overflow
+}
+
+// deliver sends an item to the consumer's channel, following the overflow
+// policy if the channel is full.
+func deliver[T any](ctx context.Context, ch chan T, item T, policy util.OverflowPolicy) error {
+	switch policy {
+	case util.OverflowPolicyDropNewest:
+		select {
+		case ch <- item:
+			return nil
+		default:
+			return util.ErrSubscriptionOverflow
+		}
+	case util.OverflowPolicyDropOldest:
+		if cap(ch) == 0 {
+			// No buffer to drop from.
+			return deliver(ctx, ch, item, util.OverflowPolicyDropNewest)
+		}
+
+		dropped := false
+		for {
+			select {
+			case ch <- item:
+				if dropped {
+					return util.ErrSubscriptionOverflow
+				}
+
+				return nil
+			default:
+			}
+
+			select {
+			case <-ch:
+				dropped = true
+			default:
+			}
+		}
+	default:
+		select {
+		case ch <- item:
+		case <-ctx.Done():
+		}
+
+		return nil
+	}
+}
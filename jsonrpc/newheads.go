@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// NewHeads returns a subscription for new block headers.
+// The blocks sent to the channel contain only header information.
+func (s *Service) NewHeads(ctx context.Context,
+	ch chan *spec.Block,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	policy := overflowPolicy(opts)
+
+	return s.subscribe(ctx, func(ctx context.Context, result json.RawMessage) error {
+		return receiveNewHead(ctx, result, ch, policy)
+	}, "newHeads")
+}
+
+// receiveNewHead sends the header announced by a notification to the channel.
+func receiveNewHead(ctx context.Context,
+	result json.RawMessage,
+	ch chan *spec.Block,
+	policy util.OverflowPolicy,
+) error {
+	var block spec.Block
+	if err := json.Unmarshal(result, &block); err != nil {
+		return errors.Wrap(err, "failed to unmarshal header")
+	}
+
+	return deliver(ctx, ch, &block, policy)
+}
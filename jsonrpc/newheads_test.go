@@ -0,0 +1,52 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewHeads tests the NewHeads function.
+func TestNewHeads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	ch := make(chan *spec.Block)
+	subscription, err := s.(execclient.NewHeadsProvider).NewHeads(ctx, ch, nil)
+	require.NoError(t, err)
+	require.NotNil(t, subscription)
+
+	// Wait to see a header.
+	block := <-ch
+	require.NotNil(t, block)
+	require.NoError(t, block.VerifyHash())
+
+	require.NoError(t, subscription.Unsubscribe(ctx))
+	<-subscription.Done()
+}
@@ -0,0 +1,59 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// NewPendingTransactionHashes returns a subscription for the hashes of pending transactions.
+func (s *Service) NewPendingTransactionHashes(ctx context.Context,
+	ch chan types.Hash,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	policy := overflowPolicy(opts)
+
+	return s.subscribe(ctx, func(ctx context.Context, result json.RawMessage) error {
+		hash, err := unmarshalPendingTransactionHash(result)
+		if err != nil {
+			return err
+		}
+
+		return deliver(ctx, ch, hash, policy)
+	}, "newPendingTransactions")
+}
+
+// unmarshalPendingTransactionHash obtains the transaction hash from a notification.
+func unmarshalPendingTransactionHash(result json.RawMessage) (types.Hash, error) {
+	var hashStr string
+	if err := json.Unmarshal(result, &hashStr); err != nil {
+		return types.Hash{}, errors.Wrap(err, "failed to unmarshal transaction hash")
+	}
+
+	hash, err := util.StrToHash("transaction hash", hashStr)
+	if err != nil {
+		return types.Hash{}, errors.Wrap(err, "invalid transaction hash")
+	}
+
+	return hash, nil
+}
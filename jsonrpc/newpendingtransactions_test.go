@@ -21,26 +21,58 @@ import (
 	execclient "github.com/attestantio/go-execution-client"
 	"github.com/attestantio/go-execution-client/jsonrpc"
 	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/util"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 // TestNewPendingTransactions tests the TestNewPendingTransactions function.
 func TestNewPendingTransactions(t *testing.T) {
-	ctx := context.Background()
-	s, err := jsonrpc.New(ctx,
-		jsonrpc.WithLogLevel(zerolog.Disabled),
-		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
-		jsonrpc.WithTimeout(timeout),
-	)
-	require.NoError(t, err)
-
-	ch := make(chan *spec.Transaction)
-	subscription, err := s.(execclient.NewPendingTransactionsProvider).NewPendingTransactions(ctx, ch)
-	require.NoError(t, err)
-	require.NotNil(t, subscription)
-
-	// Wait to see a transaction.
-	tx := <-ch
-	require.NotNil(t, tx)
+	tests := []struct {
+		name string
+		opts *execclient.PendingTransactionsOpts
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name: "FullTransactions",
+			opts: &execclient.PendingTransactionsOpts{
+				FullTransactions: true,
+			},
+		},
+		{
+			name: "DropNewest",
+			opts: &execclient.PendingTransactionsOpts{
+				SubscriptionOpts: execclient.SubscriptionOpts{
+					Overflow: util.OverflowPolicyDropNewest,
+				},
+				FullTransactions: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+				jsonrpc.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			ch := make(chan *spec.Transaction, 16)
+			subscription, err := s.(execclient.NewPendingTransactionsProvider).NewPendingTransactionsWithOpts(ctx, ch, test.opts)
+			require.NoError(t, err)
+			require.NotNil(t, subscription)
+
+			// Wait to see a transaction.
+			tx := <-ch
+			require.NotNil(t, tx)
+			require.NoError(t, tx.VerifyHash())
+		})
+	}
 }
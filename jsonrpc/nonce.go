@@ -0,0 +1,42 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+)
+
+// Nonce obtains the nonce for the given address at the given block ID.
+func (s *Service) Nonce(ctx context.Context, address types.Address, blockID string) (uint64, error) {
+	block, err := stateBlockParam(blockID)
+	if err != nil {
+		return 0, err
+	}
+
+	var nonceStr string
+	if err := s.callFor(ctx, &nonceStr, "eth_getTransactionCount", fmt.Sprintf("%#x", address), block); err != nil {
+		return 0, err
+	}
+
+	nonce, err := util.StrToUint64("nonce", nonceStr)
+	if err != nil {
+		return 0, err
+	}
+
+	return nonce, nil
+}
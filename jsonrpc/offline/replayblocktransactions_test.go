@@ -0,0 +1,128 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// londonBlockByHash is mainnet block 13593912 as returned by eth_getBlockByHash
+// without full transactions.
+const londonBlockByHash = `{"baseFeePerGas":"0x2bf3d74bc7","difficulty":"0x26ef28d3882645","extraData":"0x486976656f6e20686b","gasLimit":"0x1c9c380","gasUsed":"0x5e5cd8","hash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","logsBloom":"0x382052020800021414804040a208102000806c00010460008007020c2832010308010010009026a00508118000494140030200900908641d093400405262000c050000404040a5684980516f834040606a052008080804c01000301080460441809004080242201040f010902010891063000418183a804442040090400801064000010dc020200408d019280580040404a2002721a484780d1288c088910cc0270ac44902002803146040c28010084060000011a00648a0090006428000010080510422000000408000a081224210f40c0860800020221522c008818100200040193021090810a0020200601024500604288020011424400408a09000040210","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x15be7bda2fd66ef3c634bab035a8454365ed31d090dd99c76ac1910151ba2b9c","nonce":"0x9ed675789be2ead0","number":"0xcf6d38","parentHash":"0x3560ee45703a5c4d352b1d6d8f3c642d3f1db6c56b9db3069e9121ad63a241c7","receiptsRoot":"0x55411c42653944bbadad219f8ded587754015f1a584822fd4ed2a0d7e09db364","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0x7785","stateRoot":"0x825f84a5098241041e4e45bde4c1cdab05de1aaf3be13d5cff14d5495a2561fb","timestamp":"0x618cd7ce","totalDifficulty":"0x73d8abc71b22d49d973","transactions":["0x09d9578f3a3cd87099b9a5c979842efa6fbc80fc2c6a8044d55a2a7b90a6b14e","0x013420b2141e24aa551f4d5526deeeafb552f953d97ba567c018229eda7fec16","0xa4905adcf14540f0e214cc8bffabab0834bbb5b91f00cb31a092568a66dabdcf","0xad59a3d517782a770742e9c372fc3cc445c5fa0f21fc95b2f9f772e543d9e7b8","0x45d9c5833a07096083714aaab66379b3967d6445e35835342f4c08ea33dd8260","0x4378d013038b20e5491daab55d02c2f63fb3ce6a007ade9e492a9ecbfc80df16","0x6f9ced3adb92682db1f2a3e81182d2c84249d0aaa641ea263084417fe9fe11af","0xc0e1fa71208c0d2bf173df56f42bb7f865cebddcff7c1548a6de90a2918f579f","0x65231527913be7832119787df4649bdb6e981e6f79436556152e486d9dd5438e","0xb7ce11ea38e6079689241b9c0b507407dc785883d50378b5efcab84e176496b0","0xd932d8a9705b158887d0302ce77e8b87b44038b7807889fc6e1ba02c1850015f","0x76782fe533f4ad8d4a2213e2992d0774b44f2c5d655706bd7d24349d537ff3fc","0x78303c100a3eedf92aa41ec3edf7d4c735c09a6f5cedc3c16de1aca195215e54","0x5a1cb4c353ff0ff1de52d14376f5bfc841a566ee58fd197102006977efbd7386","0xcd231be11688fc0ef459c51c70229f20f44ab9b401b879ea76523a749f133f45","0x7a1439bc2a44ff7570924b4f78d9558234436a1247a79a30c4020fe3e7bdf4a7","0x5ea0401b40377ea4a7690f2ff7d256c3892d5cfd20a4953017b3483dacf2fc03","0x02965b8f5d46a239560cdd1a8435bbc3cfcb12ff26cbd730050a6250269ff3fe","0x06297e870b52babeada6cdfac98c46dfbaba284e15d9691e7f82221e1ea16d2f","0xa0b026ac2b423264e8d1005a7bb1c80810cbf78356259143ca716fdc435c7193","0x27b4ef7e92b8c0d199a7089a479e32512778367fd7ce769c9ae0d0d7ead3bbbf","0x5856200e70a34e82dcd079d088ce2830ac9ae428115d81545052343096d0b58c","0x6f83209b698c225128c25d94116930a36df61eb0fc1e76f34af68b408360185f","0xf0a396e0da6eb0f21bb438af0fc8a9031df366ed9c0e41583e3360a7845e2888","0xfa47d2956cef04d8e646c57eec7d84e3329fe3a3caae6ff23ea86ebbe484eaa2","0xdfacc3e5bcabc6c7665dddeadb00f6c2343f5cc77be32b9f0ea023f87136eda3","0x0f29b8168328beb7dc485cadf40056da2cde6ce885c121b0ec8784a690b328d8","0x015e81c596f8b47021c8907037f3854ef5f080bccc16fc06aaf640dddf495938","0x7eb04a849878bca27a86ccf4febb05fa7c60d5f963053f5a65e553c4a07884be","0x486b7fca0da550e0f15792fe25681c43f6fd43b562f9b47dc128496bc6a32523","0x5c612f5589b07daaecaffa78c6d6d908ea4a0c73a606876351b628b2ec8db2e8","0x6e77c3f64025b4d57b26bdab4d96c643ccef02e240a6f06058ef0807e3fef7ac","0xe1b50104e8394a737aa1ab40da31c654aac5e005d221cc1ff36b119df6bf0960","0x2653c3f5bdeb00e3a31a45ea95d10015b8be46f4a514b8d1d30378a30e8a3f40","0x130287e30a02cf004a8ce8f0d5dc5bf31267b58dad0f153116883222af67e379","0x2c9a1bc9baabf5c1fcdd50455fd389b5237edf2883040843d805d1207f646338","0x660a34be1b0ed3bc78c6a722705fcee753092ba86ba20fa2be2488d4e2498473","0x135f02bb2d674e2be1d451728a289faa41c2d55f166941d3a179b9f266200fd1","0xb45af5562729a8c41a48586dc0f30456f5c06394bfd0e2aecee04a119d8ed025","0x971217c104bddc13b247f3f36d594eb31dc40675526ab7b179272b6da902c640","0x12c55d26843510ff68f6dd24ae3ab59046b0b33a36d7f4afff04b122164460b7","0xb3217684aa4d651c883978418a51171eee8b7da44307f883fe0b542d866c29ab","0xb6d56d13c808e9db96dce8523dea01a255f2b8ab445056be1d0fe4e8deb5c20f","0xf9ba476b3e8f22e538f6f0713996652dd7e81644b8b87c48ee7a7d7462ecf423","0xad112f358c7ce6564f7fef1476a9135971f3b9c3987dd90db360d7defdca9aaf","0x791893ff90b97ab6c93ad4a234b011c6c77bd9908bdd931dacc849c9314345b0","0x5f2cd9ddc16571cdc6a2957e4200c262a17c4a0c2871ad540b69db91ee5be37c","0xf7646018e728bbaedf5caffe072c214d7848cfc20ce13c906204c36a446b9869","0x110c827394f45457e59041dc317a45ad535ec9e5402b0b55bd8114e81f0fed75","0xf7b9e23593d491b851b4f735aa8352597a0a88a811e11e6983e5d119ddd12668","0xdc857550e278dd14b8e8bb8f5639dce1a951af0e23b5e0eb56c7728eebb4165e","0xe0383f61d7d5b4232ec4e85aceefe621609e4aab8a09958d93da3c9e2e66a4a6","0xda721a685570e13d867e4b815f01d7310100be08895eb29035feb8edc8d07bf0","0xcc7b3a8d67e2f968eec59052521ef876202edb166a056d2f4fccd1411eef6546","0xb96f51f2f565230bc1ac0f3c9823ee3f9f1d5de572d82b4fc70aaa625bb5a90b","0x3be57f9143ec090f5584772543ea3ed412b33b818c2fd75e05d5295c847c071f","0xd9511045cef7f901d652794c2fba8b193b9a2e4e692419fd7edd8328c1fffd69","0xa1bcc29d8739462cedf235605b3fb5a98345914941706caebbf88933c5be3efb","0x34c704014f31157694ee1b6c616b365b7e0cdc31e4cd903519ccd200be454dcf","0x0f35868fb6cfe598103ea8a3ab4692a9a6459379db7d8f7d909dad4679d8c384","0x9fa2fee58d8218dfaed7a4becd564c65914aafd72fcd7cedaef12ba925521ef4","0x251615f10abe599e93038182a543eec1bfe999ef87c3c45f6c68880f60e7e6aa","0x447cf4729f88fd08061a41903a0358c1746a820c017bf7c8b7c08001b3fd0caa","0x12fb22392ce64c06ec8cd4ff4373390ad464dfaea5edd509e807bfa60c9f31be","0xe0597bde33efa088e1b5d2a85db7444776acb900b655fcd34395a94447a4cd20","0xa11b8598f41c7e0b93127cf285282bbbfda6c9cc3feca9dc29c65363a7c1c639","0x092bb11cb0c69e4fc60f6d7c3f9828eb30cbd8d7ad9b26e43e7326a32740074a","0xc4f0872da507a92e1360c83cbaee86f9c8826f7f7ce53082d353e99e278fe977","0x59e615a036324abc0d426231978ffb014a5c6cd0d7a1e61d8b1341a8898ba360","0xd747966887d228e4f71b26c8f79f7f5aeb5aa47b5aa0e6fb6acc5a38eea04b96","0x6d4409686e5262997cddc374d7ba2f3468c2ddd2c0a3354eb3ef6222c4d71ed0","0xde57d44abe03cb144b936ebea8ef605dcb192dd84c0c6aecdfa3aeecc8a463bd","0xed0d346c3f7740662e182e89001d53fb909e70640df9004ba0feb5249097d01f","0x703d7ede7bd6acc15db43809b440e2981a1c8804f7622475c08ae11206f91604","0x534bb6b62e4b088f8f58529b2f68e7034d65c677b78f451089abe297cbe183ef","0x63d9a85b3a93800f654b92989797ce3ce065e265e93ba0e0390a903035744437","0x6c44bbe9dc08990d25a1a96d5bc17d888b663980154764d951ec7748ac189cb4","0x55703664c47f1a27514fc82f64405d7191700941a1f5ae8b6707bfe04d5da13c","0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec","0xd000cfc8efa8398d42c60cf3f63b0dd1fcc80b798c559593b7b7610cf684212d","0xd77841e366954d70bbdcdfd76b844f0aa7a1debbd1d2cf1c3f8ff1ef8c636a2d"],"transactionsRoot":"0x4bff2452c0f0ad55fe59b70187d74eed6416d6176e77ec40a93e55bfd8e5d549","uncles":["0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec"]}`
+
+// newBlockTestServer creates a server that responds to each method with the
+// supplied result, returning the parameters of the last request for a method.
+func newBlockTestServer(t *testing.T, results map[string]string) (*httptest.Server, func(method string) []any) {
+	t.Helper()
+
+	var mu sync.Mutex
+	params := make(map[string][]any)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		params[req.Method] = req.Params
+		mu.Unlock()
+
+		result, exists := results[req.Method]
+		if !exists {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":%s}`, req.ID, result)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func(method string) []any {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return params[method]
+	}
+}
+
+func TestReplayBlockTransactionsByHash(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		block   string
+		err     string
+		results int
+	}{
+		{
+			name:    "London",
+			block:   londonBlockByHash,
+			results: 1,
+		},
+		{
+			name:  "Unknown",
+			block: "null",
+			err:   "block not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, params := newBlockTestServer(t, map[string]string{
+				"eth_getBlockByHash":            test.block,
+				"trace_replayBlockTransactions": `[{"stateDiff":{},"transactionHash":"0x09d9578f3a3cd87099b9a5c979842efa6fbc80fc2c6a8044d55a2a7b90a6b14e"}]`,
+			})
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(srv.URL),
+				jsonrpc.WithTimeout(time.Second),
+			)
+			require.NoError(t, err)
+
+			replay, err := s.(execclient.BlockReplaysProvider).ReplayBlockTransactions(ctx,
+				"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f",
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, replay, test.results)
+			require.Equal(t, []any{"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f", false}, params("eth_getBlockByHash"))
+			require.Equal(t, []any{"0xcf6d38", []any{"stateDiff"}}, params("trace_replayBlockTransactions"))
+		})
+	}
+}
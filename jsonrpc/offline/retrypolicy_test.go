@@ -0,0 +1,268 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// retryTestResponse is the response of the retry test server to a request.
+type retryTestResponse struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// newRetryTestServer creates a server that responds to each request for a
+// method with the next of the supplied responses, returning the number of
+// requests received for each method.
+func newRetryTestServer(t *testing.T, responses map[string][]*retryTestResponse) (*httptest.Server, func(method string) int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	counts := make(map[string]int)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		count := counts[req.Method]
+		counts[req.Method]++
+		mu.Unlock()
+
+		methodResponses, exists := responses[req.Method]
+		if !exists {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+
+			return
+		}
+
+		response := methodResponses[min(count, len(methodResponses)-1)]
+		if response.retryAfter != "" {
+			w.Header().Set("Retry-After", response.retryAfter)
+		}
+		if response.status != 0 {
+			w.WriteHeader(response.status)
+			fmt.Fprint(w, response.body)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,%s}`, req.ID, response.body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func(method string) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return counts[method]
+	}
+}
+
+func TestRetryPolicyParameters(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		policy *jsonrpc.RetryPolicy
+		err    string
+	}{
+		{
+			name:   "MaxAttemptsZero",
+			policy: &jsonrpc.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Second},
+			err:    "invalid retry policy: max attempts must be at least 1",
+		},
+		{
+			name:   "InitialBackoffZero",
+			policy: &jsonrpc.RetryPolicy{MaxAttempts: 3, MaxBackoff: time.Second},
+			err:    "invalid retry policy: no initial backoff specified",
+		},
+		{
+			name:   "MaxBackoffLow",
+			policy: &jsonrpc.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: time.Millisecond},
+			err:    "invalid retry policy: max backoff less than initial backoff",
+		},
+		{
+			name:   "JitterHigh",
+			policy: &jsonrpc.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Second, Jitter: 1.5},
+			err:    "invalid retry policy: jitter must be between 0 and 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress("http://localhost:1"),
+				jsonrpc.WithRetryPolicy(test.policy),
+			)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	policy := &jsonrpc.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     100 * time.Millisecond,
+		Jitter:         0.5,
+	}
+
+	tests := []struct {
+		name          string
+		responses     map[string][]*retryTestResponse
+		sendRaw       bool
+		err           string
+		attempts      int
+		minDuration   time.Duration
+		policyMethods map[string]bool
+	}{
+		{
+			name: "GatewayErrors",
+			responses: map[string][]*retryTestResponse{
+				"eth_blockNumber": {
+					{status: http.StatusBadGateway, body: "bad gateway"},
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+					{body: `"result":"0x10"`},
+				},
+			},
+			attempts: 3,
+		},
+		{
+			name: "RetryAfter",
+			responses: map[string][]*retryTestResponse{
+				"eth_blockNumber": {
+					{status: http.StatusTooManyRequests, retryAfter: "1", body: "slow down"},
+					{body: `"result":"0x10"`},
+				},
+			},
+			attempts:    2,
+			minDuration: time.Second,
+		},
+		{
+			name: "MaxAttempts",
+			responses: map[string][]*retryTestResponse{
+				"eth_blockNumber": {
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+				},
+			},
+			err:      "HTTP status 503",
+			attempts: 3,
+		},
+		{
+			name: "NotTransient",
+			responses: map[string][]*retryTestResponse{
+				"eth_blockNumber": {
+					{body: `"error":{"code":-32602,"message":"invalid params"}`},
+				},
+			},
+			err:      "invalid params (code -32602)",
+			attempts: 1,
+		},
+		{
+			name: "SendRawTransactionGatewayError",
+			responses: map[string][]*retryTestResponse{
+				"eth_sendRawTransaction": {
+					{status: http.StatusBadGateway, body: "bad gateway"},
+					{body: `"result":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`},
+				},
+			},
+			sendRaw:  true,
+			err:      "HTTP status 502",
+			attempts: 1,
+		},
+		{
+			name: "SendRawTransactionRateLimited",
+			responses: map[string][]*retryTestResponse{
+				"eth_sendRawTransaction": {
+					{status: http.StatusTooManyRequests, body: "slow down"},
+					{body: `"result":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`},
+				},
+			},
+			sendRaw:  true,
+			attempts: 2,
+		},
+		{
+			name: "SendRawTransactionIdempotentOverride",
+			responses: map[string][]*retryTestResponse{
+				"eth_sendRawTransaction": {
+					{status: http.StatusBadGateway, body: "bad gateway"},
+					{body: `"result":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`},
+				},
+			},
+			sendRaw:       true,
+			policyMethods: map[string]bool{"eth_sendRawTransaction": true},
+			attempts:      2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, counts := newRetryTestServer(t, test.responses)
+
+			testPolicy := *policy
+			testPolicy.Idempotent = test.policyMethods
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(srv.URL),
+				jsonrpc.WithTimeout(5*time.Second),
+				jsonrpc.WithRetryPolicy(&testPolicy),
+			)
+			require.NoError(t, err)
+
+			method := "eth_blockNumber"
+			started := time.Now()
+			if test.sendRaw {
+				method = "eth_sendRawTransaction"
+				_, err = s.(execclient.TransactionSubmitter).SendRawTransaction(ctx, []byte{0x01})
+			} else {
+				_, err = s.(execclient.ChainHeightProvider).ChainHeight(ctx)
+			}
+			duration := time.Since(started)
+
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.attempts, counts(method))
+			require.GreaterOrEqual(t, duration, test.minDuration)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceBlockByHash(t *testing.T) {
+	ctx := context.Background()
+
+	srv, params := newBlockTestServer(t, map[string]string{
+		"eth_getBlockByHash": londonBlockByHash,
+		"trace_block":        `[]`,
+	})
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(srv.URL),
+		jsonrpc.WithTimeout(time.Second),
+	)
+	require.NoError(t, err)
+
+	traces, err := s.(execclient.TracesProvider).TraceBlock(ctx,
+		"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f",
+	)
+	require.NoError(t, err)
+	require.Empty(t, traces)
+	require.Equal(t, []any{"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f", false}, params("eth_getBlockByHash"))
+	require.Equal(t, []any{"0xcf6d38"}, params("trace_block"))
+}
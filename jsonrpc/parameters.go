@@ -25,6 +25,7 @@ type parameters struct {
 	address          string
 	webSocketAddress string
 	timeout          time.Duration
+	retryPolicy      *RetryPolicy
 }
 
 // Parameter is the interface for service parameters.
@@ -67,6 +68,14 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithRetryPolicy sets the policy for retrying requests that fail with transient errors.
+// If not supplied requests are not retried.
+func WithRetryPolicy(policy *RetryPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryPolicy = policy
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -92,5 +101,32 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		return nil, errors.New("no timeout specified")
 	}
 
+	if parameters.retryPolicy != nil {
+		if err := checkRetryPolicy(parameters.retryPolicy); err != nil {
+			return nil, errors.Wrap(err, "invalid retry policy")
+		}
+	}
+
 	return &parameters, nil
 }
+
+// checkRetryPolicy checks that the retry policy is usable.
+func checkRetryPolicy(policy *RetryPolicy) error {
+	if policy.MaxAttempts < 1 {
+		return errors.New("max attempts must be at least 1")
+	}
+
+	if policy.InitialBackoff <= 0 {
+		return errors.New("no initial backoff specified")
+	}
+
+	if policy.MaxBackoff < policy.InitialBackoff {
+		return errors.New("max backoff less than initial backoff")
+	}
+
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		return errors.New("jitter must be between 0 and 1")
+	}
+
+	return nil
+}
@@ -15,29 +15,41 @@ package jsonrpc
 
 import (
 	"context"
-	"strconv"
-	"strings"
 
+	execclient "github.com/attestantio/go-execution-client"
 	"github.com/attestantio/go-execution-client/api"
 	"github.com/attestantio/go-execution-client/util"
-	"github.com/pkg/errors"
 )
 
 // ReplayBlockTransactions obtains traces for all transactions in a block.
 func (s *Service) ReplayBlockTransactions(ctx context.Context, blockID string) ([]*api.TransactionResult, error) {
-	if strings.HasPrefix(blockID, "0x") {
-		return nil, errors.New("fetch by block hash not implemented")
-	}
+	return s.ReplayBlockTransactionsWithOpts(ctx, blockID, nil)
+}
 
-	height, err := strconv.ParseInt(blockID, 10, 64)
+// ReplayBlockTransactionsWithOpts obtains traces for all transactions in a block,
+// using the supplied options.
+func (s *Service) ReplayBlockTransactionsWithOpts(ctx context.Context,
+	blockID string,
+	opts *execclient.ReplayOpts,
+) (
+	[]*api.TransactionResult,
+	error,
+) {
+	height, err := s.blockIDToHeight(ctx, blockID)
 	if err != nil {
-		return nil, errors.Wrap(err, "unhandled block ID")
+		return nil, err
 	}
 
-	return s.replayBlockTransactionsAtHeight(ctx, height)
+	return s.replayBlockTransactionsAtHeight(ctx, height, replayTraceTypes(opts))
 }
 
-func (s *Service) replayBlockTransactionsAtHeight(_ context.Context, height int64) ([]*api.TransactionResult, error) {
+func (s *Service) replayBlockTransactionsAtHeight(ctx context.Context,
+	height int64,
+	traceTypes []api.TraceType,
+) (
+	[]*api.TransactionResult,
+	error,
+) {
 	var transactionResults []*api.TransactionResult
 
 	log.Trace().Int64("height", height).Msg("Replaying block transactions")
@@ -46,15 +58,15 @@ func (s *Service) replayBlockTransactionsAtHeight(_ context.Context, height int6
 
 	switch {
 	case height < 0:
-		err = s.client.CallFor(&transactionResults, "trace_replayBlockTransactions", "latest", []string{"stateDiff"})
+		err = s.callFor(ctx, &transactionResults, "trace_replayBlockTransactions", "latest", traceTypes)
 	case height == 0:
 		// Block 0 is a special case, with no transactions.
 		transactionResults = make([]*api.TransactionResult, 0)
 	default:
-		err = s.client.CallFor(&transactionResults,
+		err = s.callFor(ctx, &transactionResults,
 			"trace_replayBlockTransactions",
 			util.MarshalUint32(uint32(height)),
-			[]string{"stateDiff"},
+			traceTypes,
 		)
 	}
 
@@ -64,3 +76,12 @@ func (s *Service) replayBlockTransactionsAtHeight(_ context.Context, height int6
 
 	return transactionResults, nil
 }
+
+// replayTraceTypes returns the trace types to request for a replay.
+func replayTraceTypes(opts *execclient.ReplayOpts) []api.TraceType {
+	if opts == nil || len(opts.TraceTypes) == 0 {
+		return []api.TraceType{api.TraceTypeStateDiff}
+	}
+
+	return opts.TraceTypes
+}
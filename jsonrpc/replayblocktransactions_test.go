@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
 	"github.com/attestantio/go-execution-client/jsonrpc"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
@@ -83,3 +84,31 @@ func TestReplayBlockTransactions(t *testing.T) {
 		})
 	}
 }
+
+func TestReplayBlockTransactionsTraceTypes(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	block, err := s.(execclient.BlocksProvider).Block(ctx, "46147")
+	require.NoError(t, err)
+
+	replay, err := s.(execclient.BlockReplaysProvider).ReplayBlockTransactionsWithOpts(ctx,
+		block.Hash().String(),
+		&execclient.ReplayOpts{
+			TraceTypes: []api.TraceType{api.TraceTypeTrace, api.TraceTypeVMTrace},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, replay, 1)
+	require.Empty(t, replay[0].StateDiff)
+	require.Len(t, replay[0].Trace, 1)
+	require.Equal(t, "call", replay[0].Trace[0].Type)
+	require.Equal(t, uint64(0x7a69), replay[0].Trace[0].Action.Value.Uint64())
+	require.NotNil(t, replay[0].VMTrace)
+	require.Empty(t, replay[0].VMTrace.Ops)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// ReplayTransaction obtains traces for a transaction.
+func (s *Service) ReplayTransaction(ctx context.Context,
+	hash types.Hash,
+	opts *execclient.ReplayOpts,
+) (
+	*api.TransactionResult,
+	error,
+) {
+	log.Trace().Str("hash", fmt.Sprintf("%#x", hash)).Msg("Replaying transaction")
+
+	var res *api.TransactionResult
+	if err := s.callFor(ctx, &res, "trace_replayTransaction", fmt.Sprintf("%#x", hash), replayTraceTypes(opts)); err != nil {
+		return nil, errors.Wrap(err, "trace_replayTransaction failed")
+	}
+
+	if res == nil {
+		return nil, errors.New("transaction not found")
+	}
+
+	// The transaction hash is not returned by all execution clients.
+	res.TransactionHash = hash
+
+	return res, nil
+}
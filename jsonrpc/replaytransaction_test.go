@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayTransaction(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	txHash := strToHash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060")
+	from := strToAddress("0xa1e4380a3b1f749673e270229993ee55f35663b4")
+
+	tests := []struct {
+		name       string
+		traceTypes []api.TraceType
+		stateDiffs int
+		traces     int
+	}{
+		{
+			name:       "Default",
+			stateDiffs: 3,
+		},
+		{
+			name:       "Trace",
+			traceTypes: []api.TraceType{api.TraceTypeTrace},
+			traces:     1,
+		},
+		{
+			name:       "All",
+			traceTypes: []api.TraceType{api.TraceTypeStateDiff, api.TraceTypeTrace, api.TraceTypeVMTrace},
+			stateDiffs: 3,
+			traces:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.(execclient.TransactionReplaysProvider).ReplayTransaction(ctx, txHash, &execclient.ReplayOpts{
+				TraceTypes: test.traceTypes,
+			})
+			require.NoError(t, err)
+			require.Equal(t, txHash, res.TransactionHash)
+			require.Len(t, res.StateDiff, test.stateDiffs)
+			require.Len(t, res.Trace, test.traces)
+			for _, trace := range res.Trace {
+				require.Equal(t, from, *trace.Action.From)
+			}
+		})
+	}
+}
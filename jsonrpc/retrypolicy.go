@@ -0,0 +1,164 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-execution-client/api"
+)
+
+// RetryPolicy defines how requests that fail with transient errors, such as
+// rate limiting, gateway errors and dropped connections, are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.  Each subsequent
+	// delay is double the previous, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts.  A longer delay
+	// requested by the execution client with Retry-After is honoured.
+	MaxBackoff time.Duration
+	// Jitter is the proportion of each delay, between 0 and 1, that is randomised.
+	Jitter float64
+	// Idempotent overrides whether methods are safe to repeat if the request
+	// may have reached the execution client.  Methods not listed are
+	// idempotent, except for those that submit transactions.
+	Idempotent map[string]bool
+}
+
+// nonIdempotentMethods are the methods that must not be repeated if the
+// request may have reached the execution client.
+var nonIdempotentMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// isIdempotent returns true if the method is safe to repeat.
+func (p *RetryPolicy) isIdempotent(method string) bool {
+	if idempotent, exists := p.Idempotent[method]; exists {
+		return idempotent
+	}
+
+	return !nonIdempotentMethods[method]
+}
+
+// backoff returns the delay before the given retry.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxBackoff)
+
+	// #nosec G404
+	return delay - time.Duration(p.Jitter*rand.Float64()*float64(delay))
+}
+
+// withRetries runs the request, retrying it according to the retry policy.
+func (s *Service) withRetries(ctx context.Context,
+	method string,
+	request func(ctx context.Context) error,
+) error {
+	policy := s.retryPolicy
+	if policy == nil {
+		return request(ctx)
+	}
+
+	idempotent := policy.isIdempotent(method)
+
+	for attempt := 1; ; attempt++ {
+		var retryAfter time.Duration
+		err := request(context.WithValue(ctx, retryAfterKey{}, &retryAfter))
+		if err == nil {
+			if attempt > 1 {
+				log.Debug().Str("method", method).Int("retries", attempt-1).Msg("Request succeeded after retries")
+			}
+
+			return nil
+		}
+
+		if ctx.Err() != nil || !api.IsTransient(err) || (!idempotent && !api.IsUndelivered(err)) {
+			return err
+		}
+
+		if attempt >= policy.MaxAttempts {
+			log.Warn().Str("method", method).Int("retries", attempt-1).Err(err).Msg("Request failed after retries")
+
+			return err
+		}
+
+		delay := max(policy.backoff(attempt), retryAfter)
+		if deadline, exists := ctx.Deadline(); exists && time.Until(deadline) < delay {
+			// No time to retry.
+			return err
+		}
+
+		log.Debug().Str("method", method).Int("attempt", attempt).Dur("delay", delay).Err(err).Msg("Request failed; retrying")
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryAfterKey is the context key for the delay requested by the execution
+// client with Retry-After.
+type retryAfterKey struct{}
+
+// retryAfterTransport records the delay requested by the execution client
+// with Retry-After, as it is not available from the JSON-RPC client's errors.
+type retryAfterTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if retryAfter, isRecorder := req.Context().Value(retryAfterKey{}).(*time.Duration); isRecorder {
+		if delay, exists := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); exists {
+			*retryAfter = delay
+		}
+	}
+
+	return res, nil
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
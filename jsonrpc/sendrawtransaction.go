@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// SendRawTransaction submits a signed, encoded transaction to the network.
+func (s *Service) SendRawTransaction(ctx context.Context, tx []byte) (types.Hash, error) {
+	if len(tx) == 0 {
+		return types.Hash{}, errors.New("no transaction specified")
+	}
+
+	var hashStr string
+	if err := s.callFor(ctx, &hashStr, "eth_sendRawTransaction", util.MarshalByteArray(tx)); err != nil {
+		return types.Hash{}, errors.Wrap(err, "eth_sendRawTransaction failed")
+	}
+
+	hash, err := util.StrToHash("hash", hashStr)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return hash, nil
+}
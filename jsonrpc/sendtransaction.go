@@ -0,0 +1,36 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// SendTransaction submits a signed transaction to the network.
+func (s *Service) SendTransaction(ctx context.Context, tx *spec.Transaction) (types.Hash, error) {
+	if tx == nil {
+		return types.Hash{}, errors.New("no transaction specified")
+	}
+
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return types.Hash{}, errors.Wrap(err, "failed to encode transaction")
+	}
+
+	return s.SendRawTransaction(ctx, data)
+}
@@ -26,7 +26,7 @@ import (
 	execclient "github.com/attestantio/go-execution-client"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
-	"github.com/ybbus/jsonrpc/v2"
+	"github.com/ybbus/jsonrpc/v3"
 )
 
 // Service is an Ethereum execution client service.
@@ -36,6 +36,7 @@ type Service struct {
 	webSocketAddress string
 	client           jsonrpc.RPCClient
 	timeout          time.Duration
+	retryPolicy      *RetryPolicy
 
 	// Client capability information.
 	isIssuanceProvider bool
@@ -57,17 +58,24 @@ func New(ctx context.Context, params ...Parameter) (execclient.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	var transport http.RoundTripper = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:        64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     384 * time.Second,
+	}
+	if parameters.retryPolicy != nil {
+		transport = &retryAfterTransport{
+			next: transport,
+		}
+	}
+
 	client := &http.Client{
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     384 * time.Second,
-		},
+		Transport: transport,
 	}
 
 	addrResult, err := parseAddress(parameters.address)
@@ -108,6 +116,8 @@ func New(ctx context.Context, params ...Parameter) (execclient.Service, error) {
 
 	rpcClient := jsonrpc.NewClientWithOpts(base.String(), &jsonrpc.RPCClientOpts{
 		HTTPClient: client,
+		// Nodes may return fields beyond those in the specification.
+		AllowUnknownFields: true,
 	})
 
 	s := &Service{
@@ -116,6 +126,7 @@ func New(ctx context.Context, params ...Parameter) (execclient.Service, error) {
 		address:          address.String(),
 		webSocketAddress: webSocketAddress,
 		timeout:          parameters.timeout,
+		retryPolicy:      parameters.retryPolicy,
 	}
 
 	// Fetch static values to confirm the connection is good.
@@ -165,6 +176,35 @@ func (s *Service) checkCapabilities(ctx context.Context) error {
 	return nil
 }
 
+// callFor makes a JSON-RPC call, unmarshalling the result in to out.
+// The call is bounded by both the context and the service timeout.
+// Errors returned by the execution client are returned as *api.RPCError.
+func (s *Service) callFor(ctx context.Context, out any, method string, params ...any) error {
+	return s.callForWithTimeout(ctx, s.timeout, out, method, params...)
+}
+
+// callForWithTimeout makes a JSON-RPC call as per callFor, but bounded by the
+// supplied timeout rather than the service timeout.
+// If the service has a retry policy the timeout applies to each attempt.
+func (s *Service) callForWithTimeout(ctx context.Context,
+	timeout time.Duration,
+	out any,
+	method string,
+	params ...any,
+) error {
+	return s.withRetries(ctx, method, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return convertError(s.client.CallFor(ctx, out, method, params...))
+	})
+}
+
+// requestContext returns the context for a single request, applying the service timeout.
+func (s *Service) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, s.timeout)
+}
+
 // close closes the service, freeing up resources.
 func (*Service) close() {
 }
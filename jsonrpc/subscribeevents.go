@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// SubscribeEvents returns a subscription for events matching the filter.
+// Events that are removed from the chain by a reorganisation are sent again
+// with Removed set.
+func (s *Service) SubscribeEvents(ctx context.Context,
+	filter *api.EventsFilter,
+	ch chan *spec.BerlinTransactionEvent,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	if filter == nil {
+		return nil, errors.New("filter not specified")
+	}
+
+	if filter.FromBlock != "" || filter.ToBlock != "" {
+		// Subscriptions only provide events from new blocks.
+		return nil, errors.New("block range not supported for subscriptions")
+	}
+
+	policy := overflowPolicy(opts)
+
+	return s.subscribe(ctx, func(ctx context.Context, result json.RawMessage) error {
+		return receiveEvent(ctx, result, ch, policy)
+	}, "logs", filter)
+}
+
+// receiveEvent sends the event announced by a notification to the channel.
+func receiveEvent(ctx context.Context,
+	result json.RawMessage,
+	ch chan *spec.BerlinTransactionEvent,
+	policy util.OverflowPolicy,
+) error {
+	var event spec.BerlinTransactionEvent
+	if err := json.Unmarshal(result, &event); err != nil {
+		return errors.Wrap(err, "failed to unmarshal event")
+	}
+
+	if event.Removed {
+		log.Trace().
+			Str("tx_hash", fmt.Sprintf("%#x", event.TransactionHash)).
+			Uint32("index", event.Index).
+			Msg("Event removed by reorganisation")
+	}
+
+	return deliver(ctx, ch, &event, policy)
+}
@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubscribeEvents tests the SubscribeEvents function.
+func TestSubscribeEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	weth := strToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	transfer := strToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	tests := []struct {
+		name   string
+		filter *api.EventsFilter
+		err    string
+	}{
+		{
+			name: "Nil",
+			err:  "filter not specified",
+		},
+		{
+			name: "BlockRange",
+			filter: &api.EventsFilter{
+				FromBlock: "0x3e8",
+			},
+			err: "block range not supported for subscriptions",
+		},
+		{
+			name: "Good",
+			filter: &api.EventsFilter{
+				Address: &weth,
+				Topics:  []types.Hash{transfer},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch := make(chan *spec.BerlinTransactionEvent)
+			subscription, err := s.(execclient.EventsSubscriptionProvider).SubscribeEvents(ctx, test.filter, ch, nil)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, subscription)
+
+				// Wait to see an event.
+				event := <-ch
+				require.Equal(t, weth, event.Address)
+				require.Equal(t, transfer, event.Topics[0])
+			}
+		})
+	}
+}
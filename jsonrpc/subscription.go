@@ -0,0 +1,280 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+const (
+	// subscriptionPingInterval is the interval between pings sent to keep subscriptions alive.
+	subscriptionPingInterval = 30 * time.Second
+	// subscriptionReadTimeout is the time without a message or pong after which a subscription connection is considered lost.
+	subscriptionReadTimeout = 2 * subscriptionPingInterval
+	// subscriptionWriteTimeout is the time allowed to send a control message.
+	subscriptionWriteTimeout = 5 * time.Second
+	// subscriptionInitialReconnectDelay is the delay before the first attempt to re-establish a subscription.
+	subscriptionInitialReconnectDelay = time.Second
+	// subscriptionMaxReconnectDelay is the maximum delay between attempts to re-establish a subscription.
+	subscriptionMaxReconnectDelay = time.Minute
+)
+
+// Request IDs for messages sent over subscription connections.
+const (
+	subscribeRequestID   = 1
+	unsubscribeRequestID = 2
+)
+
+// subscriptionRequest is a request sent over a subscription connection.
+type subscriptionRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+// subscriptionResponse is the response to a subscription request.
+type subscriptionResponse struct {
+	ID     uint64                     `json:"id"`
+	Result json.RawMessage            `json:"result"`
+	Error  *subscriptionResponseError `json:"error"`
+}
+
+// subscriptionResponseError is the error returned in response to a subscription request.
+type subscriptionResponseError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// subscriptionEvent is a notification for a subscription.
+type subscriptionEvent struct {
+	Params *subscriptionEventParams `json:"params"`
+}
+
+// subscriptionEventParams are the parameters of a notification for a subscription.
+type subscriptionEventParams struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// subscribe creates a subscription with the given parameters, passing the
+// result of each notification to the handler until the context is done or
+// the subscription is unsubscribed.  Errors returned by the handler are
+// reported to the subscription.
+// If the connection to the execution client is lost the subscription is
+// re-established, with changes in its state sent to the subscription's events.
+func (s *Service) subscribe(ctx context.Context,
+	handler func(ctx context.Context, result json.RawMessage) error,
+	params ...any,
+) (
+	*util.Subscription,
+	error,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	conn, id, err := s.connectSubscription(ctx, params)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	deliveryCtx, stopDeliveries := context.WithCancel(ctx)
+	m := &managedSubscription{
+		service:        s,
+		params:         params,
+		handler:        handler,
+		cancel:         cancel,
+		deliveryCtx:    deliveryCtx,
+		stopDeliveries: stopDeliveries,
+		conn:           conn,
+		responses:      make(chan *subscriptionResponse, 1),
+	}
+	m.sub = util.NewSubscription(id, m.unsubscribe)
+	m.sub.Notify(util.SubscriptionStateConnected, nil)
+
+	go m.run(ctx)
+
+	return m.sub, nil
+}
+
+// connectSubscription connects to the websocket endpoint of the execution
+// client and creates a subscription with the given parameters, returning the
+// connection and the ID of the subscription.
+func (s *Service) connectSubscription(ctx context.Context, params []any) (*websocket.Conn, []byte, error) {
+	dialCtx, cancel := s.requestContext(ctx)
+	defer cancel()
+
+	// This is closed in managedSubscription.receive(), so...
+	//nolint:bodyclose
+	conn, _, err := websocket.DefaultDialer.DialContext(dialCtx, s.webSocketAddress, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to connect to server")
+	}
+
+	id, err := s.requestSubscription(conn, params)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, nil, err
+	}
+
+	return conn, id, nil
+}
+
+// reconnectSubscription re-establishes a subscription, retrying with
+// exponential backoff until it succeeds or the context is done.
+func (s *Service) reconnectSubscription(ctx context.Context, params []any) (*websocket.Conn, []byte, error) {
+	delay := subscriptionInitialReconnectDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		conn, id, err := s.connectSubscription(ctx, params)
+		if err == nil {
+			return conn, id, nil
+		}
+
+		delay = min(delay*2, subscriptionMaxReconnectDelay)
+		log.Warn().Err(err).Dur("retry_in", delay).Msg("Failed to re-establish subscription")
+	}
+}
+
+// requestSubscription sends a subscription request over the connection,
+// returning the ID of the subscription.
+func (s *Service) requestSubscription(conn *websocket.Conn, params []any) ([]byte, error) {
+	request, err := json.Marshal(&subscriptionRequest{
+		JSONRPC: "2.0",
+		ID:      subscribeRequestID,
+		Method:  "eth_subscribe",
+		Params:  params,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create subscription request")
+	}
+
+	if err := conn.WriteMessage(websocket.TextMessage, request); err != nil {
+		return nil, errors.Wrap(err, "failed to request subscription")
+	}
+
+	// Read the response to obtain the subscription ID, bounded by the service timeout.
+	_, msg, err := readMessageWithTimeout(conn, s.timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain subscription response")
+	}
+
+	log.Trace().Str("msg", string(msg)).Msg("Received subscription response")
+
+	var res subscriptionResponse
+	if err := json.Unmarshal(msg, &res); err != nil {
+		return nil, errors.Wrap(err, "invalid subscription response")
+	}
+
+	if res.Error != nil {
+		return nil, errors.Wrap(&api.RPCError{
+			Code:    res.Error.Code,
+			Message: res.Error.Message,
+			Data:    res.Error.Data,
+		}, "eth_subscribe failed")
+	}
+
+	var idStr string
+	if err := json.Unmarshal(res.Result, &idStr); err != nil {
+		return nil, errors.Wrap(err, "invalid subscription ID")
+	}
+
+	id, err := util.StrToByteArray("subscription ID", idStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain subscription ID")
+	}
+
+	log.Trace().Str("subscription", fmt.Sprintf("%#x", id)).Msg("Received subscription ID")
+
+	return id, nil
+}
+
+// readMessageWithTimeout reads a message from the websocket, failing if it is not received within the timeout.
+func readMessageWithTimeout(conn *websocket.Conn, timeout time.Duration) (int, []byte, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, nil, err
+	}
+
+	msgType, msg, err := conn.ReadMessage()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Clear the deadline for subsequent reads.
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return 0, nil, err
+	}
+
+	return msgType, msg, nil
+}
+
+// keepSocketAlive sends periodic pings over the websocket until done is
+// closed, and closes the websocket when the context is done.
+func keepSocketAlive(ctx context.Context, conn *websocket.Conn, done <-chan struct{}) {
+	ticker := time.NewTicker(subscriptionPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(subscriptionWriteTimeout)); err != nil {
+				// The read deadline will expire, so leave that to report the failure.
+				log.Debug().Err(err).Msg("Failed to send websocket ping")
+			}
+		case <-ctx.Done():
+			closeSocket(conn)
+
+			return
+		}
+	}
+}
+
+// closeSocket closes the websocket, informing the execution client.
+func closeSocket(conn *websocket.Conn) {
+	log.Trace().Msg("Context done; closing websocket connection")
+
+	err := conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(subscriptionWriteTimeout),
+	)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to send websocket close message")
+	}
+
+	if err := conn.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close websocket")
+
+		return
+	}
+
+	log.Trace().Msg("Websocket connection closed")
+}
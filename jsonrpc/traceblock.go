@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// TraceBlock returns the traces for all actions in a block.
+func (s *Service) TraceBlock(ctx context.Context, blockID string) ([]*api.Trace, error) {
+	block, isHash, err := blockParam(blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isHash {
+		// trace_block does not accept block hashes, so resolve the hash to a height.
+		height, err := s.blockIDToHeight(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+
+		block = util.MarshalInt64(height)
+	}
+
+	var traces []*api.Trace
+	if err := s.callFor(ctx, &traces, "trace_block", block); err != nil {
+		return nil, errors.Wrap(err, "trace_block failed")
+	}
+
+	return traces, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceBlock(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	block, err := s.(execclient.BlocksProvider).Block(ctx, "46147")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		blockID string
+		err     string
+	}{
+		{
+			name:    "Height",
+			blockID: "46147",
+		},
+		{
+			name:    "Hash",
+			blockID: block.Hash().String(),
+		},
+		{
+			name:    "Invalid",
+			blockID: "invalid",
+			err:     "unhandled block ID",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traces, err := s.(execclient.TracesProvider).TraceBlock(ctx, test.blockID)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				counts := make(map[string]int)
+				for _, trace := range traces {
+					require.Equal(t, block.Hash(), trace.BlockHash)
+					require.Equal(t, uint32(46147), trace.BlockNumber)
+					counts[trace.Type]++
+				}
+				require.Equal(t, 1, counts["call"])
+				require.Positive(t, counts["reward"])
+			}
+		})
+	}
+}
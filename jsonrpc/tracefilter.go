@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/pkg/errors"
+)
+
+// TraceFilter returns the traces matching the filter.
+func (s *Service) TraceFilter(ctx context.Context, filter *api.TraceFilter) ([]*api.Trace, error) {
+	if filter == nil {
+		return nil, errors.New("filter not specified")
+	}
+
+	var traces []*api.Trace
+	if err := s.callFor(ctx, &traces, "trace_filter", []*api.TraceFilter{filter}); err != nil {
+		return nil, errors.Wrap(err, "trace_filter failed")
+	}
+
+	return traces, nil
+}
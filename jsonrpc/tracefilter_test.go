@@ -0,0 +1,88 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/jsonrpc"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceFilter(t *testing.T) {
+	ctx := context.Background()
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		filter *api.TraceFilter
+		err    string
+		traces int
+	}{
+		{
+			name: "Nil",
+			err:  "filter not specified",
+		},
+		{
+			name: "ToAddress",
+			filter: &api.TraceFilter{
+				FromBlock: "0xb443",
+				ToBlock:   "0xb443",
+				ToAddress: []types.Address{strToAddress("0x5df9b87991262f6ba471f09758cde1c0fc1de734")},
+			},
+			traces: 1,
+		},
+		{
+			name: "FromAddressAfter",
+			filter: &api.TraceFilter{
+				FromBlock:   "0xb443",
+				ToBlock:     "0xb443",
+				FromAddress: []types.Address{strToAddress("0xa1e4380a3b1f749673e270229993ee55f35663b4")},
+				After:       1,
+			},
+		},
+		{
+			name: "Count",
+			filter: &api.TraceFilter{
+				FromBlock: "0xb443",
+				ToBlock:   "0xb443",
+				Count:     1,
+			},
+			traces: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traces, err := s.(execclient.TracesProvider).TraceFilter(ctx, test.filter)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, traces, test.traces)
+			}
+		})
+	}
+}
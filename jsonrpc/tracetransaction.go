@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// TraceTransaction returns the traces for all actions in a transaction.
+func (s *Service) TraceTransaction(ctx context.Context, hash types.Hash) ([]*api.Trace, error) {
+	var traces []*api.Trace
+	if err := s.callFor(ctx, &traces, "trace_transaction", fmt.Sprintf("%#x", hash)); err != nil {
+		return nil, errors.Wrap(err, "trace_transaction failed")
+	}
+
+	return traces, nil
+}
@@ -23,13 +23,13 @@ import (
 )
 
 // Transaction returns the transaction for the given transaction hash.
-func (s *Service) Transaction(_ context.Context, hash types.Hash) (*spec.Transaction, error) {
+func (s *Service) Transaction(ctx context.Context, hash types.Hash) (*spec.Transaction, error) {
 	if len(hash) == 0 {
 		return nil, errors.New("hash nil")
 	}
 
 	var transaction spec.Transaction
-	if err := s.client.CallFor(&transaction, "eth_getTransactionByHash", fmt.Sprintf("%#x", hash)); err != nil {
+	if err := s.callFor(ctx, &transaction, "eth_getTransactionByHash", fmt.Sprintf("%#x", hash)); err != nil {
 		return nil, err
 	}
 
@@ -38,6 +38,19 @@ func (*Service) Name() string { return "mock" }
 // Address returns the address of the client.
 func (*Service) Address() string { return "mock" }
 
+// AccountProof obtains the proof of the account and the given storage slots
+// for the given address at the given block ID.
+func (*Service) AccountProof(_ context.Context,
+	_ types.Address,
+	_ []types.Hash,
+	_ string,
+) (
+	*api.AccountProof,
+	error,
+) {
+	return &api.AccountProof{}, nil
+}
+
 // Balance obtains the balance for the given address at the given block ID.
 func (*Service) Balance(_ context.Context, _ types.Address, _ string) (*big.Int, error) {
 	return big.NewInt(0), nil
@@ -48,11 +61,54 @@ func (*Service) ReplayBlockTransactions(_ context.Context, _ string) ([]*api.Tra
 	return []*api.TransactionResult{}, nil
 }
 
+// ReplayBlockTransactionsWithOpts obtains traces for all transactions in a block,
+// using the supplied options.
+func (*Service) ReplayBlockTransactionsWithOpts(_ context.Context,
+	_ string,
+	_ *execclient.ReplayOpts,
+) (
+	[]*api.TransactionResult,
+	error,
+) {
+	return []*api.TransactionResult{}, nil
+}
+
+// TraceBlock returns the traces for all actions in a block.
+func (*Service) TraceBlock(_ context.Context, _ string) ([]*api.Trace, error) {
+	return []*api.Trace{}, nil
+}
+
+// TraceTransaction returns the traces for all actions in a transaction.
+func (*Service) TraceTransaction(_ context.Context, _ types.Hash) ([]*api.Trace, error) {
+	return []*api.Trace{}, nil
+}
+
+// TraceFilter returns the traces matching the filter.
+func (*Service) TraceFilter(_ context.Context, _ *api.TraceFilter) ([]*api.Trace, error) {
+	return []*api.Trace{}, nil
+}
+
+// ReplayTransaction obtains traces for a transaction.
+func (*Service) ReplayTransaction(_ context.Context,
+	_ types.Hash,
+	_ *execclient.ReplayOpts,
+) (
+	*api.TransactionResult,
+	error,
+) {
+	return &api.TransactionResult{}, nil
+}
+
 // Block returns the block with the given ID.
 func (*Service) Block(_ context.Context, _ string) (*spec.Block, error) {
 	return &spec.Block{}, nil
 }
 
+// BlockHeight returns the height of the block with the given ID.
+func (*Service) BlockHeight(_ context.Context, _ string) (uint32, error) {
+	return 0, nil
+}
+
 // ChainHeight returns the height of the chain as understood by the node.
 func (*Service) ChainHeight(_ context.Context) (uint32, error) {
 	return 0, nil
@@ -63,11 +119,50 @@ func (*Service) ChainID(_ context.Context) (uint64, error) {
 	return 0, nil
 }
 
+// Code obtains the code for the given address at the given block ID.
+func (*Service) Code(_ context.Context, _ types.Address, _ string) ([]byte, error) {
+	return []byte{}, nil
+}
+
+// DebugTraceTransaction traces the transaction with the given hash.
+func (*Service) DebugTraceTransaction(_ context.Context,
+	_ types.Hash,
+	_ *execclient.DebugTraceOpts,
+) (
+	*api.DebugTrace,
+	error,
+) {
+	return &api.DebugTrace{}, nil
+}
+
+// DebugTraceBlock traces all transactions in the block with the given ID.
+func (*Service) DebugTraceBlock(_ context.Context,
+	_ string,
+	_ *execclient.DebugTraceOpts,
+) (
+	[]*api.DebugTransactionTrace,
+	error,
+) {
+	return []*api.DebugTransactionTrace{}, nil
+}
+
 // Events returns the events matching the filter.
 func (*Service) Events(_ context.Context, _ *api.EventsFilter) ([]*spec.BerlinTransactionEvent, error) {
 	return []*spec.BerlinTransactionEvent{}, nil
 }
 
+// SubscribeEvents subscribes to events matching the filter.
+func (*Service) SubscribeEvents(_ context.Context,
+	_ *api.EventsFilter,
+	_ chan *spec.BerlinTransactionEvent,
+	_ *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return util.NewSubscription(nil, nil), nil
+}
+
 // Issuance returns the issuance of a block.
 func (*Service) Issuance(_ context.Context, _ string) (*api.Issuance, error) {
 	return &api.Issuance{}, nil
@@ -78,9 +173,53 @@ func (*Service) NetworkID(_ context.Context) (uint64, error) {
 	return 0, nil
 }
 
+// NewHeads subscribes to new block headers.
+func (*Service) NewHeads(_ context.Context,
+	_ chan *spec.Block,
+	_ *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return util.NewSubscription(nil, nil), nil
+}
+
+// NewPendingTransactionHashes subscribes to the hashes of new pending transactions.
+func (*Service) NewPendingTransactionHashes(_ context.Context,
+	_ chan types.Hash,
+	_ *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return util.NewSubscription(nil, nil), nil
+}
+
 // NewPendingTransactions subscribes to new pending transactions.
 func (*Service) NewPendingTransactions(_ context.Context, _ chan *spec.Transaction) (*util.Subscription, error) {
-	return &util.Subscription{}, nil
+	return util.NewSubscription(nil, nil), nil
+}
+
+// NewPendingTransactionsWithOpts subscribes to new pending transactions,
+// using the supplied options.
+func (*Service) NewPendingTransactionsWithOpts(_ context.Context,
+	_ chan *spec.Transaction,
+	_ *execclient.PendingTransactionsOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return util.NewSubscription(nil, nil), nil
+}
+
+// Nonce obtains the nonce for the given address at the given block ID.
+func (*Service) Nonce(_ context.Context, _ types.Address, _ string) (uint64, error) {
+	return 0, nil
+}
+
+// Storage obtains the value of the given storage slot for the given address at the given block ID.
+func (*Service) Storage(_ context.Context, _ types.Address, _ types.Hash, _ string) (types.Hash, error) {
+	return types.Hash{}, nil
 }
 
 // Syncing obtains information about the sync state of the node.
@@ -88,6 +227,16 @@ func (*Service) Syncing(_ context.Context) (*api.SyncState, error) {
 	return &api.SyncState{}, nil
 }
 
+// SendRawTransaction submits a signed, encoded transaction to the network.
+func (*Service) SendRawTransaction(_ context.Context, _ []byte) (types.Hash, error) {
+	return types.Hash{}, nil
+}
+
+// SendTransaction submits a signed transaction to the network.
+func (*Service) SendTransaction(_ context.Context, _ *spec.Transaction) (types.Hash, error) {
+	return types.Hash{}, nil
+}
+
 // Transaction returns the transaction for the given transaction hash.
 func (*Service) Transaction(_ context.Context, _ types.Hash) (*spec.Transaction, error) {
 	return &spec.Transaction{}, nil
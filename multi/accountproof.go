@@ -0,0 +1,43 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// AccountProof obtains the proof of the account and the given storage slots
+// for the given address at the given block ID.
+// The proof is not verified; use the Verify() method of the result to do so.
+func (s *Service) AccountProof(ctx context.Context,
+	address types.Address,
+	slots []types.Hash,
+	blockID string,
+) (
+	*api.AccountProof,
+	error,
+) {
+	return quorumCall(ctx, s, "AccountProof", func(ctx context.Context, client execclient.Service) (*api.AccountProof, error) {
+		p, err := provider[execclient.AccountProofProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.AccountProof(ctx, address, slots, blockID)
+	})
+}
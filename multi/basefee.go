@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"math/big"
+
+	execclient "github.com/attestantio/go-execution-client"
+)
+
+// BaseFee provides the base fee of the chain at the given block ID.
+func (s *Service) BaseFee(ctx context.Context,
+	blockID string,
+) (
+	*big.Int,
+	error,
+) {
+	return quorumCall(ctx, s, "BaseFee", func(ctx context.Context, client execclient.Service) (*big.Int, error) {
+		p, err := provider[execclient.BaseFeeProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.BaseFee(ctx, blockID)
+	})
+}
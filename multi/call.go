@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+)
+
+// Call makes a call to the execution client.
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) Call(ctx context.Context, opts *execclient.CallOpts) ([]byte, error) {
+	return quorumCall(ctx, s, "Call", func(ctx context.Context, client execclient.Service) ([]byte, error) {
+		p, err := provider[execclient.CallProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.Call(ctx, opts)
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+)
+
+// ChainHeight returns the height of the chain as understood by the node.
+func (s *Service) ChainHeight(ctx context.Context) (uint32, error) {
+	return call(ctx, s, "ChainHeight", func(ctx context.Context, client execclient.Service) (uint32, error) {
+		p, err := provider[execclient.ChainHeightProvider](client)
+		if err != nil {
+			return 0, err
+		}
+
+		return p.ChainHeight(ctx)
+	})
+}
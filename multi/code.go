@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// Code obtains the code for the given address at the given block ID.
+func (s *Service) Code(ctx context.Context, address types.Address, blockID string) ([]byte, error) {
+	return quorumCall(ctx, s, "Code", func(ctx context.Context, client execclient.Service) ([]byte, error) {
+		p, err := provider[execclient.CodeProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.Code(ctx, address, blockID)
+	})
+}
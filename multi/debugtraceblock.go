@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+)
+
+// DebugTraceBlock traces all transactions in the block with the given ID.
+func (s *Service) DebugTraceBlock(ctx context.Context,
+	blockID string,
+	opts *execclient.DebugTraceOpts,
+) (
+	[]*api.DebugTransactionTrace,
+	error,
+) {
+	return call(ctx, s, "DebugTraceBlock", func(ctx context.Context, client execclient.Service) ([]*api.DebugTransactionTrace, error) {
+		p, err := provider[execclient.DebugTraceProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.DebugTraceBlock(ctx, blockID, opts)
+	})
+}
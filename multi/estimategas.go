@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"math/big"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+)
+
+// EstimateGas estimates the gas required for a transaction.
+// If execution reverts then the returned error wraps an *api.RevertError.
+func (s *Service) EstimateGas(ctx context.Context,
+	tx *spec.TransactionSubmission,
+) (
+	*big.Int,
+	error,
+) {
+	return call(ctx, s, "EstimateGas", func(ctx context.Context, client execclient.Service) (*big.Int, error) {
+		p, err := provider[execclient.GasEstimationProvide](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.EstimateGas(ctx, tx)
+	})
+}
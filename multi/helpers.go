@@ -0,0 +1,219 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/pkg/errors"
+)
+
+// errNotSupported is returned when a client does not support a request.
+var errNotSupported = errors.New("client does not support request")
+
+// callFunc is a request to a single client.
+type callFunc[T any] func(ctx context.Context, client execclient.Service) (T, error)
+
+// call sends the request to the preferred client, failing over to the other
+// clients in order of preference if the request cannot be served.
+func call[T any](ctx context.Context, s *Service, name string, fn callFunc[T]) (T, error) {
+	return callWithFailover(ctx, s, name, fn, failoverAction)
+}
+
+// submit sends a transaction submission to the preferred client, failing over
+// to the other clients in order of preference only if the submission was not
+// delivered.  Once delivered the transaction may have been broadcast, so
+// submitting it again could return an error for a successful submission.
+func submit[T any](ctx context.Context, s *Service, name string, fn callFunc[T]) (T, error) {
+	return callWithFailover(ctx, s, name, fn, submissionFailoverAction)
+}
+
+// callWithFailover sends the request to the preferred client, using the
+// supplied action to decide if the request is tried on the next client.
+func callWithFailover[T any](ctx context.Context,
+	s *Service,
+	name string,
+	fn callFunc[T],
+	action func(err error) (bool, bool),
+) (
+	T,
+	error,
+) {
+	var zero T
+
+	var lastErr error
+	for _, c := range s.orderedClients() {
+		res, err := fn(ctx, c.service)
+		if err == nil {
+			return res, nil
+		}
+
+		if ctx.Err() != nil {
+			return zero, err
+		}
+
+		failover, deactivate := action(err)
+		if !failover {
+			return zero, err
+		}
+
+		log.Debug().Str("client", c.service.Address()).Str("request", name).Err(err).Msg("Request failed; trying next client")
+
+		if deactivate {
+			s.setInactive(c, err)
+		}
+
+		lastErr = err
+	}
+
+	return zero, errors.Wrapf(lastErr, "%s failed on all clients", name)
+}
+
+// quorumResult is the result of a request to a single client.
+type quorumResult[T any] struct {
+	client *client
+	res    T
+	err    error
+}
+
+// quorumCall sends the request to all clients concurrently, returning the
+// result once the quorum of clients agree on it.  If the quorum is one then
+// this is the same as call().
+func quorumCall[T any](ctx context.Context, s *Service, name string, fn callFunc[T]) (T, error) {
+	if s.quorum <= 1 {
+		return call(ctx, s, name, fn)
+	}
+
+	var zero T
+
+	clients := s.orderedClients()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan *quorumResult[T], len(clients))
+	for _, c := range clients {
+		go func(c *client) {
+			res, err := fn(ctx, c.service)
+			results <- &quorumResult[T]{
+				client: c,
+				res:    res,
+				err:    err,
+			}
+		}(c)
+	}
+
+	agreements := make(map[string]int)
+
+	var lastErr error
+	for range clients {
+		result := <-results
+		if result.err != nil {
+			if _, deactivate := failoverAction(result.err); deactivate && ctx.Err() == nil {
+				s.setInactive(result.client, result.err)
+			}
+
+			lastErr = result.err
+
+			continue
+		}
+
+		key, err := json.Marshal(result.res)
+		if err != nil {
+			lastErr = errors.Wrap(err, "failed to compare result")
+
+			continue
+		}
+
+		agreements[string(key)]++
+		if agreements[string(key)] >= s.quorum {
+			return result.res, nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return zero, ctx.Err()
+	}
+
+	if lastErr != nil {
+		return zero, errors.Wrapf(lastErr, "%s quorum of %d not reached", name, s.quorum)
+	}
+
+	return zero, fmt.Errorf("%s quorum of %d not reached; clients disagree", name, s.quorum)
+}
+
+// provider returns the client as the given provider.
+func provider[T any](client execclient.Service) (T, error) {
+	res, isProvider := client.(T)
+	if !isProvider {
+		return res, errNotSupported
+	}
+
+	return res, nil
+}
+
+// failoverAction returns if a request that failed with the given error should
+// be tried on another client, and if the client that returned the error
+// should be marked as inactive.
+//
+// Errors that result from the request itself, for example invalid parameters
+// or reverted execution, would be returned by every client so do not fail over.
+func failoverAction(err error) (bool, bool) {
+	if errors.Is(err, errNotSupported) {
+		return true, false
+	}
+
+	if api.IsRateLimited(err) || api.IsHistoryPruned(err) || api.IsMethodNotFound(err) {
+		// Specific to this client, which is otherwise healthy.
+		return true, false
+	}
+
+	var httpErr *api.HTTPError
+	if errors.As(err, &httpErr) {
+		return true, httpErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var rpcErr *api.RPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Code == api.RPCErrorCodeInternal, false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		// Unable to communicate with the client.
+		return true, true
+	}
+
+	return false, false
+}
+
+// submissionFailoverAction returns the failover action as per failoverAction,
+// but only for errors where the submission did not reach the client.
+func submissionFailoverAction(err error) (bool, bool) {
+	if !errors.Is(err, errNotSupported) && !api.IsUndelivered(err) {
+		return false, false
+	}
+
+	return failoverAction(err)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/util"
+)
+
+// NewHeads returns a subscription for new block headers.
+// The blocks sent to the channel contain only header information.
+// The subscription is made with the preferred client.
+func (s *Service) NewHeads(ctx context.Context,
+	ch chan *spec.Block,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return call(ctx, s, "NewHeads", func(ctx context.Context, client execclient.Service) (*util.Subscription, error) {
+		p, err := provider[execclient.NewHeadsProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.NewHeads(ctx, ch, opts)
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// Nonce obtains the nonce for the given address at the given block ID.
+func (s *Service) Nonce(ctx context.Context, address types.Address, blockID string) (uint64, error) {
+	return quorumCall(ctx, s, "Nonce", func(ctx context.Context, client execclient.Service) (uint64, error) {
+		p, err := provider[execclient.NonceProvider](client)
+		if err != nil {
+			return 0, err
+		}
+
+		return p.Nonce(ctx, address, blockID)
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel            zerolog.Level
+	clients             []execclient.Service
+	quorum              int
+	healthCheckInterval time.Duration
+	timeout             time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClients sets the clients over which requests are spread.
+// Clients must provide the chain height, which is used to check their health.
+func WithClients(clients []execclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clients = clients
+	})
+}
+
+// WithQuorum sets the number of clients that must agree on the result of
+// reads such as blocks and balances.
+// If not supplied the result of a single client is used.
+//
+// Clients at different heights can disagree on reads at the latest block,
+// so a quorum is best suited to reads at specific blocks.
+func WithQuorum(quorum int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.quorum = quorum
+	})
+}
+
+// WithHealthCheckInterval sets the interval between checks of client health.
+func WithHealthCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheckInterval = interval
+	})
+}
+
+// WithTimeout sets the maximum duration for each client health check.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:            zerolog.GlobalLevel(),
+		quorum:              1,
+		healthCheckInterval: 30 * time.Second,
+		timeout:             2 * time.Second,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.clients) == 0 {
+		return nil, errors.New("no clients specified")
+	}
+
+	for i, client := range parameters.clients {
+		if client == nil {
+			return nil, fmt.Errorf("client %d missing", i)
+		}
+
+		if _, isProvider := client.(execclient.ChainHeightProvider); !isProvider {
+			return nil, fmt.Errorf("client %d does not provide chain height", i)
+		}
+	}
+
+	if parameters.quorum < 1 {
+		return nil, errors.New("quorum must be at least 1")
+	}
+
+	if parameters.quorum > len(parameters.clients) {
+		return nil, fmt.Errorf("quorum %d exceeds number of clients %d", parameters.quorum, len(parameters.clients))
+	}
+
+	if parameters.healthCheckInterval == 0 {
+		return nil, errors.New("no health check interval specified")
+	}
+
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+)
+
+// ReplayBlockTransactions obtains traces for all transactions in a block.
+func (s *Service) ReplayBlockTransactions(ctx context.Context, blockID string) ([]*api.TransactionResult, error) {
+	return call(ctx, s, "ReplayBlockTransactions", func(ctx context.Context, client execclient.Service) ([]*api.TransactionResult, error) {
+		p, err := provider[execclient.BlockReplaysProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.ReplayBlockTransactions(ctx, blockID)
+	})
+}
+
+// ReplayBlockTransactionsWithOpts obtains traces for all transactions in a block,
+// using the supplied options.
+func (s *Service) ReplayBlockTransactionsWithOpts(ctx context.Context,
+	blockID string,
+	opts *execclient.ReplayOpts,
+) (
+	[]*api.TransactionResult,
+	error,
+) {
+	return call(ctx, s, "ReplayBlockTransactionsWithOpts", func(ctx context.Context, client execclient.Service) ([]*api.TransactionResult, error) {
+		p, err := provider[execclient.BlockReplaysProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.ReplayBlockTransactionsWithOpts(ctx, blockID, opts)
+	})
+}
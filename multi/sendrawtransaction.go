@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// SendRawTransaction submits a signed, encoded transaction to the network.
+// If the transaction cannot be delivered to the preferred client it is
+// submitted to the next client.
+func (s *Service) SendRawTransaction(ctx context.Context, tx []byte) (types.Hash, error) {
+	return submit(ctx, s, "SendRawTransaction", func(ctx context.Context, client execclient.Service) (types.Hash, error) {
+		p, err := provider[execclient.TransactionSubmitter](client)
+		if err != nil {
+			return types.Hash{}, err
+		}
+
+		return p.SendRawTransaction(ctx, tx)
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multi provides an execution client service that spreads requests
+// over multiple execution clients.
+package multi
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is an Ethereum execution client service that spreads requests over
+// multiple execution clients.
+//
+// Requests are sent to the active client with the highest chain height,
+// failing over to other clients if it cannot be reached.  Reads such as
+// blocks and balances can require a quorum of clients to agree on the result.
+type Service struct {
+	clientsMu sync.RWMutex
+	clients   []*client
+	quorum    int
+	timeout   time.Duration
+}
+
+// client is a single execution client and its health.
+type client struct {
+	service execclient.Service
+	active  bool
+	height  uint32
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new multi-client execution client service.
+func New(ctx context.Context, params ...Parameter) (execclient.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "client").Str("impl", "multi").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	clients := make([]*client, 0, len(parameters.clients))
+	for _, service := range parameters.clients {
+		clients = append(clients, &client{
+			service: service,
+		})
+	}
+
+	s := &Service{
+		clients: clients,
+		quorum:  parameters.quorum,
+		timeout: parameters.timeout,
+	}
+
+	s.checkHealth(ctx)
+
+	if s.activeClients() == 0 {
+		return nil, errors.New("no clients active")
+	}
+
+	go s.monitorHealth(ctx, parameters.healthCheckInterval)
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "multi"
+}
+
+// Address provides the address of the preferred client.
+func (s *Service) Address() string {
+	return s.orderedClients()[0].service.Address()
+}
+
+// monitorHealth checks the health of the clients periodically until the context is done.
+func (s *Service) monitorHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Trace().Msg("Context done; stopping health checks")
+
+			return
+		case <-ticker.C:
+			s.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth checks the health of each client, updating its chain height.
+func (s *Service) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, c := range s.clients {
+		wg.Add(1)
+		go func(c *client) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			defer cancel()
+
+			height, err := c.service.(execclient.ChainHeightProvider).ChainHeight(checkCtx)
+			if err != nil {
+				s.setInactive(c, err)
+
+				return
+			}
+
+			s.setActive(c, height)
+		}(c)
+	}
+	wg.Wait()
+}
+
+// setActive marks the client as active with the given chain height.
+func (s *Service) setActive(c *client, height uint32) {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	if !c.active {
+		log.Info().Str("client", c.service.Address()).Uint32("height", height).Msg("Client active")
+	}
+
+	c.active = true
+	c.height = height
+}
+
+// setInactive marks the client as inactive.
+func (s *Service) setInactive(c *client, err error) {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	if c.active {
+		log.Warn().Str("client", c.service.Address()).Err(err).Msg("Client inactive")
+	}
+
+	c.active = false
+}
+
+// activeClients returns the number of active clients.
+func (s *Service) activeClients() int {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+
+	res := 0
+	for _, c := range s.clients {
+		if c.active {
+			res++
+		}
+	}
+
+	return res
+}
+
+// orderedClients returns the clients in order of preference: active clients
+// with the highest chain height first, followed by inactive clients.
+func (s *Service) orderedClients() []*client {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+
+	res := slices.Clone(s.clients)
+	slices.SortStableFunc(res, func(a, b *client) int {
+		switch {
+		case a.active != b.active:
+			if a.active {
+				return -1
+			}
+
+			return 1
+		case a.height != b.height:
+			if a.height > b.height {
+				return -1
+			}
+
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return res
+}
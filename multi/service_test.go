@@ -0,0 +1,386 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/mock"
+	"github.com/attestantio/go-execution-client/multi"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testClient is a client with configurable responses.
+type testClient struct {
+	mock.Service
+	address    string
+	height     uint32
+	heightErr  error
+	balance    *big.Int
+	balanceErr error
+	calls      atomic.Int32
+	sendErr    error
+	sendCalls  atomic.Int32
+}
+
+func (c *testClient) Address() string {
+	return c.address
+}
+
+func (c *testClient) ChainHeight(_ context.Context) (uint32, error) {
+	return c.height, c.heightErr
+}
+
+func (c *testClient) Balance(_ context.Context, _ types.Address, _ string) (*big.Int, error) {
+	c.calls.Add(1)
+
+	return c.balance, c.balanceErr
+}
+
+func (c *testClient) SendRawTransaction(_ context.Context, _ []byte) (types.Hash, error) {
+	c.sendCalls.Add(1)
+	if c.sendErr != nil {
+		return types.Hash{}, c.sendErr
+	}
+
+	return types.Hash{0x01}, nil
+}
+
+// connectionErr is an error from a client that cannot be reached.
+var connectionErr = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		params []multi.Parameter
+		err    string
+	}{
+		{
+			name: "ClientsMissing",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no clients specified",
+		},
+		{
+			name: "QuorumZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{&testClient{address: "1", height: 1}}),
+				multi.WithQuorum(0),
+			},
+			err: "quorum must be at least 1",
+		},
+		{
+			name: "QuorumTooHigh",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{&testClient{address: "1", height: 1}}),
+				multi.WithQuorum(2),
+			},
+			err: "quorum 2 exceeds number of clients 1",
+		},
+		{
+			name: "NoneActive",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{
+					&testClient{address: "1", heightErr: connectionErr},
+					&testClient{address: "2", heightErr: connectionErr},
+				}),
+			},
+			err: "no clients active",
+		},
+		{
+			name: "Good",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{
+					&testClient{address: "1", heightErr: connectionErr},
+					&testClient{address: "2", height: 1},
+				}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := multi.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "multi", s.Name())
+				require.Equal(t, "2", s.Address())
+			}
+		})
+	}
+}
+
+func TestHighestHeightPreferred(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clients := []*testClient{
+		{address: "1", height: 10, balance: big.NewInt(1)},
+		{address: "2", height: 12, balance: big.NewInt(2)},
+		{address: "3", height: 11, balance: big.NewInt(3)},
+	}
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]execclient.Service{clients[0], clients[1], clients[2]}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "2", s.Address())
+
+	balance, err := s.(execclient.BalancesProvider).Balance(ctx, types.Address{}, "latest")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2), balance)
+}
+
+func TestFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name            string
+		err             error
+		expected        *big.Int
+		expectedErr     string
+		expectedAddress string
+		fallbackCalls   int32
+	}{
+		{
+			name:            "ConnectionFailure",
+			err:             connectionErr,
+			expected:        big.NewInt(2),
+			expectedAddress: "2",
+			fallbackCalls:   1,
+		},
+		{
+			name:            "ServerError",
+			err:             &api.HTTPError{StatusCode: 503},
+			expected:        big.NewInt(2),
+			expectedAddress: "2",
+			fallbackCalls:   1,
+		},
+		{
+			name:            "RateLimited",
+			err:             &api.HTTPError{StatusCode: 429},
+			expected:        big.NewInt(2),
+			expectedAddress: "1",
+			fallbackCalls:   1,
+		},
+		{
+			name:            "InvalidParams",
+			err:             &api.RPCError{Code: api.RPCErrorCodeInvalidParams, Message: "invalid params"},
+			expectedErr:     "invalid params (code -32602)",
+			expectedAddress: "1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preferred := &testClient{address: "1", height: 2, balanceErr: test.err}
+			fallback := &testClient{address: "2", height: 1, balance: big.NewInt(2)}
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{fallback, preferred}),
+			)
+			require.NoError(t, err)
+			require.Equal(t, "1", s.Address())
+
+			balance, err := s.(execclient.BalancesProvider).Balance(ctx, types.Address{}, "latest")
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, balance)
+			}
+			require.Equal(t, test.fallbackCalls, fallback.calls.Load())
+			require.Equal(t, test.expectedAddress, s.Address())
+		})
+	}
+}
+
+func TestSubmissionFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedErr   string
+		fallbackCalls int32
+	}{
+		{
+			name:          "ConnectionFailure",
+			err:           connectionErr,
+			fallbackCalls: 1,
+		},
+		{
+			name:          "RateLimited",
+			err:           &api.HTTPError{StatusCode: 429},
+			fallbackCalls: 1,
+		},
+		{
+			name:        "ServerError",
+			err:         &api.HTTPError{StatusCode: 503},
+			expectedErr: "HTTP status 503",
+		},
+		{
+			name:        "Timeout",
+			err:         context.DeadlineExceeded,
+			expectedErr: "context deadline exceeded",
+		},
+		{
+			name:        "ConnectionDropped",
+			err:         io.EOF,
+			expectedErr: "EOF",
+		},
+		{
+			name:        "AlreadyKnown",
+			err:         &api.RPCError{Code: -32000, Message: "already known"},
+			expectedErr: "already known (code -32000)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preferred := &testClient{address: "1", height: 2, sendErr: test.err}
+			fallback := &testClient{address: "2", height: 1}
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]execclient.Service{fallback, preferred}),
+			)
+			require.NoError(t, err)
+
+			hash, err := s.(execclient.TransactionSubmitter).SendRawTransaction(ctx, []byte{0x01})
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.Hash{0x01}, hash)
+			}
+			require.Equal(t, int32(1), preferred.sendCalls.Load())
+			require.Equal(t, test.fallbackCalls, fallback.sendCalls.Load())
+		})
+	}
+}
+
+func TestFailoverAllFail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]execclient.Service{
+			&testClient{address: "1", height: 1, balanceErr: connectionErr},
+			&testClient{address: "2", height: 1, balanceErr: connectionErr},
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = s.(execclient.BalancesProvider).Balance(ctx, types.Address{}, "latest")
+	require.EqualError(t, err, "Balance failed on all clients: dial tcp: connection refused")
+}
+
+func TestQuorum(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		clients  []*testClient
+		expected *big.Int
+		err      string
+	}{
+		{
+			name: "Agree",
+			clients: []*testClient{
+				{address: "1", height: 1, balance: big.NewInt(1)},
+				{address: "2", height: 1, balance: big.NewInt(2)},
+				{address: "3", height: 1, balance: big.NewInt(1)},
+			},
+			expected: big.NewInt(1),
+		},
+		{
+			name: "AgreeWithFailure",
+			clients: []*testClient{
+				{address: "1", height: 1, balanceErr: connectionErr},
+				{address: "2", height: 1, balance: big.NewInt(2)},
+				{address: "3", height: 1, balance: big.NewInt(2)},
+			},
+			expected: big.NewInt(2),
+		},
+		{
+			name: "Disagree",
+			clients: []*testClient{
+				{address: "1", height: 1, balance: big.NewInt(1)},
+				{address: "2", height: 1, balance: big.NewInt(2)},
+				{address: "3", height: 1, balance: big.NewInt(3)},
+			},
+			err: "Balance quorum of 2 not reached; clients disagree",
+		},
+		{
+			name: "Failures",
+			clients: []*testClient{
+				{address: "1", height: 1, balance: big.NewInt(1)},
+				{address: "2", height: 1, balanceErr: connectionErr},
+				{address: "3", height: 1, balanceErr: connectionErr},
+			},
+			err: "Balance quorum of 2 not reached: dial tcp: connection refused",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]execclient.Service, 0, len(test.clients))
+			for _, client := range test.clients {
+				clients = append(clients, client)
+			}
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithQuorum(2),
+			)
+			require.NoError(t, err)
+
+			balance, err := s.(execclient.BalancesProvider).Balance(ctx, types.Address{}, "latest")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, balance)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// Storage obtains the value of the given storage slot for the given address at the given block ID.
+func (s *Service) Storage(ctx context.Context,
+	address types.Address,
+	slot types.Hash,
+	blockID string,
+) (
+	types.Hash,
+	error,
+) {
+	return quorumCall(ctx, s, "Storage", func(ctx context.Context, client execclient.Service) (types.Hash, error) {
+		p, err := provider[execclient.StorageProvider](client)
+		if err != nil {
+			return types.Hash{}, err
+		}
+
+		return p.Storage(ctx, address, slot, blockID)
+	})
+}
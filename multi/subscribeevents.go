@@ -0,0 +1,45 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/api"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/util"
+)
+
+// SubscribeEvents returns a subscription for events matching the filter.
+// Events that are removed from the chain by a reorganisation are sent again
+// with Removed set.
+// The subscription is made with the preferred client.
+func (s *Service) SubscribeEvents(ctx context.Context,
+	filter *api.EventsFilter,
+	ch chan *spec.BerlinTransactionEvent,
+	opts *execclient.SubscriptionOpts,
+) (
+	*util.Subscription,
+	error,
+) {
+	return call(ctx, s, "SubscribeEvents", func(ctx context.Context, client execclient.Service) (*util.Subscription, error) {
+		p, err := provider[execclient.EventsSubscriptionProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.SubscribeEvents(ctx, filter, ch, opts)
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	execclient "github.com/attestantio/go-execution-client"
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+)
+
+// TransactionInBlock returns the transaction for the given transaction in a block at the given index.
+func (s *Service) TransactionInBlock(ctx context.Context, blockHash types.Hash, index uint32) (*spec.Transaction, error) {
+	return quorumCall(ctx, s, "TransactionInBlock", func(ctx context.Context, client execclient.Service) (*spec.Transaction, error) {
+		p, err := provider[execclient.TransactionsProvider](client)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.TransactionInBlock(ctx, blockHash, index)
+	})
+}
@@ -18,6 +18,7 @@ package client
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/attestantio/go-execution-client/api"
 	"github.com/attestantio/go-execution-client/spec"
@@ -34,6 +35,13 @@ type Service interface {
 	Address() string
 }
 
+// AccountProofProvider is the interface for providing account proofs.
+type AccountProofProvider interface {
+	// AccountProof obtains the proof of the account and the given storage slots
+	// for the given address at the given block ID.
+	AccountProof(ctx context.Context, address types.Address, slots []types.Hash, blockID string) (*api.AccountProof, error)
+}
+
 // BaseFeeProvider is the interface for providing the base fee.
 type BaseFeeProvider interface {
 	// BaseFee provides the base fee of the chain at the given block ID.
@@ -50,6 +58,10 @@ type BalancesProvider interface {
 type BlockReplaysProvider interface {
 	// ReplayBlockTransactions obtains traces for all transactions in a block.
 	ReplayBlockTransactions(ctx context.Context, blockID string) ([]*api.TransactionResult, error)
+
+	// ReplayBlockTransactionsWithOpts obtains traces for all transactions in a block,
+	// using the supplied options.
+	ReplayBlockTransactionsWithOpts(ctx context.Context, blockID string, opts *ReplayOpts) ([]*api.TransactionResult, error)
 }
 
 // BlocksProvider is the interface for providing blocks.
@@ -58,6 +70,12 @@ type BlocksProvider interface {
 	Block(ctx context.Context, blockID string) (*spec.Block, error)
 }
 
+// BlockHeightProvider is the interface for providing block heights.
+type BlockHeightProvider interface {
+	// BlockHeight returns the height of the block with the given ID.
+	BlockHeight(ctx context.Context, blockID string) (uint32, error)
+}
+
 // ChainHeightProvider is the interface for providing chain height.
 type ChainHeightProvider interface {
 	// ChainHeight returns the height of the chain as understood by the node.
@@ -70,12 +88,42 @@ type ChainIDProvider interface {
 	ChainID(ctx context.Context) (uint64, error)
 }
 
+// CodeProvider is the interface for providing contract code.
+type CodeProvider interface {
+	// Code obtains the code for the given address at the given block ID.
+	Code(ctx context.Context, address types.Address, blockID string) ([]byte, error)
+}
+
+// DebugTraceProvider is the interface for providing debug traces.
+type DebugTraceProvider interface {
+	// DebugTraceTransaction traces the transaction with the given hash.
+	DebugTraceTransaction(ctx context.Context, hash types.Hash, opts *DebugTraceOpts) (*api.DebugTrace, error)
+
+	// DebugTraceBlock traces all transactions in the block with the given ID.
+	DebugTraceBlock(ctx context.Context, blockID string, opts *DebugTraceOpts) ([]*api.DebugTransactionTrace, error)
+}
+
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events returns the events matching the filter.
 	Events(ctx context.Context, filter *api.EventsFilter) ([]*spec.BerlinTransactionEvent, error)
 }
 
+// EventsSubscriptionProvider is the interface for providing event subscriptions.
+type EventsSubscriptionProvider interface {
+	// SubscribeEvents subscribes to events matching the filter.
+	// Events that are removed from the chain by a reorganisation are sent
+	// again with Removed set.
+	SubscribeEvents(ctx context.Context,
+		filter *api.EventsFilter,
+		ch chan *spec.BerlinTransactionEvent,
+		opts *SubscriptionOpts,
+	) (
+		*util.Subscription,
+		error,
+	)
+}
+
 // GasEstimationProvide is the interface for providing gas estimations.
 type GasEstimationProvide interface {
 	// EstimateGas estimates the gas required for a transaction.
@@ -94,10 +142,50 @@ type NetworkIDProvider interface {
 	NetworkID(ctx context.Context) (uint64, error)
 }
 
+// NewHeadsProvider is the interface for providing new block headers.
+type NewHeadsProvider interface {
+	// NewHeads subscribes to new block headers.
+	NewHeads(ctx context.Context, ch chan *spec.Block, opts *SubscriptionOpts) (*util.Subscription, error)
+}
+
+// NewPendingTransactionHashesProvider is the interface for providing hashes of new pending transactions.
+type NewPendingTransactionHashesProvider interface {
+	// NewPendingTransactionHashes subscribes to the hashes of new pending transactions.
+	NewPendingTransactionHashes(ctx context.Context,
+		ch chan types.Hash,
+		opts *SubscriptionOpts,
+	) (
+		*util.Subscription,
+		error,
+	)
+}
+
 // NewPendingTransactionsProvider is the interface for providing new pending transactions.
 type NewPendingTransactionsProvider interface {
 	// NewPendingTransactions subscribes to new pending transactions.
 	NewPendingTransactions(ctx context.Context, ch chan *spec.Transaction) (*util.Subscription, error)
+
+	// NewPendingTransactionsWithOpts subscribes to new pending transactions,
+	// using the supplied options.
+	NewPendingTransactionsWithOpts(ctx context.Context,
+		ch chan *spec.Transaction,
+		opts *PendingTransactionsOpts,
+	) (
+		*util.Subscription,
+		error,
+	)
+}
+
+// NonceProvider is the interface for providing nonces.
+type NonceProvider interface {
+	// Nonce obtains the nonce for the given address at the given block ID.
+	Nonce(ctx context.Context, address types.Address, blockID string) (uint64, error)
+}
+
+// StorageProvider is the interface for providing contract storage.
+type StorageProvider interface {
+	// Storage obtains the value of the given storage slot for the given address at the given block ID.
+	Storage(ctx context.Context, address types.Address, slot types.Hash, blockID string) (types.Hash, error)
 }
 
 // SyncingProvider is the interface for providing syncing information.
@@ -106,6 +194,27 @@ type SyncingProvider interface {
 	Syncing(ctx context.Context) (*api.SyncState, error)
 }
 
+// TracesProvider is the interface for providing traces.
+type TracesProvider interface {
+	// TraceBlock returns the traces for all actions in a block.
+	TraceBlock(ctx context.Context, blockID string) ([]*api.Trace, error)
+
+	// TraceTransaction returns the traces for all actions in a transaction.
+	TraceTransaction(ctx context.Context, hash types.Hash) ([]*api.Trace, error)
+
+	// TraceFilter returns the traces matching the filter.
+	TraceFilter(ctx context.Context, filter *api.TraceFilter) ([]*api.Trace, error)
+}
+
+// TransactionSubmitter is the interface for submitting transactions.
+type TransactionSubmitter interface {
+	// SendRawTransaction submits a signed, encoded transaction to the network.
+	SendRawTransaction(ctx context.Context, tx []byte) (types.Hash, error)
+
+	// SendTransaction submits a signed transaction to the network.
+	SendTransaction(ctx context.Context, tx *spec.Transaction) (types.Hash, error)
+}
+
 // TransactionsProvider is the interface for providing transactions.
 type TransactionsProvider interface {
 	// Transaction returns the transaction for the given transaction hash.
@@ -115,6 +224,12 @@ type TransactionsProvider interface {
 	TransactionInBlock(ctx context.Context, blockHash types.Hash, index uint32) (*spec.Transaction, error)
 }
 
+// TransactionReplaysProvider is the interface for providing transaction replays.
+type TransactionReplaysProvider interface {
+	// ReplayTransaction obtains traces for a transaction.
+	ReplayTransaction(ctx context.Context, hash types.Hash, opts *ReplayOpts) (*api.TransactionResult, error)
+}
+
 // TransactionReceiptsProvider is the interface for providing transaction receipts.
 type TransactionReceiptsProvider interface {
 	// TransactionReceipt returns the transaction receipt for the given transaction hash.
@@ -137,3 +252,42 @@ type CallOpts struct {
 	Data     []byte
 	Block    string
 }
+
+// DebugTraceOpts are the options for debug traces.
+type DebugTraceOpts struct {
+	// Tracer is the tracer used to generate the trace.
+	Tracer api.DebugTracer
+	// TracerConfig is passed to the tracer as-is, for example
+	// {"diffMode": true} for the prestate tracer.
+	TracerConfig map[string]any
+	// Timeout is the maximum time for which the execution client runs
+	// the trace.  If zero then the execution client's default is used.
+	Timeout time.Duration
+}
+
+// ReplayOpts are the options for replaying transactions.
+type ReplayOpts struct {
+	// TraceTypes are the types of trace to generate.  If empty then only
+	// state diffs are generated.
+	TraceTypes []api.TraceType
+}
+
+// SubscriptionOpts are the options for subscriptions.
+type SubscriptionOpts struct {
+	// Overflow defines what happens to a notification when the channel
+	// is full.  The default is to wait for space in the channel.
+	Overflow util.OverflowPolicy
+}
+
+// PendingTransactionsOpts are the options for pending transaction subscriptions.
+type PendingTransactionsOpts struct {
+	SubscriptionOpts
+	// FullTransactions obtains full transactions with each notification,
+	// rather than obtaining each transaction from its hash with a separate
+	// request.  Not all execution clients support this; Geth and Reth do.
+	FullTransactions bool
+	// Filter, if present, restricts the transactions sent to the channel.
+	// Unless FullTransactions is set each transaction is still obtained in
+	// order to apply the filter.
+	Filter *api.PendingTransactionsFilter
+}
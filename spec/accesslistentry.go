@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-execution-client/types"
 	"github.com/attestantio/go-execution-client/util"
 	"github.com/pkg/errors"
 )
@@ -92,3 +93,72 @@ func (a *AccessListEntry) unpack(data *accessListEntryJSON) error {
 
 	return nil
 }
+
+// rlpAccessList appends the RLP encoding of an access list to the buffer.
+func rlpAccessList(buf *bytes.Buffer, accessList []*AccessListEntry) {
+	listBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	entryBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	keysBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+
+	for _, accessListEntry := range accessList {
+		util.RLPBytes(entryBuf, accessListEntry.Address)
+
+		for _, key := range accessListEntry.StorageKeys {
+			util.RLPBytes(keysBuf, key)
+		}
+
+		util.RLPList(entryBuf, keysBuf.Bytes())
+		keysBuf.Reset()
+		util.RLPList(listBuf, entryBuf.Bytes())
+		entryBuf.Reset()
+	}
+
+	util.RLPList(buf, listBuf.Bytes())
+}
+
+// unpackRLPAccessList decodes an access list from its RLP representation.
+func unpackRLPAccessList(decoder *util.RLPDecoder) ([]*AccessListEntry, error) {
+	listDecoder, err := decoder.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accessList := make([]*AccessListEntry, 0)
+	for !listDecoder.Done() {
+		entryDecoder, err := listDecoder.List()
+		if err != nil {
+			return nil, errors.Wrap(err, "entry invalid")
+		}
+
+		address, err := entryDecoder.FixedBytes(types.AddressLength)
+		if err != nil {
+			return nil, errors.Wrap(err, "address invalid")
+		}
+
+		keysDecoder, err := entryDecoder.List()
+		if err != nil {
+			return nil, errors.Wrap(err, "storage keys invalid")
+		}
+
+		storageKeys := make([][]byte, 0)
+		for !keysDecoder.Done() {
+			key, err := keysDecoder.FixedBytes(32)
+			if err != nil {
+				return nil, errors.Wrap(err, "storage key invalid")
+			}
+
+			storageKeys = append(storageKeys, key)
+		}
+
+		if !entryDecoder.Done() {
+			return nil, errors.New("entry has unexpected data")
+		}
+
+		accessList = append(accessList, &AccessListEntry{
+			Address:     address,
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return accessList, nil
+}
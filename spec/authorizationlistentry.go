@@ -26,6 +26,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// authorizationMagic is the prefix for the signing hash of an authorization.
+const authorizationMagic = 0x05
+
 // AuthorizationListEntry contains a single entry in an authorization list.
 type AuthorizationListEntry struct {
 	Address types.Address
@@ -142,3 +145,107 @@ func (a *AuthorizationListEntry) unpack(data *authorizationListEntryJSON) error
 
 	return nil
 }
+
+// SigningHash returns the hash that is signed to authorize the delegation.
+// This is defined in EIP-7702 as util.Keccak256(0x05 || rlp([chain_id, address, nonce])).
+func (a *AuthorizationListEntry) SigningHash() types.Hash {
+	fieldsBuf := bytes.NewBuffer(make([]byte, 0, 64))
+	util.RLPBigInt(fieldsBuf, a.ChainID)
+	util.RLPAddress(fieldsBuf, a.Address)
+	util.RLPUint64(fieldsBuf, a.Nonce)
+
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	util.RLPList(buf, fieldsBuf.Bytes())
+
+	return util.Keccak256([]byte{authorizationMagic}, buf.Bytes())
+}
+
+// Authority recovers the address of the account that signed the authorization,
+// and hence delegates its code to the address of the entry.
+func (a *AuthorizationListEntry) Authority() (types.Address, error) {
+	if a.YParity == nil {
+		return types.Address{}, errors.New("signature missing")
+	}
+
+	if a.YParity.Cmp(big.NewInt(1)) > 0 || a.YParity.Sign() < 0 {
+		return types.Address{}, errors.New("signature Y parity invalid")
+	}
+
+	return recoverAddress(a.SigningHash(), a.R, a.S, byte(a.YParity.Uint64()), true)
+}
+
+// rlpAuthorizationList appends the RLP encoding of an authorization list to the buffer.
+func rlpAuthorizationList(buf *bytes.Buffer, authorizationList []*AuthorizationListEntry) {
+	listBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	entryBuf := bytes.NewBuffer(make([]byte, 0, 128))
+
+	for _, authorizationListEntry := range authorizationList {
+		util.RLPBigInt(entryBuf, authorizationListEntry.ChainID)
+		util.RLPAddress(entryBuf, authorizationListEntry.Address)
+		util.RLPUint64(entryBuf, authorizationListEntry.Nonce)
+		util.RLPBigInt(entryBuf, authorizationListEntry.YParity)
+		util.RLPBigInt(entryBuf, authorizationListEntry.R)
+		util.RLPBigInt(entryBuf, authorizationListEntry.S)
+
+		util.RLPList(listBuf, entryBuf.Bytes())
+		entryBuf.Reset()
+	}
+
+	util.RLPList(buf, listBuf.Bytes())
+}
+
+// unpackRLPAuthorizationList decodes an authorization list from its RLP representation.
+func unpackRLPAuthorizationList(decoder *util.RLPDecoder) ([]*AuthorizationListEntry, error) {
+	listDecoder, err := decoder.List()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationList := make([]*AuthorizationListEntry, 0)
+	for !listDecoder.Done() {
+		entryDecoder, err := listDecoder.List()
+		if err != nil {
+			return nil, errors.Wrap(err, "entry invalid")
+		}
+
+		entry := &AuthorizationListEntry{}
+
+		entry.ChainID, err = entryDecoder.BigInt()
+		if err != nil {
+			return nil, errors.Wrap(err, "chain id invalid")
+		}
+
+		entry.Address, err = entryDecoder.Address()
+		if err != nil {
+			return nil, errors.Wrap(err, "address invalid")
+		}
+
+		entry.Nonce, err = entryDecoder.Uint64()
+		if err != nil {
+			return nil, errors.Wrap(err, "nonce invalid")
+		}
+
+		entry.YParity, err = entryDecoder.BigInt()
+		if err != nil {
+			return nil, errors.Wrap(err, "yParity invalid")
+		}
+
+		entry.R, err = entryDecoder.BigInt()
+		if err != nil {
+			return nil, errors.Wrap(err, "r invalid")
+		}
+
+		entry.S, err = entryDecoder.BigInt()
+		if err != nil {
+			return nil, errors.Wrap(err, "s invalid")
+		}
+
+		if !entryDecoder.Done() {
+			return nil, errors.New("entry has unexpected data")
+		}
+
+		authorizationList = append(authorizationList, entry)
+	}
+
+	return authorizationList, nil
+}
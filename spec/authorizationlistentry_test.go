@@ -15,6 +15,7 @@ package spec_test
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/attestantio/go-execution-client/spec"
@@ -148,3 +149,70 @@ func TestAuthorizationListEntryJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizationListEntryAuthority(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *spec.AuthorizationListEntry
+		expected string
+		err      string
+	}{
+		{
+			name: "SignatureMissing",
+			input: &spec.AuthorizationListEntry{
+				ChainID: big.NewInt(1),
+				Address: *address("0x4242424242424242424242424242424242424242"),
+				Nonce:   10,
+			},
+			err: "signature missing",
+		},
+		{
+			name: "YParityInvalid",
+			input: &spec.AuthorizationListEntry{
+				ChainID: big.NewInt(1),
+				Address: *address("0x4242424242424242424242424242424242424242"),
+				Nonce:   10,
+				YParity: big.NewInt(27),
+				R:       new(big.Int).SetBytes(byteslice("0x469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970")),
+				S:       new(big.Int).SetBytes(byteslice("0x595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e")),
+			},
+			err: "signature Y parity invalid",
+		},
+		{
+			name: "SHigh",
+			input: &spec.AuthorizationListEntry{
+				ChainID: big.NewInt(1),
+				Address: *address("0x4242424242424242424242424242424242424242"),
+				Nonce:   10,
+				YParity: big.NewInt(1),
+				R:       new(big.Int).SetBytes(byteslice("0x469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970")),
+				S:       new(big.Int).SetBytes(byteslice("0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140")),
+			},
+			err: "signature S value too high",
+		},
+		{
+			name: "Good",
+			input: &spec.AuthorizationListEntry{
+				ChainID: big.NewInt(1),
+				Address: *address("0x4242424242424242424242424242424242424242"),
+				Nonce:   10,
+				YParity: big.NewInt(1),
+				R:       new(big.Int).SetBytes(byteslice("0x469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970")),
+				S:       new(big.Int).SetBytes(byteslice("0x595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e")),
+			},
+			expected: "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authority, err := test.input.Authority()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *address(test.expected), authority)
+			}
+		})
+	}
+}
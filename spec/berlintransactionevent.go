@@ -188,3 +188,40 @@ func (t *BerlinTransactionEvent) unpack(data *berlinTransactionEventJSON) error
 
 	return nil
 }
+
+// MarshalRLP returns an RLP representation of the event, as it appears in a consensus receipt.
+func (t *BerlinTransactionEvent) MarshalRLP() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	t.rlpFields(buf)
+
+	res := bytes.NewBuffer(make([]byte, 0, buf.Len()+9))
+	util.RLPList(res, buf.Bytes())
+
+	return res.Bytes(), nil
+}
+
+// rlpFields appends the RLP encoding of the fields of the event to the buffer.
+func (t *BerlinTransactionEvent) rlpFields(buf *bytes.Buffer) {
+	topicsBuf := bytes.NewBuffer(make([]byte, 0, len(t.Topics)*33))
+	for _, topic := range t.Topics {
+		util.RLPBytes(topicsBuf, topic[:])
+	}
+
+	util.RLPAddress(buf, t.Address)
+	util.RLPList(buf, topicsBuf.Bytes())
+	util.RLPBytes(buf, t.Data)
+}
+
+// rlpLogs appends the RLP encoding of a list of logs to the buffer, as used in consensus receipts.
+func rlpLogs(buf *bytes.Buffer, logs []*BerlinTransactionEvent) {
+	listBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	logBuf := bytes.NewBuffer(make([]byte, 0, 1024))
+
+	for _, log := range logs {
+		log.rlpFields(logBuf)
+		util.RLPList(listBuf, logBuf.Bytes())
+		logBuf.Reset()
+	}
+
+	util.RLPList(buf, listBuf.Bytes())
+}
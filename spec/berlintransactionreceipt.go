@@ -112,6 +112,13 @@ func (t *BerlinTransactionReceipt) String() string {
 	return string(bytes.TrimSuffix(data, []byte("\n")))
 }
 
+// MarshalRLP returns an RLP representation of the transaction receipt.
+// Receipts of typed transactions are returned as an RLP byte string containing
+// their EIP-2718 envelope.
+func (t *BerlinTransactionReceipt) MarshalRLP() ([]byte, error) {
+	return receiptRLP(t.Type, t.Status, t.CumulativeGasUsed, t.LogsBloom, t.Logs)
+}
+
 func (t *BerlinTransactionReceipt) unpack(data *berlinTransactionReceiptJSON) error {
 	var err error
 
@@ -20,10 +20,19 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/attestantio/go-execution-client/trie"
 	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
 	"github.com/pkg/errors"
 )
 
+const (
+	// logsBloomLength is the length of a logs bloom, in bytes.
+	logsBloomLength = 256
+	// blockNonceLength is the length of a block nonce, in bytes.
+	blockNonceLength = 8
+)
+
 // Block is a struct that covers versioned blocks.
 type Block struct {
 	Fork     Fork
@@ -40,6 +49,8 @@ type blockTypeJSON struct {
 	BaseFeePerGas string `json:"baseFeePerGas"`
 	// Present from Shanghai onwards.
 	Withdrawals []map[string]any `json:"withdrawals"`
+	// Present from Shanghai onwards, including in block headers.
+	WithdrawalsRoot string `json:"withdrawalsRoot"`
 	// Present from Cancun onwards.
 	ParentBeaconBlockRoot string `json:"parentBeaconBlockRoot"`
 	// Present from Prague onwards.
@@ -82,7 +93,7 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 		b.Fork = ForkCancun
 		b.Cancun = &CancunBlock{}
 		err = json.Unmarshal(input, b.Cancun)
-	case data.Withdrawals != nil:
+	case data.Withdrawals != nil || data.WithdrawalsRoot != "":
 		b.Fork = ForkShanghai
 		b.Shanghai = &ShanghaiBlock{}
 		err = json.Unmarshal(input, b.Shanghai)
@@ -99,6 +110,217 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 	return err
 }
 
+// HeaderRLP returns the RLP encoding of the block header.
+func (b *Block) HeaderRLP() ([]byte, error) {
+	switch b.Fork {
+	case ForkBerlin:
+		return b.Berlin.HeaderRLP()
+	case ForkLondon:
+		return b.London.HeaderRLP()
+	case ForkShanghai:
+		return b.Shanghai.HeaderRLP()
+	case ForkCancun:
+		return b.Cancun.HeaderRLP()
+	case ForkPrague:
+		return b.Prague.HeaderRLP()
+	default:
+		return nil, fmt.Errorf("unhandled block version %v", b.Fork)
+	}
+}
+
+// ComputeHash computes the hash of the block from its header.
+func (b *Block) ComputeHash() (types.Hash, error) {
+	header, err := b.HeaderRLP()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return util.Keccak256(header), nil
+}
+
+// VerifyHash checks that the hash computed from the header of the block
+// matches the hash reported by the block.
+func (b *Block) VerifyHash() error {
+	hash, err := b.ComputeHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to compute hash")
+	}
+
+	if hash != b.Hash() {
+		return fmt.Errorf("hash mismatch: block reports %#x, computed %#x", b.Hash(), hash)
+	}
+
+	return nil
+}
+
+// VerifyTransactionsRoot checks that the root of the transactions in the
+// block matches the transactions root reported by the block.
+func (b *Block) VerifyTransactionsRoot() error {
+	transactions := b.Transactions()
+
+	values := make([][]byte, 0, len(transactions))
+	for i, transaction := range transactions {
+		value, err := transaction.MarshalBinary()
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode transaction %d", i)
+		}
+
+		values = append(values, value)
+	}
+
+	root := trie.OrderedRoot(values)
+	if root != b.TransactionsRoot() {
+		return fmt.Errorf("transactions root mismatch: block reports %#x, computed %#x", b.TransactionsRoot(), root)
+	}
+
+	return nil
+}
+
+// VerifyWithdrawalsRoot checks that the root of the withdrawals in the
+// block matches the withdrawals root reported by the block.
+func (b *Block) VerifyWithdrawalsRoot() error {
+	withdrawals, exists := b.Withdrawals()
+	if !exists {
+		return errors.New("block does not contain withdrawals")
+	}
+
+	withdrawalsRoot, _ := b.WithdrawalsRoot()
+
+	values := make([][]byte, 0, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		value, err := withdrawal.MarshalRLP()
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode withdrawal %d", i)
+		}
+
+		values = append(values, value)
+	}
+
+	root := trie.OrderedRoot(values)
+	if root != withdrawalsRoot {
+		return fmt.Errorf("withdrawals root mismatch: block reports %#x, computed %#x", withdrawalsRoot, root)
+	}
+
+	return nil
+}
+
+// VerifyReceiptsRoot checks that the root of the supplied receipts matches
+// the receipts root reported by the block.
+// The receipts must be all of the receipts for the block, in transaction order.
+func (b *Block) VerifyReceiptsRoot(receipts []*TransactionReceipt) error {
+	values := make([][]byte, 0, len(receipts))
+	for i, receipt := range receipts {
+		if receipt == nil {
+			return fmt.Errorf("receipt %d missing", i)
+		}
+
+		value, err := receipt.MarshalBinary()
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode receipt %d", i)
+		}
+
+		values = append(values, value)
+	}
+
+	root := trie.OrderedRoot(values)
+	if root != b.ReceiptsRoot() {
+		return fmt.Errorf("receipts root mismatch: block reports %#x, computed %#x", b.ReceiptsRoot(), root)
+	}
+
+	return nil
+}
+
+// blockHeader contains the fields of a block header.
+// Fields added by later hardforks are nil for blocks that predate them.
+type blockHeader struct {
+	ParentHash       types.Hash
+	SHA3Uncles       []byte
+	Miner            types.Address
+	StateRoot        types.Root
+	TransactionsRoot types.Root
+	ReceiptsRoot     types.Root
+	LogsBloom        []byte
+	Difficulty       uint64
+	Number           uint32
+	GasLimit         uint32
+	GasUsed          uint32
+	Timestamp        time.Time
+	ExtraData        []byte
+	MixHash          types.Hash
+	Nonce            []byte
+	// London.
+	BaseFeePerGas *uint64
+	// Shanghai.
+	WithdrawalsRoot *types.Root
+	// Cancun.
+	BlobGasUsed           *uint64
+	ExcessBlobGas         *uint64
+	ParentBeaconBlockRoot *types.Root
+	// Prague.
+	RequestsHash *types.Hash
+}
+
+// headerRLP returns the RLP encoding of the block header.
+func headerRLP(header *blockHeader) ([]byte, error) {
+	if len(header.SHA3Uncles) != len(types.Hash{}) {
+		return nil, errors.New("sha3 uncles incorrect length")
+	}
+
+	if len(header.LogsBloom) != logsBloomLength {
+		return nil, errors.New("logs bloom incorrect length")
+	}
+
+	if len(header.Nonce) != blockNonceLength {
+		return nil, errors.New("nonce incorrect length")
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	util.RLPBytes(buf, header.ParentHash[:])
+	util.RLPBytes(buf, header.SHA3Uncles)
+	util.RLPAddress(buf, header.Miner)
+	util.RLPBytes(buf, header.StateRoot[:])
+	util.RLPBytes(buf, header.TransactionsRoot[:])
+	util.RLPBytes(buf, header.ReceiptsRoot[:])
+	util.RLPBytes(buf, header.LogsBloom)
+	util.RLPUint64(buf, header.Difficulty)
+	util.RLPUint64(buf, uint64(header.Number))
+	util.RLPUint64(buf, uint64(header.GasLimit))
+	util.RLPUint64(buf, uint64(header.GasUsed))
+	util.RLPUint64(buf, uint64(header.Timestamp.Unix()))
+	util.RLPBytes(buf, header.ExtraData)
+	util.RLPBytes(buf, header.MixHash[:])
+	util.RLPBytes(buf, header.Nonce)
+
+	if header.BaseFeePerGas != nil {
+		util.RLPUint64(buf, *header.BaseFeePerGas)
+	}
+
+	if header.WithdrawalsRoot != nil {
+		util.RLPBytes(buf, header.WithdrawalsRoot[:])
+	}
+
+	if header.BlobGasUsed != nil {
+		util.RLPUint64(buf, *header.BlobGasUsed)
+	}
+
+	if header.ExcessBlobGas != nil {
+		util.RLPUint64(buf, *header.ExcessBlobGas)
+	}
+
+	if header.ParentBeaconBlockRoot != nil {
+		util.RLPBytes(buf, header.ParentBeaconBlockRoot[:])
+	}
+
+	if header.RequestsHash != nil {
+		util.RLPBytes(buf, header.RequestsHash[:])
+	}
+
+	res := bytes.NewBuffer(make([]byte, 0, buf.Len()+9))
+	util.RLPList(res, buf.Bytes())
+
+	return res.Bytes(), nil
+}
+
 // BaseFeePerGas returns the base fee per gas of the block.
 // This value will be 0 if the block does not use base fee (e.g. pre-London).
 func (b *Block) BaseFeePerGas() uint64 {
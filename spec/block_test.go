@@ -168,3 +168,231 @@ func TestUnknownBlockFunctions(t *testing.T) {
 	assert.Panics(t, func() { block.TransactionsRoot() })
 	assert.Panics(t, func() { block.Uncles() })
 }
+
+func TestBlockComputeHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		block    *spec.Block
+		expected []byte
+		err      string
+	}{
+		{
+			// Mainnet genesis block.
+			name: "Berlin",
+			block: &spec.Block{
+				Fork: spec.ForkBerlin,
+				Berlin: &spec.BerlinBlock{
+					Difficulty:       0x400000000,
+					ExtraData:        byteslice("0x11bbe8db4e347b4e8c937c1c8370e4b5ed33adb3db69cbdb7a38e1e50b1b82fa"),
+					GasLimit:         5000,
+					LogsBloom:        make([]byte, 256),
+					Nonce:            byteslice("0x0000000000000042"),
+					ReceiptsRoot:     types.Root(byteslice("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")),
+					SHA3Uncles:       byteslice("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+					StateRoot:        types.Root(byteslice("0xd7f8974fb5ac78d9ac099b9ad5018bedc2ce0a72dad1827a1709da30580f0544")),
+					Timestamp:        time.Unix(0, 0),
+					TransactionsRoot: types.Root(byteslice("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")),
+				},
+			},
+			expected: byteslice("0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"),
+		},
+		{
+			name: "NonceInvalid",
+			block: &spec.Block{
+				Fork: spec.ForkBerlin,
+				Berlin: &spec.BerlinBlock{
+					LogsBloom:  make([]byte, 256),
+					Nonce:      byteslice("0x42"),
+					SHA3Uncles: byteslice("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+				},
+			},
+			err: "nonce incorrect length",
+		},
+		{
+			name:     "London",
+			input:    []byte(`{"baseFeePerGas":"0xbad4cab72","difficulty":"0x2e6cfc89e63894","extraData":"0x486976656f6e20686b","gasLimit":"0x1caa7ec","gasUsed":"0x8ff415","hash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","logsBloom":"0x6ea00a06218969a910081683c9012433c1ef3430830104da232f2100241211139760011a50b0eb16840e7800681201023241c84a2828a800062347180c642690014406459c428a2a998625291734a32402202000095280012a831c6680004404a2222500a3210081290e9403300028df08c88e014809a41831882274409d00401483413e39201037354800000981700514a06087d158e1c91c206671885808220eba988b0022a80196cb6594acec00027269444a42b4034190f82408b11b22b0c1166463400385e0a090290a40065880040d6ab10a1b081a00802162082a660481313148640420028a0c544a0ee0988301780001f0c0504c301b4a32437258b6","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x9cc6673f8d971f37ccd89f0f7296d213fc7e05bd6318695e5cfde210ce7e22e6","nonce":"0xa4c0db72ed33f298","number":"0xdc3207","parentHash":"0xdc49656f3dc58120579f8b33624811cc029470ad872d777e0903065a78a2d34b","receiptsRoot":"0xaf4441e57f0061a7d5a787ac275327120b761d36f776007be09768fc29446413","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xb1c3","stateRoot":"0x127449324fa85284b46401a7162872bb2c19a0e0a4005234c17e15c60605178c","timestamp":"0x6238a4cb","totalDifficulty":"0x96400ed9c41106d7ac6","transactions":[{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xdd21d4ddf6af83fdc8efc0d59508451299c8d79c","gas":"0x7a120","gasPrice":"0xc601d0972","maxPriorityFeePerGas":"0xb2d05e00","maxFeePerGas":"0x12a9705772","hash":"0x4a0c27774c83ab57c0486a76bb3ed1a98f68adcaec796d7be6067f8215de5c01","input":"0x7ff36ab5000000000000000000000000000000000000000000001c336bdb82eda6000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000013307b8854a95946b54a904100afd0767a7a577b000000000000000000000000000000000000000000000000000000006238a9740000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000fc979087305a826c2b2a0056cfaba50aad3e6439","nonce":"0x921e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x0","value":"0xc7d713b49d9ff9c","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5f6813c8a31c7fdf41adac3c74aa891e5763a06aacf53498145d0278b2d7d825","s":"0x10e3cd58fb4f1044ac6b69c302aefa6eb113a0aa3936fec0af02b7e1cb0d1d03"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x58295bba59b4bf056cb99a900da9bde190606eea","gas":"0x489c3","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x46cbf60c285cd2988801c15bcd033144e2ac281c08b5bf118c5e5459da72b366","input":"0xac9650d8000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000000c4219f5d17000000000000000000000000000000000000000000000000000000000003314800000000000000000000000000000000000000000000000025a70c645a3330fa0000000000000000000000000000000000000000000000056bc75e2d630ffff300000000000000000000000000000000000000000000000024953da4c60754090000000000000000000000000000000000000000000000050f29401c07776931000000000000000000000000000000000000000000000000000000006238abb500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x419","to":"0xc36442b4a4522e871399cd717abdd847ab11fe88","transactionIndex":"0x1","value":"0x25a70c645a3330fa","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe6aefd9b048aa7e0cb7fbe08f0ae48228d1e9c8342746b6c07b6a901c6a91e16","s":"0x2c2bd2c61019448aadc1c1cb7d5b94ed571384cc7fba56be91351a0e7fc7697"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x43e4715ae093a4c86b5ecddb52216c4f879e9672","gas":"0xdbba0","gasPrice":"0x3f45b8ae66","maxPriorityFeePerGas":"0x33986c02f4","maxFeePerGas":"0x9bc0df626e","hash":"0x25f368ad2fa5a476bf15405f76ff05a60d2c2a5b27a1fdee4259946096e8aac6","input":"0x","nonce":"0x184f","to":"0x43e4715ae093a4c86b5ecddb52216c4f879e9672","transactionIndex":"0x2","value":"0xae03","type":"0x2","chainId":"0x1","v":"0x1","r":"0xa602a4b4b017d2728339f679f98d5dc8910f9bea1bcfcf76ad353a4753f2cdec","s":"0x6c9420b53e9b178a803dc02a1b3850d350488a995d6ae57dca273db201f7c6a2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf92dc08d81900626ac56edf96e2f3802615605f1","gas":"0x46b7a","gasPrice":"0x32af9882f0","maxPriorityFeePerGas":"0x27024bd77e","maxFeePerGas":"0x781422bcc7","hash":"0x8c018c3d1a4879e9946db629f689a51f9dd1891b3508d8c4ca5dfd55c51bf826","input":"0xd66decf80000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000005ccb6a6c355a6c00000000000000000000000000000000000000047e50e664c29680000000000000000000000000000000000000000000000000047eba4b89cfc80000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x939","to":"0x8aff5ca996f77487a4f04f1ce905bf3d27455580","transactionIndex":"0x3","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe64d119a3b9ee324fa180053e87a6cf3cdd9626738a93f1b37f631fd682665f8","s":"0x4f54811b85637b216ce08f2ecaf51340cf665ad64774d44de24ca37884ab1c48"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x429bf8ec3330e02401d72beade86000d9a2e19eb","gas":"0x15f90","gasPrice":"0x2794ca2400","hash":"0xa799b24dfc5b36d2d504641a07c22e11b448377efcd582c2170349bb52acd7c6","input":"0x","nonce":"0xc827","to":"0x01c50cda35358f0e3b6e8d9c14b24105eeb3a68f","transactionIndex":"0x4","value":"0x4ead713a08dc000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xec721c583f519593eea111a57d258dbb964688182aaae0eecea03f4aa9b0855f","s":"0x73686ad2e3dab47c545da4986d6cb7b3f770014129ec1f652f1f4204f339d5a8"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff","gas":"0x4ff64","gasPrice":"0x174876e800","maxPriorityFeePerGas":"0x174876e800","maxFeePerGas":"0x174876e800","hash":"0xb659c1c1396d08e54fc1103a823c6be8f39a4535dbca0f9decbd7900cc812dd7","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd2420000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd24200000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a303fe4b5300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a45400000000000000000000000000000000000000000000000000000000000000002849bd9dcc8763054f3cd4e80b691c0ccf02bd62a47942eb063a98c844b6c97300000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a303fe4b53000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000062358e910000000000000000000000000000000000000000000000000000000062ac35da4b7c5190b99f76230f0a1c86ee39a04ade1e0d1899bcaec1ab4d689d27f14b380000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b445c35e6d06f3abfec543b21f314df911cd8f2c3940537431dee7c6e73c4274e26d50cbaf15f3e0fdf3e10470a4fd9f1a03552b9a6c2ac8a3c296cc3f58623c9445c35e6d06f3abfec543b21f314df911cd8f2c3940537431dee7c6e73c4274e26d50cbaf15f3e0fdf3e10470a4fd9f1a03552b9a6c2ac8a3c296cc3f58623c9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff0000000000000000000000000510745d2ca36729bed35c818527c4485912d99e00000000000000000000000000000000000000000000000000000000000002f2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd24200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000510745d2ca36729bed35c818527c4485912d99e00000000000000000000000000000000000000000000000000000000000002f2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x69","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x5","value":"0x2a303fe4b530000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x195935aad7f9da2fbe26b3607c74202e53168389a33907b3160c9687343276ce","s":"0x15ac92c582dfa2d67845ca990ac9e5dae03b392724abb4cc87d316ed6870dc60"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb63d434fafc6240e928928f27cdfcec90eba69d6","gas":"0x1ab3f0","gasPrice":"0x13d3764972","maxPriorityFeePerGas":"0x826299e00","maxFeePerGas":"0x174876e800","hash":"0x594a996178caac0461cfe3f97d07a71d0eee8cd2d4800433f1879e58376322ce","input":"0xbf0843d90000000000000000000000000000000000000000000000000011c37937e08000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000006238a7190000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a3f13f1572ff1e263758fb1bca22548a64fdc3a50000000000000000000000000000000000000000000000000000000000000005000000000000000000000000b63d434fafc6240e928928f27cdfcec90eba69d600000000000000000000000095cda79caaf7986a0818744d636ee0c67c9354070000000000000000000000003aa928d20c4db91ec8d385520b93d92e8ddf1c27000000000000000000000000e988ff2ed601f9fbae48386f466faf1dcce54f5900000000000000000000000027cb427ec93653a6d4f2ed5d1171323df186a201","nonce":"0x3f5","to":"0x9a25d26ce56a336ede8e415dbf43216de98f73f5","transactionIndex":"0x6","value":"0x4db732547630000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x29eab89d73eadd5d14dd9476628bae27e939820c64d575dbb1fde34211bcc527","s":"0x20abaf15cfebd4a253c74d88ebe5807b1ef0cccbd8519703f85aaad290b0e00e"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc35fb86f962ea955751a793a007b5cdd44f798d7","gas":"0x5208","gasPrice":"0x12fd05a98c","hash":"0xc7870df61248fb56e13f01b62541859576255ac65e50c85d3974b70a36fc8d5e","input":"0x","nonce":"0x25e66","to":"0x9971f9bb0c07460b3d968452fdb5211d986bfd90","transactionIndex":"0x7","value":"0x7f246a103fc000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x75e66b105b44fdc160b32259245fdf17b56384067be332a2a2393fa097f287ac","s":"0x29f7a661a9d0d68dd5bd594ad292308325af2d87860ab6853d8d0dd08de57caf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbaefcfd3ce5e1e00a7181f1a098ef036317c1ca4","gas":"0xcb20","gasPrice":"0x12a05f2000","hash":"0x66a451afebfe107a24710dc746bb64477211ae97a56e9c611807b243f317b5dd","input":"0xa9059cbb000000000000000000000000d1cf9597a15d68284e8713a2749113382f18289f000000000000000000000000000000000000000000000000000000005ed4bbc0","nonce":"0x2f","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x8","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x64c101f493f03cebc75c2379d1763f90f505548f6c9eab32a3404daf43f2d7d0","s":"0x63057059129368def92ca4945dff18575a355d99609b0a45258844ebdce661c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x1264c45600","hash":"0xb13dac42c19007528f195ff8de33958d7b617a0888f3443bcda22de220db649c","input":"0xa9059cbb0000000000000000000000001671a3e4a2519a653e66e827ef6eae690ee8672900000000000000000000000000000000000000000000015f1c47d3b458e48000","nonce":"0x1f0c69","to":"0xa117000000f279d81a1d3cc75430faa017fa5a2e","transactionIndex":"0x9","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0xd5d18614c98ce095b692d6d02b77dcfd71044d333cb6d07752f742357960972f","s":"0x7e28bd083718b54b2f8924608385fb759323075f599cb1b4d9b5d7a0452d2bfd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x1264c45600","hash":"0xbe2f7190f3e7a985720a5c495d4229d561434a96b0ee03d85e341d61f020b0e9","input":"0xa9059cbb000000000000000000000000f22235e9542de0ad61052776517ceda5f78b7c660000000000000000000000000000000000000000000004b2c943ab2526f68000","nonce":"0x1f0c6a","to":"0xf17e65822b568b3903685a7c9f496cf7656cc6c2","transactionIndex":"0xa","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x5cd393f4dedb80e105268f75cb768bbdad6c6dfdb88efe167282249e321b6b72","s":"0x571ab27c3f9a06015fd590c3f9d0e3281de465b8fc2103cf9cbe48f6edcfa521"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x46705dfff24256421a05d056c29e81bdc09723b8","gas":"0x19a28","gasPrice":"0x1176592e00","hash":"0x38ed9b0d4749fe82c1d072af49095567dc1e42a15d53fed234c8e615f9cad8c9","input":"0xa9059cbb0000000000000000000000009c6e1f593705f7a4b3d95c6f0ed27ff98cc6426000000000000000000000000000000000000000000000000000000000585fda0e","nonce":"0x1c2c68","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xb","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x852050d4fd26ba6e2012d8a1b0b0be81e09622057300e73a3dbbebb716b468d","s":"0x74b7365ec0f5914da618fa930f274f8de18c210337b96a9599742bfce68ad90a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6e268ff674f9e3952e9430de8878f57a22fa7dad","gas":"0x11820","gasPrice":"0x1140725f72","hash":"0x63a38c53f39325b3c36b84b8ee23d12df115e23a2691c82ffed2d6f3b278d0f8","input":"0x7373e4eb0000000000000000000000003ce563cae22d057f6199ebd54ec881cafdb955ed000000000000000000000000bc29bd4cc8a53642091523d94fcfc8b86bc03df40000000000000000000000000000000000000000000000000000000073717f50","nonce":"0x201","to":"0xca309b85a5a0559f61e767fc102a0a0a374ca5ca","transactionIndex":"0xc","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xd59a7a55a4526735f2efab14b879917d9ac7bf4eb9c1b34c10b66767ed5754a9","s":"0x24e6fd84c7836aa54deee6ceb10727036e6366b0525d2d46d226214742ab1314"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x000f422887ea7d370ff31173fd3b46c8f66a5b1c","gas":"0xc350","gasPrice":"0x1140725f72","hash":"0x8e68439d9756ee0d04fb0c726559331e2c09a67c5cc5392b3ecf22208844b790","input":"0x","nonce":"0x5f8d9","to":"0xf2d66d4e7235b1811b07a4320b9f3771780a4ccd","transactionIndex":"0xd","value":"0x15aaea40875d3c3","type":"0x0","chainId":"0x1","v":"0x25","r":"0x652dac908063e4d92b32ab02f9c9ab59ee45f4b51c181e7f870767deb71a8332","s":"0x13bca7282ed6e48a3e9ab04a078a07658230f2fe751e5440f70094c732732e8c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc564ee9f21ed8a2d8e7e76c085740d5e4c5fafbe","gas":"0x15f90","gasPrice":"0x11246e20d9","hash":"0x956679ffd3fcbd0445ae8c63ae8786926479196ce19d2a05d574ffb46a180aa1","input":"0xa9059cbb000000000000000000000000258473a955e900385f984fb3fbfd7480d5949cd700000000000000000000000000000000000000000000000000000001849f4e00","nonce":"0x16485","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xe","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x6626dd51a3920e6711b2cf5d2c48861a46eef13cda16153c76d7e376739d687e","s":"0x3e60da6ae7c6b156f5f1a33e40547dfe7d0e47a2d5bf9f9f40272fca6aa0d25"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7b769ea5428d2797a5d220797318a6850e4f4cf2","gas":"0x5208","gasPrice":"0x1096d4b880","hash":"0xbe5eb4b298079f825f8c1752c89f6a2bddc74d34a2427b8a31b2091208b77b1c","input":"0x","nonce":"0x0","to":"0x6dfc34609a05bc22319fa4cce1d1e2929548c0d7","transactionIndex":"0xf","value":"0x72d647848efc00","type":"0x0","chainId":"0x1","v":"0x25","r":"0x4f7435a3d559ffb32971e90f7fb01802d5e1c86e7ab79d48cf0271bd4bcbfe34","s":"0x72b09a8fe928675fa9c4613395a2e11bb9c9b83a94f57471f4c1d39cc5822abf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x9acbb72cf67103a30333a32cd203459c6a9c3311","gas":"0x15f90","gasPrice":"0x104c533c00","hash":"0x72c4ea9180e70bfc82d2781bc5bf12d8ed8f15032a7ff013428c7f4b08dc5ec6","input":"0xa9059cbb000000000000000000000000121e656d8f61c7699b67654c76d2888758f6f7b600000000000000000000000000000000000000000000000000000000566d3e80","nonce":"0x89603","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x10","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x3a18701d0034062f7776ae55f0d3868287ae54edf65437728fbe02bef37228db","s":"0x55810f47652e3afc6c7e00a43d1396863aef74173fcb6338fad1eb8f33464134"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5f35e8a2783df2100ba7435d3126c2a2bb33dfc1","gas":"0x5208","gasPrice":"0xfd2da0d4a","hash":"0x286bbb06008de1c6ff1ef00c26af2122a105f03bce4865d830fe3513d27846ac","input":"0x","nonce":"0x5","to":"0x40bbe207ef4e4da653bea94228130dee1b99d908","transactionIndex":"0x11","value":"0x1a914dbf2725000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf724f63f50dec5b7eac96a5b5ce61565232a0ac1c28d20437cec1ef04c48e6fc","s":"0x3fe2a93a3fc06b38f11e847ced32380ce7916fd0c665ad9505e52f3a2b305f22"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x30c5312d9cf0d873994f000e72f1cbf561d0209c","gas":"0xb9755","gasPrice":"0xf43159f80","hash":"0xdd0b8bb00d357af60668df4c6433ee802a595cb52acd9e48f7a520b9f7ee9c42","input":"0xac9650d800000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001800000000000000000000000000000000000000000000000000000000000000104414bf389000000000000000000000000ed35af169af46a02ee13b9d79eb57d6d68c1749e000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000000000000000000000000000000000000bb80000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a94800000000000000000000000000000000000000000000d27f529a1126395898000000000000000000000000000000000000000000000000000e10dc0b8f11e849000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004449404b7c0000000000000000000000000000000000000000000000000e10dc0b8f11e84900000000000000000000000030c5312d9cf0d873994f000e72f1cbf561d0209c00000000000000000000000000000000000000000000000000000000","nonce":"0x5bff","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x12","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xc3696fc6532a668f8b88d4e12dd901c053dac0647c727d9c36549fdfca61a4ea","s":"0xa79d7aa39ff5de4d01299048f267141afa9f5df1f5e90664ec0116752f72260"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc715a60f627c14277478a4b6e2bf71fa82b9402f","gas":"0x5208","gasPrice":"0xeab17b600","hash":"0x6c75efc160589a5336352b0a65e70d4d5a6a87afe549342848fe9fe89d82923f","input":"0x","nonce":"0x16","to":"0xdf404f252ff07095f7846f2f72918fd3f72ffef0","transactionIndex":"0x13","value":"0xc074bc54809940","type":"0x0","chainId":"0x0","v":"0x1c","r":"0xa042430549308f676b69407045307918e54f95e724a817379fec148a565ec1a7","s":"0x6aecf235e5eb7e77b98be1b27fe6a4482371fe7ba88fbe621ac5f1faffaa8eb"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe33f0f552dec1edf75272f15d4ea37f50b8bff5d","gas":"0x5208","gasPrice":"0xe6f7cec00","hash":"0x7df7001f983fb23561ce7e8b2cdc5f6030660865c90c058c5f12eb0016e77c08","input":"0x","nonce":"0xc3","to":"0x3ce4d4f2b731c9c8ee91ec12cfe310a1f3d1726c","transactionIndex":"0x14","value":"0x1aa535d3d0c0000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xa02e545ab33592cc79428cc7db6025b661ea995a237dbe4c5457099848d06764","s":"0x6134ea9e4ac7577ca6136ec23fe3c40d852279cd55123c5d66dc8f5390da5998"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x07f0eb0c571b6cfd90d17b5de2cc51112fb95915","gas":"0x2a7b0","gasPrice":"0xe6f4a3b6c","hash":"0x17f37232e6be59b6b5fa2cd4fd495a979bac0d573fdfabf6cf01fd0fc9638bf9","input":"0xa9059cbb0000000000000000000000004c8e372c5d05d4fadcb2800cc7fa979de0a8420e00000000000000000000000000000000000000000000000000000005ce81fd70","nonce":"0x12580","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x15","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x907068550c8d11cb6bcde3479fffb6e3ce439e42d6d24d2eb9c7c5f93c09e491","s":"0x4df1944f3726e5fe329213903320346e416f6aeacf65f5b078c56f37bf944028"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa978eadb605761725d11d3b3a4045cf5859e2d3c","gas":"0x7a120","gasPrice":"0xdf8475800","hash":"0x061f9ca5ad9544c8f34829b49f56dd5726b733080b93de1bc50ad5790cfeb10e","input":"0xefef39a100000000000000000000000000000000000000000000000000000000000000cf","nonce":"0x31","to":"0x47e312d99c09ce61a866c83cbbbbed5a4b9d33e7","transactionIndex":"0x16","value":"0x2c68af0bb140000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x18604c1641fb98aab76c1844301dff05b6e62ffb2a5cfd5eab4e7c6160c86d71","s":"0x706fe38c6ffca1f49677ffda199fea11ffa34c6089bea980aa6d6ce3d454a869"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa070425ab2e7610a0cdfd928a5f474d6e17f8aae","gas":"0x3e5f8","gasPrice":"0xdecbfe2bc","hash":"0xefa2f95e3ae47b1dee48b04ac62aac78631daa8d13982e205fa45dea72f0083e","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000a070425ab2e7610a0cdfd928a5f474d6e17f8aae00000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e500000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf00000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000031bced02db00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a457000000000000000000000000000000000000000000000000000000000000000077be4f6d168e752d8cbf921a8d4860eb57c23af9f1ab8da9251531e8a2393985000000000000000000000000000000000000000000000000000000000000041a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000031bced02db000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000062369d0800000000000000000000000000000000000000000000000000000000623f35e7962d9715fbea77cc185d8ab9b5293425cf557d0057940da0abc8bf84557717250000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c4db4acb77d3a791c326b2bb361be23649d316b9c0ce7d45f55deaa27f99abcba3af3cbe78c056962b919f8675f3c5c207c56b3ec379d3b4a66f0b0af8045f5a24db4acb77d3a791c326b2bb361be23649d316b9c0ce7d45f55deaa27f99abcba3af3cbe78c056962b919f8675f3c5c207c56b3ec379d3b4a66f0b0af8045f5a2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a070425ab2e7610a0cdfd928a5f474d6e17f8aae000000000000000000000000daeecd365bcec74656bd4068879db469e815641d0000000000000000000000000000000000000000000000000000000000000a46000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000daeecd365bcec74656bd4068879db469e815641d0000000000000000000000000000000000000000000000000000000000000a46000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x8d","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x17","value":"0x31bced02db0000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x564a4a6a2cf0ebca9ce31e5c9e17fcc9f86733b76ff97d2346d18266fd1b03d8","s":"0x28c91de72e47053c740de2fc8100b205d467b09e971127af073d0b6b82e89caa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe78388b4ce79068e89bf8aa7f218ef6b9ab0e9d0","gas":"0x30d40","gasPrice":"0xd8a22fb72","maxPriorityFeePerGas":"0x1dcd65000","maxFeePerGas":"0x15f1bcde800","hash":"0x24205d8327ef12c144861fb96b6f88272f3cb44c80bb9b7a70ea8c2d151a528f","input":"0xa9059cbb0000000000000000000000003178aa92bf66355664c92684f1dcfe1857a873a300000000000000000000000000000000000000000000000000000000395ae182","nonce":"0x1b033","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x18","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1bbffd0fc54bb2835fd3f6a47a4c98963f538565a2c35859b45d35282bd867c0","s":"0x299137f40f24529d6184eb02917ddf6d38751b6b8042fd1477d6a7aaf89ad059"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xabd8eed818ddd601f71d0330d96dddc12ab5be32","gas":"0x5208","gasPrice":"0xd8111c400","hash":"0xc6ba4c554a670fcb62e42505c6ee70a41c3a96372cf8546598d6194c6396b1b3","input":"0x","nonce":"0x0","to":"0x077d360f11d220e4d5d831430c81c26c9be7c4a4","transactionIndex":"0x19","value":"0xb008da65823400","type":"0x0","chainId":"0x1","v":"0x26","r":"0xb6d53517a93418e4ebca8faa4c4e4b11d9c7aeebf3e9bd7b35999bf53d77c2fe","s":"0x5697b7480f9b7eaa2a9f2b2bb349dcd18112be401b32dafc109e3272a88bfa85"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x077d360f11d220e4d5d831430c81c26c9be7c4a4","gas":"0x15f90","gasPrice":"0xd8111c400","hash":"0x233c5c49ef9376976fe4949183d94b92689369b06755416f600d28ef22fb7663","input":"0x","nonce":"0x4aebe","to":"0xddd1939707a98bcf66b5a695a72aacbb8a7fd442","transactionIndex":"0x1a","value":"0x1d6651758a6fc00","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf9a4d44856a5fd5392337727d51021b66f1ab7d6b08cb0048e412d4047f7510c","s":"0x6d8034a6d7bd83d1d60f967987fcaa3d3f7d80c0f97043e5611c3d4d7fa9b0a9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc098b2a3aa256d2140208c3de6543aaef5cd3a94","gas":"0xf618","gasPrice":"0xd5051f4f2","maxPriorityFeePerGas":"0x1a3054980","maxFeePerGas":"0xe8d4a51000","hash":"0xdd6f65c69131418db9ac1698a6a9a79d82366d1bbe3001c0013229c7fb87a7bb","input":"0x","nonce":"0x17feb1","to":"0x266d1b791d8418974c0bb614d33ad6621489f8b7","transactionIndex":"0x1b","value":"0x1a1e52b6f2e400","type":"0x2","chainId":"0x1","v":"0x1","r":"0xef1ab86960451e988754c7e0f2e512150cd1ea8fcb5ae4cae59acd5a38655735","s":"0x2add3f986e81c0c216bc078f57ba10f4271610bd8adc5f2e2a6d43475d19cfb7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x543500e7477c7bcbe64637b6f6d5130ebc86107c","gas":"0x2acf6","gasPrice":"0xd4e883172","maxPriorityFeePerGas":"0x1a13b8600","maxFeePerGas":"0x138eca4800","hash":"0xf76971a9c5d67804fb13b30d7d2e1e68cdf7031c5d95618138c78bac89511a93","input":"0x5cf5402600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000000000000000000000000000051e71558c07a53100000000000000000000000045c2f8c9b4c0bdc76200448cc26c48ab6ffef83f000000000000000000000000000000000000000000000000000d3c9e99fae7cf0000000000000000000000000000000000000000000000000000000000000128d9627aa40000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000051e71558c07a5310000000000000000000000000000000000000000000001ac62a9a0c6c12a9cc100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee00000000000000000000000045c2f8c9b4c0bdc76200448cc26c48ab6ffef83f869584cd000000000000000000000000382ffce2287252f930e1c8dc9328dac5bf282ba10000000000000000000000000000000000000000000000f49a425a816238a4b8000000000000000000000000000000000000000000000000","nonce":"0x7","to":"0xe66b31678d6c16e9ebf358268a790b763c133750","transactionIndex":"0x1c","value":"0x52badf426028d00","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8915e453b1acaed622ed027504e1eba675333c2a1268f4e36234cf3bb49b7466","s":"0x753b67f0d609c5ef875a96e5d2f54fb1dc1fc4bff33d4372a3283d2b9f2a36dd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc098b2a3aa256d2140208c3de6543aaef5cd3a94","gas":"0xf618","gasPrice":"0xd37f4ceb4","maxPriorityFeePerGas":"0x18aa82342","maxFeePerGas":"0xe8d4a51000","hash":"0xffd0f412a002ef80d44a606cf22347a590f8321f60bfa48b9c52a8c08192ae09","input":"0x","nonce":"0x17feb2","to":"0x307ec02bf5674caef56af71a3ceaeb68771b70f3","transactionIndex":"0x1d","value":"0x12a7ca26624a800","type":"0x2","chainId":"0x1","v":"0x0","r":"0xf44abeea1bcd68b30446f0f8f7cfb3790ca59a5d5aa1ffa4a7bcbd8fad7b098f","s":"0x75848eecf16e1d1d480799b7cdc6a3bfc8856b1a2e0b8c6593223bff9541e9ed"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x71ec583a7442b97cfd30b398976b019f10683dbd","gas":"0xd176","gasPrice":"0xcd7529d72","maxPriorityFeePerGas":"0x12a05f200","maxFeePerGas":"0xe8587b33c","hash":"0x9caad3c037921295f3c1a64f3db0ae276a31c572087943c5c4a03402fbc074a4","input":"0x095ea7b30000000000000000000000001111111254fb6c44bac0bed2854e76f90643097dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x2d9","to":"0x614bbb02e7fd360ee393de19cbfe11847e2c021e","transactionIndex":"0x1e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3fccf1b07419dd476a4fbbfb265b85419a9738cdee97f352038bf56401df5363","s":"0x3796afb38f1493e363000b7b008e01f483495b7dda4ae59c9b54f44251eaeaa2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1733ba1a96aa7c44be180a032c09d665f024b921","gas":"0x1d4c0","gasPrice":"0xc9bb7d372","maxPriorityFeePerGas":"0xee6b2800","maxFeePerGas":"0x22ecb25c00","hash":"0x4b0d83b9d2e49fd74633ce415213c8d6ed4627394d86e48d8b067fcb59c72573","input":"0xa9059cbb0000000000000000000000005770815b0c2a09a43c9e5aecb7e2f3886075b6050000000000000000000000000000000000000000000000000000000130f2ca70","nonce":"0x4","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x1f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x28951ddae6b218e278d8156ece5fa5f575d702112f7cf16c149bb07352e4c3a4","s":"0x9bec9905c83518b5213d61baa28dc5579a61fa8afd78eeb8f2126a3bd88538b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5d232a6011452bf2a7dcbaa4087e7e410a07fd48","gas":"0x14820","gasPrice":"0xc9bb7d372","maxPriorityFeePerGas":"0xee6b2800","maxFeePerGas":"0x22ecb25c00","hash":"0x212ff3b885bcb80a7953929e3d40b91d13291140f0f96350f22a3c61c30bc5e8","input":"0xa9059cbb0000000000000000000000005770815b0c2a09a43c9e5aecb7e2f3886075b60500000000000000000000000000000000000000000000000000000004c835e52a","nonce":"0x1","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x20","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x5a8109182247837e390e798fa705ab66bd1126663612dd22813d86a9262d7d19","s":"0x17020505873a29207384409db3155c0c486c73c538604ac6f892b8bb8ccbbdbb"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf1ab8efebb0f8cc494047bcd05bf3671492a3082","gas":"0x16ffb","gasPrice":"0xc92a69c00","maxPriorityFeePerGas":"0xc92a69c00","maxFeePerGas":"0xc92a69c00","hash":"0x53871098486061cc09dd109b947b02ded1659d4ef27284c995cd7a22d9ee725a","input":"0x4447e48c000000000000000000000000000000000000000000000000000000000000009d52afb6bd1679c1b9d3187a39448eafe3048e105ee568596a21c82e9e2e9a91fb4147706ed94fa5c51a200a0487d7fe3e87565b4c49f3056bc6f0a7a3a9e2d16f00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","nonce":"0xf6","to":"0x33eecbf908478c10614626a9d304bfe18b78dd73","transactionIndex":"0x21","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xfbfc6b2eaa2f954564f79caa125324345fc1eca9325049a22957423510b32ec0","s":"0x4b3923d685c8f9d209299b86ae066baf458f6f4b144a92605e3c3089752b083a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd0bd91168ad1743e930e151c7327adf1245f4e74","gas":"0x5208","gasPrice":"0xc92a69c00","hash":"0xe661f7350a6008207ca78dd16f89d6cb67445165ee6dfb666b490bbd344451b3","input":"0x","nonce":"0x21","to":"0x4de23f3f0fb3318287378adbde030cf61714b2f3","transactionIndex":"0x22","value":"0x8e1bc9bf04000","type":"0x0","chainId":"0x1","v":"0x26","r":"0xaa97cdceb6ecbf3c22081e7cd4b4150f90778799a7d73ef9ab864066caf2132","s":"0x511b02a97cf1b92db48af7c473ba3a4d2c37a91bff6e1cad9a9be7afcfabb682"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x25b5118f646583caa577a5d14f4b78f658eee127","gas":"0x124f8","gasPrice":"0xc8ba9f46b","hash":"0x7dd7658b4d31e29e12555d90e4c91eab6dd1b326cd165677e52c23a9be733154","input":"0xa9059cbb000000000000000000000000ffec0067f5a79cff07527f63d83dd5462ccf8ba400000000000000000000000000000000000000000000000000000004a77f3180","nonce":"0x0","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x23","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x1515f3adcc9fad706aa3ba71086200516c899f45fa95bc7254882567a5defa8","s":"0x2af497f027d1cca322d4821437da899dd53e2b340e37d6ee0f82890f5b1da8a3"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa29fed2b3f3bb68c21e9d725c069964fb261bb8d","gas":"0x493e0","gasPrice":"0xc8ba9f46b","maxPriorityFeePerGas":"0xc8ba9f46b","maxFeePerGas":"0xc8ba9f46b","hash":"0x2137283becdf77aca1a123cbf8913f01d3b791cbf79e831b8559462a059f1eca","input":"0x18cbafe50000000000000000000000000000000000000000000000f2dc7d47f156000000000000000000000000000000000000000000000000000000035a0b3cdd64b6b200000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000a29fed2b3f3bb68c21e9d725c069964fb261bb8d000000000000000000000000000000000000000000000000000000006238a51f00000000000000000000000000000000000000000000000000000000000000020000000000000000000000003106a0a076bedae847652f42ef07fd58589e001f000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","nonce":"0x36a","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x24","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x7ba9318c153daad837c26a23e29ea1d105cfaa116f966f956597bf6eccc32597","s":"0xf6a5fc94920eff9db0c42be46d26810d5fe739851bba844a19997333763594d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x0fa667ff71a47dd0edd2fa59d7f760adf4deda7d","gas":"0x124f8","gasPrice":"0xc8ba9f46b","hash":"0x7c8e0ba17b09f261b2a4f350add0ac2d3b6f30fffe87e641846a33c8b00f4a75","input":"0xa9059cbb000000000000000000000000ffec0067f5a79cff07527f63d83dd5462ccf8ba400000000000000000000000000000000000000000000000000000001472ae200","nonce":"0x1","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x25","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x665a9def84fef0c8ae3e450c3c89f7a6e3aed59a95bb339068e9f6eed76be7b0","s":"0x4945ad0bd796101fff04de815da8ed7585e570a04f2e5d35b9592587786cf69d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x506abcffb687bf37ecc3380bb7cd4af6f7fcf238","gas":"0x17237","gasPrice":"0xc83256a25","maxPriorityFeePerGas":"0xc83256a25","maxFeePerGas":"0xc83256a25","hash":"0x6498a9eed9fbdbe4a417169050a95cbcf5e0cad48fb23f20214d6664ed8a2012","input":"0x696d48200000000000000000000000000000000000000000000000000000000000000001","nonce":"0x2b","to":"0x4f2c9b6e18f84c618910eb3edba1b5327c4e7851","transactionIndex":"0x26","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xd13f2890b6e7f5415cf8ea06f01e4db5cd27478a327ceb128ebcd905c0fb5d77","s":"0x557f8bd00c5a0c2d13162d1a5e6c5d17e6989665006fad93272dd5d813d518a7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc36315c5c42bbc57ef6d9b1fefa800c689fb75de","gas":"0x1c9dd","gasPrice":"0xc83256a25","hash":"0x75d3401662b36f2dd05632306820864905175e333f870854355bd1c1de0fd559","input":"0x","nonce":"0xe","to":"0xc36315c5c42bbc57ef6d9b1fefa800c689fb75de","transactionIndex":"0x27","value":"0x0","type":"0x1","chainId":"0x1","v":"0x1","r":"0x90765b5190f26b7231b00093fe64c98164eada8c8d8b977c6718b04c3ce5e691","s":"0x3490fe8c3d3752b4dd511b8235d31f2e9331e32d6160e20ce5f4ce0349e43405"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbbd0d4d067d5af2065b1b6fd936d93237ae1c56c","gas":"0x5208","gasPrice":"0xc6f9c5272","maxPriorityFeePerGas":"0xc24fa700","maxFeePerGas":"0x14f5885a18","hash":"0xd10ee5cbd1c7e4af94d9ecce08a6b4b3a15f96ab52fa4855124edcc0d47b775e","input":"0x","nonce":"0x360a5","to":"0x793c9be48a8a0c4bc38c85e5e3a3b9fdc363f729","transactionIndex":"0x28","value":"0x236be4232f0a08a","type":"0x2","chainId":"0x1","v":"0x1","r":"0x9b6a261e267a8a799b82bd1304e62a7bea14a14f6153af55e0373497301e9345","s":"0x16c8d1736da9766f7a2c7e07e6c1467f7bdbd8c1e46360689a033e0a8d13508f"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x88dcdd4a0a58b7e2208805d547043c37dca2b6dc","gas":"0x5208","gasPrice":"0xc6f9c5272","maxPriorityFeePerGas":"0xc24fa700","maxFeePerGas":"0x14f5885a18","hash":"0x664da484e3b6cb4abd79a42b23502eebaf6fbeaad08fc53b807e1c557deed52e","input":"0x","nonce":"0x14779","to":"0x4ae3280e25b61404fbb81c6a196a8299eb593c7c","transactionIndex":"0x29","value":"0x351ff44245f24da","type":"0x2","chainId":"0x1","v":"0x1","r":"0xbed2b47f0d351e3ea0af333ed48fee46202ce005dc3de3a564ccddb8443939f8","s":"0x60ef5dcdd813780c23b110e1c7f0c3cec8ae7da540c1f34bf23ee6b887f45c68"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbf627404ceabca0cbcc018810c75d8f8f31d6add","gas":"0x2f7f0","gasPrice":"0xc570bd200","maxPriorityFeePerGas":"0x1a13b8600","maxFeePerGas":"0xc570bd200","hash":"0x767763a4cadaf2bce9cf5174c0f3fe787a530b1399cd753e6e2c8acc37c49884","input":"0x5cf5402600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000000000000000000000000000000040172ac66deb000000000000000000000000f0bc1ae4ef7ffb126a8347d06ac6f8add770e1ce000000000000000000000000000000000000000000000000000000a5ba9d27e50000000000000000000000000000000000000000000000000000000000000128d9627aa40000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000040172ac66deb0000000000000000000000000000000000000000000000000000000000597cd800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000f0bc1ae4ef7ffb126a8347d06ac6f8add770e1ce869584cd000000000000000000000000382ffce2287252f930e1c8dc9328dac5bf282ba10000000000000000000000000000000000000000000000534623483862389fad000000000000000000000000000000000000000000000000","nonce":"0x2f","to":"0xe66b31678d6c16e9ebf358268a790b763c133750","transactionIndex":"0x2a","value":"0x40bce56395d0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x874375c2b8dc0bbc6be0039966c98adbe923486310e17be3d29b8be5c9c13509","s":"0x1754a0dec595aa856574ced1bbaac085ed9893f2bf3e6c6ec148e5e61164df22"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x22b91","gasPrice":"0xc43708adc","hash":"0x3e580c334e947c6dbaaa383f48225cc694f84f793dbc12f7f7a3419967f8f05e","input":"0x0175b1c46d19114c567d6a8b8d478abd3ffe3af2c1f3cc0c250260cf8a66ce12476624fe0000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea00000000000000000000000067ebc532d648e6164a6fd726ff0b39e69180dafe000000000000000000000000000000000000000000018dd6dd351e795e5c1dc200000000000000000000000000000000000000000000000000000000000000fa","nonce":"0x2b4d","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2b","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x6de5da8aae4a8b115af477a99335b36b45d625ad74ace40338642cf1b1ccf64","s":"0x105939f5d06d38bcb2d4a2239a9bbe40a83981e93a0d995d423ad450977d4039"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x283a8","gasPrice":"0x11246e20d9","hash":"0x1836cd3d1a4e79c6ab880eb7db88c4a589f5737de62655b721cd798679c96532","input":"0x0175b1c4bcea3dde489beba90e96a971222fdb5a00e883a5543e63afd5d9fc33cf7cf9600000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea0000000000000000000000006f0a19d563fdf85ce1dce18f8c852c96b9ecd6bf00000000000000000000000000000000000000000003968ede5643bbf3550000000000000000000000000000000000000000000000000000000000000000a86a","nonce":"0x2b4e","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2c","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xd7e4a696c36ab18c7d8748d3b82e726d69c65e95242c1f08cf8247d175842676","s":"0x2f166bf0c19ac438ccf4f3517edeee2fa8b28d3428630c42595c4e7fe63fc025"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x283a8","gasPrice":"0x1012c7f034","hash":"0x0e5483bf79bceb722ff8c07a9186668921b328ff06141cd572bf7eed208519e1","input":"0x0175b1c4f3cc58640469461a0fd35233070f886caab7306f9bc340b4ceb4cda99489e50a0000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea000000000000000000000000cb018790c03bdfd33a82354dee4e320f1398d66f0000000000000000000000000000000000000000000190fccdc5503c1168000000000000000000000000000000000000000000000000000000000000000000fa","nonce":"0x2b4f","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2d","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x1748021875eaae26c2837ff6cab749c745e084e64d55280ddbbd6de4b7d64bc7","s":"0x28e6a94a7723f84b912758a93e54317b6798a9bfdd50dc3f0b6707d458596bef"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xff62478469962006bfaddb2edbc537ec1d5cda3f","gas":"0xb45f","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1d0b8d94d6","hash":"0x74d895439176b2727b9cca6fc1e10a11c0504b3f0de722f93e8272a1f963189a","input":"0xa22cb4650000000000000000000000005f3ebea72c25d286de8d4787cfddaeb0aa68a2a20000000000000000000000000000000000000000000000000000000000000001","nonce":"0x445","to":"0xc0423cd6d1e19d21f1f031895867e4ca36a14af3","transactionIndex":"0x2e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3a5830fb54d970e7ffb381d4efd7ad146ef240828caed1764f3b61c0b6c2ca25","s":"0x4b020fcb502fc420720b5badbef59389a1d0284c9a29e175c7ad07946d32ff28"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1425425563615b5b7654319dcc4488c46c9e09ac","gas":"0x107e5","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1d0b8d94d6","hash":"0xfea61304b28a38cf01ed744b6a8e6251f621c93e225521d5520745d6f2aa3ac0","input":"0xd0e30db0","nonce":"0x2","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x2f","value":"0xde0b6b3a7640000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x272f7937f5ba5372999a0086d4b2487dd94dcde0fd4e8592f4d8964f07e3e9a7","s":"0xeb19a22d07f7cdf8fd907348e4915bb492f27c8b95006d9279d2a385ffe6b1d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1bbadc48b976a38537729ecfb5a221c3255be14f","gas":"0xb6b1","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1b4c067b78","hash":"0xaad637e097fae3d0dce6ca90eb71b3f45c83ba8861905e24a9af374bd14235e3","input":"0xa22cb465000000000000000000000000e19184397acd38fa12425c7471c63a2fcf48f1790000000000000000000000000000000000000000000000000000000000000001","nonce":"0x28d","to":"0x7cba74d0b16c8e18a9e48d3b7404d7739bb24f23","transactionIndex":"0x30","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xdb8ddc9e607e4fff16371bb0e85dbe858fdfff5084d74e8dabf85aba36f37360","s":"0x32acefd0bc0c4fdf7fa67552af867e923766e099e29ac3913f69c6bff0c62892"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x59a5208b32e627891c389ebafc644145224006e8","gas":"0x62ad","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0xe3678dcbb","hash":"0x7d2e7c14af591cbd4eb9309382557209741d1a2e0da392407908c02f102dc802","input":"0x","nonce":"0x1beb37","to":"0x6c34c12eaab761ed34bebf6aaf5bcf4a8a659819","transactionIndex":"0x31","value":"0x4d75ba7905a3600","type":"0x2","chainId":"0x1","v":"0x1","r":"0x88f6ac1c3e039199ba2d141586cf65df837998b103fdba53c102794971081253","s":"0x20bf4e88ef6af62faeb5a8eec4248bf4b9107a8d2997a435c42c5a03cfeac193"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x0f5e6c11c553bb43c47be504b7b06bde8652678b","gas":"0xfef6","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1aa855f7d8","hash":"0xafcfb073bf6d00100d9893207b3ec8db23c412da6fcbea01eddbdd4985be011a","input":"0x42842e0e0000000000000000000000000f5e6c11c553bb43c47be504b7b06bde8652678b0000000000000000000000003e09132aa15b450e2ca237bb2a91d75cea93a7290000000000000000000000000000000000000000000000000000000000001d71","nonce":"0xb7","to":"0x5501024ddb740266fa0d69d19809ec86db5e3f8b","transactionIndex":"0x32","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xd0e229e6cf5b306f6a3f2786f1f82b629f8670b28f54c67bef2afddd596e5812","s":"0x3778878628a800b487b66ebb822fd1a7e17fe32b58feec71bc0585454b54b1fc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x34be15adbbcff56abd05bea96c95b483ff9454df","gas":"0x27b20","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1aa855f7d8","hash":"0xefbed26e5c3c9f36c51d2bc2a83225000e678cb32c8d749f7651783cebf8bbc2","input":"0x57db6e1d000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","nonce":"0xe3","to":"0xfdeef424c147e869a9bb2723874186f06f36b386","transactionIndex":"0x33","value":"0x354a6ba7a180000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xc150a5adaae2ba1944e3d734669624d6a292d6ea0a84b42b7827195e43f88044","s":"0x6617188c91354cfa169e9d6b3224aba7e7e1e6c1aa4d3e8152c3e9059ebe220d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf94ebf72c7335fae118c43387c34bc8c783d2e10","gas":"0x5b8a4","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11d491759b","hash":"0x7a69fa6c7717e85b798a3b65b7eb259afa7a729eeddf723310fdd773e7c44010","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e4472b43f3000000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000bb0b777ecb9110000000000000000000000000000000000000000000000000000000000000080000000000000000000000000f94ebf72c7335fae118c43387c34bc8c783d2e100000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a3f13f1572ff1e263758fb1bca22548a64fdc3a500000000000000000000000000000000000000000000000000000000","nonce":"0x776","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x34","value":"0x8e1bc9bf040000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xbfb84dfcfaaa298f3468fbbfd12d242f0104e4d41bdc69ff3f833232518a6169","s":"0x48bcea25ae56eafbcabe8212cf446bf437655a74939d3870e0152c32c2d6a260"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf7a4e60ca56980c6d94b4fa07cb3e215c477d033","gas":"0x5ae44","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11b1f3f800","hash":"0x2fb2c8bc358f4c33f2c6a40fddf4dcfeab0ab31a00a0aa96d29e3fa8277c5527","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000f7a4e60ca56980c6d94b4fa07cb3e215c477d033000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab700000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000905438e600100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4490000000000000000000000000000000000000000000000000000000000000000b183c5f945d750d3852ae373d585d32cf2ff611b02aed6164ffd37355f69d26000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000905438e600100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237eaa800000000000000000000000000000000000000000000000000000000623bdf601c169769334aa7d046bfc0fdaf818079a59ba4c067845b29fce8e9a2159c50c70000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b0e23b8acc764d7d547933196fe6c85701ad54ff6013369f04d46ff8b27ebe1e511d698dc6297961d54d2891e8144e4e6e5d347d93ca4b2f90468b9bb4598dc510e23b8acc764d7d547933196fe6c85701ad54ff6013369f04d46ff8b27ebe1e511d698dc6297961d54d2891e8144e4e6e5d347d93ca4b2f90468b9bb4598dc51000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000f7a4e60ca56980c6d94b4fa07cb3e215c477d033000000000000000000000000920a8d9e9f0defd6f86e4388a5503b04cac83b57000000000000000000000000000000000000000000000000000000000000066d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000920a8d9e9f0defd6f86e4388a5503b04cac83b57000000000000000000000000000000000000000000000000000000000000066d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x4","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x35","value":"0x905438e60010000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xa272165aaf915b58a82d07e09a65b00d7e1711b07dc90dcb6d06954f512f26a7","s":"0x91db600923f276ff079d0e0ac0cb02fd7080b186549e998b3375de6850e32b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x998f16cfd8efb044f66771cd3efed4641a592770","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11b1f3f800","hash":"0x09235e08b1bcde423b125441accbf73e2e7c5c5de3d883803269218e5677ba5c","input":"0x","nonce":"0x4","to":"0xd37259e8651ea6af19f81bd663f31280e2b3411f","transactionIndex":"0x36","value":"0x5eb106c4ddf0e0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xed4c904a32de3040b148d2fb627305f01e941c7fbac5c46645eef5a2954ac3d7","s":"0x6d805aa856ecb009a3c8f1e47065a799540fa264a2d10be61f9169a386f23764"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1ba56eaa78","hash":"0xac114adde331c975e23688cef3c59768cab22d4cb8ac1141ad4cd4b83aa15e3a","input":"0x2da034090000000000000000000000009b44cb0e212f78f5e33eeafaadbafa888e47f86d000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a499f","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x37","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5907cb2b3c4278f59e1a34030c455b7e3ecffd6d2616d55ad4b9f7f56221d95c","s":"0x6e139c895cdb1bc27a3b0d7be886729692a972099a8cdefa0f56aa27bfa3f3ed"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1d1a94a200","hash":"0xaacf3b5856b75493d2951b0b9c406ea215e43dbb93f9570bde1338105b04434c","input":"0x","nonce":"0x4d20d5","to":"0x7eeca051c3f086b16ee743094af9b245cf5d0082","transactionIndex":"0x38","value":"0x9addcc147946000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x369caaf5907470fa22858b99e37f7a314902c4520fdbd9fd41a73e83ca74b9b2","s":"0x32f53a9c00d88d2204dc081f194843f90e295ba4367a9a7cba5f097540d2f6cd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x25ad0fde4dc379b2c0e51dc5e70e0174bd8ae123","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x17bfac7c00","hash":"0xf83226339db9fe6028226c017c076244212b7343d7f3a353fda26f99596ae080","input":"0xa9059cbb00000000000000000000000071660c4005ba85c37ccec55d0c4493e66fe775d30000000000000000000000000000000000000000000a0541eafe5d9aead80000","nonce":"0xe72","to":"0x090185f2135308bad17527004364ebcc2d37e5f6","transactionIndex":"0x39","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x5896e029fcd486801f5efa7d7fbf5e635e9fa226c286695b892be77070f9a82d","s":"0x220d248d56db2cc9059e4837da9df7c98cfc7f4859074cb8673a726d51d0f954"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x77696bb39917c91a0c3908d577d5e322095425ca","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1b3dbe5200","hash":"0x08a7094041b3d1d79de412a4a6d66d62993e576d33abb7404f093a351df08883","input":"0xa9059cbb000000000000000000000000035226b054828d3e14a972584d952e99f5de230b000000000000000000000000000000000000000000000000390a3187f92fbc00","nonce":"0x317e9","to":"0x0f5d2fb29fb7d3cfee444a200298f468908cc942","transactionIndex":"0x3a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xa289bd8af7f7983402c6b93583470b79992340738ef3a45e876de9458c0ec6","s":"0x35369713b94f938e8d4caa7476e51ea1f5d18429a043d3539b27dfa58a11c596"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x66b870ddf78c975af5cd8edc6de25eca81791de1","gas":"0x183ac","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x1144cfc53709422dd3ddea66f706701a3e426736a37df8d9e332b5aafa88d42d","input":"0xa9059cbb00000000000000000000000000e5a16631c257366e26005de7eea1457cc35414000000000000000000000000000000000000000000000000000009195cafafed","nonce":"0xeb0","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x3b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x4db3aa0a2e031f73fb926f258c953c37ff909e4788f625d114e2239ccf393cfa","s":"0x649c2b5f611161fd346ad8704ba7c1eda928fad31d59b5a8e4c61b74f8cf8bd2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x866e7dacda4520969628bf11d4301845bfa3ff1d189faafa6392b732242b4ba2","input":"0x","nonce":"0x4d20d6","to":"0x9ef31dfb18a2e5588dc7f02480f96d9c063de8f6","transactionIndex":"0x3c","value":"0x186cc6acd4b0000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8d68af18d4c6f85448fcb8e6b97f88bbc6d44e2bea8f6c17c225836e6f0ee369","s":"0x95da3a57e25b5adba8f2c8485fa724bb03e43f349943269d3239765eec0a60c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb739d0895772dbb71a89a3754a160269068f0d45","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x24ad3710fbf2656bc4972f0a8461bbf187703cf8c6076730c2c3b346a265dbfc","input":"0xa9059cbb0000000000000000000000000c92b036caea02b436594434ca36c0e92750aaf600000000000000000000000000000000000000000000000000000021b86805c3","nonce":"0x566ae","to":"0xa0b73e1ff0b80914ab6fe0444e65848c4c34450b","transactionIndex":"0x3d","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9aa6eaf6d8bf94e16053db2de3dc920f09f55daafa6a577865c19ed05ff06ade","s":"0x798d02eec0e8e0eff1fd764eccf1d6a868ce78dbaec286607d71bf07e0797f19"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x88d041e262b66524719e75fbc4313747f6772f4ca843a26563c9647196716ce5","input":"0x","nonce":"0x68619f","to":"0x82f25f227997823dfaa35afe6244c0e9b0347d88","transactionIndex":"0x3e","value":"0x70b87055631c00","type":"0x2","chainId":"0x1","v":"0x0","r":"0x88031e6e9926fe5787e749a720b822166615efa8b8206ca8c03730400bd72b7","s":"0x1617245ecd75f624ef84133f85b453c5502e6e306abc471056bb384bc19bdcae"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x877d935f53567f907c6289c43e0c308e376a84e7","gas":"0x8caf","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x1fa8e6fd96049a879f9158bf522d9aafecc8efeebb0823beccdf902c0337ee8b","input":"0x2e1a7d4d0000000000000000000000000000000000000000000000000429d069189e0000","nonce":"0x7","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x3f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xecc6a7d411d36d801057226e677a83bf9a879cba7c7ed6bb12dc05422d8a6233","s":"0x5e2a0515e9a05670d9c9ca865205d92650b26fbd5ac5a982d73772f368b2b9a7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x77696bb39917c91a0c3908d577d5e322095425ca","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x6eb68c1f1d088d97d8d804215d2763da7f37bc6e5fc00dc5c9692499ac33952e","input":"0xa9059cbb000000000000000000000000803d6a65840dbe84995bd5bd6744a56f0bd561b8000000000000000000000000000000000000000000000001067b31c479d6c800","nonce":"0x317ea","to":"0x0f5d2fb29fb7d3cfee444a200298f468908cc942","transactionIndex":"0x40","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb6aaa62ff34f5ecf991fb9eca824ceb71e6be5e93220b20d5f06a2cb569433d7","s":"0x79b7d038698a13e05133433d6c94674da2f98b8f6aff85cd02f6896d72f65104"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0xc39f499c69f2f2cddfcb383566df014791b6cdec464c4b16facc678fccb4ea2d","input":"0x","nonce":"0x6861a0","to":"0x9cced5391143a3d838a7ec054a4e2fdf3c58502a","transactionIndex":"0x41","value":"0x1e5a6ecdfafc800","type":"0x2","chainId":"0x1","v":"0x0","r":"0x4e59c261ef26f4cc2e3ab885a712ab21a26d00728af578600749ca132742debb","s":"0x464c0ce24160cce18feaaf32b7af17f87ea140fb9c0e85ce7c76c135fcaf265d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf93e4d693715374929bea688784fd17ea30e1aa1","gas":"0x3cdbc","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xfd51da800","hash":"0xf9203d227da3e154bd82ad26ea8a3b3180a0e384b8cf0a3c5aa3c413e21d2fc4","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e45023b4df000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cfef8857e9c80e3440a823971420f7fa5f62f0200000000000000000000000000000000000000000000000000000000000002710000000000000000000000000f93e4d693715374929bea688784fd17ea30e1aa1000000000000000000000000000000000000000000000001bc16d674ec800000000000000000000000000000000000000000000000000000054f5b641a5ae54c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x53","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x42","value":"0x54f5b641a5ae54c","type":"0x2","chainId":"0x1","v":"0x0","r":"0xafa385acb782785fff2275089d60f1b01d2cf8ef969e679a7954506627c33fd","s":"0x59e1447051de06da5c2cca67d37864cb246d3caa4f29ed29640ca2fc9aea7ccc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x42f028e4d830b8961373bafdd83acaf08ba08d37","gas":"0x5208","gasPrice":"0xc1b710800","hash":"0x5bab0f789dc85f7dc8d42257c163ec868bfdcaaa60bf9f95ed807c4e88e1fcec","input":"0x","nonce":"0xc1","to":"0x65dae44c9fead4c807cfe684613c0dbe7c55dfd2","transactionIndex":"0x43","value":"0x58d15e176280000","type":"0x0","chainId":"0x1","v":"0x26","r":"0xba29a019be60e7787b0ce0285b34b0a3a6aebd320abcf53ddc99851e39732a01","s":"0x681a37910f527a56d5e1f1d6af9dec7ca5837b506f05d2ef66a66e2dc9aac74a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb2644b8c5d4301759616d34c2c60e1e39dd5712d","gas":"0x300d1","gasPrice":"0xc1b710800","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xc1b710800","hash":"0x6a57e1c8fb7e65ef934b58426cecf5721b5b18b0c038781a9a17db4b0b0d3d8b","input":"0x27ed3dce00000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000000e7e428eb56ea16e9fcc7cb5449eccc09a065311a47d3043dfd4576012607a1cf50e0177098f4b3e991235cafdf1b8fbb8ff4c471cf5ee46c7f61832c52684bdf4b7b9ac0f696c136abb028a037120f3d0db403124546b948fccb660863fc3fbaf3d4cf208d24d7a25104d2109c0e60c66cbfc4a1adda588dc9f80c4151290586feeb379677bf5526e2934a0e7d2d1112cb015e541b3408dae48fa0401b86a6ee88e2c53c692f99462e582be569d3a7fb1a5b4a02ba15c44c018adcfad06450c991619138821d2c3a34b557eb277e442794d604d20d0b8fd0af7387e49c67f781ed4948b1ede17afcbacb12874818863e2a4e05d0f4ff3d821269df3b32d7f3e461c648cf7c11a3f6f7be39346492a15511b81712db751797588fd58d50fb0b404b507475a35fd07cf06f8fbba131157a2c7f921b1f06d002b562d511a5f3cbacef11ff4e50bdc44a5a3d3eda714b2eaf5633ebb50665b394b0afe79b737c5ccce8d8075adc6451fb8feea97486fd42ba3fcb0acd08355355c26492620730700a7d2b9325aa9f7052716eb632d91c05d4348cdc742b9211adbd9b1ab52e1e18bc1a854211dc3a9a696246df40d309afaa46fbb550793473d831078206b6b561992","nonce":"0x27","to":"0x19cb5b009bdad0dad0404dd860b0bea75465e678","transactionIndex":"0x44","value":"0x2df69673c818000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x76d044f0d44a532644fda2919ac0bbb801b1c80050f671ee2292846243b4d62c","s":"0x9c55cfe0359a9232b0fc5a049a3658bb413cdbdde2406a282273027ae98f425"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3494edd48b90f8aa91186ac356c956e658b78848","gas":"0x61730","gasPrice":"0xc1a0f452d","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xc1a0f452d","hash":"0x198371baa808ee9a0e242dbca67c952ae0addcda98a468ec652a161ab854884f","input":"0x5f5755290000000000000000000000000000000000000000000000000000000000000080000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c0000000000000000000000000000000000000000000000085e62e27c31e2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000000c307846656544796e616d696300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000440000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000085e62e27c31e20000000000000000000000000000000000000000000000000000668abf97c6c416a4000000000000000000000000000000000000000000000000000000000000012000000000000000000000000000000000000000000000000000eee3650a7561e0000000000000000000000000f326e4de8f66a0bdc0970b79e0924e33c79f19150000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000030877725df6000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c00000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000085e62e27c31e200000000000000000000000000000000000000000000000000006772785362d4ab57000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000160000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000337fea5e0ff7e5aca000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000526643c9b3263a5370000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000002b990f341946a3fdb507ae7e52d17851b87168017c000bb8c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000869584cd00000000000000000000000011ededebf63bef0ea2d2d071bdf88f71543ec6fb0000000000000000000000000000000000000000000000776e38f2a46238a03f00000000000000000000000000000000000000000000000092","nonce":"0xe8","to":"0x881d40237659c251811cec9c364ef91dc08d300c","transactionIndex":"0x45","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb8589903018f0a1bc1a1302216d72ed7fc5d4416fbc4689e92f6a9a8110ab5","s":"0x3c6b4a13223b4e7a90bef125bec37307fb8f3ecee7750077ffba341c7d020b69"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x230a1ac45690b9ae1176389434610b9526d2f21b","gas":"0xf4240","gasPrice":"0xc141e14b1","maxPriorityFeePerGas":"0x66d1693f","maxFeePerGas":"0x15d3ef79800","hash":"0xd054facb9d0288d483ebefddb075aae27d08b5334bd52e2a41f9fca773b1fa5b","input":"0xd57eafac000000000000000000000000d4109bab1e296b5d11bc17efaa5b7537e3f51cd40000000000000000000000001b84765de8b7566e4ceaf4d0fd3c5af52d3dde4f000000000000000000000000000000000000000000000005a7ba096c979f66ff00000000000000000000000000000000000000000000000340aad21b3b7000000000000000000000000000001116898dda4015ed8ddefb84b6e8bc24528af2d8000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000028a56c4000000000000000000000000000000000000000000000000000000006239f62d5f39f6c80473e5b2a22165b32df83c0e0449264cf650f5bf79cb7682d74cbb9c","nonce":"0xc70d","to":"0x2796317b0ff8538f253012862c06787adfb8ceb6","transactionIndex":"0x46","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xfb047540bc24481eb7425dc05e429bd940feb7465d44478f1538d5f38ab8653b","s":"0x1bcf2f0d0605cdb1135736850a1cb0df80c2a508e3f4ee141db512dfb6fe42b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf42336e35d5c1d1d0db3140e174bcfc3945f6822","gas":"0x7a120","gasPrice":"0xc124c5868","hash":"0xbb881c3df1fe5beba942d3264199d7214d0601981829d211e5b4fbcf298aebb8","input":"0xc98075390000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000003e00101000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000026000000000000000000000001c0e29dcd78cd89afdca83fd726daed00002b331020a05080c03010704000f060d0b020900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000f0000000000000000000000000000000000000000000000000037628f64c3ddf3000000000000000000000000000000000000000000000000003773b6c25ad5b4000000000000000000000000000000000000000000000000003773b6c25ad5b40000000000000000000000000000000000000000000000000037838271f5b00000000000000000000000000000000000000000000000000000379447c81896680000000000000000000000000000000000000000000000000037b9a4296b537f0000000000000000000000000000000000000000000000000037c86bca4a2a000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d82f475df5a80000000000000000000000000000000000000000000000000037d971629fa5440000000000000000000000000000000000000000000000000037e78a5f4fd6580000000000000000000000000000000000000000000000000037eb7254c3c0000000000000000000000000000000000000000000000000000037f2fdadf6b33a0000000000000000000000000000000000000000000000000000000000000006e7a8b325f9c005ecf33810ebb29d21480f9b1262ef63ed90bff7f38a2c29ea6a215327e550d5a0988be95c176517f3b8d0d040d347528d3f847a5b4221937d945a1288a53c180fac4b0dd6d1db50224808bcc16f862a91f8dca8499c2b429446a228e4e16e3369db0c2abd61e06071585a3ed3026e5833bff279023f8da8331b6641b2da0aec7387efdf5b7c67d89b19585cd8d0017f069188b515b30d283bd86136da0a45b4ec7f494e59f85647df283d8c715ab9f1b3134c1fff373c0c410d00000000000000000000000000000000000000000000000000000000000000065ac5bdbec5002a8e3eaac92906761158cb5e8c021aa240b5dac4db1c42fdc5252f6d6102a817c1475342cf4c3dc1c3c740a9ed17e5e5b675907dd3cfd7d24adf65c194882a935881b7e61fac1eef21997b14e8a43a75e461da58c0a38068a0cf3284b65634e6d8723e4ed8b94438eb19938b997a78672ba46b28db97b4a108de6100315a9cefbf128946911a2a0216b33501b8fed82d7df85fe641a3a709478c0a730f24af974bbea68b192fd7abb5d666efaad05ef0513d990a3dc8c71ffa1a","nonce":"0x70b4","to":"0x57560ea88060739107399670d8905ffca3b57d08","transactionIndex":"0x47","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0xe0a6b0b7d62ccd031aaf8842ccda1d107eca591945b7113147088896f36192a","s":"0x12f4efac35a95288b61ad839334edc8a76ef34ee5dc3feceae29ff0fe3bd05b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x29d5527caa78f1946a409fa6acaf14a0a4a0274b","gas":"0xa410","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0xc943a05e9","hash":"0x05b76328d4270d6f1cb9da98e555f9d76f50ae9ae383f2fb3e9ce68b8621309a","input":"0x","nonce":"0x81ea8","to":"0x53a142efa0647f2a3d28358f8b9767c9f6821291","transactionIndex":"0x48","value":"0x474311e61383000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x275dd75d2c3c6775d456f724a004f4444b931ecebc1fcd63c1860df28fa582c","s":"0x1282abc0a3473ffec5d046d2baae99b58ed24daedbfe91f2e5211b0bc9bf4e32"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd1cd19598848809084585853a330a994bd191094","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xbf7c13184f6f94489ee2f108baa1f9350a600add04e165fd8851472506c20498","input":"0x","nonce":"0x4e","to":"0xc907b4c02ccebf64e17126b9f5ecd9fefc596b27","transactionIndex":"0x49","value":"0x247b3e9e4b943f","type":"0x2","chainId":"0x1","v":"0x1","r":"0xe95fa8a42d27ee422798a025b70871996bd3cbda97493e78091cc0627335a247","s":"0x391b9d2c90a506aef365fddf5325974c2045d421f1c5863d605630f00684d454"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x4ca19e8c5c6d2e7993ca0ac141cc26de76f1f8c1","gas":"0x7e313","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xe972a83dfda0e6ceb1364d9e6d9d3692125e25d921eee57e6ff8c2cfc9cb0718","input":"0xddd81f82","nonce":"0x13","to":"0xa5409ec958c83c3f309868babaca7c86dcb077c1","transactionIndex":"0x4a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe0e38b0900d0fcbf48e84e4c579451e59fe8cc7b87fd5212e8a6970a4c5cff94","s":"0x17eb6206a4561a5e82ca1fe7e73cdb7d36cc4bd7f30c3e6d5bc230892ce76b4d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc2092ac155700218c70fbdda708bee592fd3cb19","gas":"0x14036","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xa71a0b532890c54b2f015615eee13386f647acd3f872eb745d24ff673d034506","input":"0xa8a41c700000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000c2092ac155700218c70fbdda708bee592fd3cb1900000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010a741a462780000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000623852a3000000000000000000000000000000000000000000000000000000006238e751d32c89e86e2599e76eb07ba3f7d8446747b680cc1151c68829cf9eeaae0a5f530000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000034000000000000000000000000000000000000000000000000000000000000004600000000000000000000000000000000000000000000000000000000000000580000000000000000000000000000000000000000000000000000000000000001cf6b48539740f8a09a3d625316d93b0961d8a9547d0555c9ad8b935a6405a986d3b652b44a2b4ec54e57fedec4a9cda8489f69a7f8740faad72b1557dad17074e00000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000c2092ac155700218c70fbdda708bee592fd3cb1900000000000000000000000000000000000000000000000000000000000000000000000000000000000000008d4100897447d173289560bc85c5c432be4f44e4000000000000000000000000000000000000000000000000000000000000023e000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x33b","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x4b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb33256fcdede4daef7efb0084e3a3adca8ec14802c3b313aaac99e48e68c996","s":"0xe1fc3a60d098c48fbef4111099190267b8fc5328aed3e3c93963475c396f5db"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb6cef19d36c1fb5283d81010454e5de30fd315ea","gas":"0x39a08","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x3e9c408e9d71272dce18902ce7656e08a72c5ca48246f199c02bf88cfbc89ee0","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238ab90000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e442712a6700000000000000000000000000000000000000000074ef6a373d4fc5f5c000000000000000000000000000000000000000000000000000000392d1c0d224ffa00000000000000000000000000000000000000000000000000000000000000080000000000000000000000000b6cef19d36c1fb5283d81010454e5de30fd315ea0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000055509c29853405d819ba611c393ce920bcca2c0b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x363","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x4c","value":"0x392d1c0d224ffa0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1263069356526f49d1932fd88e78aff9758a8fd717c71325d909577dd9a03225","s":"0x307b2bbf55e4c2213c1d176ce6b6d8acd872427c935cc7eecf883d664300e7f6"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5c1985191349f409bbf80c0518d5499b1c009ba6","gas":"0x3e9e5","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xe95ae7de6162a797461af5dd939fcae3c2e5299f12bed29924d41aa8d48a2a21","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000005c1985191349f409bbf80c0518d5499b1c009ba6000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c869110000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c8691100000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b4f762e93f180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4550000000000000000000000000000000000000000000000000000000000000000983fdb5acfd0d05f6da669d7a495501e651bc3a3391e54a4eaaacdf233695daf00000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b4f762e93f180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a19d000000000000000000000000000000000000000000000000000000006241dc772384d8932a9e908392decb605db610350a60d97dee53cc2c1f9ae757dc8388e10000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c63510e0f754b17c5f420c2316dbd239c2ef833a45836ccf8371d20afe898358f400bb5758f56f89c470e8fe5b9becfd567f2b379f78ed0c827ec93b9fe79888b63510e0f754b17c5f420c2316dbd239c2ef833a45836ccf8371d20afe898358f400bb5758f56f89c470e8fe5b9becfd567f2b379f78ed0c827ec93b9fe79888b000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000000000000000000000000000000000000000000000000000000000000000000005c1985191349f409bbf80c0518d5499b1c009ba60000000000000000000000007feb477600a03fd6ab1fe451cb3c7836a420f4ad00000000000000000000000000000000000000000000000000000000000006ba000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c8691100000000000000000000000000000000000000000000000000000000000000000000000000000000000000007feb477600a03fd6ab1fe451cb3c7836a420f4ad00000000000000000000000000000000000000000000000000000000000006ba000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x2f","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x4d","value":"0xb4f762e93f18000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9555cd3a766091ed54e71f9ce4b614c6fa401f11acc9eb36a396dd1d4a342f52","s":"0x626a73c8e1ed248593d130ed9bb39546c1bb6b79c4d120a18718f01ffb5edb1d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xcafe3e8d011f5c672683b64ef9f89f17692e7fbd","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x12237ed066","hash":"0xdd2ae8e09b0ebcbef97bdbef01f247e9012c4f374de4f9634c8553a62e939243","input":"0x","nonce":"0x1","to":"0xf77770a3e837c96f2be6b76fd71e8b3a3915264d","transactionIndex":"0x4e","value":"0x378f4dad30db000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x2c0b2a2922a39ab17f31c4824e05deeffdaf9acefd190cafe8869c0dcbb88d82","s":"0x687d8dde9099ead0c8bef5618fa58dfd10b3a9d2802ce80b543e3f90b2b6660b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7af0c539bad46e7ff84be8e11cd5e5bffbf191d3","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xeaba7e1f3d4a3d3ae4277c24b82d8cf671f550255a7d27c700a162a915ed2b31","input":"0x","nonce":"0x47e","to":"0x90e24d68e572900987b10865304cd0f63ba1c771","transactionIndex":"0x4f","value":"0x58d15e176280000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x6b3656926ae25a916558653eacaa3bf8d2ddd70675be444fd9ae0e966cb39d7d","s":"0x785112173d55864d5e8ba354a2a5d3ebe0ee67f1b9c09daab81a86f8396a1d86"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xcc8dd60a8cd21205d077a6feb43c9bc9ed70fa18","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x8074a55c289a95025e8abd7ffe0d4e2fa60e1602399f2ac5df68084e6485d6e8","input":"0x","nonce":"0x187","to":"0xfaa44ac43cd87c2a4393c3204f87a2f1ca10e5d0","transactionIndex":"0x50","value":"0x3e2c284391c0000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xa030bb8081db4109ae3467a090b7936db1c47b0b0f0efa7eb366aa36c3bf6dba","s":"0x62de097ab97c7bbc53df5b138cea7cf34351594f6e8f6d170bd21d064bed39b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x8cd84d9c2e76a0c6f48732f8e0e2508430ae6e28","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xc33fb1daa43c0e214c618fbbb7e8704c623ec1c73b58affe32793631fa353c5b","input":"0x","nonce":"0x27","to":"0x04c56c70d9fb6ee29def6c48f729a1eeaaf95f5c","transactionIndex":"0x51","value":"0x16905bfa669d3c8","type":"0x2","chainId":"0x1","v":"0x1","r":"0x3ae865ff3d600dbfaa674ba2ec20d9718f39fe4a1fc45e58a00d7df5d9dfc976","s":"0x2b70f8099b69286acdb929d2fc91f2f4024591e9fccc8950a057b29be25db85a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1f7c8e627cd8b378a1872a43cd47e92646c381b5","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x8028c301167b01bcf17fba2e7e444bf5e738fc45f62e9433ef74ec5af7cbf8d5","input":"0x","nonce":"0x3","to":"0x9b34753a1342fd26234f9dacd147138ece981740","transactionIndex":"0x52","value":"0x192abebf7366b2","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8e1ba2060891ba4ca074d28b7b1b650229eb4f735ee9aa5d47f333915c2d5e55","s":"0x2c60182077a73cc872c33ab93ad462db23408cb87742e729934642304f1a13fa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7306fd26ee946209539b16a0fb42aa6b017494e1","gas":"0x1839a","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x2b5d7d364fcdcb8a39002ea7c055854548c496ada57868615811b2c9af25a8bd","input":"0xa9059cbb0000000000000000000000008821c46cc0ab31cab33824b2b31a361109dd086f000000000000000000000000000000000000000000000000000000007d2b7500","nonce":"0x1f","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x53","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x75cf87e9b5e3391cc149610a5300eae40b03f5bea6bdbbc93d8008ce10b3e310","s":"0x7ab33dcfb94f5beb6996d03b2239bd487af944ade40f53e230b258734746c7cf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x28fb2fd8b6a3de87aa6536e717375a65a94a21a5","gas":"0xafee","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xddd0dc4fd1276d4e474a469741c75f0d3f0ab7f5c0101e7dc76d62a310e5d560","input":"0xd0e30db0","nonce":"0xa","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x54","value":"0x1720d4b1c0931ec","type":"0x2","chainId":"0x1","v":"0x1","r":"0x4d424732d5b6481e3ae6275e0ede66a7c6f8adf5e3f5743780cb2aba8c1ff46c","s":"0x559e86042ef1ae76c25f94f8104df54f7d24fadcecae2d9213af6391b76808dc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6f55fa1aad4d453d1424a37a852c2892457e209c","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x6bac38996fbea54019a80706686ce568aabb053b5c27b56915c318670c297c9c","input":"0x","nonce":"0x35","to":"0x3adf2ce2f1dca86d6c843f3d8bdafcac441d5c21","transactionIndex":"0x55","value":"0x3e73362871420000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x6f03ac9ec84c2a77296cd8683dae5474a3152528de4de48dc22e1058f5ea0dd4","s":"0x4714c0845a52cdf7ac5ee872fba445f715047753eda30cfd1d6621374c4dfede"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x068c0b630fddb7e5d1e3dc0d5a0fd3ee64e88e51","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x12237ed066","hash":"0x7c5a2ae63925b2a6467f1a709bece1b9d4941381e1d22fbf48fb58504f0421c8","input":"0x","nonce":"0x120","to":"0x8c1685de0f6772cada26f4a89298c4bf76a1d97a","transactionIndex":"0x56","value":"0x14d1120d7b160000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xcef74414d3a315f36321a72e7dc93c2f1d3469174fec198a1755f37957d77a57","s":"0x47bc4ce2abb83cc0163d65837777287408b4744c0db6d556689e3ef5263f7ebc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x93199f6930809b90e9454bdeb52b1a967a8eb563","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xbd1e4bd653befeb1dcae408f0738d889f474f9d1565669acbab529db39ba0cb5","input":"0x","nonce":"0xb3","to":"0xad4cc9fbc767249c37451afe588d69ff41f71752","transactionIndex":"0x57","value":"0x6f05b59d3b20000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xac87e7d76611f1568834d010aa6aa184749b871b5cabbec1c44e4eedcd5eca85","s":"0x5e58a25abe9082096aa2120ccface5f9ff656017888032192178b02374174ddc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x27a42","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0xd041c9d905645e904f4291c6425283b93bc1213e53ab1318193072307ee99728","input":"0xfb90b3200000000000000000000000008d1f2ebfaccf1136db76fdd1b86f1dede2d2385200000000000000000000000000000000000000000000000000000000000e5cc5","nonce":"0x1a49a0","to":"0xffa397285ce46fb78c588a9e993286aac68c37cd","transactionIndex":"0x58","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1a041757e8f947d6ae358a28109f1e35e7dfa2823b93dae4ec789670c59f8ee0","s":"0xbbddd3925cc308cb3d4c347a44d49e8cc95d215b63903053a07af9dad671de7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x30b4a5477314e3fbd0c22d6afcd71eecf4d9d22f","gas":"0x2c5de","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xd3891003372d2d2fd611e8c18348b9f14c9532b77e724fc20592ccd070223cf0","input":"0xac9650d800000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001800000000000000000000000000000000000000000000000000000000000000104414bf3890000000000000000000000003845badade8e6dff049820680d1f14bd3903a5d0000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000000000000000000000000000000000000bb80000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238ab9000000000000000000000000000000000000000000000006c6b935b8bbd4000000000000000000000000000000000000000000000000000001cedea6da61c7ef1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004449404b7c0000000000000000000000000000000000000000000000001cedea6da61c7ef100000000000000000000000030b4a5477314e3fbd0c22d6afcd71eecf4d9d22f00000000000000000000000000000000000000000000000000000000","nonce":"0x46f","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x59","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x7cd59c10aa586d8c91266375d00143e76043055c8fee8d157a075131359fc2b0","s":"0x6ce0461f7222e17a281153dfa4386f252ac8b200d1c3ebb27a79ec76d2623348"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0x566c748f365028dd8f2e81431bc6433107bf0381d5aee885190238359b0c47a6","input":"0x2da03409000000000000000000000000c858a0d4d8f45e44837ab5a1059e527ff7656e89000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a49a1","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x5a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5831a562021023f173da0b389100a19453f54f3b17f75fd1bc47cd42e5641e23","s":"0x6cf87049d688cfb6205e217b806a11d1d4c0b368de762254caf99392c3680996"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0x2d57a3d4f9cfdebd492144d1ac5ef09f9fade9369350c7c9fe5766a17db23789","input":"0x2da03409000000000000000000000000b495496f926c70c8d28d6aba79f7bbd5c6f43ea6000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a49a2","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x5b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x4b5c0607ec17e19b3f19b0b443de1d787f9f00c62c742c02ce473afe067a639b","s":"0x6956552e21b2e28de42deee24890a1e6d5fff2532e5aebd1941faa4ebe45cd1c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x92e36bfa5654c996beff0ea59a0eacea4afd8c5b","gas":"0x250b2","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x34e8b0cc9a6715cbbd509beeae9822df497e1d74186cfdb07a1ab00eabb01849","input":"0x9ee679e800000000000000000000000000000000000000000000003831972a7bd66a2e70","nonce":"0x40","to":"0x65f7ba4ec257af7c55fd5854e5f6356bbd0fb8ec","transactionIndex":"0x5c","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x90d13bb82430c0fb2b4264e9d64cc36378a95f5d38080799dadf8b8c4b7f2194","s":"0x3ea3856475586804237ac536bbe1700ac5e69809524e4274e471579e41fa46c2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe8bad8e905fc748517cfb1b3f7d6a7d7b4ed3998","gas":"0xc7c6","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x7635603af13067c6715ffeba53cb7f6175246aafd8753dfb63cda4d54c6c3f7f","input":"0x215619350000000000000000000000008eb24307a8969650bf10b211008b8324ba3d65050000000000000000000000000000000000000000000000000000000000000002","nonce":"0x1d","to":"0xcda72070e455bb31c7690a170224ce43623d0b6f","transactionIndex":"0x5d","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x13b4a009e177428778dbc65d80dad60498338c11ca5c63a8dda9e57976d89e4e","s":"0x7171c515dd1f54255fb997498f7188f81055532196abc3e6577f01c2b59bc7a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x901f4ba839381b8080917c616796f4fe3e1b84d3","gas":"0x12cae","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x97a1182cd4e6ad38a1f95f4c068e5614a5b52102a3153534e3d4ea6cc35b3f45","input":"0xa9059cbb000000000000000000000000367dd1b604553b3d4859d060434d53b7f74e20c600000000000000000000000000000000000000000001d8bb438d667d6e58bff3","nonce":"0xef7","to":"0x090185f2135308bad17527004364ebcc2d37e5f6","transactionIndex":"0x5e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x30a247439a7b821721547871652ca7c9947c98ba5ab06493c8985bcd04992d69","s":"0xf189fbc6f4ae3134f958cfc40e83e252b6e49fdbcbca0765beee4f873eb273"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x66df76c2c2bf40c6f40195b67f34de880fae6b59","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xa1426b806bfb648e118958b27c1b18b7302840c355219ca74dca5a04b15c80e1","input":"0x","nonce":"0xc","to":"0x7bf8790509700b67f051d5f83eae759337ddf60e","transactionIndex":"0x5f","value":"0xde0b6b3a7640000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xd0762b118a91ab0da307e4f9edfaebf1aed594ee53ce6319734e884a0683f94b","s":"0x5788567e1c61a573a0a1f8b4adc519db1213c72ba0ffeef27117afa20a319d0"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x80488154a508b0642670af4961a35588d2a33343","gas":"0xcbfd","gasPrice":"0xbf7ce27f2","maxPriorityFeePerGas":"0x4a817c80","maxFeePerGas":"0x1378da3c99","hash":"0x864d63323fd8621334206317f63fbd25d2269ba23429fa5e7dcb88dc80d49854","input":"0xa9059cbb00000000000000000000000042e9632ba96ea96ccede077f56185121459ce20b0000000000000000000000000000000000000000000000001fc2f56971d846f7","nonce":"0x8","to":"0x58b6a8a3302369daec383334672404ee733ab239","transactionIndex":"0x60","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x6e7280d975846b418514841c58730b2b430c5beb301a10c653bd0b442e056518","s":"0x3c5e49d550f6348c8900602d2537170efa91ff0475cf7f9ff88b5d9c8e2f75ab"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x92ad90f0f2e208df7fae055b7fe2f4e20a21e688","gas":"0x1e204","gasPrice":"0xbf5622ef2","maxPriorityFeePerGas":"0x48158380","maxFeePerGas":"0xc8fa023a5","hash":"0x323b7536f93929efff5ff956be89dc1413f8dd3e40bf93b52cb6d25e311142bd","input":"0x42842e0e00000000000000000000000092ad90f0f2e208df7fae055b7fe2f4e20a21e688000000000000000000000000c0d40ed9ebe45f52b485e037a675d1f6a745b5da0000000000000000000000000000000000000000000000000000000000000e46","nonce":"0x213","to":"0xe8f86613c0ae8edbcd540c8b9f9b3d33f0911cb2","transactionIndex":"0x61","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x56a9e2e4a4b8418997672f6096d305acb26e91f008ab60a0720a3f0a7dbc33d7","s":"0x32ce2ab0abb36ba9642fdc2baaae844c5b1a757d3cf219810a670acdfafbef98"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x70ff25c512a3cf8e53af58e2ca9eea727b3fcccb","gas":"0x424ab","gasPrice":"0xbece44592","maxPriorityFeePerGas":"0x3f979a20","maxFeePerGas":"0xd4941198c","hash":"0xdc34136baef97744e63ba4f679d05b4c77f162816945dc7fdcc124e2a21ae0bd","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e500000000000000000000000070ff25c512a3cf8e53af58e2ca9eea727b3fcccb0000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ea11e32ad500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4440000000000000000000000000000000000000000000000000000000000000000542f3ec30bbeff2a45e44977d9dfec450f0d0d2a8f948fc3a5f8a5f36cb2e2b900000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ea11e32ad500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237605600000000000000000000000000000000000000000000000000000000623ceef26527dd7221b16afd1ad877f15a85280d5a52085598e694bd755fe5cd983cc5300000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007e000000000000000000000000000000000000000000000000000000000000009200000000000000000000000000000000000000000000000000000000000000a600000000000000000000000000000000000000000000000000000000000000ba00000000000000000000000000000000000000000000000000000000000000ba0000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b8d15b04288edb25c40fec94d806db5b1bbb2e74cd89df508be8934ba023a486428e2ff83d82e43f732ef2bea3f07663a93724646604ae871bdb99c38e4ade8258d15b04288edb25c40fec94d806db5b1bbb2e74cd89df508be8934ba023a486428e2ff83d82e43f732ef2bea3f07663a93724646604ae871bdb99c38e4ade8250000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010496809f90000000000000000000000000000000000000000000000000000000000000000000000000000000000000000070ff25c512a3cf8e53af58e2ca9eea727b3fcccb000000000000000000000000495f947276749ce646f68ac8c248420045cb7b5e2316214b077a5f29a95248d3f63074ce0a61e8ed0000000000012600000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010496809f900000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000495f947276749ce646f68ac8c248420045cb7b5e2316214b077a5f29a95248d3f63074ce0a61e8ed0000000000012600000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000104000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x15","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x62","value":"0x2ea11e32ad50000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9209db11fbda1befa716076b736a0c905d32ec80a74a14e00151129b9644b4e9","s":"0x2ffe78bf95f149babb053b0acfb7bba0d3a98ab678a19f9f7cde59a48597b2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa29148c2a656e5ddc68acb95626d6b64a1131c06","gas":"0x19a28","gasPrice":"0xbeb248ff6","hash":"0x6745c68072d16d6ea232b8d5e291736ebd6ba2f167f45c4fd13ff747682042a3","input":"0x","nonce":"0xf7fd","to":"0x0816d200c779fefec677753fb53b93a1c53c44f3","transactionIndex":"0x63","value":"0x47b86c9e6738000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x9200a0c2c5a3059868eea5afadae08114f49ad5e995deab49df6c128586bc75d","s":"0x7a2913d3ae2142972753655fd297da675ede636cbb7b834b21bb24a680f31b91"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf598b81ef8c7b52a7f2a89253436e72ec6dc871f","gas":"0x19a28","gasPrice":"0xbeb248ff6","hash":"0xfa62b4e94bb052e960fbf3a7ac4a19f44a0772888c7529fbfe8e0397b3b43542","input":"0x","nonce":"0x18e63","to":"0x5b66a38f29db71b90a4bfa495ee3fdc0e976ec4c","transactionIndex":"0x64","value":"0x5dd7732c63310000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x187f3436cbc13aa7fa4e743b12e58b8fd63327b3b391e2d508aa9e7a9581183d","s":"0x5b8dceaa8afd1f6f70484e10ec7b6ffa2b5192e332dabb5c2396c6df5c7d1de0"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe22619f6d538de6e0b6c1845174d45e90cbd3576","gas":"0x5208","gasPrice":"0xbe9eadbb2","maxPriorityFeePerGas":"0x3c9e3040","maxFeePerGas":"0xd7025908a","hash":"0x68ada9a8209368650560fb9745d2cdd0524375ca58862cd0116fc6333b492a71","input":"0x","nonce":"0x535f","to":"0x0e8ee0f87d317f45f4aba48532bba908cc625a90","transactionIndex":"0x65","value":"0x5d0d56f736c00","type":"0x2","chainId":"0x1","v":"0x0","r":"0x305d359eb1d904f27e0646c843d3a37d18e94019fcdb0dc97a43bff6c90a01c6","s":"0x3a784710d5333f4690151aa5fea364710c74dde2eb92020123fd51d432f7dece"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe9b45883f5942511284e4a48a0b0c09e29dd3c4b","gas":"0x13012","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1043034b7b","hash":"0xa9f334cc57045f75e738075fae951643ae89905cb29f9603e7b953a45f6d1ea1","input":"0xa9059cbb000000000000000000000000ebdbd959f587aef05b42c6ae0fcb43a25c19a21f000000000000000000000000000000000000000009b18ab5df7180b6b8000000","nonce":"0x8","to":"0x95ad61b0a150d79219dcf64e1e6cc01f0b64c4ce","transactionIndex":"0x66","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xf154142f508cf1e3edc331daf9306f069ec3615e179d79837da5f6e0d24a5849","s":"0x600ab587d925f05ccf742e8792221d0da9e0550bc7359a1c599a06af1f7afa7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x8c231e3ba0b31bdd6ba2596d44bda7f0f214f43b","gas":"0x2eec6","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1043034b7b","hash":"0x03b752802615995d378e6f59f081e7930d69d3445e78853158e79af8553380f0","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e442712a670000000000000000000000000000000000000000000069e10de76676d080000000000000000000000000000000000000000000000000000001faeca95583b75500000000000000000000000000000000000000000000000000000000000000800000000000000000000000008c231e3ba0b31bdd6ba2596d44bda7f0f214f43b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cdca1b81dbb543baa92c97b701396cd3ba315e5d00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x7","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x67","value":"0x1faeca95583b755","type":"0x2","chainId":"0x1","v":"0x1","r":"0xee15eef0e4485b6fea1b0075f6736560eb37d9cea206285de7c1b56b4b30900b","s":"0x294277bf1a282410cdc0050ea1a9fa14fbe7003817c260b8a12608bc51a46cfe"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x4c9af439b1a6761b8e549d8d226a468a6b2803a8","gas":"0x1d4c0","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1567991dae","hash":"0x65e601dcc49c935b7ee2d51d2d912a38b138ffa1fdbe3ebf1f9da8b452185d41","input":"0xa9059cbb0000000000000000000000006794cf1e22d181a81d7c1ebb064573120adba8470000000000000000000000000000000000000000000000000000000012e7ef70","nonce":"0x771c","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x68","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x176480108c52c95ba4c219d114b012b8ca28cd10ab58b3bbeb94af7ede615811","s":"0x6b8bd6ed47f14d128197d47015980d52cb68f8ec9808d2bf8698d67f665847f9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa815482080ae792e00d103246fe874c905811054","gas":"0xcaa2","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x114f878de6","hash":"0x3c3be46ea96ddf0e98f4d8a386449e6a2b4e49e3b27056697ed3766473df7695","input":"0x095ea7b3000000000000000000000000d83f623a0a77e09dfc586b831012118bdf3b414100000000000000000000000000000000000004ee2d6d415b85acef80ffffffff","nonce":"0x3","to":"0x6c936d4ae98e6d2172db18c16c4b601c99918ee6","transactionIndex":"0x69","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x6ca1d9806d162beb887efaefbde4bc017f25a69d754480b281f7132151cd49e1","s":"0x43630428ccf55900a46eadc6835391c1705d35fd8053d3a6dc91e32921795daa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb9a192e14dbbeb31ce825b40db568e098ee5e9c","gas":"0x5208","gasPrice":"0xbe1c56e78","hash":"0xaf962411d291ffeae3e5755cdae186d16582c1ccda61389e9a0f06eb992aef15","input":"0x","nonce":"0x6","to":"0x0392b64b8bfda184f0a72ce37d73dc7df978c4f7","transactionIndex":"0x6a","value":"0x1c81974ed5ad25c40","type":"0x0","chainId":"0x1","v":"0x26","r":"0x1e8bd99b91c79f4648b1ddd444bf5aa13c1fe89f9408d843131e875feb15566e","s":"0x3541c2379ba7e1079a850d7dc4c26975cc23603c0171696b5682de653a801b41"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6761d6684c550aaaaf9eaa410b8e24bb86ca7669","gas":"0x124f8","gasPrice":"0xbdfd63e00","hash":"0x7d791dc4008467fdf6c0365968d132a7d816897db0acf4d8aa5fb521b3b10990","input":"0xa9059cbb0000000000000000000000001d038448e82743b7894ae5ff88ed789b6851163e0000000000000000000000000000000000000000000000000000000023c34600","nonce":"0x53","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x6b","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf03bdf1f1205957c9d209aa8ab28d167774f2bdf811e76ebc1fbd5efd64c997c","s":"0x33da7d06257a951b785e04075dd98e941bfe4b2ea2db87d5848ca506f6478955"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xebb6238c7d8f38451c60b09eafd6228b07549129","gas":"0x18462","gasPrice":"0xbdfd63e00","hash":"0x4798ae3d0f7b20c384a9b5522a269aabf51e78706d5192daadd0303cfeff64e5","input":"0x1801fbe57034dcc5c238c3bf191e027108e25ffc837e195f7623fe5b95c4b892b264566200000009dba4adbd01be0c031774b4d33b03412768f9ce16b3cec4e3d737c432","nonce":"0x13","to":"0xd9506121d67fb918ac47af0b883730694be9377c","transactionIndex":"0x6c","value":"0x0","type":"0x0","chainId":"0x0","v":"0x1b","r":"0x652c6dc75ac93344cfce240ef19378f25119dee15a1dfd62eed673f1d8708e39","s":"0x6b74fb86b92f0c68ecea485926f52e6ec4fb215c73fbc37f59b5095aa9e89376"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3598fdaf00cfcebe3a23402a71bbb310d4ebeed8","gas":"0x50d17","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0xbdf94f712","hash":"0x04af2fbc454a6d8aff0cd64e3f676975ca08337c5ef6c36c77c799e1b2bc1fe4","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000003598fdaf00cfcebe3a23402a71bbb310d4ebeed8000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f400000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003520000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000214e8348c4f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a3b600000000000000000000000000000000000000000000000000000000000000006048cce0d9f655494cfce4da704ce99d3964ef3bc3e0ea35bac5b2ea7da034ca00000000000000000000000000000000000000000000000000000000000003520000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000214e8348c4f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237ce7b00000000000000000000000000000000000000000000000000000000623bc34f16f2855bdb4204400974de828c0d0cc2c6f6eedf8be94e66981b3391af223a050000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cc364593b7ef1b8a74799db5cd2ccd9c38024cc50fc58262d161543d3790eb5520625e6ea7d01c6e825fb61bd047477dc78e14bcb602b5dfa804d7364305e7cf9c364593b7ef1b8a74799db5cd2ccd9c38024cc50fc58262d161543d3790eb5520625e6ea7d01c6e825fb61bd047477dc78e14bcb602b5dfa804d7364305e7cf9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000000000000000000000000000000000000000000000000000000000000000000003598fdaf00cfcebe3a23402a71bbb310d4ebeed800000000000000000000000073883743dd9894bd2d43e975465b50df8d3af3b20000000000000000000000000000000000000000000000000000000000000e17000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000073883743dd9894bd2d43e975465b50df8d3af3b20000000000000000000000000000000000000000000000000000000000000e17000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x4e","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x6d","value":"0x214e8348c4f0000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x7b6bf6ac06f5b37f07c9bc5531e22058ee4e2ffb054e83a0838da6949234b84e","s":"0x2d7c9b4d4b74b6bbca68fd327202366af760e833d014cfd8f147eeafc52cac9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xec2b0deb76b6c78a9773425ca2d903f1476bb51f","gas":"0x5208","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0xbdf94f712","maxFeePerGas":"0xbdf94f712","hash":"0x6f70c9fe1f29e1baccc9d36a3b76c4bfc213041a75e5896502898a74969324f5","input":"0x","nonce":"0x15","to":"0x4103c267fba03a1df4fe84bc28092d629fa3f422","transactionIndex":"0x6e","value":"0x38d7ea4c68000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3cb3d453060baa5c1c4ed28c484949e6f0fe21b62674103ce8b554424b4fcaa5","s":"0x46cc14be8e84c882f3232b5108e80afa52b2fb94b0b6786a70ae4ed773eef898"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd84095ef707152d8e3f86697ecd640a551e86d69","gas":"0x10353","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0xbdf94f712","hash":"0xf0defdd7ae5a9450c74f0a51d8589767513965fdb898e916d38ab70737c9be3d","input":"0x42842e0e000000000000000000000000d84095ef707152d8e3f86697ecd640a551e86d69000000000000000000000000d4b52502ffab8174c5e0440e366b7d3b542886bb0000000000000000000000000000000000000000000000000000000000001534","nonce":"0x73","to":"0x6110cda0fa3e327b05727af9763cec44d39b5805","transactionIndex":"0x6f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xb5fa7948ee6ceb66ba5911a55a1298d6375652644b77360447a9fd66d2c967ef","s":"0x4d2200c0deffabb59eb95b69df2f640616f2ead39e84a3257a8bb3e066cc0456"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd4dcd2459bb78d7a645aa7e196857d421b10d93f","gas":"0x3d090","gasPrice":"0xbcbd1e4fa","hash":"0x33ba5c9086f4b6c6ea62c6cab6f7177caa383218f69ed11696ca7034f93ab395","input":"0x","nonce":"0x1ebf9","to":"0xabb9eac45b58ca19751ec6e4feebd40d9f8e288e","transactionIndex":"0x70","value":"0x1cc85402f0290000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x8977c965cdf77d6b396508f3ad8cf9d2513439288a77759d4cc45edff2690ce0","s":"0x6f6607af89029ef56930a8e60ea6a0e31f32eb3d4e795a3da8131522151e252f"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x007174732705604bbbf77038332dc52fd5a5000c","gas":"0x249f0","gasPrice":"0xbb13d4b93","hash":"0x6e5c9dc0440d3bfce3224eefd25f68c87dbe8f3369d0c4bba6b078165c5a17b6","input":"0xa9059cbb000000000000000000000000dd8244cc042a37e9907687a598761e8039816a20000000000000000000000000000000000000000000000000000000003439645c","nonce":"0x15f66","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x71","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x3223ad301c63675e03f0895a0785b369030dd8f74804193b4f8650eff16c498c","s":"0x6873fa7fab0da59c460f3ad42cd468571809acc3383e1644414596a80cbbe371"}],"transactionsRoot":"0x526a2cb1c6bd30a154d3eae233621500cdffc6baf4f910ea003ff8ec79a42719","uncles":[]}`),
+			expected: byteslice("0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a"),
+		},
+		{
+			// Mainnet block 18189758, with transactions omitted.
+			name:     "Shanghai",
+			input:    []byte(`{"baseFeePerGas":"0x1f1106c84","difficulty":"0x0","extraData":"0x546974616e2028746974616e6275696c6465722e78797a29","gasLimit":"0x1c95111","gasUsed":"0x9e0380","hash":"0x802acf5c350f4252e31d83c431fcb259470250fa0edf49e8391cfee014239820","logsBloom":"0xdaa17125c458582c508070b48993d338a9aaab4f0f902129981d200a8110108262b67dd54282243420d2138b013505390a9333083f917cc0d660958ab12ea300e013a1dc040bdc18890f7a19d95a80e43e8326e289c79c880ddaecc69e62a0c019087924d209c18730c210b24c265c0f02974088880844b29754921a52793855874822d02a468aa0114dc4c84a230c96600e6485ed1d8c8eee6900ce14d8166d82a0f0c14aac2042e10600e851d68c31260a0ea844b32833244d056711105941c7c1129239c51d395142886aac98f20748382938044ea6534a04513a42303063a83eb1960b326db1c3a7609a8881c801aaa09a9b5b0038f3806bbd475f971c43","miner":"0x4838b106fce9647bdf1e7877bf73ce8b0bad5f97","mixHash":"0xf25f7763261cdf5ba7a89b400998a1403f12dde232c5d9ed85caeac1f30974b2","nonce":"0x0000000000000000","number":"0x1158dbe","parentHash":"0xf08c1d3dd9cc49d708e89dfe8543dead59bda12ebc714c9df0a5902259dd4fb4","receiptsRoot":"0x4e30ab0d1b712b4b4b93864f956287dfcd688f3c077dd356d1b78b6d316d1622","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","stateRoot":"0x7a4d9731f6fbcb9135225b82edb9418b8bf9407957a524cd3d3f0e60dd520974","timestamp":"0x650d3b4b","transactionsRoot":"0x1d7757cb83f4a319a23490400ddca36c92685217b4d98c6b86a6fe8929cc8ed7","uncles":[],"withdrawals":[{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf44632","index":"0x119eee1","validatorIndex":"0xadcb2"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf39777","index":"0x119eee2","validatorIndex":"0xadcb3"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0x356296a","index":"0x119eee3","validatorIndex":"0xadcb4"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf46d89","index":"0x119eee4","validatorIndex":"0xadcb5"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0x351ef67","index":"0x119eee5","validatorIndex":"0xadcb6"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf4e212","index":"0x119eee6","validatorIndex":"0xadcb7"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf5d01a","index":"0x119eee7","validatorIndex":"0xadcb8"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0x34a2d11","index":"0x119eee8","validatorIndex":"0xadcb9"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf4a97e","index":"0x119eee9","validatorIndex":"0xadcba"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf417fa","index":"0x119eeea","validatorIndex":"0xadcbb"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf3f71c","index":"0x119eeeb","validatorIndex":"0xadcbc"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf40187","index":"0x119eeec","validatorIndex":"0xadcbd"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf4c206","index":"0x119eeed","validatorIndex":"0xadcbe"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0x3416ed6","index":"0x119eeee","validatorIndex":"0xadcbf"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf5f63b","index":"0x119eeef","validatorIndex":"0xadcc0"},{"address":"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f","amount":"0xf60420","index":"0x119eef0","validatorIndex":"0xadcc1"}],"withdrawalsRoot":"0x2000a17ef6773049d73297ceffc1d2c67444c02b49681cd5101561af43454b14"}`),
+			expected: byteslice("0x802acf5c350f4252e31d83c431fcb259470250fa0edf49e8391cfee014239820"),
+		},
+		{
+			// newHeads notification for mainnet block 18189758.
+			name:     "ShanghaiHeader",
+			input:    []byte(`{"parentHash":"0xf08c1d3dd9cc49d708e89dfe8543dead59bda12ebc714c9df0a5902259dd4fb4","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x4838b106fce9647bdf1e7877bf73ce8b0bad5f97","stateRoot":"0x7a4d9731f6fbcb9135225b82edb9418b8bf9407957a524cd3d3f0e60dd520974","transactionsRoot":"0x1d7757cb83f4a319a23490400ddca36c92685217b4d98c6b86a6fe8929cc8ed7","receiptsRoot":"0x4e30ab0d1b712b4b4b93864f956287dfcd688f3c077dd356d1b78b6d316d1622","logsBloom":"0xdaa17125c458582c508070b48993d338a9aaab4f0f902129981d200a8110108262b67dd54282243420d2138b013505390a9333083f917cc0d660958ab12ea300e013a1dc040bdc18890f7a19d95a80e43e8326e289c79c880ddaecc69e62a0c019087924d209c18730c210b24c265c0f02974088880844b29754921a52793855874822d02a468aa0114dc4c84a230c96600e6485ed1d8c8eee6900ce14d8166d82a0f0c14aac2042e10600e851d68c31260a0ea844b32833244d056711105941c7c1129239c51d395142886aac98f20748382938044ea6534a04513a42303063a83eb1960b326db1c3a7609a8881c801aaa09a9b5b0038f3806bbd475f971c43","difficulty":"0x0","number":"0x1158dbe","gasLimit":"0x1c95111","gasUsed":"0x9e0380","timestamp":"0x650d3b4b","extraData":"0x546974616e2028746974616e6275696c6465722e78797a29","mixHash":"0xf25f7763261cdf5ba7a89b400998a1403f12dde232c5d9ed85caeac1f30974b2","nonce":"0x0000000000000000","baseFeePerGas":"0x1f1106c84","withdrawalsRoot":"0x2000a17ef6773049d73297ceffc1d2c67444c02b49681cd5101561af43454b14","hash":"0x802acf5c350f4252e31d83c431fcb259470250fa0edf49e8391cfee014239820"}`),
+			expected: byteslice("0x802acf5c350f4252e31d83c431fcb259470250fa0edf49e8391cfee014239820"),
+		},
+		{
+			name:     "Cancun",
+			input:    []byte(`{"baseFeePerGas":"0x8","blobGasUsed":"0x40000","difficulty":"0x0","excessBlobGas":"0x0","extraData":"0x9a726574682f76302e312e302d616c7068612e31342f6c696e7578","gasLimit":"0x1c9c380","gasUsed":"0x5c490","hash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","miner":"0xf97e180c050e5ab072211ad2c213eb5aee4df134","mixHash":"0x5854ff07a305068660b271d7661297e9703aab3388a0f3789c6348eef7b42e61","nonce":"0x0000000000000000","number":"0x50936","parentBeaconBlockRoot":"0xde460880db8fe723aba6e3c5d13bf93860161c6f63a855c94bbb625af3cc6ebd","parentHash":"0x4bb5d8423fbdfe66d13db89bebc4097c2f74314b5329a53c894f36f5b5189a9b","receiptsRoot":"0xb4a350c5a8bf9e47b02683403ad5d8e1a85f2c965f953b626611af16a4dcd610","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xce9","stateRoot":"0xe2ee0557bb3f616b86824ac8f6b9c4237e194d82f540ec68053982f65796ece4","timestamp":"0x65a6d1c4","totalDifficulty":"0x1","transactions":[{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0x5b5ac3b470c818077522ceae91785831a5f679bd","gas":"0x5208","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0x4a817c800","hash":"0x6b70ce13ce9c2617501720379a69a2c6d7e4fc16e0cb04291139c9259c6b822b","input":"0x","nonce":"0x866d","to":"0x578c5b6ba9e9779ccb804f812e806f2d70c4d357","transactionIndex":"0x0","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a1f0ff46","blobVersionedHashes":["0x016316f61a259aa607096440fc3eeb90356e079be01975d2fb18347bd50df33c"],"v":"0x0","r":"0x508248224d127dbf19d52fa936bf1a3480e50881ddb395472b5220f159a1f2c8","s":"0x20956b74c990233464f812c08a9a7e81cb2221c03dcfd9044bed21ab43737438","yParity":"0x0"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0x8895b6ba745c49c65ca7b99c6d5c6ee92081467f","gas":"0x5208","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0x4a817c800","hash":"0x9c285167bb1ebc7197aa95db94468ad1961830c20e261107dada8092ba8483f6","input":"0x","nonce":"0x807b","to":"0x5b5ac3b470c818077522ceae91785831a5f679bd","transactionIndex":"0x1","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a1f0ff46","blobVersionedHashes":["0x018eb585430fcbddbe2429bc09f6b7ab2c6d718bd8ccf3761e0c65645f792187"],"v":"0x1","r":"0x10f677b15ee303bec9d575ce984b4b79628ed263f610eb9d2a2c4699915584cf","s":"0x47735f72b92c24b5a0337b3c87ae889cf08df39e9fea55686d1b4ddd1dc3a0f0","yParity":"0x1"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x99e77d72222248a03f774feab88a6ed0e05354cecded5b911fd397c1d6b0bc8d","input":"0x","nonce":"0xc1ee3b","to":"0x6177843db3138ae69679a54b95cf345ed759450d","transactionIndex":"0x2","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xa4281b9997834059259791ee7996f0324a5883498080493661620c6a4bffb2f5","s":"0x73b58281b0eee3e4f8c7434daa7d87e575f9d660df1c0c7bbca9ef3d93b606c0"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x3cc7160b4145e6a0f8de9c602f76e698975e144583083a134b60f9fdfbf1bef1","input":"0x","nonce":"0xc1ee3c","to":"0x687704db07e902e9a8b3754031d168d46e3d586e","transactionIndex":"0x3","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x1f7b093257d434b3fa47ed99401f452a83e329a642aef6f5b5945b7c24cfa67f","s":"0x6e1bd159c617b1185e1cae376ec7a4a6ec2c84a17dcb93ee75781581e4153a1"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xfc4d5492e0d07a473d1fadaead632efb81d57a53029a7442c1b6159f53abcb1b","input":"0x","nonce":"0xc1ee3d","to":"0x15e6a5a2e131dd5467fa1ff3acd104f45ee5940b","transactionIndex":"0x4","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x2ccf6bab4c7f23d00c63749969a8716c2d2bfe251317270f9a4dd62e50ea7658","s":"0x5c68a5fd273712f6212081605cfbf41dceb96b16bdf64c797a76bda7a78d9936"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xb2c702d67429ebe71c9d6c9208583397bfdb93785b595d813a2b25e8bc66ee1d","input":"0x","nonce":"0xc1ee3e","to":"0x80c4c7125967139acaa931ee984a9db4100e0f3b","transactionIndex":"0x5","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0x254e252cf1d006d1c924ef57c27fe4e991a293eb310747fa43aec3c4822d63b4","s":"0x43a93e01b9d0e9a69c33229b61f737d24d0b96dc3b929bf58bda42b7ea4abe33"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x4ab48d3a39cb641bd181588adeece1e9a8b193003cc2f6900a4694edbf3b8297","input":"0x","nonce":"0xc1ee3f","to":"0xd08a63244fcd28b0aec5075052cdce31ba04fead","transactionIndex":"0x6","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xb83343f15462152f022f7130c526d8ce03909978884ae4447b9bb1bea24fdf21","s":"0x7cbe615cb63b1a852c1d90f1802c39f7e4707cdd9d7e7ce4717055a23ae63702"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xf971694a14f080b67d689ef6d57e0a3a2c5a7cff3b5394186c20f70fe866fbaf","input":"0x","nonce":"0xc1ee40","to":"0x0b06ef8be65fcda88f2dbae5813480f997ee8e35","transactionIndex":"0x7","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xfb26a1c6ef40479f8bf62626719a660d71895b25eeb89fb0bc0078d261ee02e","s":"0x60bbc2d6112b6464528c58d7e2fa87360bf168699267fbb6d55e66ebaf41f246"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x6fde7e7e6013290df219715fbfa620a9953b81107d4ad8ac207d5a44c7328ce3","input":"0x","nonce":"0xc1ee41","to":"0x1cb96c5809da5977f99f69c11ee58bae5711c5f1","transactionIndex":"0x8","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xd45d991da32102416a896195136c3e1f6456c0d8b873c191b51a5873f6350b03","s":"0x782c2c6e2537f2fd16c6c13ab07bceaaf6e13fd0851bbdb71e82ccdd480eb8ca"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x52f3ff490d6a36e0b3f822362d7a5e6f3361bb902a51df9f2927f621089ae0b5","input":"0x","nonce":"0xc1ee42","to":"0x2aa48ee899410a6d97c01b0bb0eeaf1771cc435b","transactionIndex":"0x9","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xad1fb931de4ad80bd8d1d2f130920046798bf212f5409579bc9f30ace1ffe4b2","s":"0x7c7d6c210a4546d7443e0ab671c045d6efc6d81ca4e5d1f80d75f6b0958c1f61"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x2879ed1e8d03a202f933c0563c0c701a1144009162c7fe873a2b6d088a35ec6e","input":"0x","nonce":"0xc1ee43","to":"0x07b9d920dd8e8d83dc1125c94fc0b3cdcdf602fb","transactionIndex":"0xa","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x97fca44ab1dba1cbe023e38e110d061c4e450698108ff6251c9ca1d809769a0b","s":"0x30ce78cd9cc3b8802c051dccedacf35428f831cb71246cf50b8ddf34f5986979"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xc263f1fcc8b46592b173b592952f7a535cc6f719c87b8ec5b6b3ea449c502666","input":"0x","nonce":"0xc1ee44","to":"0xfcb6e353ad4f79245c7cb704abcffe2f48684241","transactionIndex":"0xb","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x9ff632c2d2dd08f2a2412ee3933911396213fdfabe65bed6f92542aafe8f9b98","s":"0x34853f43deec1b08dc881b1f37b12133e45268f0c10c8058125cb9a3f6fd014e"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xbaab8b9b916544d500513e0883324d74ad1a76ef84fefa5f191b680c044023d4","input":"0x","nonce":"0xc1ee45","to":"0x0d3de4256d6322683fdea9ee23765ccbfcb83da4","transactionIndex":"0xc","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xfd7ec43a058d6d815b3e34c2e07d8f219b5b9fb9f8961a07fa9c9fe82be50b63","s":"0x39a7dec81cad734418d68cdda073a0f5bbb52163d8a91441155b4e7a1579b51e"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xf5fb9ea53ff685840fa7d5f10a84b44749a5288e4fe4298ec125a22a86660c2c","input":"0x","nonce":"0xc1ee46","to":"0x6021752d8d9b2f221d4fea4349dea34ddbcfce50","transactionIndex":"0xd","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0x3e21a976a4edd517890d77022d1ed135eb2fe73eff0ad4996b31ab78adae1da9","s":"0x2dec4ea07c615741de4a08e796cfa15a84e3ba94e859d6a0aa393ba8f2d2fe18"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x5565a192c53ad35ea124fd41a8839af3ed330bc66ab9d5351485d67df470f31c","input":"0x","nonce":"0xc1ee47","to":"0x61e296d527edc89e831cf593ec341f16197eeafb","transactionIndex":"0xe","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xe9fa15e673507235ebc2817992ba1251bd67d7659056c1c520118e599758ef19","s":"0x4f31e57680d0c3dcfa2cbd6b32eb1481e208ff92a8b68165911652ee9f83a89b"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x4af9e61a90c884151104840f1c24ab274239aa16e96e4167f0d6d26d21c746ed","input":"0x","nonce":"0xc1ee48","to":"0xcf7317ee7a3b497ecf634b94bff60ff91b925747","transactionIndex":"0xf","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x724cf1dac7784b9cb927f6a33fb9e17c2887c5df5952bbb3e0450cd8b7a9ec90","s":"0x58193b4602a969e8998dfac699b333d34482bbf6db51ffceb00ff6e9f46f2b0a"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x3a519e94eb42e020d81559c4ae0b973709cb25e380343cded099864dfac14e64","input":"0x","nonce":"0xc1ee49","to":"0x7e7b519df31f77ced83eea1b16aedb6dcb0f0b24","transactionIndex":"0x10","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xf036f3ee37bf147610f1d6cd29270c24d6af1bf3d49174e3b016c5323a0150d0","s":"0x1fd0442746d1c71ab646e939a3523ef52ad77b7b1c58c08f4e0c403316566970"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x68983ab89ff46823610e2062d7f6e5cefafac2708b971c56da10762471a78125","input":"0x","nonce":"0xc1ee4a","to":"0x88a075e0fb1c9309a200a8bf0a88b214bf7ceb8d","transactionIndex":"0x11","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x79afb9855259cf6cea20c2f75f5981263734f54613acc37d98ff808f18daeda2","s":"0x12581878a1a62fb43e1f68364d4fb8c9c6b8a391e363b8d2369c91038b0780da"}],"transactionsRoot":"0xc4af8ac4bbfee0ecd6900efdec157be8df5505b8b0a108aa7b299f3b92538294","uncles":[],"withdrawals":[{"index":"0x443dd7","validatorIndex":"0xb00","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dd8","validatorIndex":"0xb04","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dd9","validatorIndex":"0xb08","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dda","validatorIndex":"0xb0c","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddb","validatorIndex":"0xb0d","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddc","validatorIndex":"0xb14","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddd","validatorIndex":"0xb1b","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dde","validatorIndex":"0xb1e","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddf","validatorIndex":"0xb20","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de0","validatorIndex":"0xa94","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de1","validatorIndex":"0xa95","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de2","validatorIndex":"0xaa2","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de3","validatorIndex":"0xaa5","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de4","validatorIndex":"0xaa8","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de5","validatorIndex":"0xab5","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de6","validatorIndex":"0xab6","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"}],"withdrawalsRoot":"0x68e83c7114fa8cd26a789927628a18985b83ed2c947ee8e6dbe46e8ad3259008"}`),
+			expected: byteslice("0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d"),
+		},
+		{
+			name:     "Prague",
+			input:    []byte(`{"baseFeePerGas":"0x8","blobGasUsed":"0x120000","difficulty":"0x0","excessBlobGas":"0x4140000","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0xa883d9","hash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","logsBloom":"0x00200000000000000000000080000000000000000000100000000000000000004000000000000000000000000000060000040000000010000000001000010000000000000000000010000008000000200000000000000000000000000000004000000000000000000010000000000000000000000000002000000010000000000000000000000000000000000000000000000001000000080000004000000000820000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000001000000400000000000000000000800000000000000000000000000000000000020000000000000000","miner":"0xf97e180c050e5ab072211ad2c213eb5aee4df134","mixHash":"0x46d5ce10b05421d79262c145843242090c1f7a55ef914876799ef1b09009a422","nonce":"0x0000000000000000","number":"0x57d8","parentBeaconBlockRoot":"0xcfaf54cc45943f1a1af776d6f815c7f069be2b7529c6cca4330d4979b9a0a7e8","parentHash":"0x288c8ddd13847b99d034d553f34ed061a1895a8a2ed9ad8423fcbff7752c5d11","receiptsRoot":"0xf5bdb2ec0950a0100fffd7480fceec712e0ff7c812ff85134f2325222cc73e15","requestsHash":"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xb31d","stateRoot":"0xddf65add46f097c6529129ac070f17a6858c0afe115dee292462ecdb622bff22","totalDifficulty":"0x0","timestamp":"0x67a564d0","transactions":[{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc7360b3b28cf4204268a8354dbec60720d155d2","gas":"0x249f0","gasPrice":"0x12a05f208","maxFeePerGas":"0x12a05f2000","maxPriorityFeePerGas":"0x12a05f200","hash":"0xd0941f23ed24d0f8658cdc073e84dae6fc31790835c2731d09bd8070861c0115","input":"0x0cc7326300000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000047d9073678e7bc5000000000000000000000000fdce481e976e548fb63dbba1490044c39fa68bac000000000000000000000000fc7360b3b28cf4204268a8354dbec60720d155d200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000fe03f4ce071cb8f8c405601777351c534cfd31ba0000000000000000000000009f2f86b887e060aec6464c8b2443b16a79863b29","nonce":"0x58c","to":"0x9fd1efb5b00accefc22d9f8e29e301cd4427153b","transactionIndex":"0x0","value":"0x0","type":"0x2","accessList":[],"chainId":"0x1a5887710","v":"0x0","r":"0x3da0950be7b5e06fc3b63fd5ee7e43ac8fd733b630165570e30be52db59e87d2","s":"0x7b8ecb94a4a6a84cae91290808c6e4a936cad032ac50fbb3566743033e769efe","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc278435313cc88059bf72b92952763e7fc511fb","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x83ca25c941a7f4687262a6b3204cf699bc0a2acefed262ec1162ba60810285e3","input":"0x","nonce":"0x124","to":"0x14389557e091c0fefa0686006faa1d7194692700","transactionIndex":"0x1","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb46862488912b8b80cf1b962dac36ca81f58f7fb32b5365729e96fb73e3048cd","s":"0x6783eadde8364bb04c9c758c0c4cb6481de01330d7a7114ff8a00227a65133c7"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x984984f1d9b288def2b0f977c8b4aef08833f3499965dee5c21489ec61eda76c","s":"0x3f3c408abe136b356b9d5508f9047b713bc121d84be25f242c89f1e295de0b32"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xf7ffeb622480c530b5d92367f62a00abf8db047949e2361f4637bd81552064a7","s":"0x4bbf3df5d502ee6143e039411c22d8496f1269b9934bee6c69c0c3fcc332cc7d"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5b054684e6172116f535263253d5374452cc8a7a86a08e48704d5ba3952545a","s":"0x7f647c6522cfa2933d0dc57504d74c330915df2a529b511da36dae7c3da3f637"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x9d33c2b63bd32037e3f3c87671d0d3517537a6ae4d26aeded6631d0db6e2749e","s":"0x2cd87b70912f79556822dd47f4745d996ca7ee849cf0fc4782ec983eeb5d0fd4"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xfe42e8d60c189aab22a6597c89e9048423b857a28c017850c2feb572ae66fcc1","s":"0x67bd96474b5473382efed233a690be0f62d37dc5be9abe9a556efc1930946edb"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x98324c3c17e459af076902307d3eec2899228ee8f8c7c6665a651893f9c8acc0","s":"0x36794cc01de004f32ef8ec52e2c43d93908c290750a831b1b0b481c1447e3cf"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x1af4918e6b1ad6abf73d955645f4594a10f20fd30f635e93f63e72d128792736","s":"0x360193f6e16c85f2d72e56a00ec0771201226c32ee7f61a5a561e07b6b5ba545"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x71a56816a47d905815a11df66e6294468a5ca2efe991ed7336221875351fec77","s":"0x43177fc4f34cf56b4149c49232cfb8590a468f5a1481bd80bfd6f9ffabb9b93c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x277635a8107ffdf815d3c1098bfc54656e59563643315ea019b0fe92e21518d7","s":"0x2daab5fc7c592d9b0b8989b6f5bf9ffff53f5be92d90f16f947db0859867bfa9"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xc17c007a7fd0cf3ee84318fe61c218cc927fc41a1413ab6f5f9582bb7321846b","s":"0x6b991503d0bdd91e8f779e4feea90600be262b0edd53de0a973294b661aef05"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb0de0b89b0715d1446c7bc070fb36149de5b2ceffde855b4f279324d5a1d03aa","s":"0x65c9251ada29b5ebdf9fe484095e887eb8d1bd33ab847984f0e9839b5485ca88"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x59a03174ef2b81c63933850f5404ac23f81ea9e9a526dca83bac49cd0d31b4b7","s":"0x78d58a49066e4dede7ba1bcb63fc866f3251e0b76fa39436708742b5bef8617c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x6096577b2600e966371ec2774bdb08377ebc1add92f25efe7b7ddcd994f58ca8","s":"0x35364e9d81d6ff588ad2fd13be54624e157f79a15ae8a42d940bd71c653221f2"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xe6e6672b6f979cf1efa844d3d1d7410e338720ef6c6948c240562d7a129ac76d","s":"0x30c6c8db55c5d7031ebd3da4f8774f2e3f4e6872d54de3c922ef6794f5ab445e"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xb93bdf34408a8daa1a733b2fa1b4f4fb2e8d1c89a329e1b611fd781aaa266222","s":"0x794d8f80c485dc36f28e056d6f0f549b2dcf5ce5420a54d3163736552b867671"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x6c0a05b20386534b0387590405c156eff063bb744f0a223fd733a5ef2c0bbdf4","s":"0x121196c37d4dff2aec8b39e98eccc14278e1603d86a38f31d4aa28aac0469add"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5208108df9d9dcbdde4771a4e552880519e89fdee7b6a2376a1a69b549eb9c0a","s":"0x103d3c4326a329a0a2023d1d21e01b3584619b3fb26a650ca16e0ad12d7dca0c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x10bf5e96b18d93fa08785058802b9ebd73da629257ae8a610d85f49ce67293d7","s":"0x6d7b18913b7887704af90bbba9a2578320e18ddf1eb158509147e7b6fccd50ea"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xbcf676b4d06a20998d60f199ebc5a658d6ecbb86fce2468c239b65d246e4e8ee","s":"0x177e0b39d98c7e6d8dc3927143714d52da8f5a0cc201ced9f5e7cc08751f58ab"}],"v":"0x0","r":"0xab23d457ab10e64e07d8f86745b8a3275696493a751a5aaeeb2ab97fb73f1a33","s":"0x7fe80b9a92f47c188c656345837e0f08b7002a931e1bd88a9a431df75a536921","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6b912a710ddaa0674f35482ccd0ac1fbcb827b102c2b40d8913611f547d1ac8f","input":"0x","nonce":"0x124","to":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","transactionIndex":"0x2","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x2fb0f500a26ff479d45f91901d9e4c3b709e4b137ed18ad8f46660e897596cbc","s":"0x515e5c1b9075f12b25d2b10bb51092e0659998ca32fdca7441d2612c7b355446"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x6dabb166f024ac6d303cbaede417360c8a094e5ea5950b4c0941efb1cf063bfb","s":"0x7d9910fe04727b3e21358885f17e64b59546f94de8f0fbe635e972a974465d82"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xee03fd40b8608865c83859ada692acbc31962c1db631c3b0dceff740ca9f80a2","s":"0x4ad25a9e85acb9794d8fd9825a0d36b978f372f0057da6c41412e7d31caef698"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x8f6fa3d4eb755f03bb664eeb04821c3dee602a197138e465c3a187e425202e8f","s":"0x2a80db1cf181b3ad80ae1e60aa770f2cc37baa790769afc776cff4905eaf58f"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x4a41d3482ad3ba541e3c1746c9cadf7dca3a9880bf54bf3c631015a1610e65f5","s":"0x6037c67b5571447b0cd2c4c20240374e534814883f5501b7a4ff253347d4a1d4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x90f07268c5623cbdf4a0955f2da64b3da7fce62391e9d0d5f793e6527213f626","s":"0x1145b1e36cb035daf63c2d2c139b68a9196d058e68ffccfa775698680e5b5d36"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xcab35aa41191cabcf343e0f17e356b1f24f2ab38522f582c2292d206328303ac","s":"0x22e4f1e4772fb614568872834766184e227df96d943995b15bf9860c030c1142"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x62ba9a793e35edd6023329f1685becc70445e6e0a0357d47d80fd20698b78c5a","s":"0x66e04dbcf3059f61a5a7554351cb46fd808cddb73087473a244d24a93991e23a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x762d7cde0dad47bbadcdc1b45b7fa7267fddc87384674242c57af69b856d23a2","s":"0x54f313e29893da99d2e889180b92bc9bac0ce9a23eed80b5a2810d4b28550a3a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x52b644194ebe373c6b015ff4ec6c6c2c06eb8380b9e1a53797e34d66e75a8941","s":"0x6941e9997ad2e7faae99b0d3836b76eef4092a3f0d93c12e92125b4ad2671d63"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x7c414d1ba25dace23e90c31152d8684f9a62f226d7c0c56eadd3d5de6b2e97c0","s":"0x19c2dd9e626f1ff33912c871c451d3619c572ca6c32694fa18cd3bb1dcdd9ec4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa464baeae4585545171839acb0b7790f477f709e0e3db73615667dda3b7e6895","s":"0x3033fd898f2003378f21a0f39c0135099171bd06692620890a91e445d803f153"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x989f8c2c93b8835252cc7178c1dbe399aa0aa24917b53ea2f08c0117acd6dedc","s":"0x286017ed0dd164d9ffeb48fdc4ef3157b6c5581e8b57ed409a5a75db2b6cf960"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x89ad23c01a8782d83237c6cdfcdce4834a6bddaee503295a9f11e04079d28276","s":"0x49dc77bcfe9cbff0a35eef1a4dc01d7a64f4a600db263250fe6592de2ac7c062"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xfc2af35a166eda16e917de8ca5d6f078a23c22471bd6585aa7c9baf8396e868f","s":"0x56b1ba14f27dcebf3df9c8b205141c3fc51d42cfe565e9ab57c5cd6de58984db"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xe38dedd1cb7701a28d1600a5ae800da0393bc794c961f63e02e1c57393dd7aa2","s":"0x52650e8492f039c7abd9f6b52c2c212c2e6cac3ebfb8165d3c67ad1be18458ee"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa4764f690f9073d956dc96f0297fca58abcaa8159769dcc4054c3c89dfdabfb6","s":"0x59e79b1ae93134cf083ba968e486f775c9a915153ae992d667fa6ca70b9e85fd"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xb4d133a5caf3105429669d4d6c8062c3275749f22bc3761e33154da60784d98d","s":"0x2c008f662e741e49d51bed591dfa712c8d6401c9dcc7708e0702ff822cf011e2"}],"v":"0x1","r":"0x861ca08ce34e3496853a9198f249594a12917c711d28623e45ff4327b1975ffa","s":"0x311738727aab3d3fe4d2ddbe3e66b3b97cfd4f70d9fbc22e227187d04625d478","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdde31aa5d18352412bc18514578e35d4f930330a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xde70e26cc76958d449439f20cf388e1563a92ced3d7a21ceedb0efc74acbe68e","input":"0x","nonce":"0x124","to":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","transactionIndex":"0x3","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0x6c218235fc2e2a56e8da0159ef8cce3ccef7211175358faf111d205473271e65","s":"0x44b9747b97fbc6318cfbc0fc92f037e8f0c2ac8f9f30bb8ef18b1208ff416e2f"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xf76f3408029b65a75f24817d6939089e4ed89bb58379df9e356153267cfe6243","s":"0x39641ced57869b44c873d2781116bff66839135aeaf4f05a9dc8c329a334d297"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xc3552a3f2eba4761ea5b4bebb592c61aa7f3d6e3d559ab229a7fb30751acdfe9","s":"0x47cc26afe7d02edbc81d5e4d62ae98b707bf2e7680ad7c609c707c593bd367ee"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xf49a4ed94c24adffe8e348804d1a82f069b11e9c4c02cbed0d5a0a0eb4c2a9de","s":"0x417ab689954888142c3d15b7bff1c583024c830f6e80f7e558c29ed95c399d0c"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xd4468d91e2db223d5f2c320227303f5774db257f1f45adee379b6151193c2535","s":"0x126cb8bf74832677730a7d9c6a22ba2cf87264b6b3ce21f10600a318fd5278c2"}],"v":"0x0","r":"0x188362980176a156196ee342b194cc47a92484bcbb70e70f1bb45ecbace2aca1","s":"0x236c1d4692713d8aa8a0ccb9e8e5b74456b6b2812ee4a2cc979bf66c5637c19c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xea9fb0c050f24a6b3d8ca9882012504da7fa89fe97b97d07f68cd8c5e3c8c03f","input":"0x","nonce":"0x124","to":"0xa297e3e19b9f0c10a353e31027c8812524866b17","transactionIndex":"0x4","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xe41e964a9d93f641fea6b59384e003433cf2d81f472a517598f439e931f2b550","s":"0x2d706a2470cda0098dc168ca90cdadf7f3f136015f57f1cc028ddc3b6b8c4566"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xd84a7c60987588cc72998c7899739f7c03bc782d19a09a574a461792ec045351","s":"0x6e23cf84b5d5aba745b9e6a3458102da35f565991757e16f74f31cff07a59bb2"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x7ba6ca4de1e290e6f9c2ed6d8cd22990b0eef85f0aec1d55a47075f17160ad12","s":"0x506516c08141b564e2613ed0027b211f4aea47372ddc8182f3e8cbade58b9df5"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xb578f9c04c87fc25139d95fa0f04eeb688a3f38945e25d9c542ab3024b5e0648","s":"0x743247c67c15a390fdbb19e8d048a6777a39c5ddb10a51771a42159cd1b3dc7c"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xab4fa6539aee07d55767ce4f5c13f3552ed4d8426ec7742501e984952e6c2548","s":"0x3416040964d0331367b677528ffa32a9b06e9313a416dd360156071776367125"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x322230c26d14a4fd0d25c86825c0dbeb35f14106ed3c40b90c7038311767be22","s":"0x5abef2e177fa4c3c89f12493a55b8a7f99ff73b105a3328cfd3b3acc09a1f19e"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x321ed70b45da33340940c33af3ed83cafc1f369983f2e9ce7c332a3772c4755e","s":"0xeeb3146f56c94c6a1350ae212c762e430934ceed3013cec0b3f01c44daaac04"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x42d50a79d86b3ad832f220c2e5c506db892169f13c5eb00b5994cc7b1b06aff","s":"0x2b73ac3f7df5b835a501ac9dc42723c2d187c7216a618b7bfbbb5d6ca46d8f83"}],"v":"0x0","r":"0xa049bdef669b6a623ad00b3eb7d23f534f7f22e86a7ae7d74faa30a481025a18","s":"0x74693d149983c4d3232aba8b468f2e59c737d69ee629de44ecfb61e85e404ff8","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x747c8db37b09fc1651bd2da905887b4dfd60ab43fcc6d443d836860f2ac22fd1","input":"0x","nonce":"0x124","to":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","transactionIndex":"0x5","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xd89128e7d4da948a804cfea969af6b66ea66778d0166f09afee1841641cce19","s":"0x1bf717534ac82a72dec75add16b7ba23661b16a4f1de80384dd62cfb02eff7fd"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x792755753a73515512c7cef3e4299130295bb7e19b524fda816a52314390efc7","s":"0x6140ed97a596937025f6a77f399fcc04cfd4655f62abcf97a04f74201e92890a"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x8882f26c24748ce509b9ba23950ffede09466ef9c981c332fa6938a8871c8d78","s":"0x8cd03ca8189b4b9c2cba9b2b29bd8b2968cc0f3e06fb13eebe077f3785b1f74"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xe69e367bf478ae784a8dca97518e7634da068b6e1a2b5c2f0b2487526c77970a","s":"0x521227f189b29418559f778949c9290177bcdbcb989197d6af4b9cd4dc3d2fba"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xc9a476e2a9a0450c5f5d71ffaef61a6a5bec7acc5883d2c059847c1374234234","s":"0x500e96554604212483df4a2aeb82b4c494711475c4a3d611abf09a5be217a4a5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x6f6e81faf5cc88cae893193e8f237b89c513983cde1c8e896f3eedf199aea426","s":"0x2db949a612d270b8634f10bb7ee2262f2bc98effe164912d431ab004e085428f"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x69d68a1167245246fc964d21aa9edbdf0c88f9faee91f7e516af2ae1a27bf69d","s":"0x5f7d7f0191166cdce2ba9dc646586051255c6d9c0b88ceb868160c7e2c3d1bda"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x78f316c4c823ed5caa1542345e7e3f877d8c58c0251f90fd3d82d852dbee78d8","s":"0x42e958ce75ea0ac80e17c0d5d888f2f9cc88b33d3c25b64e5d0ed2e9cb7e7751"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x8159cc6840cda3e7c8392ed5731cfddeef79317d2805cfed714cf191818f0894","s":"0x2e28ba90df19df298a76add7afa976e110336d0e91b8770a87efdb7f99ae55a9"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xaf014ae62b12f36888e1578d75cbeba5ddaa1dd0b179d748ebc11a135ca80c76","s":"0x18c1031265d12e3e4c74e5521771110872f7166870c6fd3fd6d7caa8a090dba5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xbce407f30ae5a667ac9d89a371189eee81d0e004852f4f8d456254ca7ccdad42","s":"0x342070cbb05653294208d852f3e69bed4b1fce129136bcf53bef83626fd4e05"}],"v":"0x1","r":"0x3da28822e8d87e08e5a8087d668975b9c2c2a512e3b28437a50fa621f0cee12e","s":"0xe7390d0ae737ec8836ededdaa0d332c8a9661abcd8e03c75f0f3a3fdb332926","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xf4873b4f63a61f54f6f0df95379191cce01c5b88cb02cc80d1686177be74f655","input":"0x","nonce":"0x124","to":"0xfc278435313cc88059bf72b92952763e7fc511fb","transactionIndex":"0x6","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x14580b1d14220ab37f47d1a2957e05333b625475091d4c60b8b60bc2b2cdb15f","s":"0x572a2bb7ec090f3a0bbe7304fe32724a984be1476477372c1670bdab11e13666"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd5ac931db7893cabb2bbf881c0186be1f77cd55aefc565bc849318f4570087f8","s":"0x1041dd9917e9f3de03131858311ff8c5ffa7efc8d6b8eb9e91c58f981be78218"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x478f0a9195c71be7354e637b7df8735fc29737a379fe622d1d7a90675610cdd3","s":"0x25d179d1838668f6b95e0753cbc0358a43d226abe193fca189400666edc66580"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x7d8f9ff3e6798ec2ec011e15eb74272f236646021e8c378f74c2113580d20b2c","s":"0x51933a7bc83b100b1be51ce28384f11348818f5f05c079f37ff0d9e9faecc50f"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xa93e97a75b1ba28336aa1894906084baf4ba33ab9ee740808bc6c39b8db9f411","s":"0x72d149a99d8207b3145382b177f3c201a2da0d5cd1efb9956c2b8e7d3248bc54"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x84c7f8d7260b7615c84d21463f19749c332cc015fb66b670ce7fb6fc41e05d4d","s":"0x76b887e8a5c05da9b7c2d235592d63656ff0cdc150ca7c2057742ed76cb30c7d"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xeba0993d1a5a5f7046799101c3bbf2ea7c2ff62d6186a79a1979f4a3dee1ef2a","s":"0x7788976a503bb0e3b3b4a0823773bc0c5e8a76ea693d9581243c6b27cb13cc63"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xb3486a9a3c30bb97bec2738518f5cc70149ec34bbe22f6ae57f92d08bc188cf6","s":"0x5a402ed50fe6b9c02074070f90663528cf1792e74cb6b9ca8c91c220eaa20c81"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xcb54fa13255b6fb876379ae6ac134b27d6038b75026a2281d7cf4f655791cabb","s":"0x637c0b429c5cdf3f5646076b7ab3de437df6d37cbfc07dec04f89634fbac9a11"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd7c8916557d4c5cccad35510027f007deea3ab48cbf92a2d741bbe1b76cb9e7d","s":"0x6731d146798c8f21cbbbd9cf2122970f6d87b24bc54c29ea1d0ec457878218c2"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xaba8881bded36f752dd8106572322c31154b1e3c35dfc3903949baea6f0b0cd7","s":"0x5f8985f2d32b9f98d2ed28cf804e2a6efd86e21fab7d563c1e42207c45880865"}],"v":"0x1","r":"0x95b169ff9ea5f8ffe0f8ff89d51aae7b21eb6d9d7df5b17125f2448b0ffbcb92","s":"0x569cd3856f38e8d8be277ab01d638b623da34b60affd7143bd38ed8b748e1303","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5730525874e27b91760cdb3e062ad1f07f765a13","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x549850cc50a38ff663a175c2820a62182842fa4c2cca4c7b87f4206a501d2bb5","input":"0x","nonce":"0x124","to":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","transactionIndex":"0x7","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x2b72ba828d7237c05e1da2d9c9e887e39459bc1dafa19af2ede676abd2b0fd4a","s":"0xab9f523194411a26ea016bc29627e9458244eeff231a6c177b269331f22a0e7"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x671fc0368792ee4ad2a9909bffbef8a6c90a6751c5fbdf2cf34db8b7cfc10650","s":"0xa3017d994425c7be67a7f30607c9d0d6139ef97577efe1df418443052e0a840"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x4b9272f7729519d5bf5a0cbe8b641d86a2860920f586fc475a8b7e5e3de1c7e3","s":"0x5cf2da66e064d5a644c829babda93ca1a9a90cc9cd078ffc1e6886c8964ab99d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x79450484d638cd26659f8b348abe86d36ebe7e2b20453ccd17993c88097e61d1","s":"0x48bb3534bc75cb0f66c010e2e6f274ebacf2f77273c742eab0f302d6002b0a1d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0xf2eb00af4393794d99e1bdb5aa794109c5009b143c5b9ef7b5f25cb431e4486f","s":"0x3a2acb096c4979b6ebe243ab802565c1ca3bce36d34c352d205096d766dea9fe"}],"v":"0x0","r":"0x77c2f1f466e6cb0c836b6af2c3ae9b51867bafabe2983c5cf1a36a39fef83db4","s":"0x51a0c6d64665801a0b1c9f2c5d98e9bab4bed1bf5cd4f6ceed0ce9ebfe392125","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x944baf51880dec2dffac20795a733beaa8cbaf8f4fa4069d734db4fc0df43292","input":"0x","nonce":"0x124","to":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","transactionIndex":"0x8","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x6dd9fcb081f011480e55b256c8843d8aa4a73f157bb661850ff0ced587fa6bd3","s":"0x33fcd98b05d68689e93f0f4dc644d0dccc058e6215eef58828ef0b6fbf355aea"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xff093565560802560afe3f4a365dd147b6c14a7c4d4236b5eb69be96d1942733","s":"0x4f5c91482ba58beccfdc5e827b59d92655042f4479127f0460bfcdac900d6977"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0xad2818051f023041ed65ba3bb3a531c91018b33985b67ae0cdddaad2a84f314b","s":"0x1bff4f3b5e53798a3dc72ac8eb06c4df82de4b4cd06c8583e1c2167ed881d884"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x659e40f59b329fffb43cac91a41d514fe723618b3743ba60bb6732a09ce3e42c","s":"0x5931bc76e0f6e898d8280023b9cc170b4ca5aa95bd676c963a8f9017e0a8f81c"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x97be95b79e05c135bca45de3be1be6610118446e559804012265dcb5a00048d8","s":"0x642eed1c78e75aa87b84a2c0a6b23e85d758f342d1ce246d62846ee87837a9b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xfa1e41907a6ddd38b3de57d75e591a0815e235edb8f594298a43afac8b198fd8","s":"0x7e22c62f9e81255d80c0fb59491042fe89a6ea8acfbaa42a92318cf022e9f59b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x3b81f43cffc258e6786db6f49e327cae4d5187864d88cab50a0e75865e2c13dc","s":"0x1ad669da15ed39a10ca228d152fdc61c2d8189e27023a66f785bd03dee78a923"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x46afeee0da0a1177dcc7d99ea7a7b242ba699e8e7dc1b011a94ff05d415c9183","s":"0x4fd428b80a2fcac859a361279f6a7f42684e3b72303c0fb4aa9e8ae4d78d2eb1"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x3963b8a049775b83b651ff62520ef775227fdfe5a7f545b7077ab63ea1cd493","s":"0x4207deaefae172e6a33c47903079548cae543c71d31b57c2e63abfaa3611e370"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x842fef4ab07067c98d92015cc2203874b6fb0ac77af34a89b853dcd8bd986dad","s":"0x1a77b21e66e49b39db8bda121dbc65941109b4d43922956c1cfcbdb9715e4110"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x27ffa1c38d942cf973781a509b78052dbf9611849c0a75d9a82d117ec6cb1496","s":"0x7abd6f8c940a11d65a65ed80c3524657358fbc52e73a2c1d003bdbbaf2dff61d"}],"v":"0x0","r":"0x5ed58adc5a0800fb61a7de7fb49d326a0d4d9d4fb7f48b13305ee98b76d3d249","s":"0x2e2f988ab0c142391d5924207a298a552ee821c2d720b6c6e3a1eca5969f234c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6e7ff1f5e608f2adbf14a1bb0e342896a4e7db0d1692e2d83544aabe069436c8","input":"0x","nonce":"0x124","to":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","transactionIndex":"0x9","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x71f5dab9c79640d0614f68b4bc48aeae1739cb0828fa41758a7e7dcd84ba6637","s":"0x131230fd8abe8bf20a7ae43aa10454f0b24e9f4e61483a35d04303675fe24b74"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x6e5f7c31b904108691cda599fc68d841e2349116b611f61dc09269f53b73e514","s":"0x180e34a9d12d787176f5b35c7bc8618656fdc3ce30c3e15762c66938d0f9a709"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x1de9055101e4169121140b923d0fd88b2e5762dc07a8ed6c975a02dc5d3e6d7b","s":"0x5ba2dcb548dfca60bea45fde9944a559b595ae0df8cb74eb7efc704418a92c02"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x3f69c3a47358af6416fae244a42122ef451a001febdf273583a4fd2052cc4b91","s":"0x6af4be94470ff163b42313841201c27dc470144d2009259cd2c095a92be075a7"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x9fcd780d44e52971ba4ff67934bca8c3e3be6a1446dbeb2de065265116531231","s":"0x14b199e6eb7893b6d25acb2dd61a0243a71615172f4c2ce9916998cc9dcc63a6"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x21b8436a44096f91f5e86a049105acd3d1078636ab63de41c66fa2f82eabec4a","s":"0x6490235fa59cec9090a0f8604aa41eb5c0b20fba7b0222381a811dc18841b90e"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x7055abf5fece0f6af0914e82cbc27eca18e65842bcbac1fc55ad1cdbdc39257d","s":"0x1e171c7ab684dcc06f578cd14ee540dd3957a6b9ae9a725229cd2e8716915a57"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0xb8c14ffddb9ed10e0bd93673ce2d18f6f0331b21c9f592a7a7ab275ef975eedf","s":"0x4fcc16b08c11f60498732acf79b68974446bf5cf2c5a92c0f44989f583a7784d"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0xc22279bde1e7392fe4d9c06a195183f95b0a21851e21461fa23c9e4c35b6b80d","s":"0x241aea70adacf51f1df6ba619e2abd4e6a4d79edd409c044895950f050308765"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x25adf12af5af8678c2cd27f669441fbdc02ecd617d9a9a545d3dcc450d044597","s":"0x58f99850c42f6932d0d4e3a4451f57ea13d5c6cb0eb5d215e2fd79084867e9c1"}],"v":"0x1","r":"0xb865e8305145843c1c37e53d0c2f9603700adf76673fb9b5e449ef03daf9916","s":"0x75eed1b9a9b78b12782d0c122dd4142e718e80ec9a5282d0066437059f3b32fa","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1bb4dc8e5e6e43b1cec5975247a20b28393db65b12c09993da7cde330b12e505","input":"0x","nonce":"0x124","to":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","transactionIndex":"0xa","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x63ee6586a0f7f87253544c6555b73f601160cf2093163f276a94df4824dddfc7","s":"0x15a53cc110bb6d38266b381b8d37444616f1ae13f0fe3c892e9cd4e32fbcc066"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xb2d55b412817e7dd7798c20ecca23e563cbefe0da79544132fe3090d8fa40810","s":"0x6745de56399ec82d74cfc9c008f44c35bb7d35308bb33a8cf8a5e74fed286fa7"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf579befedfb6885c6e5dbf7b76f8a394c948fe937901611d68c09abf627f4d36","s":"0x4ff96b496176be32f1b901b1c772764bad06443c07626df9b8bdac3fea7bde88"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd476bcc5246d8b00de1a9ee377b239eaebb178a2fc1562aa52ab547dd7b73e6d","s":"0x790a541bd219ee8d89b5f1940b8be3e2f203b9703197ca45a6f3f0305e2bb57f"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x6b7dda671ee44268e43df49ded9d652ff958f40e56090f7a7e569f75852e6d86","s":"0x5d9fc4ca74bd06e0a740ffa0b232fd99a339cd9769ef29f9613d4f92e445f7ca"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd5d36921ae371e4e800c3d591bb6ea1c12c3b8e475a369c12cd27403de2cd455","s":"0x2275dce73e580c25be916a4c5977cddd185ff7111b1acb79bdc260691f7f58f8"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x803656045a9387a874f69ffbd4769a469eaae93fcc4ee8ced7c5eb0dc90ee041","s":"0x16f90c03fa8232ffac123e64b4294c2dd5aa7704dda5319d768c63cd6af00dcf"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x2c08e2d5b396dcdc586a4959337fc21bff6b1a1f6bf931182f135a56c1cd0a48","s":"0x2029fda69775b5d66c6525b2856a98465160f36dfea7c916672777cf90b8c8b6"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xd6580858befbc110bbd5b1d503dc2962b278fd4ad79b9f4419d1a03b641e38a7","s":"0x40c8babcb0f82f89cabac329008c1698a2d92d92da7bde75fb508493fe84a935"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x3a94ca8871c4051fa5abe3172fa1de359b4a521e44543a6c9461f929c27a12e3","s":"0x35e0c91f1e5f5314b3e9cd64b460904142fc0448ed20d0663f39cfa2f3812add"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf1217f4ef791d8889e3e61dc04d921a989657e941a0530e2dcc24aa917a1dfcd","s":"0x576487f5e47773615a7d5365d03783ad26c20ac737e018dfe0ec7e6c58ad8e82"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf6a227860daf114043df1513b45fce4b80ad9e586002a807358ed4c476561068","s":"0x583fa9a460188c75c9bf81d607146a6805dddecdbe1b4f8f15187eeb978f70be"}],"v":"0x0","r":"0xabb57e0eeb8353dfa360439470fe10baac99831e2cbcfca6f2e3fe7a9fbdb819","s":"0x6cf8ab5fafdc4c3b8f5efd0245090b6a183450d9666ce29297aa86118ed19e88","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xaa1353811eac986e421798c71ce8aa347e85b8bd161ea2ce5d7f2ba92091a27e","input":"0x","nonce":"0x124","to":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","transactionIndex":"0xb","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0xcdd6d1acc2e22cbaea497282e3a23b906b519cdbd70df3a847857885bae9527","s":"0x57855905bff3132a26c933f8d7157444f46fac46562912a06d09c0b0f7c898e0"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x8368c396719ed1d968da7aa320d5ea57a402be7ea799d6ae75f73100e116973d","s":"0x85c06dd3f8e7b796ba647dfbce63360fdf8cc146378e8f8202e528a01044ada"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x37f200f7115a99491862674c73b4d7d2643b0ff0c6909c42decaea89218203f4","s":"0x571b7f51da0497876a0d8948db5283436a444b0b0dbac3c635f55e31dc9e0176"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0xb5b11efd0a7a6c3678b9f3edd55b1bae695d3457fd6462f3ec8966c7c9843b0e","s":"0x145f337cc0af31974c604a38c0b09dd238808c29c1ae8cdea585d784fed4b868"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x47e102902dda58ed1e52101e68d4c821bb87ffd78b4bda7b03e64626b5c21df4","s":"0x151d7172416cf2a43a8608dbbc6f20969d5d623de98fe9a7059ec89e1735a6db"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x3c1423f73dbf041b54c693c0b9785c5b9f5599c6daf133f00c31e8dbd3a091d9","s":"0x13610156ec380447f489a2de283c8d09ccf07f74aaa7a872c7b23c731c0302a2"}],"v":"0x0","r":"0x60108b1e650cd0447d588489757d0c8feb688d1151524d88a468f17d7966bca8","s":"0x5c02442af25ef82919b38682bc5949c6ea8e934ac173eaef5283a5528c96f574","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x4711a02ed9c748cbfbfa536990a0de078d753f96d7b0893283a055d3c522bfd1","input":"0x","nonce":"0x124","to":"0x51f8ed66be313155f74c4bedd3b6642ae3440927","transactionIndex":"0xc","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x15b49afe17b4738354ec6b03f44d22d18a8ee7136865b162779f7beff1c1b345","s":"0x2443fd90df0e948179f7c0a96cd373005e89658d0e83bac5e515c35e2b4f8d77"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x27bb209d55da08ec141cf3f8ee8b1d037301a26faf50e1b928860f96e68f171c","s":"0x7e755fc4d5552955ae486aa5c9cc76b5ca7f9fc713203cb5d8a9d2eec334b6a4"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x53e207f7c204e7fbcb7ddd052e40bc3d019ecd5b36e19843518704a0dba8d60a","s":"0x223787f46e5bf8117aa6bbc51171b9b1e13a4a376eda96884638efe1eeb5a488"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x327496abac7c3b71fdcd3d1591c8e8cf62a3c3de634a791d844b6a35289f86b4","s":"0x34592af26abc82edf701656831a5174caca010426bf0b5d0d6fe7f84d4aff6b0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe320a3ab72023a4b57085c3302e9a0248828ae4fe586e29899785a36bc8cde9b","s":"0x2ea5ae133f80f65349af8044bf12d3309ed138dd849f3af2ec593a0ee31f4448"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x75f10ec9ed7a56424ea287e084476188e8d6f87c0c171fe26c3e0744636ca5f7","s":"0x23a3f014d9b352290cc4af0d6fba1147f33ba2565f4c9412f91747757cb77782"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x7667f128de6fdb65baa0fd1b5868a84944f661ae02eeb096f89b9f14b2fdfa39","s":"0x4889e1421ecfbd4f67f189b89951a03e311dcce8f36f904437cc99f5dda3b17a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x1c37c1422a423ccb142022bfbb235e60c805b552fdfadc663634a32e934bd4d7","s":"0x13b6864ad1b7c282cc6a352adee2a2c100350de8aa53c72fadde598754b7a8ff"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xea9d49d751511f8c6999ed27adfe086b9ad7e93f6213827de2d21a13a98687ba","s":"0x2e3b3a68e074cf448f02a1bbab63e600c1cca7bd5b2d34a16d04a806a747184a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xb24820f7d8524c10cd4f2c0038dd26bdb7e9d7fea1aa7714fb242856501f8cbb","s":"0x8dabd79f60dfebd80ec8061079ee327f7ce500a739f012886ef68e703e4c4ae"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x6bff7cf52bcbb336c1dd80c2d725c8519cfc8799b617aa09d41cdab1d86ab0fd","s":"0x6e8a086aacabb637fe15e5712955bb4e8b16e611a3d709d9cf181d1bc5b3ab98"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xf88213aa9f2a19e4ef16ffcd1d74dcf32b53139e861a843f679b4bbf2c65c554","s":"0x1ef0453cf735179feb2fcd304458803c55e910b4901354ab7c4ee8de19490075"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xbf9c75a1d14de399b554a8c405b3b73d8145dbe58a7200a86c171bcf88f6c188","s":"0x600055f2e2b68adcdd8fb4741f1cbe7d5cb5f3c2884c3f898f68622370420ef0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xda64ba64b0a2d8896d9d51114c50e8c9f827534da21d31fcfc7747d63ee8c452","s":"0x6c4f9c30a2a6f33fbf24e07e0af2a0de07484a1f734ba240125a232ffe3e0a6"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xa9a95d9ecbb545c606945a4999c3f452f9e3a37ab2edcad77dd890d92209ab98","s":"0x12317d6fd111fb1d57b8c19143a07f8f0e023244286aed2359c724ea642e3a40"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe40399fca3c06408cecd4317a62cee48d34f71893a438ae0131a0a4427dc11d4","s":"0x293dacac4693bc1869198967858873e92af6fdac6f753147e4b43acdf0a82f41"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x729105ba2ab019b08928614a03d2e3bff6e1e057542480345737c51153530267","s":"0x5fc451f41bf429beab148f20112eabed20e6c731827a61cb02dd8dedf332307b"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x31dfdace5ec70a790352e1ff787d42479f6168ca2f3a4e9a7439e66d67493c06","s":"0x5cd46bed07d096d62892d18845f5caf03d93532081e90b510e2d36d5e5d0008c"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x5843123b7a15b6b530271d22791f867b9ec77e82339b1b9c25ad469ebc2068e4","s":"0x413446693e172888603da736013622e2ce4b9d4ca182f3b27abe10753b8dfc23"}],"v":"0x0","r":"0xf20a90d36ac4def46a5e33661928b258891e7659ee0513bc5de53225bce9b7a9","s":"0x4a2eec78a3a068c264026c5841a1d29f5743701663c2bc6ab0e4872656ef9a08","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xa4641fed13831528152a48bc95e4d785faa40d7a476eb30303929f960ab82973","input":"0x","nonce":"0x124","to":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","transactionIndex":"0xd","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd38af3fc0d9ecbc09d36815665868b8af51897d1ee23846245773d73d37c248c","s":"0x6e0f8fdb0bae7e07825cef05ab7ec316d3b9e2f87ed3b6bc6bfb48c5e7b7e102"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xbf18aa597ce6d02f35eb1cb65e53f8c3f7f12891fc9a5458d36fffa4d12db8bb","s":"0x32aaa13808a57dca7ab49d384be1f4779a8746eda064ec80f4098de3abd025d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x4c8f477001f3acf9890795429dbeb7e8b74f4f489127268b25d3f29d7d8fb409","s":"0x92071f3a43c2b53eea373b5bc08bb04de84f82812506dd64333a1a16a32798e"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x91d9875d47e8d6947457a30de53b61d4f0b4b9b1c68013a9eafb88b60e16376d","s":"0x402adc53a38e94fb0d38a777e7a29c21ca007430b9e81ce89cf7e5011049f51a"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x93abecf7fd7f76ce13ea5b05baaee40dbd449cc2c84959ca96d24e2882b4d483","s":"0x2e3aeaaa6ade94f95aab0771adb63410246aa387f0fe7285049579a8f7c5f8fb"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x2afb7af537ecf1b6f2c20e8588f69c1d03d82d22ebc558d8f8f104038b2aabc3","s":"0x1836738bf6c97b010c152f686f8276d29b75bc4086a7842c92a50d37fc94f402"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x8a542c8b88993e48cd7c0ab8e09ce31ce15bb1178d60f2791db251eb70ae8126","s":"0x7a966c74d8a2def19e53dc8d0110b8194b1db7d2fb0a601d576a9b1466c8695c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xb7997972083503be32a75655831e9274e843623afeaa4bdeb2cbc42e480b889f","s":"0x46e07e3ed6f4ea6b262e689abbc76c0fb90b0a8e03efdf2fc03148cb4f8e3b0d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2afa0b20bdde7ce541009da8b6fe8331420ee41b857cdf191366cecdbda58fbd","s":"0x5f8c783730529cc8065b0439e522a5655dfe93f699a18b244b81b164d9611f82"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd8b410719586d8930196e06bc2927af076c6021356a8f33967327eec9ae270b3","s":"0x1b97d55395f40c3ca1af140024b44cbdb727c87a9ae335116d8beb74101b338c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x994ce9bd84888ec3ac826a169baf082498b34f13597894fb7246a38704cd279b","s":"0x710d5e49ababc49508fb40c61206d77a8f46dd388567be6750df6306499892ed"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2acd678201d753b051b30bc09a5e52c5a97e0a370b55a6f811938d7cfcac046a","s":"0x3e5d9db03775362bc24e32783e78477a1460139e93d83a8a433f3ff45d05a666"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0xc201eb4025dd98ea2f6da59d8bab9ea6ac4cd1ba1c93c918edc33afff09cb675","s":"0x518e34230c495b37bd7eb405ccdf1762551cafebc5c0e8973f78edb943173839"}],"v":"0x1","r":"0xa09ef36a3f76029df001295aff34630b0928d490b6802e69ffb2a033ffba5f1e","s":"0x15c499bfb9d7194df3af663842e17a5baf0b29a9cae1a5658367cfc2ff302f24","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x14389557e091c0fefa0686006faa1d7194692700","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x251174e826344f5f15c1797ec424ec7e5edd7c722f1df4431c457087a43cf975","input":"0x","nonce":"0x124","to":"0x5730525874e27b91760cdb3e062ad1f07f765a13","transactionIndex":"0xe","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x1","r":"0x89cda4eb425c2347a4cb1ad3e847069a18520754d9a31d13d1d8116d7c061b2b","s":"0x47e8cba907e91fbd56ea29b245d7258082a65c376f29eda285415bfda124cd2c"},{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x0","r":"0xf6e0cdf9821bfdc4a24727dfe8f51219e20445a84e2914a5101cb03492b372a2","s":"0x3390dcec2ed992798d6e53c629edb550cc3f4bf5f8aa1ef1b211909bd567594d"}],"v":"0x0","r":"0x8fdf945627df6289ffaa128aa3bcc9592dc98e131f24995fa23448e435691b1","s":"0x630497b5e8f6015d20e2a982df2836886eb7473ef30a938c5c9f2210a773ab3a","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcfc6618e733ed833f980746c67dd9a41a0065aff63c900aa204dc3641a1d474d","input":"0x","nonce":"0x124","to":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","transactionIndex":"0xf","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x4af5002702f3b0960ee2ddb64f59b7f38bfdfb5a59f6b6233b9bbdbca890d6cd","s":"0x347dec1a5cf6d93e3fca5ec23c2d6cc2132ca8463737ea74222181affc9988c5"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xc22ed4a944e128751ea077286b96eb0ba6f8680cd59bc66b3d9fe4d5fd9016e0","s":"0x1fe088f3a6f8c4a06e4c4a4b9c55a57dc0ca8bef1991d1a59249299c86a725ff"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xde6027fed51bab62645bcc026548a83e4054499ab56246ed0d5554610303ebc2","s":"0x1ca9dda7999b12f906ff4df57e5c084f0380a28a88db0dc8e72af12c59b10c86"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf48097c784ad0619d411c3eda0b85450244554a618d1eb5aead87071783c558c","s":"0x6169afe8088b34d8f6db42b662db0be5e29c81e7ab4381cfe24dbeb596b6c203"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x3112be718166b136a5cfec5939a53f4fc7058ae51e5b7a79a3e0de61b573d3bc","s":"0x7ec2e8680304d15c648fbd748ac18903a5720f53bf9e3285f0d742d4baad48c8"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x23a3c30fe2dc656c566e6d11690719273fd69bc645724d1e2f513df9a16a4f37","s":"0x299d49f8e373fcf2b2faaebc986b50337bcfdd8a58465d68209a0a2172aaf51e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x5cf0a3c92292f0476decd4be8806a0887a9b9fcdb78e96bb312095baa4d2fc45","s":"0x75843521e3946d562e3b210e6b41abb7c99a209baefeecb68fddac4e6b794ce2"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf66b6b9dced6729f69371ce06fdcd9761b230b29cc595e6a79bbcc7bfeea4e34","s":"0x2e3e9cb4f430551f7b5b3cf9a30864ca4d41200482ec66b33202c6ef8ecaba04"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x2d07e9887d7926a3506cc8d0e06748d95b7395227c6142c379e960bafff2685e","s":"0x3cbc6cb189b0847b205e22f5327009eb63edbccbc4fe8dc60bf3fef9b271b549"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xa82230ea185e5bc7909bebca3cca35e6dcd73e787aad0b4fd18b175c66c5cc1b","s":"0x760a356d9b160d213f074f68c0e7612fc891e7cb0fc0ed597ce2c3adcda1049d"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x7b71bf8b814b2e7fa8c632728125fb42a1c303bf29a3ef4469b16b57cfffd5d6","s":"0x6a3050ede7b8e48f45423ab5a2c16907f969c6a710cbeb41c9f80afb2c0a6dae"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x16c7b7c9c9f132daaa4ab5cbe96424dcf48fa05de34fbb2b880aca2010505471","s":"0x4b4649d593cafdb3b294a8268a5b812ac1dbf5ffd7d1859996029373333745be"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x8adb76d659105891cd2b9707467bf1f8c65a41ebce7fa573ae21df99b12521c8","s":"0xcf44d1ba98113c7900f5393db4b00808faa64c344a352ab7e57ff899788dd4e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x5ecb2d4bfc4cd97fa8f40fbdf6a616f3fae850c2ce37d89781e2cc2ef4f3e024","s":"0x455c283de53c86312d9682c9b94cd11c33b7eb4749cc7963abe022454ec9d490"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x1ccabbbb086cc00bc5a103e8cff7a70df740b8e16649dddd0fa8fd5d04cde03b","s":"0x7e3597704c80aada6b11c11ae7f5fcf5813b7e5d7b5fae29061046a3ff492853"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x6e9c3b6071b76ba9c9904c78b7dfc0b2b5eea935247f2342829249613fd6caf9","s":"0x216cf4d1072133c5d6498d46962b35f8738db3fd148dc0dc071c1ce09960f43a"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xe01e6f1f3012873e9d48882cb0e77ce2e53ad3daf7f0832f8231024dcf7b5129","s":"0x3ac9198aa314a765c698bd9b4e9e97ca7417e0ab40732e8ca6d5beda66063e77"}],"v":"0x1","r":"0x19d61b0a9d615e5332e2b83d968ecebcc3e43f6252303ea69b2b3e19c3d82e29","s":"0x1f4db0a7797135288453cdf109d2ce3a5c0bab1089a5c3f7c0937053eafd2b45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x0667517a45f4dac84446c829438d5bf551823547","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1ae5a39e360974f6f57c98a7e2c217ea515418d7f7470b2842f277553331ea70","input":"0x","nonce":"0x124","to":"0xdde31aa5d18352412bc18514578e35d4f930330a","transactionIndex":"0x10","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x6c1d7d19a564d3a847357586f3e58ab9dfcc86c3769a385d39d35e9bf1876547","s":"0x4108c7b38c7ad4d7617bd86a0102acc611cd9e6ee145af2f8e85a88780df5548"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x600db7108625909099560397bc83385ccfea450c74de79aaaac28f0170edbb39","s":"0x2605cd1b4f3994b4406902d1d803c5ea4c3bd27d2fdd5507651dc1044edf1244"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x8891e2289260c39f4306d627798617179665df734cc137348b86d98b57a11443","s":"0x58dbf8f904806a17dffd29d9692d481c916de5d03367c9f31750b84ee5ff6ffd"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x188835843d49cc8ffb125f265d104a44483d39dbbcfdfd0cb0c772be58670502","s":"0x63f1998b149ee8be992276b5f11d488ca606634141def2f9cb2115e58abc924d"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0xb792f15309debbf656854d0318c7c127c793921e8c4abe6ae883848c65a97ce1","s":"0x1a77e6c1c9aa80f3a5dc7b1e9af800ff6c10bc2c2055de292e70d3e3423888b"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x7da52543c8c2025bdd664c23199a6112a4bf99f3f4117e266287c2ee3a7764cf","s":"0x27f49b1145e505ca5781b95ac3ed8ba500f1872d4c76b1e04a234b6d8c68ea92"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0xffac19da42c808edea3d41075853a5cd0c1d373157dd3fa77b6bbd664eec0824","s":"0x643969db399b3cf17021e783b5417651a00147da14902369344560f3bae95cce"}],"v":"0x1","r":"0xcc20e033b6bbb704c8d013033dc140cb39cd10300f5c4e34ef2f1cb357e98d1c","s":"0x6bf7614fab2ea3e76ab5c0cd090d045ebedb69ee1d75b5ec6ea7c9f3b06a76bd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x3239f090cc1037124e32608a0a3cbdce50b59d4acb030ba39ffed387087b5061","input":"0x","nonce":"0x124","to":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","transactionIndex":"0x11","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x85e3ae7b28a4221c3cbbef8ae7292f521307be82f7ade40b42c5fd46b8fad104","s":"0x349849ff2419de48bc660b19670e0b9c653c276774fa79ed3d3a127561acb9c9"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x3fa67e094ebfd138b10683116ac6b6c1e99ea7e68475cf80913926cbc2c8d56f","s":"0x5fdc573797413791114cdc05f6aab44c0552a93d4858d375d66f879971236e08"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xb6d9de0ca9fe63a94a0a7e3b815b64e84cdde8ebf651f19809ba2909bb23dd4c","s":"0x1c77da4dc8cdc44f6af146a19ffc93da773f2a1e7b941d4d47518a6d115b2ccb"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x1cdaa21800bc79425cffb56930c8ec3351d1d27ef0cfe7571a87d7066044e835","s":"0x1f2318b2e4552fde26f64df05aab16f8f40746d046f100eba034af099ce3908a"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xab5e7575ca6720de527ff1437073b3c29e81a31b7d68deec840862a3af9b252c","s":"0x4bc515daea374440ad86b13783c4b1442fa5c481c1d3f966aa6137d1d04bbde4"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x5a9ea82ecebe5b2aedc5dbd25d3f0673d7c32cd8efaa3081c18ea99f80ffbd00","s":"0x46858e5d89f77da65bc1f6cf0c187cf3396101cef41d4095cffacb4fb208821f"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x86b4a3df3f08b2dd783a605b257b5538930b650b744edaabbed3fdc572d5fc3e","s":"0x5ed21e1db3e168bfdef32f6ed71ec20766e8e2ec3286cb3db5263691e952fc30"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xd769518bf8947a09ec1729d9966e47efa579fbad4dd4bafbe0f0945f7a02df85","s":"0x451dc81aa426813cbe217b86bf743bd8c17a9d6625b043f40971dc9245f4ac8b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf2078416d387147defe9e588d4bb978ba14f77186985325bad8381c939e0055a","s":"0x32cd85bee47df9b5a0e92c70fc8b6007c3c4d52d0de07f82605c46d45283714b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf83ac2e43a654f7846cdb2b7a62842fbc87d4b6960dbdb7b14df8f39c3a47fa1","s":"0x714e90d5f38cfb349fa7d7b8c311ec9faeb637a341e9b19b2c028610a83d87cc"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x779e279529a428ab9519fab51139595e757b58cc109d26fae9762972c82b3167","s":"0x2a84d5206624826f89ed09420a48a1f78e9782bee51f562ac36354f39de478e1"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf6fe877cc13968326bb70c7de248936b444aa1159009bf78c7586ef3456e677e","s":"0x3aeb48996ad97edb267a59c2d3138783348df362a3a356f8c547087286786b1d"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x800612502b4292bb9fd852f8edb1bec090ec091317ad60c8462b7205a500465e","s":"0x1921cfcf0889e6bef52a705113714d0a4151c258e49c50dd8c41e81ce49cb816"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x2832af1b36d7fff8238d66720dee4354acdabcb597af74389e2a237383eb4dcd","s":"0x6b850e9fb4d4f45b1be534fb1c41d2e9fb34c3a3eb2d9ee71161c51ce9ee2719"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xa36cd3d8e317c2fb16d6fdfab3f12246e9c3d8cd5df03daf9cc662e79865766a","s":"0x1c020dd9062065e085f30ef98d1533ad8452bdd8d106c9151210b9d09653c6ef"}],"v":"0x1","r":"0x7fffedbc1e27dee4970ae07b6ff94054f13e1827c6ced8eed9be8cbf1bd14bf4","s":"0x47bc1eb3525d9e805943294a80eef0c36e9a2c623272cf1cce18edb2a4b2b302","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbeaafb428bb29faf943f4050258e6f4cfd351b47799c931196766afcc1c473b1","input":"0x","nonce":"0x124","to":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","transactionIndex":"0x12","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xafd84500ba746bd3149116cef1800e8a6b5c22c730a81c98c7fea99df3e37f04","s":"0x62ae783b90dc1c74f8a1583bbacefd927e5189a017ead5f03a9aa17770baeb3"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x57d4b333d6cce5dac434acb06116507d2fd9b11e0054df09e0d3d4388c23b38c","s":"0x543169ac006411fa2f5d2d1ac93784d9ae3768dd125c15592e74efa17e15e8f5"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x7c74db0c9027c5d9fbf01ba6367f983a6a938380073376bb2f3a1a24e65357f0","s":"0xe61a3b2fc68053d069a92b6bc810934cbd4008de34460f639f1b3e9a0fc82c4"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x848f1a9103b9cfad86c3e6d2e40e36ff431c4e8948fecfda69e69c37fa5bf50e","s":"0x6f6f99a1a5ad26324517a939980c8fa06bf5e10fabdf05dc24b56bbf538a75ad"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa31db6b92a29a6077a2d99a95945e097d02972830989a46868f52a696b9318a7","s":"0x442bed56943bc5fb75f6dd1694aa8ec6cfd5ac803e3c4746d6e6759a92073e31"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa4e10a3625ed18afc772e495fa50493414e4a9300ca2ab6a7ea9195c578c8e5a","s":"0x67a45755b00cff16b1dc3d774adc92e7a7c133182f743d60562d8d6e740e4694"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x1819ace82a1bed6ac538c4e1acf4946f67f4dada66b34435bf1c2abca6e0101c","s":"0x63ac6ecd8cd95fc7c2a41517f2051e2326ccb7a34fb67c3d6450dd1499146119"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x9a768545d76b1fbc94d5001941003532acc73151b5436ea0f56c91dc65a8bb36","s":"0x4804ce4c01a0a22ade14de7ed423ed809627e45b0c7ed29982bb75982f33c08b"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x19fc3f0ff88c9646cfd568b211d9116b58752cdf6f9c2087e201b31a269e6563","s":"0x12f1ec72b5dcfee614edd802ece0f6292fd491ae317330f70888c430258e200c"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x3b09cd8444c3274255c1d11feea2187f5288482fca2d869f9db1e995bce19e4a","s":"0x41d5422f3de36b63e992233cf4554a7314a46eed8c342f1a73731523ec126866"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x95718f22bab2f658562f816cff3e7814b5fb10aff2875db9ca63c61c212868ce","s":"0x73c6082d04a77e3fa21e80861c0348b16d8f1020a58ac664401ca0d635ab1e12"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x5f0b61b046465366383ecb0772a3c076f5fa66831e92576fda6cec37d3973433","s":"0x453255fe2422328cb222eaadb6fb1ba486df7dc404300f14dfd11f662467fb51"}],"v":"0x1","r":"0xaf4ada6b20e3279dd305cb53553e3241b7d59047b52e812a1696e854c4c375de","s":"0x2f9a95f298737aa73f3388565479f6f1fb7f4a55c6ab279b7a4e4d643acfae2c","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xc71914db9254a81a555383496715cc79cfd85e0c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x99687892078f793a426b27b24571b1e490c98c8b4ad79e0a37c82acfd4261aca","input":"0x","nonce":"0x124","to":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","transactionIndex":"0x13","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x320407c470985065994edddd6fa944b9d581f01fa7e1b75bab6ee3b7cb119cf7","s":"0x5be32bfd1c9cd6942ca5194d7547acdd319c9795f1a85d4b457b9f81c85b2a97"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x6c9eb8b4ad58140dc7cf062c79cc74993c96ddaf96322e6596c1322b65237424","s":"0x25a82a103a030cf356e683dedc52f3afe0adee52b97385a01f4845b7a97a19a4"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf4aa6db0f2eac702055833f49c97ae455693dd8a90ed6805b4b594b7351cbc5e","s":"0x766bc678c6b2c7b7d6dc1cc33788de679806163a97d31b4bb65bf6251147e8eb"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xebdb7024a02ddb38103771ed44b07892479b709ebf8880f1b7071456864e6d0d","s":"0x5ece7846e1c56b1bfae65bdfa3e44fc15816147d4f59379061d16348302f072f"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf421c7edb2c13498d6157e2988fc11c6f5838fa1d815f7717c4460ea4699c00f","s":"0x418e2cfe0a05c477ef90e254e8a132e5065ab8846df78dc2ee45b57ecb536a0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x415f560e688c08fd8ac5fe38f2da732f23406957612ac78e53b3a70ef2fa3222","s":"0x6635b7aef3059288c79ee48198451d068d0ec68fb7c00ad5b867fd8b1a11824a"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xe8b3867ecd1a540dff4d3c2d2186c4e0ff9add68fd0b8798d9fb6572ab908acf","s":"0xf394bd92215e273e3d2596ed95c02069a7867f6760feaa6bda33d1dde8b5ff0"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xcb3e476ba96de1b5f05591e07f0b3fe55af538d5384d75042725740a2fcd446c","s":"0x5b5d98417c9b330c92bb3dd4714b9a5986f801a1c0250982bfd7491e37453f05"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x56b4dc02146296d589ec766964684c27d2ccf9f95d48bb74710d126c9dbc4bf8","s":"0x28bced0a64513e851e6f4d9e7b19676e7122d01fe0a9c714a5d3f1174a3fac0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0x6b0283aaf57a0a4f6c1c86c463059218ea99c992b24b31e5129052db33ddd377","s":"0x586015e888050498bd77837597fcd2bc26716dc96b43e2f0e5c9e62058a775af"}],"v":"0x0","r":"0x3be3e48a873bb7805fd4e5b96ecfc72287b38741bee16a0c759e00c43b078a79","s":"0xf2dfd7f9c45465eaf2506b4c26a3667776e894e34196487cac907c1b6628bf6","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x73f245ceca5d8315aa2c7e2923322c106ed7858caa65336477bb10d47f316af5","input":"0x","nonce":"0x124","to":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","transactionIndex":"0x14","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x89a96807d2dfd02cbd0e23ae010e1a64c91e7ae3404e4122b917c09d9f091b2f","s":"0x19381b5a357c6209709e3c3cd9f91c2aff2536714d4ac0875b45ebb5a00585a5"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x46e0dcaece4a468b6b49092d3c0f9d1ff255d3d89ef1fb061d678a05b30dd1b8","s":"0x449469a344936350d3fdca8e73c33ef6c298bbe1f132c34900b7f55dc07a4794"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x725e78a6fe75202ff0954f3c6d34295a6b42a8eefc8a63e5703b549ce9dd79f4","s":"0x4ae75a4e767fb13ddd3bd186d7253f44ce3131dabad0a32ebd92380c291c87d8"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0xdfb6d37617116343a43ad5128512e112586535203199abeea067c8d8954c8a4b","s":"0x3a9000a0036e86592f84ee42408a60adc022e4688eeced1c0542dbce8fce93cb"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x7768555593a0fca44b02e32c20d27118ab4644fca1402c0096937249589dbe66","s":"0x4a205eac4cf2d23f1532bd99845060bed18174c86909dfa7119bf15a0ead9049"}],"v":"0x1","r":"0x382bd0fe9fa3bee706ec591a885d129b41781d44566976fcf55e9c0e4a22f4f6","s":"0x2786048618bd61daa8dab3f029da729539557d65763bbaa542bc42cb05ae5082","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x758f6a2266ea552511d200a190f41c571cf5a2a6651ce6ff38efc83cb608a485","input":"0x","nonce":"0x124","to":"0x854c42e38402dc9db7557cfc061474f06925af45","transactionIndex":"0x15","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x55b56469bfc8538e70107d64166f4690020c139410e68e758880aa28c0a4b555","s":"0x47a8cc4aa3cf2d2b5194a1efc9a2bd4a4f111796f2e02c2f86db5e1e8690eee9"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2791ff79bfc80ccffc5e96f452f6c16acc593d9c3865e21edcb5c8aab85ffca","s":"0x2fd15f05ec11029bd2c1ab61452f3e91c9af07e48f73e5921a995f44706ee7a6"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x30f14b71619d5db2a1d929b36a3d265c759afde418c07a3b13f5ab0866684e7c","s":"0x2cf0d97ccad75624594729226399569cb9804b93c9df819771c319b3927dcd0b"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x70c0756e58985fa36e4ac189f87436cf78b50718a8fa24383ea7ef91a41c7bfa","s":"0x3f27f274095ca8f92fe90150d348fbc6e633a3d68dd18abb7b4370422ce0cf81"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x49ff88942859bf10ddc900b6af41d26e08e30b4892a1d0b2076a3813776c2ac5","s":"0x6f6da0be82e0571c3531b327a59cbd2416833b3bdcbe9787b47702905a1e1831"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x609629a392255c0b2efea27fd7dac1225acf90ab84fe20188ca629927dd4f5e0","s":"0x1eb44d5ddb185be3fc234bc3688803ab7bfbfbebab06dd4de8dc5b27549b8b33"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x2a39851f663a3a25cabfdea2f4a0b2eac146e10b547af46e1c271649cbc77c09","s":"0x558fa2fa34612e79d1f650169edc3a92d335ae3e08554cb288ba8548f3a9fa01"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x91ca20a029881e09978fe208327da5cf70e7ba7a1bd51a048ad239d13ee414a5","s":"0x5ed33f3484853154d83f4aa196f2aa3e00fa328497876bc366f3a26087b92a23"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2afb0afcc1bdceb28356328b97eac586219e1aea0c9b16663b3b7ef4a90409c8","s":"0x49a4a17185dec5620637ed4e9e077674bb18ac235179c3b7cf446afca14889b8"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x7635ef846db79f5cbb38dafeec56753f983a1fc7133ba3283bac3853c38142d5","s":"0x337dd6e8374335f29e2adfac30899a696645fe57d4a8e760807e2c1fcb4efd16"}],"v":"0x1","r":"0x70131c98ba270fa4863e3f4f5d0ae1c0e014731bc45150aa09cd218b9deb5fd5","s":"0x20581b1fc9dd66ddfdfb79588b0eb0402c80f694d5b5f4a5c8aa8d7fa98cf3c1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcbbfca7340dd1a6b71b5a2040c140ae3d7630cce91ccaab258f7777cdae75d0a","input":"0x","nonce":"0x124","to":"0x1d009de960092a8ba788d484fbee9b4c7438721d","transactionIndex":"0x16","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xb060c5729337941ed9a27f9b1e20e878a665bc01fabff173c163aacbfd7a7e0c","s":"0x4dac092758c1b42c2780a90b0948f8b1aac633fc0135a7f80391fe0dd662f39c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x35d3bd2259ab04e1907ab967adf3d4062a31ab05549393555acc01930599c18","s":"0x1d0f651a22da4844643c71707162a704b4eb97b035d1282b1e40dd6967fe11a7"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xd48f3549fa0771dbdd10238eebcd2e76bc107b200cac9dcdc50e440955e6aa16","s":"0x3f04b7b29dfbf13ce692c569d9e98bc30debb75d8ae83bbce010fae62601109c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x6bbb1c39fb37e82288404d4f53ef6e3bad2f636e466c612c28d4a6b666ffa3b7","s":"0x457b0b8efca84fca6c62464d0423a9cb5e18d9a6d1b5829f88211fe9935c8054"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x15c27604821cb2a0260c1b6720c9c3a93b4110a94ba37594f7b04293eed29870","s":"0x738a7594ef98f69b1d4d7d92b2325192bfdb433b82fd773d7cce0ed682412792"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x23e061f90068473d0095d481750a129f359b781be3a5c52dfa98af387086b35c","s":"0x5c38467a5e2292f8f6431b49cef14ae9cf761b470f09270621d387b1f8706d2e"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x4b8b533f543392692e33913a8e2c1a8d6e0ebaac06a420a96b809d3156fdb45f","s":"0x14e7a0733be1d6f6f2017bb070a4baf35d014771e31301deb3391e6a05716058"}],"v":"0x1","r":"0xcf6d6d78179088824d75956ad7d3b740de9313ecdb554226ef86d528453de0fe","s":"0xe0b87018b53952511d02310d42e7a8e4a30bc8580c3884464097a7a32fe54f9","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x34a8fac6801a269bae275edd41a65156289277edc84a48defb95349893fd8c16","input":"0x","nonce":"0x124","to":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","transactionIndex":"0x17","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x11045048c570b159e6205736b45d23fd82fa53eec296c9efecc8771084500af9","s":"0x8cd193e1e0175c2cd23c87650b1c608ab141fd5d3a25d344a898a90e35ce844"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x1ec3007c0c169fa24b41b5cdffe14c25713971b5a896b3c2722a629582b135f3","s":"0x8a27cbeb4fefaf03f28ba1552c32ce1f8ff3e82130efec0f98804e725d0984c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xc6a21bec802d5b4c05ff17268d7341f066120a8d4ba9b7e4c95803dcd2d1fbf9","s":"0x6b0ee67a03b3474bcd020ab07aa3f2899f049ce4906a417be7393bfcb0fd452b"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xf53521cc7a9c0ad92c570d78594ca1a04682b4067611e0d8e814a94e120f5a35","s":"0x67bff6a9e0f279c88f29bcba610ca0ef907e459fd1152839deeb7cf99514fa2c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x827844d978fb2afb8322e03f031943140a9906ff6246d189a08661cf6089fce1","s":"0x2f56de9af01b41913ff3cce23c02fd04ffe0bbe090494bc12ee21f3d5c60bf8a"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x4fc47a88ca11fac3305afa574a17e709ce0cbaa242aa8407beaca8e4a727d5d8","s":"0x3ec68ebb5bd8bfbc1aca853c158e217bdeab5290d99c9dff604a39e82d6a8fb9"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x63827a86a80720bc7ac2a79216da4482272d3f72e7d276b07ec72e0b1904dca7","s":"0x67952954c83a1bc76f891975c4db612e3390abf43039b5ca35f70fbf6428a5b3"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0xb50cab6629c4e355b99f81524f72eb9c2c83bcce600b8bb389bb97cb993ae293","s":"0x24198e35814fbdd30cea47db661f5f5ce6ff2cf360c0cf163808a39a5e7b7fa4"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x21bea6ce5d680e37dd9ead2c5febf0b0739cdac22c770d6d0cbed769548e71c5","s":"0x50154a3c529f679e57085e9f5d7ae160b6c9ba25943f3becedd158dd460a9891"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xe9850bbd20f0d9fae42b24e104ef211957f7248524a60d7870c8e59dcbac019d","s":"0x17b98abca63a7c95478037d5e87958a87031d14285469b2cf6df54e5c07806ca"}],"v":"0x0","r":"0x72f4f42473bb135569413dd477893a2c56199a58e95b4d02998506ee81ade52d","s":"0x250ded7b0dee527212736239eb6838f9b7eece5d1a39e3f9249140608e2178f7","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa297e3e19b9f0c10a353e31027c8812524866b17","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x9092064fbe87759718b5dd9b414e65f7e92afb1de022b7da5d4aef5b68ca6b71","input":"0x","nonce":"0x124","to":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","transactionIndex":"0x18","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xf95df0c73a155c4c9cdabeaba002e43a0de56b9c655a43bc74a0e4cc6c11faa4","s":"0x6635c92f8645f01ab004f15ffbab2bbc9b9bd761f821fc7ee067d8c3a5e088a2"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x3162c53b547a316b472b441b77853b7a2dde7dd16051dfe0f4de773ce2cd3078","s":"0x568c20dddf284fd60e0f4dd3243a0a7a5ba763d89ae4b4a18f2df89b7d474927"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xff79d0bb1759104996bd8f586f7d3e3b0f424cf94ce4da70b80fd7a3a4c3970f","s":"0x21187958d69221908a5a2792ce59b5fbb34c593d8730623ecffd86414067b9b9"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x6a27c1e029c59fa3e2eee98114709315b44befe4361db8adf61670c0e6defdd7","s":"0x2ffc8a9d65d881aa0b370dd2782df700a2e7ee4077c7ff002e69376c755bcd13"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x6adcf3f467773dcc4e23be7293d2e89c38c7b5f56ce040c33e3dea328a54cb44","s":"0x467bb825b2a3ce44db986bd3f068dce0cd336bc298fcf6a8bbe55b0678e3ec36"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x4dc925276dbc1309d2cb664c95ee59550146b912439082930b6f3c51545487fb","s":"0x6545589fabe7e2cee44f740f78455f22182d9040b8e6d95d417177588d5fab2a"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xa7a50488500575591b08e7bec3eea67adefbd741cf178b0cc962abbbff528721","s":"0x2cfd4276113884fd5d285ed35ba4fbec4850e8893b09eb4a8536bc27b4758d0c"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x1b27d6b28ae2c41491ed28c233020492563e471834f611e4331d5dd950411754","s":"0x616eec96d984b0ba84e2b913010cf5a342fb501f7571c7cc2849d8b098100ba6"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x9084b96d63fca2d2eac1ec0f9ddf7302d3d491515fb5e1467c306e26da023b2c","s":"0x63bc43aaf840651f78dd9a11f07d9ca73b8b8664c2a7149a895c73141e50286e"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x803fb41064501ff53d117444112314a22c0b5fc6655754bc0a393ddc639bb422","s":"0x3d7691bd669f88ae4abd82f989bae0624fe049abf2ebf324882cdf46ca14c128"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x2a1c8950b0942969d6274a298178fe7299bdf1e43ca32023300e4b82c25e6b35","s":"0x36e09a984e53bd5d3e79e77207bdae71de144ad41388793d36768f7aa90a79db"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x89907f93c072770bfa2fcb543dc09eaf8bc4c63959fe095f93fd2232ec96feb9","s":"0x6e8445a7bc4cc0c6c2195414e59401fafa9a23d475da09826786df3ef736da6b"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0xc964fb00d980652c5517435dfc32172f04cd31117f6e7f8cced279793de0944a","s":"0x3c0820e869fda57c1727b52f1c0481416d43f009decbd56a2b43f32b035d1ae5"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x3713c2e2fc71f43b511e6896569df9356dbfde72538f1370bbf608491bd0b89b","s":"0x15ed2b8b4018e84f0d5144aae98e5577395db8290c68eb33efb3656d08b7d082"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xdb2edeab7f9ec59315b17a0620b61800bdbdb31412c37d1f386436d83c441220","s":"0x734d62dc03bb6b96f072230831113463a1762c5298267c60195ae73e0a3dafb3"}],"v":"0x0","r":"0x86303c179da28981cc8b2b71b2f7b3ba53d8a834e35389c77d196e67d4ab8fcb","s":"0x6a595915136497983bac95329a6713f6b47ad4b9526b6b4c601e76a63c354113","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x0fed73b4d94982b8b359c1d07451da7bb9cde23c61c8dc6dac17a4a69cc0bb45","input":"0x","nonce":"0x124","to":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","transactionIndex":"0x19","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x9d1da0ad529a5dfcf455da954a2e821eeee9b5d347709270dae1e77a8530bb30","s":"0x57472a551c898810ea06b2224e2623ad23b4989798638254af51a894a269f754"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xae62bc1ce0906c1646c34d27b67131c9e8c9d13d62868d2c16585124aaf74b75","s":"0x66782605564f5fd733d9cdec0b47398bc2feacd4ff6c054ba818941ae24b21f9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb292ff047a54cf1153bdf366cea5875dc74a5494e757186e2bca5900c2644eb5","s":"0x62f227ae65d83164ab11c565cc27bb582f592cefdb2965919d5c02df2b8a2172"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x8465235072ba03983403cec4a784336e9a24cbb8401424557d7640ed33ebee1b","s":"0x6ca7c6ae7dd46e39272d5d361840f652140b7f008bb52c0bb7f292369f2f46b9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x2044d412d537750249ca8a5266590d38a180adc699cdd3a43ea0951f5491dd36","s":"0x29690397d40990bfb60ade2173e2310bb6fe6e0cdb3c87830a30b52d03bed44d"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xf409d31b36d8c2441b05b67a2e91c611b7d9e18a9a4f1361380a00ca8b240b0d","s":"0x69a1628bff55eb1be2134c8f300c922169af39a1dd7d8d6eadd89b78c12bf1f"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x393f7ece3db7f06b55d071803728c462026fb7f74ea8f42572049af82a91dded","s":"0x18ab0e87de56a71596db0a2ff5efda91fdad6dc4085b21eb8488c3c621622c81"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb01ad4eed1e05cdca0fb1d5092b21843440dcd8d4077312ab2a8ea7c5986c540","s":"0x175fc8f02114bc5e67002ef82a218f5620e31643ece8c6f44cea0740ffe0833"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xed5df724441bf0d70735f5608f666d6e473f4d387fe25a282130cbe1dcb6886c","s":"0x636ec1e68260f5180b18c26c5459cc402c63526f34ea852e6dd93ee7e4b6b55"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x1436af905af2c3b9093c67758854cde16635a8c07c7c0bc86951f05f5450ce13","s":"0x6cbe0dbe7a53d9a2b115f192169b13681d7bf6df4f4802e44f832ed6a210b3af"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xcb81c9939ae637b0a4a10ce372f9c73967007badcbc80c3f2ac714914cd18bf8","s":"0x646fb5c03c018f20e54ab6104f98f4b0360610acc4b2c4911b507259d2bc6a88"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x111b0975176a92fe13e02c501d384a7c9d26ebec09d1e3b1eb10af788ef61483","s":"0x1c317e24a97c03bef64e70babb8900e0e2dbbadedf0111040c74058a9bb4c389"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x6979ad66c764cee9983c766d9afc52b7c0a0aafddbdcd8dfd1368d8ef97ae098","s":"0x4769f289ad6396d212204433b77e332a682c39472e5919450cf7a7ccabd06b9a"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x55260a853d700eb2957db104c82892b2b09b6a8040492db769d7da5294ffce68","s":"0x10c7cdcf3985226a92485624ac710f820bc6173f08c67111ea5a0e34ea098d9e"}],"v":"0x1","r":"0x1ec8113238a869aa8d2ca8ecadeb58acdd29ead429e6de1f39392ffb0dfb1ad2","s":"0x6c1ed1fbda0b5c858749d90bd9da1f59de34c893037402bf92e2f707e6a7f3e1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x854c42e38402dc9db7557cfc061474f06925af45","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x597f6b5e6febb2ad78bbcdd22016396b2890352c241dfe18509661fb4c5f77cd","input":"0x","nonce":"0x124","to":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","transactionIndex":"0x1a","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0x5f81f09d2e53e38384c29fd9e08833b8d208a228e68a6218565f95310fef0f17","s":"0x2d840d00f2a6a31ee1f06323174d19634b77e4afd12468d9c5ce2927a772d34d"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xacc3ead55c5fae9ed9ed4cda995539a0f51ba51b7265307dda7bc8992735b496","s":"0x365dd298b82195e80f2556dd56137954b4f01aeb8a2499718b38dd672c7a74b1"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xf4dbbbbaae759616a1538b68d9c58a0bb60de9d00c897c153d735e4fd441e1c2","s":"0x61328d974c4e289ca63701999892b027be036822c140d109d52af31165f6f9e7"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xe13c2d7f15307c7a546e4be770eb6306d3d059ceb94a1e30abf32d7ded0779eb","s":"0x52059cc2081c0cd3ff0669efff3054470ce19471a1a88639c7d833be767d025b"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xcdb24c124d43d5630f39fc4c25e4007559c50ef5b8bd7c9c550e4df2c3fe4488","s":"0x75a040a060b76e03c15fb3978f350f201fb81101763a219e37b7ff35e46c7fcf"}],"v":"0x1","r":"0x7645d5e55bba9acf3d6e5c70390f8a939d8bbbd9bb30c23653674b120d6c6343","s":"0x5c95c89680bf9d5ba69c756077995628f0523ae3433d5f7942cbc07de4ddcb45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x39c49544946c502049fd63e4f7217fbdd2a2c224a41d5a65145dbf2248f4d323","input":"0x","nonce":"0x124","to":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","transactionIndex":"0x1b","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x1ed240dcc6f6afa02ca576cf7123bc12019f889e55dddaeacbffa6c36a0ed7e4","s":"0x430842fcf01cb761e30ed3a9fe3ee3b213e4eac055a65de447e65d5e96d61227"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xd6822962567256c48342a686fc6b03c53a76c5f9f66f66d9b4e982cfba6b26c7","s":"0x124b8d69ef2a9d1283f4408a4e6dd5efd81cf3720fd3d62d6e9e3740766655cd"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xde59e4c2b13c14e67fc098d2f7cd31d29586807ad62c12d4461dc54cf02a71b7","s":"0x74b6b7faa6798fa9fba36f9c1c604fa34f5a2ac760bb044737541dea14649a02"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8addc1ff8e9ba9c2785f1174ca4e7252d1e4a49ecbe7de2ec30e7a3f059118b4","s":"0x7596f0d219135b721594284944fe69bd8aea45ce6423c88db8ad92b1923fb7d2"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x33ac67330b1486808978d5c62a69f807092d60dbe45227939fa23ab907f328fe","s":"0x7a8f6a8faa33011ab8b4f0c883566d7187c0c7a7c7af7771e799ebcb49860406"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc940c8e817544b11f13c442f0b09bca2f874dbe2965070a3621eccdb049ccc28","s":"0x2097a1d5cb803c40047013cb950c6a806323c14674f31015a36131e9b917349e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x904bd058e6ecd2b3d5b28ebe3cc93a01735ff923ddba48050a3140063ce83899","s":"0x55dd12d04531512adb8b4c71990b18759e6eb516df8d64f420de30c166060881"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x785abd7f946005c85c2ef2b10d600c17352675055788c11ad583a2657ba35c0b","s":"0x21f53dd9a3572a8094171d7175a815d5077c5b03041ef7cd3a894482098b03ef"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x441ac343e3fccc2272aa5647d11c2bdb073123d040968d24f87f09d349a300f6","s":"0x464358e128ae12cb8c8af4e0374ed5bf7d4deb683192e1432541db2a7f24d8f"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc00b6af3e06ac8ddb68a611e582117c3cdd63592c4f183105bb092ab6a1c216f","s":"0x1a44d0785dc8cb7ffe5bd093b4dfc8b7d3ac680f783a55dec676ba91e1d4fe8a"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8a22cfe42dca09d25149e71491bb3c847967ded6ed15f366bae49e9d7e53f869","s":"0x48bb749b521bf05b0fc1a6e8fdf8dd8fd648108f8561d76241eb192ce9026e6d"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x9f8e4a394f9016a6d35385b77ffa2a290f0b60bc62486f433362a4bb98d41ae4","s":"0x1102b36ad4a049149bf1d19d2717a550b058a6d198da97e815fadb2335545d2e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x521303def7981b14d2fd2b163c3154d8da9abceb3ec6a04ef8475bd1e0822eda","s":"0xaeaab37fe9d16e73547c3331b26a675d5e1452241fa6c6e8ee535bd5b78ec74"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x7784de6fb17f6f5f115b534d71d1b79f492cb5b56ce4f9be908efe39c5af17e7","s":"0x66612018b830550a561463277f4e66527d2b1e744d9d7ad582528c0a4aae054b"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x6e1d1d83f7a57536269d84706088c938c0d1c1c7884beec87b7ef9b172ca3b8d","s":"0x35ad2651dd814f3c148fc7f32b860113bf2840976966ddd717e4f6fca327fc8d"}],"v":"0x1","r":"0xcc309e6da75a4df39795191d385aefe548f64ba62dba0616e0150e74f575ce8a","s":"0x32dd4d854ab47e5205fcb05756bfb768148845673be8bc4aa45c2b5645254402","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xd9ed58ee5bc043335f498650333e7d0cb0a0203c0cd5668ec6caabc44a349132","input":"0x","nonce":"0x124","to":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","transactionIndex":"0x1c","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x639bcbb37acd4798702d1dcea48bd7dfdef1a8d1378c0226f82aa6ae8aeb0d6a","s":"0x55ff1a94f32acde65a6222e3268b1526e88967e1b14f4e1003b92f3a6c03c755"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x3b74df05c774ceb5870a60d88a11aecc0afb7bd55ff6b91e9e080f4481d6d893","s":"0x4bc7c2215cca20635d9251a9e55098c5e61a6b92a20c1556d4afc1ac63b292dc"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xa79376ac67d5604c0bd127b6f3b9e8a1a93489b3847abdf68b9189ecc4cf5bb5","s":"0x51953ddfdfe7655799924e7e58cd7f293880ea0d04f5981221d2630338c38b20"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0x9fc014d9f71645b7e52ea1f1e9859b61b110d6bb61230a7365f7b1306b9cad39","s":"0xf410ef618aad2f1d2a05464eb2260adbc7952061b5328f460c50005e92eb4a"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0xf48afbe3f1c1dfa9369940b75f10c1cfd3542da3f69adb703c809bafa1e892c4","s":"0x6df3616b09d7ba3e25a8f56ff22d2f93a218ce819e610dc4aaa5307b372e2bd4"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xcf412a533c119271052e1de3aedb7a9f00c8d944ff2320b03ed4813f635ebe11","s":"0x53969863aa3f847c5febea8474c60bdd79d255ddf7b5b2d32b12309f522b3d24"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xfdcc83cfa24d123615c9b30b4ba883b3606a305bc063861a0dedbc1e570de461","s":"0x4bea3572d7076226ec55b042bae76f3e8ef9f66deb40e13d6bc9019094a83f7f"}],"v":"0x1","r":"0xb3c97db67122be5011d51eba4289871cd4dbe5f0252e96b1c07edeaf43b4485a","s":"0x4303b1df2ab458f1fb76a296e9fea4453f1643c80906ccbbb4f1552f968a9dd0","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5e3ef44a9a721e7bbe61a5913e0a0e5ab6655bfbfdd005ade79e9623298a9cff","input":"0x","nonce":"0x124","to":"0xc71914db9254a81a555383496715cc79cfd85e0c","transactionIndex":"0x1d","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfc9fec1b3cc9ec8b8bc386154af2c4774d2127fbcb65960701c9b4fbc1fe29d","s":"0x5c9f12fa5dbfdad0c6a164b25a07c5ee12a84d7a4d57233a64319f392eecf6dd"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x7e06432dc4da4fe86ea629ed6adf35c402fac553f34764d6abf366b50b599496","s":"0x26aafacbffe2e2d6e522ebdd2e67d9414e96cdd7b643fa976ea197fa1340ee7a"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xfc3bee6912545b3f5c5c2a799fa8cda2ba57d9fbe671c2fb7ffd82c58880f424","s":"0x18dd846a978d8ed025c3c82eed83e87599699d9c1791a3fb51d6241b008bc6d4"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0x4c7e13757f606286bc88538e737bb213837ee1090b84b48634f67ca5b24e7fd1","s":"0x7d68cd6e06730e40cf4feb86c7912460e68a30f85606f147b77e0f6556b48c83"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xb709bfd0fbe5082811f48d3fdd0f19c8c9f2479acb077bc925b7226ede49e2cf","s":"0x73715cf4159caf76d044c415976702378f3bd210d961a34a97d701392068faec"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x3daac9f5f5d6a831ec7914a0145e9ed50e1b40d04c757bb1f7be9c36d687a9c3","s":"0x40b56d444ed74d3c6630c81e138f8deabc0079fe07095f96774986bf66d2f50b"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfeff58fa33af0c2080f005c10ffb2808186f65a6dd6cb309ed7493afcf8c4c8","s":"0xc539ccd83955545cfa6a6d3d5641bcfb9be5d6f7c470bdea3e5072994c93de2"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xeaf90ef23b69b86b711b8bf3fe4aadee523bde275bafb61532a387ab6e36bf83","s":"0x4831fb9da21a53c8f1468ee3f2f52a80e215f8fecbdcbecd2f265cd4f251beb4"}],"v":"0x1","r":"0x27dffa1aa6883d71266ad01305ea7e7eb1b48109e399d6e078b815a0f5668a21","s":"0x42307aea0c7814785df08789fce006dc7ccf3199a05cde3764820b9b9a63339d","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xda089537370f75401a128af4945c9e483752359be57be9d2a1356d5acd61c7ae","input":"0x","nonce":"0x124","to":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","transactionIndex":"0x1e","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","nonce":"0x0","yParity":"0x1","r":"0x6d293ccb516bf68ed7cff2cab88e814966dd6f6ff36edd714d375fc24546aeba","s":"0x63370c927d6d079139328ea35d674929ab9570ef1bbf68bfa1e9be997a6b0253"}],"v":"0x1","r":"0xc23ff262f8b5c75f6c27b2799ed066aa75d27d69906a40bf5a2e10c0d669ed7f","s":"0x759cfc38fb81281477014189e19f9f165f82a20e56c83f2813ef8e20a744b867","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x96ab4d97881732824d2ad894dacc17a547b2407b7a408e13196f52f4c32c43f9","input":"0x","nonce":"0x124","to":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","transactionIndex":"0x1f","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xe83bdf2111be9cf5ada368d13a9182ea6ccae4c92f3bffa560f51c3d3a930171","s":"0x133c0fb709b2e0e5ac59ed5e7229e26a1dbc94f000dab468f98f83c5bcec40f8"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x3635db7b4d54e442ddf0b6311676ce1b4b8cbd059d85f5cd5a177fcf8f8b6ec4","s":"0x1d84e3220067a61ed13b40021ea6b5d002bf38481ede5baa7d48b9c83c5c9708"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x14983e7e498756f561b7c224e03a0a20772050fce83c23ffb8d9f8dd375648b6","s":"0x220267959973e61181dfa9660a82c1d6fd51246406dacf6ab7754d5e80b6413a"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x78827c5f3a34ea9117e33b8618d6fcd869e62fefb88d8f0c76e1f292acf39291","s":"0x73f3e8117aca4288650d6f367106e3edd92f0cc5abad9a2037580e0bcc40887d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xd2b72d68a38c523cf8a2634be33d82f9f5e2591dacc9aa272fae13a4abb5c6c","s":"0x34dd1c56261ee45df20c9d0af4ad5badb9bebfb41bdfb28b19114cb9951f09f4"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc859b5e259d31cbb9a2221dd64e0012da2f45221e1f1c012904604117ab825db","s":"0x40b07e9a173cff09393afae16cda7e3d9d2ce0b8b02599f9dae50a87b5be0f00"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x104e2108d6f5ee9434839a11663c0dab3d6e0f5f8e85f4a5639e0126db91b9d5","s":"0x6049a338cb934f54dd44d87244d727812a3395ff632033e096f5af40183526a9"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x207114f9ced1345c5be18842807a9286d9c3d25e5016671e007774b611223c65","s":"0x6b73d1d7d135404fd5bb179ff264539858c9b9dffc38035f18684604f3f7849d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xb07bd17e0787523b5dfa0d87da640440eeddf05972c4322eca921f41de206b0","s":"0x73ddd28e019bf7d46150e6f49d013169cd52c4155c3924c84f21272b493c39ad"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc7740ea10bb19ff26be23f54d4f6e7557e1b3d2a0e4888c8bc1c72ca91fc14c9","s":"0x2478f830f47050c9a6ae80858762762c70604768a25108047c4937f237d13ae6"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xafd9ed8f3c99596160870a32a551b73fccee63bbe87b2c395777632814fef87e","s":"0x33daac653723a6dd377b03cea0be21b0992b21ebf628a5eaf69ad09a2b61b299"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xfba3146333b5fe1494b4d736be1d77280d7c251843f33298f50f6007d82524f5","s":"0x5b10ba35b11c78805a754491db1db0f6a26fd88f5c79c0652224f47e3ece6bab"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xdff137a6620e6cd1fe2a9a872f8b209af8f2ec52d8a755c6b5ec658b531ebcdd","s":"0x28a032cdace93183789604d878cd057fd4dee8c0d01a99753cd70898843c8291"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xe772f7a5537803eacc0de4f4f19c2edd94eda1a484cb08421da821334c2ed029","s":"0xbfb2709166e460c9d00352250507422cb3e358d5c4bdc90c377293f8425104b"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xa29c20d9ad2e3a3fbb28496b6ed573927f9503411716c25c5c0eb4582028299d","s":"0x1ab3de5aec82eabbfb7ae8ccb6dc552eb6c08f86eb4e19a2f698e22c93a6dcf5"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x46147301e5ac0bf4ad4c0114c853be2d3882db0a32d62e5162b913f5c4855df2","s":"0x389fa25d3f272da262be47702f5a8b19c9dccf22fd61590bab2ec37a902dcfa"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x54314bdd6792e2bc2d1a0c5fc00d6abc73fd4ac2b76100f7f6c3d3707bf39667","s":"0x250f24209069b9c6cbb6098b608ce7c61530370b922c2fa5ebbfb9c0dbc3255c"}],"v":"0x0","r":"0xb5a0ef06b1645b2df6e7deb53591dc306e056e64755d7d01c4a601c4ed636298","s":"0x58606ac4f981eb780687ab1e7ba25371cea0bb48b6f2eac32563c85f62a9e7b0","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5186ac1b71b7eb0ac92d756d1983b20c3f9de7564a7e96bebc3fb1d79e13cc3b","input":"0x","nonce":"0x124","to":"0x293f2460cebdc5de195af3edc173e5d42b438532","transactionIndex":"0x20","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xd5c27e980b8a1ab07cbe2739badbdba6e7c56c6c8d07aa2120c74c6de6c7cb4b","s":"0x19daa3215e05d602cec58953087b0fcd918a67ef1f3e43e8bece598235a95ee6"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xb15f9ab1b23af74b7d106108f77dd476595309525ed260de3794466257ed5aba","s":"0x17da86d18abf4951027e2848a58a28da1fd568614e0da442f441b73ceaa07882"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xef22115063dfb780b9bb74afbd3cfc1a986f4a7f49786d1aa8cfbc88ced04f9e","s":"0x5d32e0d218b19bc68037b21a3694fd96cbd0c3ffe419d8d0f12e07933eaab0cd"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x96b21f3a0d6ca839728334af7c63e629e7ef3dd4273b45b19c87d576fff3afa","s":"0x3fd7a11b7ca3a8bf788b019021957371c7c76fdb5f88ab0b39cc2f8e750820b9"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x53f02d528948075781f2ec67d1dd250ae98562276be499815d7cb1b83465f90d","s":"0x724f85cbba27c80b75036dccc9ea6a805967fea7a0a6ecd0a44ddcd2a8ea5d40"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xb5b495ce281fa7fef320eb9e8c08bc8109a2a8074d21f875a412345e62407ae","s":"0x3ae22244776c301faa965c23d129e657414bdfeb256c1fca85fd34b792e17fa6"}],"v":"0x0","r":"0x74b60296bbc5cc92ec0a9ffe02a5f2dbf11f09e94da0e3ae63668e0165bfcde9","s":"0x24c588a57cca4a6c509db9488ff17542747245366c84e8d1c2c691d2a8396b81","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbd789003b95829d7c77c2693e1040a564844da28bb1e918b1d07d35a3d7878bf","input":"0x","nonce":"0x124","to":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","transactionIndex":"0x21","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x48826f0d31c55d4a1503a8c5231d2b1df8a61af32b4867b786d87825eedd505","s":"0x7b824aca70c8d966fde0b84d476a2b9fa7dcfd8fac392f53fe50e38ea4c73e61"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x461d5f259bf0a2370455053d26ec36a99a6b5dee48da563e90ff774f0bc9d3d2","s":"0x19a53538afdbdce3536387800cbe04078bb689295bbbe7e234d7b87fce613f14"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0x67d3a60bc88b9892d3b6252f7715cffba4c50aba131cab5fa268ef1ebc490f7d","s":"0x79980999bb402d451a3cf90641e8e8bc79dda5d96833692ead85fcd253de8e23"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x9516a5d7a14931f0070036cc0681017f9801857a7cf5f97e7b87e3f9dfac9edd","s":"0x3abb63aa54907af22fd642184cba1b02bc05722c23ffe6b9c71edb63566bbe3a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x381a81298ddd37b8bbf70291d30da343a6dc8f3743d909b95de85499276748b0","s":"0x771e67447d7886c5e2f1a13c89233b895a234d71e284124e44f93d390afd0566"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xa4a689358968d7d8a7e5aeec98f37069cda073066f1a1273db00fc90e3145519","s":"0x6b9c302206b7a8cab87bc5d4136d988769180a9d9eccb4dc406204ea55a40842"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x2cac5ff558e2706350ff9b70bcb28fc6970dd8632e5103b6fe5e2553fef8b3b8","s":"0x5b5fb6a3a66be0b4c68927f1cc8d1ac52559c5b7f01c038c3db5302853720a9a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x862c9f862bef2a22bd3677eee58b47ab44f78431d7a8b0b288a5f755df7ce75e","s":"0x10d09f9abbe644ade0db8403b0b1e0cc1a856f1cc9a60eb620df6c3ef3ecc24"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6729c399e6ac51ba508145120653ff6ce66c07b516eba42037a47b02f863e7a8","s":"0x285393c657918ff81ebf4b72edb5be5f903f8e3e22c2d5a4eba85729051fe210"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x4fa026d167a7b7938bcc93151fb9318b2b74ad6a5b87501481727e00c0660687","s":"0x2bd343889ac377e4ddd92bdf20ba883bf3b93a5338ae13caae748f604076c9e5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xc06ae7e692b7b84db56887d6eb9edac7365aef64c18bdd33adee55ab4749dbd","s":"0x7840167e29e0e035b5a25eb49c4cff21ec776038acb812c0c2bbb779e62c3333"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xd7fca9269c950334cfb87a3393d107784452056ebefe4bb1d937e80a87e5d373","s":"0x225c11d044676c4613cee9c141846bf431cd4f60d92d8ab976030698f9d75585"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6495fec7a9e354caeea2bca6874e0cc56c988a310cb791594c2e2cb12a78b6f6","s":"0x6beba6fdb25be4341a98e9aa7853d349efd1f6c1719fd85d786a8249263e9ce5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xbc1f7fc16bfb89fe2fa0b9986d2ada8d1b0274879159b3145789150a156857dc","s":"0x5512237416179e5708ab7303244d072d6a69e31062ba977a21c6c78e7744868d"}],"v":"0x0","r":"0x8c32440547bd878d19978cc4400546e89c4aed37c6ad127cb2ce5e4210aaeb33","s":"0x77651f2cb2e62806d9e243d66656a615a4c6dc5e6c683d6b63a218294041223d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x293f2460cebdc5de195af3edc173e5d42b438532","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x71aa110108e9012a9ef1e54ebba79d4d508fef72b1cf4b4e31b5fa5201832d4e","input":"0x","nonce":"0x124","to":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","transactionIndex":"0x22","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x1425c0009ff73833611e17204e73816643918800806efbb01f9596285efcbbe7","s":"0x715e0711aebe77d2f7948f21d7a963399ed76df375be44272a08744c8059a298"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xb3bebdd82f299e58aa38522074bae88bcdcfde09b6e6961d79d44a61a3aad9f8","s":"0x12ab97610fef8dec7f390a3f731717d05a6d0b214e4493113d8bc79443ca16b1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x5ceab90370918652b1d148d48274b0d5280f3e4c645d15e4ba3c29ec6f28e357","s":"0x108b0a345d460e0c9d3b152b38ba8881c2ad56aa46fc611819a2d233995e236e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x7d0ad926428abb3c9bd11c4f5e41695201739b35abbe3a689a82a77fb13231d5","s":"0x541a4af7fe44fef23e122e8ee2ee835fca31ca4f6e3c1634a575fbbab4ffe96a"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x2c4257492d50ad959cb660f2e1eda9ddfba313173c2e28cd20b298658e2aa72b","s":"0x37f1abe85b6732baa9e22ebc93af3373dac2cf4ee8d5c0df9cfbe88ef56bc821"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xf9e2aa0d77d4ea79587113fd08656723fbc6891ead834a4b758aae6b7cbf90f4","s":"0x7467c7714f1bbeb2749b6741359cc3c8f2a9f1c7e24bea4e1db811d6e9d2a092"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x77f16aea7405d9ecda17c77bc4b831295f1929639683a32a13b981be2a2afb84","s":"0x6216f87bbbb43e8e45ab1582e7e82ebd2f6996a659f985b95672cab0cbead5ba"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x9832a1c1b5195ceaf4b9ad237121204d99a4811e9007a2200b1e82ff266cca4","s":"0x7a378c113e6a3d86652103b97c4d2a65575d1aade14067d86dafe2e77bbd25b7"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x92c331e4f15b86ccdf1788aff42e6800ad7ca562161e0b2f2d031aca364a52a0","s":"0x33b895dc06d806112756fa89dcbd58e5960896abb031413b4ba6f1f4a85b2892"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x47e985611e3901aad5fd6b8a2c3e3a5c910798799ea87080fc2236c9d49218f5","s":"0xb20550130cb20b73b3606431d70327956aece01702c54fdaa4363858cf053f3"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xc9aa6bcd05029439508d9da688253f61455e50530737b55c9be96d3da3ebbc5a","s":"0x3767a9cb915b9e2d5ee1d8b4367c6d4a7e136515de72ab5f3660a0b9f3e00c27"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xd5cd750c5293efa983c056a6a81a7a129fba4ec6d47bce3803e56a204e174e54","s":"0x15ccdd950db4abf3ac309b450224d1dce2ff9bc789de2f8d353045afd6be6234"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x6c7ca4936db83c03654a1049bb791c542794b4e41c6c5e67bf43236a71535833","s":"0x29b912714cef0785325f3bc374ac9e78b5434f462495e6a2d4d281147e134aa9"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xd52242b6c0a2b3d383361d1ad43c38807650664a8c683c4bab44c1f6328fea57","s":"0x271b119cebfaaf9564e273b36e56b18f280deb1d50ef08a1285d63eb631622f1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xe79170300d94b552137128485b379af15b014e80064cf84bfec9392c25c63e","s":"0x53c77b423edb0ee41ed7ed59f751f423f7c2f0a1661b6fdcd1c9f9837510199e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x91bb9192cd43729472d43a9506744052cd645ab21bcca454cbde893f6b3f19e1","s":"0x200eb8ff4e7e09bb713140b3f67edababe9d6ed59a928f385b72b2c60a03a032"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x49a37bb268043bce2c6b8d3b50d6f715059cf63073bae5b92dee918f17404531","s":"0x3b3a44a1c218f26c57e71c1c14b4c8741ac7c7c36ae9e4e32dd392d948c003bd"}],"v":"0x0","r":"0x3c7dfa6b5dd6f0e4d453330de8038be32d6704a29bebbaff664e6485a9fe263a","s":"0x5265140c2318167faeb60eae0c68839343bd5d01453dd6149f1f05d88e2fbe22","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1d009de960092a8ba788d484fbee9b4c7438721d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x8e1cd7b74960a4eaa95598461b421eff66443c3c5f88fc29c6e2f43839d72fbf","input":"0x","nonce":"0x124","to":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","transactionIndex":"0x23","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xecdb53b4a1024ca85086b37e34511569819ecbc33f2c960a2928fd2d1ba9a9b3","s":"0x51546311bf7b54d2a85adb9b08f2ba287e51cace0faeb43fa1d606cb14fd56ab"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xad1708308d9a13630eb39327f96b8af4a3305e0b8aeb5d62d2902b58b4133ac1","s":"0x48b77c7adc95239c400aeb0160ff61530fd919de95632e602d9926e9c1c4f442"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xc97b988b67ad55cf7a63673cba02b942485e2e9f542ad6df6c782a129e10f21c","s":"0x45a67e5530354d6046b76a396384e38ea288447a58afa3110e3f2841e91c9eb2"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x6bb19a57977e46f91ea8e162c51b340cb978fc9b62ee2e66e7f7556171cb9f82","s":"0x6927d7990c269ba457ffa84ce189d596fd410a79ab4881fc5f5e8fc8d6779f6d"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x372470d1f685bfb4a7ef4937511c3dfd80708485875c02fa361b482b2f439557","s":"0x2ade5799a1a9f121517193b0ba99db87c7d172eca6cdfb53c037b2258aa21135"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfb51d2c42edc120fe56609564e92ec30f140bf4056f77b765845fb56c8e6f341","s":"0x2ba7286baca5ed4b90dfebd7bd39000a7563c75756db4f85c0978dbc908d6ba3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xd279a74bf87ba2f14acd8f0bb3fcf818fc49666c775b2cd57c0fc9fe56cdc249","s":"0x32ed9d3907cee8940bc9dd7ae9aa764c8adeae20a3a37a08b35ff6908f935721"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x366e66ba2c7b096f41dc1251c40686ea8fa7d6b83b5a78a5ab05a9c365595a12","s":"0x2eb806154a01cc88744b8294eab3ee5c50b20aabc83eff6be68f8b9ac87550b6"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfa53403f8118da374d24b670394700a9a3804995c24c21e17525329d3c741c69","s":"0x3512e7b78b3adf8d49b3a7b1644e076b54a1c5dfa7dc9a945d65f3758078c3b9"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xdcaf740a589fa9a7889ca23be03458ca0d2a922bca77aa6ea24b11cee4412553","s":"0x379dc3b687ba8ef0a966e80e1caedd32302844d54bc2b812640e56d75729b592"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x7878b6cf5d4548b33339f050397f25fa982c3f680932ac5d89e28d6044e4d223","s":"0x431be9ee89fea4083eb1b48b8ac83ba69c0491f27168a4bdf90f706f9cc56780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xf8ab527fe8dad430700ba5867aa8e1a34bacaefd45e3a1a2665e3240782026cc","s":"0x5f1ace49c2e6e27810b2a98f457432b5e58f8749f23b1b6b8bd3f278a962b780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x955a6bd2b8f7cbefe8d4cf01c3321b8991478de22b2d9b458d84fee9a824a6ff","s":"0x5ddd6e2eb7ae2954cdfeda015787fbfc9efafced5afc98cbf5265a1f44300929"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xc5e7e967e2c93003206e1062cc13a19d699934b7254e29a1b244b80775df1ebb","s":"0x7cb86f003dea67728597f0ae720095e2f593ed9e615d12bf13810deaf1a053e3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xedf2958228e2ce984313e31754910bbaccf61fb6a3f7ba49cfc4d238345e862f","s":"0x5d74a5132415791b08942ff0707b870376079fbc1323c439213283441d7b1160"}],"v":"0x0","r":"0xa9a6ae3933c5f5b99b67857aef7d3ece85c911c1a107357d46738bf12ddd656a","s":"0x3dc11a9a54774d6ccd67ddd31cd9eb28679529fe6af06c33cc6469989622384d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xe665f3433c951ca6f0cd6717de9a2103a3d5f888aa56780476d87652c13db933","input":"0x","nonce":"0x124","to":"0x0667517a45f4dac84446c829438d5bf551823547","transactionIndex":"0x24","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xb0a4d13e2c0dd6062934190e764e0b7e31f4686087c2bc73b0fdfa71502db27c","s":"0x4a159aaa3c3f78b1f6a507163f7a6e170bf05853699a2448e9b39e0df5f9c855"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x89705eb6c37858e772ee964d704a1826e71cc02fb711895dc931b5c171fee9b9","s":"0x7f258027f5dc1a4c518fb6560cac7867944f586ec9cb632d4cd0c061cbb80be5"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x50b5376bf91e84a3476d0876da8aa7a8e8640cd41ca3041c2248526459bb5dd7","s":"0xa378dc6e70e93abf251310fea38c01b2703e1eb766f83aedc5c6f0d872d6896"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa1e9eef142eea1d9ccd27499541a3f7af33bff3d31a4ec4fb8ad18f7c91120b","s":"0x18a19bf7c540db1f953afeadc566021a2073dcc96e151e7008641728f8044175"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe08e2b3d66c709106b5d5fa775b5a2fcbcb6e08cf9bb5309ce1037522852dce9","s":"0x5c7178752619a4ad21b6c1b4b40a0e073f99dd01ebd2c5852b39110dfe6a98e0"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe239c2a9c490de7814d765e3a0d0f47bd54b5c4006c2c1aa7a5cb96a522b62cf","s":"0x437649fe8e842e7d88eefff3301d76bdd96ac9891b7e72fdfca51b01eed83dea"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782f06379f4971311d05b6a07f06772c56eccd11190896d7fe4475b7007715e6","s":"0xb51a80118ddc8e4e9753a5b1073bf8d05983808f80b8452c2c926e06db7aa92"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x190373b965c31e10a9cce753c5ed5eb4771581580ef1a5e2dff875372f235ae8","s":"0x505d9fc3665f7a6dff3b20609bab71d906cde01fb777bcaee622224acfde05b1"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x66fd452218a3373cdf138520a821b1ac210aae2be8861370f8bbdb0d30a0dd81","s":"0x25475f5e823b139a6ba0176835eb8edc82767be14ec57da44af02f374d00094a"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa095dd56792c466d7b3ef560cd6700681397d4107416db79ed4b7309b99d7afd","s":"0x2e8213744780496d50d60f1e275c250cc1e95190e05762acad6e8ae68dae85bf"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x84f5fb52a1bd74b82c27d357da34f3350376e1d5c748bbe0c4484316ad7c1f93","s":"0x2252e139156f5a12858d47f8d194a269ef3c61f44c962ff52e7cf4c490cfb9d8"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782213ff850398d670fdd6e456d3f7a518532e0795f1ecc44d50930763809fb8","s":"0x503a78b701db1d28a704599368541719c4d3cfc8b73e8be7d9de3f53da9b3a95"}],"v":"0x1","r":"0xc737b52363049f791a207a0fc88fd7ea81598251bf3e8304fd3cc95f04b45499","s":"0x36db01bef8c6d3ad49f79d1328e4ab7a7f5d0c3bd018c32bb1287f9faa2b518b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x4e3d7f35d4d8d2c471c2da522579143ded0c9dde4ea1c2ee515f86754f1f7f53","input":"0x7f4504101dd94d473d6f4a9788ec3b9324281e6c31cf85fdd984781be08bda0b3c6036527f7c47824e363650cd8fb520b7cfbda1e6f84b65b0bac5e8d3be7461cd23bfc4866056527f68019ce8f1f877620feeab27eee757726e14db711f2d40d028b58a10324b4386607652608f609653609460975360f86098536071609953","nonce":"0x382","to":"0x01abea29659e5e97c95107f20bb753cd3e09bbbb","transactionIndex":"0x25","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x013c95c22d3c6bd765712a821b08ec6f727c3ebd9ae66ab80c2f10f6ea485a51"],"v":"0x1","r":"0xc38319750d48ce5ce01c1d859592dbe9725b3f6444fec7d75d9bbbf36884ea46","s":"0x7f2ecc58f1bfa9f77733a6010a2023952051ba48e18a829d1ac875d338b93802","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xf91e74405a2d1786450135920460f4a4cf3ee3d3f2c443f8c07ede1eb449037b","input":"0xf4600060f95d600060b55d7fdc8c5d392fd3cdf4845998b61158fd372e6ba00d2123fe20fdeba31c66586bd76082527fdac0d26d6301f8c5d27a487cc47224b615f4753b3b6020f6f522e37700c78f7660a2527f638867b7c25da0fec6cd6cb80484642fc328ab9255efa82cc246e2bc29186d7d60c2527fe4064c68e42451f5","nonce":"0x383","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x26","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e4f926dc335bf2ac1881a1e0a4e87fc9817689c8ae322e2b06546dc6b4e507"],"v":"0x1","r":"0x635e10a0f3593b8e908c4ef490eada430171b07f8fbd3f6e9264600e1deaf34a","s":"0x4718fc67ecdbf1717830df73acc6e31bbf7ea6460e3a6d2533e2e6d4b073314b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x72e88529e8541b54356737cd6ac7d193d1fb47a1a06bd05734cb7316628f9291","input":"0x60e6497f97cf8da4c4ffd9275cf07b4969c5f06102400cacc9444dc2ed40a4b95b4c1c0960ac52609460cc5360b960cd53601360ce53600d60cf5360a860d053604060d153601360d25360f160d353607860d45360b360d553601e60d653607d60d753609b60d853603460d953607260da5360ef60db5360bb60dc5360bc60dd","nonce":"0x384","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x27","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x017d3b3a04cdded9035dd0c886c427cd23374ffdb65e5a0c2890d3d88f32c0cd"],"v":"0x1","r":"0x88c85d5702c708480ec1deb36f1f959d94205364f571a15a278359ace171fafb","s":"0x2b0f7e43fdfbdee7a36f725f7033f207a449696a66a2782bd84f0bbb835c8807","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x9ae3d4a0cac29e3282de68c9be082d8de2e426043ffccf73d3d85e7438830d85","input":"0x609763ce8242a353605663ce8242a453604563ce8242a553600063ce8242a65360d563ce8242a75360f163ce8242a8537f73764bb123a25b5d5602644fefaf149d037bd43c25899a03676602fcde9ae252607952605960995360c4609a536012609b5360da609c536039609d536070609e5323600060cb5d7ff4c43cae738591","nonce":"0x385","to":"0x7a40026a3b9a41754a95eec8c92c6b99886f440c","transactionIndex":"0x28","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01f42bc0613d994dff0c63884f74cbf6a4d9bdfcddd8290dec62f8523e5417f5"],"v":"0x0","r":"0x9c6e40ed154f497d39872c10e31c4d7b57a3a1b56a9e3fc216fa24f90015bcd2","s":"0x46993d82ff68fa4d57109bb5c901cf011065ab23b85898d553748df790f6bf19","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x1d3903f025928fd26aa342f6677e5027886c634d1af7116ec49eefcc9a06242d","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x386","to":"0x09fc772d0857550724b07b850a4323f39112aaaa","transactionIndex":"0x29","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0128ff42601c64b028404114c257fa554b2972f5586a1bad0c0fb1f9abde5dd3","0x0168dae2848c0731e74be3718137a78596e1fe5b3bcb27f769acc34ccf9547b6"],"v":"0x1","r":"0xf962b1186bbb76051e35ac3852101204040cbb2f606d237ef9f63d7149e5565f","s":"0x46c70b6c68b7c7df34b05fcc3e5ba1145b118d9091bf04923c72889ea2cd1dfd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xa95b2b346c1dfc18ee5e960f8797112c51c1ebfc449b79b3c258ecddc4162595","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x387","to":"0xc92d81729f35231d2cbddf1664e70691d1f8b389","transactionIndex":"0x2a","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0124d35a551ced9320cb1e36d1b6135b433e37782f17f9e4cd4cd0481ff69ccf"],"v":"0x1","r":"0xe27cb11819d48227ae9c5c7dc0244ec2cf1264324519401868c888fa12b0f5f","s":"0x104a33a96115659d0223755d741cd0ee8bc70055be86b5d31035dbf26ea87bcf","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc07c60993cf689438b8c85f86b0ed938dca77ea","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x3d012b0819c6846b10449300a35777d1339070539eafc6603432f49fe9ef357f","input":"0x6000603f5d600060675d7ff8be64b5f996351c8fae8b538661f73d365776ea65bb4308895112386ce885ea60d8527f57518b95fbaee3694b3cf3fc3267ae5856c6782d7176ca28ca5dcbaa7b600e3360f8527f20665b8964a6307ab36fc24f1ac3e1ca966cf4597fcff39fd82975978ef37ebd610118527f3869374f5e3d88cc","nonce":"0xcda","to":"0x056a78e32e992cdb7a24eb405ce6e92eb1d7e177","transactionIndex":"0x2b","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0145278fec3b40013f973bccecae5432fbdc1e482f58699d93989724ea69cebe"],"v":"0x1","r":"0x6cba5340d5963219def0e84f07320f2de95566bdd4c520fc33cf71348925bb61","s":"0x69a232c70e01dd35b8e6968238b6a82b66cf1aaa2e17a7afc6ea1840758a5642","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x4dc4ec6ac43c8c45777292db987203c0248e17b7","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x0628c063b4290de6eab4b6772a800215ec0e75476a9d081c114956fcc085827c","input":"0x600060af5d7fe2bbd47b2086e4cd6d29e9e3681b7dd9ad913b6e53fc4a10490fe640b7db402d60be5260d760de53607160df5360be60e053609c60e15360d460e25360fe60e35360c960e453603360e55360d960e653608160e753605860e85360ae60e953608a60ea53604f60eb53600060ec53608f60ed53609e60ee5360a6","nonce":"0xddd","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x2c","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e712f72b9f5b03cadb98dbe6892166d78051671fa088e955ef8fc8252fe753"],"v":"0x0","r":"0x276a245882a4df9bbbc5e021f382274948419eabee4ffb945ec522fa064e10a5","s":"0x3f3138d798bcf9388688817f36e63d9e0cccfaedbf3aa934afbd68931d3ff35b","yParity":"0x0"}],"transactionsRoot":"0xc5864e1053c5147b97d8f3edff27fb0c0b4e1536756ed1e3634536465ab7bd78","uncles":[],"withdrawals":[],"withdrawalsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"}`),
+			expected: byteslice("0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := test.block
+			if block == nil {
+				block = &spec.Block{}
+				require.NoError(t, json.Unmarshal(test.input, block))
+			}
+			hash, err := block.ComputeHash()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, hash[:])
+			}
+		})
+	}
+}
+
+func TestBlockVerifyHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Prague",
+			input: []byte(`{"baseFeePerGas":"0x8","blobGasUsed":"0x120000","difficulty":"0x0","excessBlobGas":"0x4140000","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0xa883d9","hash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","logsBloom":"0x00200000000000000000000080000000000000000000100000000000000000004000000000000000000000000000060000040000000010000000001000010000000000000000000010000008000000200000000000000000000000000000004000000000000000000010000000000000000000000000002000000010000000000000000000000000000000000000000000000001000000080000004000000000820000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000001000000400000000000000000000800000000000000000000000000000000000020000000000000000","miner":"0xf97e180c050e5ab072211ad2c213eb5aee4df134","mixHash":"0x46d5ce10b05421d79262c145843242090c1f7a55ef914876799ef1b09009a422","nonce":"0x0000000000000000","number":"0x57d8","parentBeaconBlockRoot":"0xcfaf54cc45943f1a1af776d6f815c7f069be2b7529c6cca4330d4979b9a0a7e8","parentHash":"0x288c8ddd13847b99d034d553f34ed061a1895a8a2ed9ad8423fcbff7752c5d11","receiptsRoot":"0xf5bdb2ec0950a0100fffd7480fceec712e0ff7c812ff85134f2325222cc73e15","requestsHash":"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xb31d","stateRoot":"0xddf65add46f097c6529129ac070f17a6858c0afe115dee292462ecdb622bff22","totalDifficulty":"0x0","timestamp":"0x67a564d0","transactions":[{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc7360b3b28cf4204268a8354dbec60720d155d2","gas":"0x249f0","gasPrice":"0x12a05f208","maxFeePerGas":"0x12a05f2000","maxPriorityFeePerGas":"0x12a05f200","hash":"0xd0941f23ed24d0f8658cdc073e84dae6fc31790835c2731d09bd8070861c0115","input":"0x0cc7326300000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000047d9073678e7bc5000000000000000000000000fdce481e976e548fb63dbba1490044c39fa68bac000000000000000000000000fc7360b3b28cf4204268a8354dbec60720d155d200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000fe03f4ce071cb8f8c405601777351c534cfd31ba0000000000000000000000009f2f86b887e060aec6464c8b2443b16a79863b29","nonce":"0x58c","to":"0x9fd1efb5b00accefc22d9f8e29e301cd4427153b","transactionIndex":"0x0","value":"0x0","type":"0x2","accessList":[],"chainId":"0x1a5887710","v":"0x0","r":"0x3da0950be7b5e06fc3b63fd5ee7e43ac8fd733b630165570e30be52db59e87d2","s":"0x7b8ecb94a4a6a84cae91290808c6e4a936cad032ac50fbb3566743033e769efe","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc278435313cc88059bf72b92952763e7fc511fb","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x83ca25c941a7f4687262a6b3204cf699bc0a2acefed262ec1162ba60810285e3","input":"0x","nonce":"0x124","to":"0x14389557e091c0fefa0686006faa1d7194692700","transactionIndex":"0x1","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb46862488912b8b80cf1b962dac36ca81f58f7fb32b5365729e96fb73e3048cd","s":"0x6783eadde8364bb04c9c758c0c4cb6481de01330d7a7114ff8a00227a65133c7"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x984984f1d9b288def2b0f977c8b4aef08833f3499965dee5c21489ec61eda76c","s":"0x3f3c408abe136b356b9d5508f9047b713bc121d84be25f242c89f1e295de0b32"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xf7ffeb622480c530b5d92367f62a00abf8db047949e2361f4637bd81552064a7","s":"0x4bbf3df5d502ee6143e039411c22d8496f1269b9934bee6c69c0c3fcc332cc7d"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5b054684e6172116f535263253d5374452cc8a7a86a08e48704d5ba3952545a","s":"0x7f647c6522cfa2933d0dc57504d74c330915df2a529b511da36dae7c3da3f637"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x9d33c2b63bd32037e3f3c87671d0d3517537a6ae4d26aeded6631d0db6e2749e","s":"0x2cd87b70912f79556822dd47f4745d996ca7ee849cf0fc4782ec983eeb5d0fd4"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xfe42e8d60c189aab22a6597c89e9048423b857a28c017850c2feb572ae66fcc1","s":"0x67bd96474b5473382efed233a690be0f62d37dc5be9abe9a556efc1930946edb"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x98324c3c17e459af076902307d3eec2899228ee8f8c7c6665a651893f9c8acc0","s":"0x36794cc01de004f32ef8ec52e2c43d93908c290750a831b1b0b481c1447e3cf"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x1af4918e6b1ad6abf73d955645f4594a10f20fd30f635e93f63e72d128792736","s":"0x360193f6e16c85f2d72e56a00ec0771201226c32ee7f61a5a561e07b6b5ba545"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x71a56816a47d905815a11df66e6294468a5ca2efe991ed7336221875351fec77","s":"0x43177fc4f34cf56b4149c49232cfb8590a468f5a1481bd80bfd6f9ffabb9b93c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x277635a8107ffdf815d3c1098bfc54656e59563643315ea019b0fe92e21518d7","s":"0x2daab5fc7c592d9b0b8989b6f5bf9ffff53f5be92d90f16f947db0859867bfa9"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xc17c007a7fd0cf3ee84318fe61c218cc927fc41a1413ab6f5f9582bb7321846b","s":"0x6b991503d0bdd91e8f779e4feea90600be262b0edd53de0a973294b661aef05"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb0de0b89b0715d1446c7bc070fb36149de5b2ceffde855b4f279324d5a1d03aa","s":"0x65c9251ada29b5ebdf9fe484095e887eb8d1bd33ab847984f0e9839b5485ca88"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x59a03174ef2b81c63933850f5404ac23f81ea9e9a526dca83bac49cd0d31b4b7","s":"0x78d58a49066e4dede7ba1bcb63fc866f3251e0b76fa39436708742b5bef8617c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x6096577b2600e966371ec2774bdb08377ebc1add92f25efe7b7ddcd994f58ca8","s":"0x35364e9d81d6ff588ad2fd13be54624e157f79a15ae8a42d940bd71c653221f2"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xe6e6672b6f979cf1efa844d3d1d7410e338720ef6c6948c240562d7a129ac76d","s":"0x30c6c8db55c5d7031ebd3da4f8774f2e3f4e6872d54de3c922ef6794f5ab445e"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xb93bdf34408a8daa1a733b2fa1b4f4fb2e8d1c89a329e1b611fd781aaa266222","s":"0x794d8f80c485dc36f28e056d6f0f549b2dcf5ce5420a54d3163736552b867671"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x6c0a05b20386534b0387590405c156eff063bb744f0a223fd733a5ef2c0bbdf4","s":"0x121196c37d4dff2aec8b39e98eccc14278e1603d86a38f31d4aa28aac0469add"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5208108df9d9dcbdde4771a4e552880519e89fdee7b6a2376a1a69b549eb9c0a","s":"0x103d3c4326a329a0a2023d1d21e01b3584619b3fb26a650ca16e0ad12d7dca0c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x10bf5e96b18d93fa08785058802b9ebd73da629257ae8a610d85f49ce67293d7","s":"0x6d7b18913b7887704af90bbba9a2578320e18ddf1eb158509147e7b6fccd50ea"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xbcf676b4d06a20998d60f199ebc5a658d6ecbb86fce2468c239b65d246e4e8ee","s":"0x177e0b39d98c7e6d8dc3927143714d52da8f5a0cc201ced9f5e7cc08751f58ab"}],"v":"0x0","r":"0xab23d457ab10e64e07d8f86745b8a3275696493a751a5aaeeb2ab97fb73f1a33","s":"0x7fe80b9a92f47c188c656345837e0f08b7002a931e1bd88a9a431df75a536921","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6b912a710ddaa0674f35482ccd0ac1fbcb827b102c2b40d8913611f547d1ac8f","input":"0x","nonce":"0x124","to":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","transactionIndex":"0x2","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x2fb0f500a26ff479d45f91901d9e4c3b709e4b137ed18ad8f46660e897596cbc","s":"0x515e5c1b9075f12b25d2b10bb51092e0659998ca32fdca7441d2612c7b355446"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x6dabb166f024ac6d303cbaede417360c8a094e5ea5950b4c0941efb1cf063bfb","s":"0x7d9910fe04727b3e21358885f17e64b59546f94de8f0fbe635e972a974465d82"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xee03fd40b8608865c83859ada692acbc31962c1db631c3b0dceff740ca9f80a2","s":"0x4ad25a9e85acb9794d8fd9825a0d36b978f372f0057da6c41412e7d31caef698"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x8f6fa3d4eb755f03bb664eeb04821c3dee602a197138e465c3a187e425202e8f","s":"0x2a80db1cf181b3ad80ae1e60aa770f2cc37baa790769afc776cff4905eaf58f"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x4a41d3482ad3ba541e3c1746c9cadf7dca3a9880bf54bf3c631015a1610e65f5","s":"0x6037c67b5571447b0cd2c4c20240374e534814883f5501b7a4ff253347d4a1d4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x90f07268c5623cbdf4a0955f2da64b3da7fce62391e9d0d5f793e6527213f626","s":"0x1145b1e36cb035daf63c2d2c139b68a9196d058e68ffccfa775698680e5b5d36"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xcab35aa41191cabcf343e0f17e356b1f24f2ab38522f582c2292d206328303ac","s":"0x22e4f1e4772fb614568872834766184e227df96d943995b15bf9860c030c1142"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x62ba9a793e35edd6023329f1685becc70445e6e0a0357d47d80fd20698b78c5a","s":"0x66e04dbcf3059f61a5a7554351cb46fd808cddb73087473a244d24a93991e23a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x762d7cde0dad47bbadcdc1b45b7fa7267fddc87384674242c57af69b856d23a2","s":"0x54f313e29893da99d2e889180b92bc9bac0ce9a23eed80b5a2810d4b28550a3a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x52b644194ebe373c6b015ff4ec6c6c2c06eb8380b9e1a53797e34d66e75a8941","s":"0x6941e9997ad2e7faae99b0d3836b76eef4092a3f0d93c12e92125b4ad2671d63"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x7c414d1ba25dace23e90c31152d8684f9a62f226d7c0c56eadd3d5de6b2e97c0","s":"0x19c2dd9e626f1ff33912c871c451d3619c572ca6c32694fa18cd3bb1dcdd9ec4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa464baeae4585545171839acb0b7790f477f709e0e3db73615667dda3b7e6895","s":"0x3033fd898f2003378f21a0f39c0135099171bd06692620890a91e445d803f153"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x989f8c2c93b8835252cc7178c1dbe399aa0aa24917b53ea2f08c0117acd6dedc","s":"0x286017ed0dd164d9ffeb48fdc4ef3157b6c5581e8b57ed409a5a75db2b6cf960"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x89ad23c01a8782d83237c6cdfcdce4834a6bddaee503295a9f11e04079d28276","s":"0x49dc77bcfe9cbff0a35eef1a4dc01d7a64f4a600db263250fe6592de2ac7c062"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xfc2af35a166eda16e917de8ca5d6f078a23c22471bd6585aa7c9baf8396e868f","s":"0x56b1ba14f27dcebf3df9c8b205141c3fc51d42cfe565e9ab57c5cd6de58984db"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xe38dedd1cb7701a28d1600a5ae800da0393bc794c961f63e02e1c57393dd7aa2","s":"0x52650e8492f039c7abd9f6b52c2c212c2e6cac3ebfb8165d3c67ad1be18458ee"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa4764f690f9073d956dc96f0297fca58abcaa8159769dcc4054c3c89dfdabfb6","s":"0x59e79b1ae93134cf083ba968e486f775c9a915153ae992d667fa6ca70b9e85fd"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xb4d133a5caf3105429669d4d6c8062c3275749f22bc3761e33154da60784d98d","s":"0x2c008f662e741e49d51bed591dfa712c8d6401c9dcc7708e0702ff822cf011e2"}],"v":"0x1","r":"0x861ca08ce34e3496853a9198f249594a12917c711d28623e45ff4327b1975ffa","s":"0x311738727aab3d3fe4d2ddbe3e66b3b97cfd4f70d9fbc22e227187d04625d478","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdde31aa5d18352412bc18514578e35d4f930330a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xde70e26cc76958d449439f20cf388e1563a92ced3d7a21ceedb0efc74acbe68e","input":"0x","nonce":"0x124","to":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","transactionIndex":"0x3","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0x6c218235fc2e2a56e8da0159ef8cce3ccef7211175358faf111d205473271e65","s":"0x44b9747b97fbc6318cfbc0fc92f037e8f0c2ac8f9f30bb8ef18b1208ff416e2f"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xf76f3408029b65a75f24817d6939089e4ed89bb58379df9e356153267cfe6243","s":"0x39641ced57869b44c873d2781116bff66839135aeaf4f05a9dc8c329a334d297"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xc3552a3f2eba4761ea5b4bebb592c61aa7f3d6e3d559ab229a7fb30751acdfe9","s":"0x47cc26afe7d02edbc81d5e4d62ae98b707bf2e7680ad7c609c707c593bd367ee"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xf49a4ed94c24adffe8e348804d1a82f069b11e9c4c02cbed0d5a0a0eb4c2a9de","s":"0x417ab689954888142c3d15b7bff1c583024c830f6e80f7e558c29ed95c399d0c"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xd4468d91e2db223d5f2c320227303f5774db257f1f45adee379b6151193c2535","s":"0x126cb8bf74832677730a7d9c6a22ba2cf87264b6b3ce21f10600a318fd5278c2"}],"v":"0x0","r":"0x188362980176a156196ee342b194cc47a92484bcbb70e70f1bb45ecbace2aca1","s":"0x236c1d4692713d8aa8a0ccb9e8e5b74456b6b2812ee4a2cc979bf66c5637c19c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xea9fb0c050f24a6b3d8ca9882012504da7fa89fe97b97d07f68cd8c5e3c8c03f","input":"0x","nonce":"0x124","to":"0xa297e3e19b9f0c10a353e31027c8812524866b17","transactionIndex":"0x4","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xe41e964a9d93f641fea6b59384e003433cf2d81f472a517598f439e931f2b550","s":"0x2d706a2470cda0098dc168ca90cdadf7f3f136015f57f1cc028ddc3b6b8c4566"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xd84a7c60987588cc72998c7899739f7c03bc782d19a09a574a461792ec045351","s":"0x6e23cf84b5d5aba745b9e6a3458102da35f565991757e16f74f31cff07a59bb2"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x7ba6ca4de1e290e6f9c2ed6d8cd22990b0eef85f0aec1d55a47075f17160ad12","s":"0x506516c08141b564e2613ed0027b211f4aea47372ddc8182f3e8cbade58b9df5"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xb578f9c04c87fc25139d95fa0f04eeb688a3f38945e25d9c542ab3024b5e0648","s":"0x743247c67c15a390fdbb19e8d048a6777a39c5ddb10a51771a42159cd1b3dc7c"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xab4fa6539aee07d55767ce4f5c13f3552ed4d8426ec7742501e984952e6c2548","s":"0x3416040964d0331367b677528ffa32a9b06e9313a416dd360156071776367125"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x322230c26d14a4fd0d25c86825c0dbeb35f14106ed3c40b90c7038311767be22","s":"0x5abef2e177fa4c3c89f12493a55b8a7f99ff73b105a3328cfd3b3acc09a1f19e"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x321ed70b45da33340940c33af3ed83cafc1f369983f2e9ce7c332a3772c4755e","s":"0xeeb3146f56c94c6a1350ae212c762e430934ceed3013cec0b3f01c44daaac04"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x42d50a79d86b3ad832f220c2e5c506db892169f13c5eb00b5994cc7b1b06aff","s":"0x2b73ac3f7df5b835a501ac9dc42723c2d187c7216a618b7bfbbb5d6ca46d8f83"}],"v":"0x0","r":"0xa049bdef669b6a623ad00b3eb7d23f534f7f22e86a7ae7d74faa30a481025a18","s":"0x74693d149983c4d3232aba8b468f2e59c737d69ee629de44ecfb61e85e404ff8","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x747c8db37b09fc1651bd2da905887b4dfd60ab43fcc6d443d836860f2ac22fd1","input":"0x","nonce":"0x124","to":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","transactionIndex":"0x5","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xd89128e7d4da948a804cfea969af6b66ea66778d0166f09afee1841641cce19","s":"0x1bf717534ac82a72dec75add16b7ba23661b16a4f1de80384dd62cfb02eff7fd"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x792755753a73515512c7cef3e4299130295bb7e19b524fda816a52314390efc7","s":"0x6140ed97a596937025f6a77f399fcc04cfd4655f62abcf97a04f74201e92890a"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x8882f26c24748ce509b9ba23950ffede09466ef9c981c332fa6938a8871c8d78","s":"0x8cd03ca8189b4b9c2cba9b2b29bd8b2968cc0f3e06fb13eebe077f3785b1f74"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xe69e367bf478ae784a8dca97518e7634da068b6e1a2b5c2f0b2487526c77970a","s":"0x521227f189b29418559f778949c9290177bcdbcb989197d6af4b9cd4dc3d2fba"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xc9a476e2a9a0450c5f5d71ffaef61a6a5bec7acc5883d2c059847c1374234234","s":"0x500e96554604212483df4a2aeb82b4c494711475c4a3d611abf09a5be217a4a5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x6f6e81faf5cc88cae893193e8f237b89c513983cde1c8e896f3eedf199aea426","s":"0x2db949a612d270b8634f10bb7ee2262f2bc98effe164912d431ab004e085428f"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x69d68a1167245246fc964d21aa9edbdf0c88f9faee91f7e516af2ae1a27bf69d","s":"0x5f7d7f0191166cdce2ba9dc646586051255c6d9c0b88ceb868160c7e2c3d1bda"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x78f316c4c823ed5caa1542345e7e3f877d8c58c0251f90fd3d82d852dbee78d8","s":"0x42e958ce75ea0ac80e17c0d5d888f2f9cc88b33d3c25b64e5d0ed2e9cb7e7751"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x8159cc6840cda3e7c8392ed5731cfddeef79317d2805cfed714cf191818f0894","s":"0x2e28ba90df19df298a76add7afa976e110336d0e91b8770a87efdb7f99ae55a9"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xaf014ae62b12f36888e1578d75cbeba5ddaa1dd0b179d748ebc11a135ca80c76","s":"0x18c1031265d12e3e4c74e5521771110872f7166870c6fd3fd6d7caa8a090dba5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xbce407f30ae5a667ac9d89a371189eee81d0e004852f4f8d456254ca7ccdad42","s":"0x342070cbb05653294208d852f3e69bed4b1fce129136bcf53bef83626fd4e05"}],"v":"0x1","r":"0x3da28822e8d87e08e5a8087d668975b9c2c2a512e3b28437a50fa621f0cee12e","s":"0xe7390d0ae737ec8836ededdaa0d332c8a9661abcd8e03c75f0f3a3fdb332926","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xf4873b4f63a61f54f6f0df95379191cce01c5b88cb02cc80d1686177be74f655","input":"0x","nonce":"0x124","to":"0xfc278435313cc88059bf72b92952763e7fc511fb","transactionIndex":"0x6","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x14580b1d14220ab37f47d1a2957e05333b625475091d4c60b8b60bc2b2cdb15f","s":"0x572a2bb7ec090f3a0bbe7304fe32724a984be1476477372c1670bdab11e13666"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd5ac931db7893cabb2bbf881c0186be1f77cd55aefc565bc849318f4570087f8","s":"0x1041dd9917e9f3de03131858311ff8c5ffa7efc8d6b8eb9e91c58f981be78218"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x478f0a9195c71be7354e637b7df8735fc29737a379fe622d1d7a90675610cdd3","s":"0x25d179d1838668f6b95e0753cbc0358a43d226abe193fca189400666edc66580"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x7d8f9ff3e6798ec2ec011e15eb74272f236646021e8c378f74c2113580d20b2c","s":"0x51933a7bc83b100b1be51ce28384f11348818f5f05c079f37ff0d9e9faecc50f"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xa93e97a75b1ba28336aa1894906084baf4ba33ab9ee740808bc6c39b8db9f411","s":"0x72d149a99d8207b3145382b177f3c201a2da0d5cd1efb9956c2b8e7d3248bc54"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x84c7f8d7260b7615c84d21463f19749c332cc015fb66b670ce7fb6fc41e05d4d","s":"0x76b887e8a5c05da9b7c2d235592d63656ff0cdc150ca7c2057742ed76cb30c7d"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xeba0993d1a5a5f7046799101c3bbf2ea7c2ff62d6186a79a1979f4a3dee1ef2a","s":"0x7788976a503bb0e3b3b4a0823773bc0c5e8a76ea693d9581243c6b27cb13cc63"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xb3486a9a3c30bb97bec2738518f5cc70149ec34bbe22f6ae57f92d08bc188cf6","s":"0x5a402ed50fe6b9c02074070f90663528cf1792e74cb6b9ca8c91c220eaa20c81"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xcb54fa13255b6fb876379ae6ac134b27d6038b75026a2281d7cf4f655791cabb","s":"0x637c0b429c5cdf3f5646076b7ab3de437df6d37cbfc07dec04f89634fbac9a11"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd7c8916557d4c5cccad35510027f007deea3ab48cbf92a2d741bbe1b76cb9e7d","s":"0x6731d146798c8f21cbbbd9cf2122970f6d87b24bc54c29ea1d0ec457878218c2"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xaba8881bded36f752dd8106572322c31154b1e3c35dfc3903949baea6f0b0cd7","s":"0x5f8985f2d32b9f98d2ed28cf804e2a6efd86e21fab7d563c1e42207c45880865"}],"v":"0x1","r":"0x95b169ff9ea5f8ffe0f8ff89d51aae7b21eb6d9d7df5b17125f2448b0ffbcb92","s":"0x569cd3856f38e8d8be277ab01d638b623da34b60affd7143bd38ed8b748e1303","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5730525874e27b91760cdb3e062ad1f07f765a13","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x549850cc50a38ff663a175c2820a62182842fa4c2cca4c7b87f4206a501d2bb5","input":"0x","nonce":"0x124","to":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","transactionIndex":"0x7","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x2b72ba828d7237c05e1da2d9c9e887e39459bc1dafa19af2ede676abd2b0fd4a","s":"0xab9f523194411a26ea016bc29627e9458244eeff231a6c177b269331f22a0e7"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x671fc0368792ee4ad2a9909bffbef8a6c90a6751c5fbdf2cf34db8b7cfc10650","s":"0xa3017d994425c7be67a7f30607c9d0d6139ef97577efe1df418443052e0a840"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x4b9272f7729519d5bf5a0cbe8b641d86a2860920f586fc475a8b7e5e3de1c7e3","s":"0x5cf2da66e064d5a644c829babda93ca1a9a90cc9cd078ffc1e6886c8964ab99d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x79450484d638cd26659f8b348abe86d36ebe7e2b20453ccd17993c88097e61d1","s":"0x48bb3534bc75cb0f66c010e2e6f274ebacf2f77273c742eab0f302d6002b0a1d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0xf2eb00af4393794d99e1bdb5aa794109c5009b143c5b9ef7b5f25cb431e4486f","s":"0x3a2acb096c4979b6ebe243ab802565c1ca3bce36d34c352d205096d766dea9fe"}],"v":"0x0","r":"0x77c2f1f466e6cb0c836b6af2c3ae9b51867bafabe2983c5cf1a36a39fef83db4","s":"0x51a0c6d64665801a0b1c9f2c5d98e9bab4bed1bf5cd4f6ceed0ce9ebfe392125","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x944baf51880dec2dffac20795a733beaa8cbaf8f4fa4069d734db4fc0df43292","input":"0x","nonce":"0x124","to":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","transactionIndex":"0x8","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x6dd9fcb081f011480e55b256c8843d8aa4a73f157bb661850ff0ced587fa6bd3","s":"0x33fcd98b05d68689e93f0f4dc644d0dccc058e6215eef58828ef0b6fbf355aea"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xff093565560802560afe3f4a365dd147b6c14a7c4d4236b5eb69be96d1942733","s":"0x4f5c91482ba58beccfdc5e827b59d92655042f4479127f0460bfcdac900d6977"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0xad2818051f023041ed65ba3bb3a531c91018b33985b67ae0cdddaad2a84f314b","s":"0x1bff4f3b5e53798a3dc72ac8eb06c4df82de4b4cd06c8583e1c2167ed881d884"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x659e40f59b329fffb43cac91a41d514fe723618b3743ba60bb6732a09ce3e42c","s":"0x5931bc76e0f6e898d8280023b9cc170b4ca5aa95bd676c963a8f9017e0a8f81c"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x97be95b79e05c135bca45de3be1be6610118446e559804012265dcb5a00048d8","s":"0x642eed1c78e75aa87b84a2c0a6b23e85d758f342d1ce246d62846ee87837a9b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xfa1e41907a6ddd38b3de57d75e591a0815e235edb8f594298a43afac8b198fd8","s":"0x7e22c62f9e81255d80c0fb59491042fe89a6ea8acfbaa42a92318cf022e9f59b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x3b81f43cffc258e6786db6f49e327cae4d5187864d88cab50a0e75865e2c13dc","s":"0x1ad669da15ed39a10ca228d152fdc61c2d8189e27023a66f785bd03dee78a923"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x46afeee0da0a1177dcc7d99ea7a7b242ba699e8e7dc1b011a94ff05d415c9183","s":"0x4fd428b80a2fcac859a361279f6a7f42684e3b72303c0fb4aa9e8ae4d78d2eb1"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x3963b8a049775b83b651ff62520ef775227fdfe5a7f545b7077ab63ea1cd493","s":"0x4207deaefae172e6a33c47903079548cae543c71d31b57c2e63abfaa3611e370"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x842fef4ab07067c98d92015cc2203874b6fb0ac77af34a89b853dcd8bd986dad","s":"0x1a77b21e66e49b39db8bda121dbc65941109b4d43922956c1cfcbdb9715e4110"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x27ffa1c38d942cf973781a509b78052dbf9611849c0a75d9a82d117ec6cb1496","s":"0x7abd6f8c940a11d65a65ed80c3524657358fbc52e73a2c1d003bdbbaf2dff61d"}],"v":"0x0","r":"0x5ed58adc5a0800fb61a7de7fb49d326a0d4d9d4fb7f48b13305ee98b76d3d249","s":"0x2e2f988ab0c142391d5924207a298a552ee821c2d720b6c6e3a1eca5969f234c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6e7ff1f5e608f2adbf14a1bb0e342896a4e7db0d1692e2d83544aabe069436c8","input":"0x","nonce":"0x124","to":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","transactionIndex":"0x9","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x71f5dab9c79640d0614f68b4bc48aeae1739cb0828fa41758a7e7dcd84ba6637","s":"0x131230fd8abe8bf20a7ae43aa10454f0b24e9f4e61483a35d04303675fe24b74"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x6e5f7c31b904108691cda599fc68d841e2349116b611f61dc09269f53b73e514","s":"0x180e34a9d12d787176f5b35c7bc8618656fdc3ce30c3e15762c66938d0f9a709"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x1de9055101e4169121140b923d0fd88b2e5762dc07a8ed6c975a02dc5d3e6d7b","s":"0x5ba2dcb548dfca60bea45fde9944a559b595ae0df8cb74eb7efc704418a92c02"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x3f69c3a47358af6416fae244a42122ef451a001febdf273583a4fd2052cc4b91","s":"0x6af4be94470ff163b42313841201c27dc470144d2009259cd2c095a92be075a7"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x9fcd780d44e52971ba4ff67934bca8c3e3be6a1446dbeb2de065265116531231","s":"0x14b199e6eb7893b6d25acb2dd61a0243a71615172f4c2ce9916998cc9dcc63a6"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x21b8436a44096f91f5e86a049105acd3d1078636ab63de41c66fa2f82eabec4a","s":"0x6490235fa59cec9090a0f8604aa41eb5c0b20fba7b0222381a811dc18841b90e"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x7055abf5fece0f6af0914e82cbc27eca18e65842bcbac1fc55ad1cdbdc39257d","s":"0x1e171c7ab684dcc06f578cd14ee540dd3957a6b9ae9a725229cd2e8716915a57"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0xb8c14ffddb9ed10e0bd93673ce2d18f6f0331b21c9f592a7a7ab275ef975eedf","s":"0x4fcc16b08c11f60498732acf79b68974446bf5cf2c5a92c0f44989f583a7784d"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0xc22279bde1e7392fe4d9c06a195183f95b0a21851e21461fa23c9e4c35b6b80d","s":"0x241aea70adacf51f1df6ba619e2abd4e6a4d79edd409c044895950f050308765"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x25adf12af5af8678c2cd27f669441fbdc02ecd617d9a9a545d3dcc450d044597","s":"0x58f99850c42f6932d0d4e3a4451f57ea13d5c6cb0eb5d215e2fd79084867e9c1"}],"v":"0x1","r":"0xb865e8305145843c1c37e53d0c2f9603700adf76673fb9b5e449ef03daf9916","s":"0x75eed1b9a9b78b12782d0c122dd4142e718e80ec9a5282d0066437059f3b32fa","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1bb4dc8e5e6e43b1cec5975247a20b28393db65b12c09993da7cde330b12e505","input":"0x","nonce":"0x124","to":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","transactionIndex":"0xa","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x63ee6586a0f7f87253544c6555b73f601160cf2093163f276a94df4824dddfc7","s":"0x15a53cc110bb6d38266b381b8d37444616f1ae13f0fe3c892e9cd4e32fbcc066"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xb2d55b412817e7dd7798c20ecca23e563cbefe0da79544132fe3090d8fa40810","s":"0x6745de56399ec82d74cfc9c008f44c35bb7d35308bb33a8cf8a5e74fed286fa7"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf579befedfb6885c6e5dbf7b76f8a394c948fe937901611d68c09abf627f4d36","s":"0x4ff96b496176be32f1b901b1c772764bad06443c07626df9b8bdac3fea7bde88"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd476bcc5246d8b00de1a9ee377b239eaebb178a2fc1562aa52ab547dd7b73e6d","s":"0x790a541bd219ee8d89b5f1940b8be3e2f203b9703197ca45a6f3f0305e2bb57f"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x6b7dda671ee44268e43df49ded9d652ff958f40e56090f7a7e569f75852e6d86","s":"0x5d9fc4ca74bd06e0a740ffa0b232fd99a339cd9769ef29f9613d4f92e445f7ca"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd5d36921ae371e4e800c3d591bb6ea1c12c3b8e475a369c12cd27403de2cd455","s":"0x2275dce73e580c25be916a4c5977cddd185ff7111b1acb79bdc260691f7f58f8"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x803656045a9387a874f69ffbd4769a469eaae93fcc4ee8ced7c5eb0dc90ee041","s":"0x16f90c03fa8232ffac123e64b4294c2dd5aa7704dda5319d768c63cd6af00dcf"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x2c08e2d5b396dcdc586a4959337fc21bff6b1a1f6bf931182f135a56c1cd0a48","s":"0x2029fda69775b5d66c6525b2856a98465160f36dfea7c916672777cf90b8c8b6"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xd6580858befbc110bbd5b1d503dc2962b278fd4ad79b9f4419d1a03b641e38a7","s":"0x40c8babcb0f82f89cabac329008c1698a2d92d92da7bde75fb508493fe84a935"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x3a94ca8871c4051fa5abe3172fa1de359b4a521e44543a6c9461f929c27a12e3","s":"0x35e0c91f1e5f5314b3e9cd64b460904142fc0448ed20d0663f39cfa2f3812add"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf1217f4ef791d8889e3e61dc04d921a989657e941a0530e2dcc24aa917a1dfcd","s":"0x576487f5e47773615a7d5365d03783ad26c20ac737e018dfe0ec7e6c58ad8e82"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf6a227860daf114043df1513b45fce4b80ad9e586002a807358ed4c476561068","s":"0x583fa9a460188c75c9bf81d607146a6805dddecdbe1b4f8f15187eeb978f70be"}],"v":"0x0","r":"0xabb57e0eeb8353dfa360439470fe10baac99831e2cbcfca6f2e3fe7a9fbdb819","s":"0x6cf8ab5fafdc4c3b8f5efd0245090b6a183450d9666ce29297aa86118ed19e88","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xaa1353811eac986e421798c71ce8aa347e85b8bd161ea2ce5d7f2ba92091a27e","input":"0x","nonce":"0x124","to":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","transactionIndex":"0xb","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0xcdd6d1acc2e22cbaea497282e3a23b906b519cdbd70df3a847857885bae9527","s":"0x57855905bff3132a26c933f8d7157444f46fac46562912a06d09c0b0f7c898e0"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x8368c396719ed1d968da7aa320d5ea57a402be7ea799d6ae75f73100e116973d","s":"0x85c06dd3f8e7b796ba647dfbce63360fdf8cc146378e8f8202e528a01044ada"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x37f200f7115a99491862674c73b4d7d2643b0ff0c6909c42decaea89218203f4","s":"0x571b7f51da0497876a0d8948db5283436a444b0b0dbac3c635f55e31dc9e0176"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0xb5b11efd0a7a6c3678b9f3edd55b1bae695d3457fd6462f3ec8966c7c9843b0e","s":"0x145f337cc0af31974c604a38c0b09dd238808c29c1ae8cdea585d784fed4b868"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x47e102902dda58ed1e52101e68d4c821bb87ffd78b4bda7b03e64626b5c21df4","s":"0x151d7172416cf2a43a8608dbbc6f20969d5d623de98fe9a7059ec89e1735a6db"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x3c1423f73dbf041b54c693c0b9785c5b9f5599c6daf133f00c31e8dbd3a091d9","s":"0x13610156ec380447f489a2de283c8d09ccf07f74aaa7a872c7b23c731c0302a2"}],"v":"0x0","r":"0x60108b1e650cd0447d588489757d0c8feb688d1151524d88a468f17d7966bca8","s":"0x5c02442af25ef82919b38682bc5949c6ea8e934ac173eaef5283a5528c96f574","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x4711a02ed9c748cbfbfa536990a0de078d753f96d7b0893283a055d3c522bfd1","input":"0x","nonce":"0x124","to":"0x51f8ed66be313155f74c4bedd3b6642ae3440927","transactionIndex":"0xc","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x15b49afe17b4738354ec6b03f44d22d18a8ee7136865b162779f7beff1c1b345","s":"0x2443fd90df0e948179f7c0a96cd373005e89658d0e83bac5e515c35e2b4f8d77"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x27bb209d55da08ec141cf3f8ee8b1d037301a26faf50e1b928860f96e68f171c","s":"0x7e755fc4d5552955ae486aa5c9cc76b5ca7f9fc713203cb5d8a9d2eec334b6a4"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x53e207f7c204e7fbcb7ddd052e40bc3d019ecd5b36e19843518704a0dba8d60a","s":"0x223787f46e5bf8117aa6bbc51171b9b1e13a4a376eda96884638efe1eeb5a488"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x327496abac7c3b71fdcd3d1591c8e8cf62a3c3de634a791d844b6a35289f86b4","s":"0x34592af26abc82edf701656831a5174caca010426bf0b5d0d6fe7f84d4aff6b0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe320a3ab72023a4b57085c3302e9a0248828ae4fe586e29899785a36bc8cde9b","s":"0x2ea5ae133f80f65349af8044bf12d3309ed138dd849f3af2ec593a0ee31f4448"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x75f10ec9ed7a56424ea287e084476188e8d6f87c0c171fe26c3e0744636ca5f7","s":"0x23a3f014d9b352290cc4af0d6fba1147f33ba2565f4c9412f91747757cb77782"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x7667f128de6fdb65baa0fd1b5868a84944f661ae02eeb096f89b9f14b2fdfa39","s":"0x4889e1421ecfbd4f67f189b89951a03e311dcce8f36f904437cc99f5dda3b17a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x1c37c1422a423ccb142022bfbb235e60c805b552fdfadc663634a32e934bd4d7","s":"0x13b6864ad1b7c282cc6a352adee2a2c100350de8aa53c72fadde598754b7a8ff"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xea9d49d751511f8c6999ed27adfe086b9ad7e93f6213827de2d21a13a98687ba","s":"0x2e3b3a68e074cf448f02a1bbab63e600c1cca7bd5b2d34a16d04a806a747184a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xb24820f7d8524c10cd4f2c0038dd26bdb7e9d7fea1aa7714fb242856501f8cbb","s":"0x8dabd79f60dfebd80ec8061079ee327f7ce500a739f012886ef68e703e4c4ae"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x6bff7cf52bcbb336c1dd80c2d725c8519cfc8799b617aa09d41cdab1d86ab0fd","s":"0x6e8a086aacabb637fe15e5712955bb4e8b16e611a3d709d9cf181d1bc5b3ab98"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xf88213aa9f2a19e4ef16ffcd1d74dcf32b53139e861a843f679b4bbf2c65c554","s":"0x1ef0453cf735179feb2fcd304458803c55e910b4901354ab7c4ee8de19490075"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xbf9c75a1d14de399b554a8c405b3b73d8145dbe58a7200a86c171bcf88f6c188","s":"0x600055f2e2b68adcdd8fb4741f1cbe7d5cb5f3c2884c3f898f68622370420ef0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xda64ba64b0a2d8896d9d51114c50e8c9f827534da21d31fcfc7747d63ee8c452","s":"0x6c4f9c30a2a6f33fbf24e07e0af2a0de07484a1f734ba240125a232ffe3e0a6"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xa9a95d9ecbb545c606945a4999c3f452f9e3a37ab2edcad77dd890d92209ab98","s":"0x12317d6fd111fb1d57b8c19143a07f8f0e023244286aed2359c724ea642e3a40"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe40399fca3c06408cecd4317a62cee48d34f71893a438ae0131a0a4427dc11d4","s":"0x293dacac4693bc1869198967858873e92af6fdac6f753147e4b43acdf0a82f41"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x729105ba2ab019b08928614a03d2e3bff6e1e057542480345737c51153530267","s":"0x5fc451f41bf429beab148f20112eabed20e6c731827a61cb02dd8dedf332307b"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x31dfdace5ec70a790352e1ff787d42479f6168ca2f3a4e9a7439e66d67493c06","s":"0x5cd46bed07d096d62892d18845f5caf03d93532081e90b510e2d36d5e5d0008c"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x5843123b7a15b6b530271d22791f867b9ec77e82339b1b9c25ad469ebc2068e4","s":"0x413446693e172888603da736013622e2ce4b9d4ca182f3b27abe10753b8dfc23"}],"v":"0x0","r":"0xf20a90d36ac4def46a5e33661928b258891e7659ee0513bc5de53225bce9b7a9","s":"0x4a2eec78a3a068c264026c5841a1d29f5743701663c2bc6ab0e4872656ef9a08","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xa4641fed13831528152a48bc95e4d785faa40d7a476eb30303929f960ab82973","input":"0x","nonce":"0x124","to":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","transactionIndex":"0xd","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd38af3fc0d9ecbc09d36815665868b8af51897d1ee23846245773d73d37c248c","s":"0x6e0f8fdb0bae7e07825cef05ab7ec316d3b9e2f87ed3b6bc6bfb48c5e7b7e102"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xbf18aa597ce6d02f35eb1cb65e53f8c3f7f12891fc9a5458d36fffa4d12db8bb","s":"0x32aaa13808a57dca7ab49d384be1f4779a8746eda064ec80f4098de3abd025d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x4c8f477001f3acf9890795429dbeb7e8b74f4f489127268b25d3f29d7d8fb409","s":"0x92071f3a43c2b53eea373b5bc08bb04de84f82812506dd64333a1a16a32798e"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x91d9875d47e8d6947457a30de53b61d4f0b4b9b1c68013a9eafb88b60e16376d","s":"0x402adc53a38e94fb0d38a777e7a29c21ca007430b9e81ce89cf7e5011049f51a"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x93abecf7fd7f76ce13ea5b05baaee40dbd449cc2c84959ca96d24e2882b4d483","s":"0x2e3aeaaa6ade94f95aab0771adb63410246aa387f0fe7285049579a8f7c5f8fb"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x2afb7af537ecf1b6f2c20e8588f69c1d03d82d22ebc558d8f8f104038b2aabc3","s":"0x1836738bf6c97b010c152f686f8276d29b75bc4086a7842c92a50d37fc94f402"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x8a542c8b88993e48cd7c0ab8e09ce31ce15bb1178d60f2791db251eb70ae8126","s":"0x7a966c74d8a2def19e53dc8d0110b8194b1db7d2fb0a601d576a9b1466c8695c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xb7997972083503be32a75655831e9274e843623afeaa4bdeb2cbc42e480b889f","s":"0x46e07e3ed6f4ea6b262e689abbc76c0fb90b0a8e03efdf2fc03148cb4f8e3b0d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2afa0b20bdde7ce541009da8b6fe8331420ee41b857cdf191366cecdbda58fbd","s":"0x5f8c783730529cc8065b0439e522a5655dfe93f699a18b244b81b164d9611f82"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd8b410719586d8930196e06bc2927af076c6021356a8f33967327eec9ae270b3","s":"0x1b97d55395f40c3ca1af140024b44cbdb727c87a9ae335116d8beb74101b338c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x994ce9bd84888ec3ac826a169baf082498b34f13597894fb7246a38704cd279b","s":"0x710d5e49ababc49508fb40c61206d77a8f46dd388567be6750df6306499892ed"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2acd678201d753b051b30bc09a5e52c5a97e0a370b55a6f811938d7cfcac046a","s":"0x3e5d9db03775362bc24e32783e78477a1460139e93d83a8a433f3ff45d05a666"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0xc201eb4025dd98ea2f6da59d8bab9ea6ac4cd1ba1c93c918edc33afff09cb675","s":"0x518e34230c495b37bd7eb405ccdf1762551cafebc5c0e8973f78edb943173839"}],"v":"0x1","r":"0xa09ef36a3f76029df001295aff34630b0928d490b6802e69ffb2a033ffba5f1e","s":"0x15c499bfb9d7194df3af663842e17a5baf0b29a9cae1a5658367cfc2ff302f24","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x14389557e091c0fefa0686006faa1d7194692700","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x251174e826344f5f15c1797ec424ec7e5edd7c722f1df4431c457087a43cf975","input":"0x","nonce":"0x124","to":"0x5730525874e27b91760cdb3e062ad1f07f765a13","transactionIndex":"0xe","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x1","r":"0x89cda4eb425c2347a4cb1ad3e847069a18520754d9a31d13d1d8116d7c061b2b","s":"0x47e8cba907e91fbd56ea29b245d7258082a65c376f29eda285415bfda124cd2c"},{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x0","r":"0xf6e0cdf9821bfdc4a24727dfe8f51219e20445a84e2914a5101cb03492b372a2","s":"0x3390dcec2ed992798d6e53c629edb550cc3f4bf5f8aa1ef1b211909bd567594d"}],"v":"0x0","r":"0x8fdf945627df6289ffaa128aa3bcc9592dc98e131f24995fa23448e435691b1","s":"0x630497b5e8f6015d20e2a982df2836886eb7473ef30a938c5c9f2210a773ab3a","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcfc6618e733ed833f980746c67dd9a41a0065aff63c900aa204dc3641a1d474d","input":"0x","nonce":"0x124","to":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","transactionIndex":"0xf","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x4af5002702f3b0960ee2ddb64f59b7f38bfdfb5a59f6b6233b9bbdbca890d6cd","s":"0x347dec1a5cf6d93e3fca5ec23c2d6cc2132ca8463737ea74222181affc9988c5"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xc22ed4a944e128751ea077286b96eb0ba6f8680cd59bc66b3d9fe4d5fd9016e0","s":"0x1fe088f3a6f8c4a06e4c4a4b9c55a57dc0ca8bef1991d1a59249299c86a725ff"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xde6027fed51bab62645bcc026548a83e4054499ab56246ed0d5554610303ebc2","s":"0x1ca9dda7999b12f906ff4df57e5c084f0380a28a88db0dc8e72af12c59b10c86"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf48097c784ad0619d411c3eda0b85450244554a618d1eb5aead87071783c558c","s":"0x6169afe8088b34d8f6db42b662db0be5e29c81e7ab4381cfe24dbeb596b6c203"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x3112be718166b136a5cfec5939a53f4fc7058ae51e5b7a79a3e0de61b573d3bc","s":"0x7ec2e8680304d15c648fbd748ac18903a5720f53bf9e3285f0d742d4baad48c8"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x23a3c30fe2dc656c566e6d11690719273fd69bc645724d1e2f513df9a16a4f37","s":"0x299d49f8e373fcf2b2faaebc986b50337bcfdd8a58465d68209a0a2172aaf51e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x5cf0a3c92292f0476decd4be8806a0887a9b9fcdb78e96bb312095baa4d2fc45","s":"0x75843521e3946d562e3b210e6b41abb7c99a209baefeecb68fddac4e6b794ce2"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf66b6b9dced6729f69371ce06fdcd9761b230b29cc595e6a79bbcc7bfeea4e34","s":"0x2e3e9cb4f430551f7b5b3cf9a30864ca4d41200482ec66b33202c6ef8ecaba04"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x2d07e9887d7926a3506cc8d0e06748d95b7395227c6142c379e960bafff2685e","s":"0x3cbc6cb189b0847b205e22f5327009eb63edbccbc4fe8dc60bf3fef9b271b549"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xa82230ea185e5bc7909bebca3cca35e6dcd73e787aad0b4fd18b175c66c5cc1b","s":"0x760a356d9b160d213f074f68c0e7612fc891e7cb0fc0ed597ce2c3adcda1049d"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x7b71bf8b814b2e7fa8c632728125fb42a1c303bf29a3ef4469b16b57cfffd5d6","s":"0x6a3050ede7b8e48f45423ab5a2c16907f969c6a710cbeb41c9f80afb2c0a6dae"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x16c7b7c9c9f132daaa4ab5cbe96424dcf48fa05de34fbb2b880aca2010505471","s":"0x4b4649d593cafdb3b294a8268a5b812ac1dbf5ffd7d1859996029373333745be"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x8adb76d659105891cd2b9707467bf1f8c65a41ebce7fa573ae21df99b12521c8","s":"0xcf44d1ba98113c7900f5393db4b00808faa64c344a352ab7e57ff899788dd4e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x5ecb2d4bfc4cd97fa8f40fbdf6a616f3fae850c2ce37d89781e2cc2ef4f3e024","s":"0x455c283de53c86312d9682c9b94cd11c33b7eb4749cc7963abe022454ec9d490"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x1ccabbbb086cc00bc5a103e8cff7a70df740b8e16649dddd0fa8fd5d04cde03b","s":"0x7e3597704c80aada6b11c11ae7f5fcf5813b7e5d7b5fae29061046a3ff492853"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x6e9c3b6071b76ba9c9904c78b7dfc0b2b5eea935247f2342829249613fd6caf9","s":"0x216cf4d1072133c5d6498d46962b35f8738db3fd148dc0dc071c1ce09960f43a"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xe01e6f1f3012873e9d48882cb0e77ce2e53ad3daf7f0832f8231024dcf7b5129","s":"0x3ac9198aa314a765c698bd9b4e9e97ca7417e0ab40732e8ca6d5beda66063e77"}],"v":"0x1","r":"0x19d61b0a9d615e5332e2b83d968ecebcc3e43f6252303ea69b2b3e19c3d82e29","s":"0x1f4db0a7797135288453cdf109d2ce3a5c0bab1089a5c3f7c0937053eafd2b45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x0667517a45f4dac84446c829438d5bf551823547","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1ae5a39e360974f6f57c98a7e2c217ea515418d7f7470b2842f277553331ea70","input":"0x","nonce":"0x124","to":"0xdde31aa5d18352412bc18514578e35d4f930330a","transactionIndex":"0x10","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x6c1d7d19a564d3a847357586f3e58ab9dfcc86c3769a385d39d35e9bf1876547","s":"0x4108c7b38c7ad4d7617bd86a0102acc611cd9e6ee145af2f8e85a88780df5548"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x600db7108625909099560397bc83385ccfea450c74de79aaaac28f0170edbb39","s":"0x2605cd1b4f3994b4406902d1d803c5ea4c3bd27d2fdd5507651dc1044edf1244"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x8891e2289260c39f4306d627798617179665df734cc137348b86d98b57a11443","s":"0x58dbf8f904806a17dffd29d9692d481c916de5d03367c9f31750b84ee5ff6ffd"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x188835843d49cc8ffb125f265d104a44483d39dbbcfdfd0cb0c772be58670502","s":"0x63f1998b149ee8be992276b5f11d488ca606634141def2f9cb2115e58abc924d"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0xb792f15309debbf656854d0318c7c127c793921e8c4abe6ae883848c65a97ce1","s":"0x1a77e6c1c9aa80f3a5dc7b1e9af800ff6c10bc2c2055de292e70d3e3423888b"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x7da52543c8c2025bdd664c23199a6112a4bf99f3f4117e266287c2ee3a7764cf","s":"0x27f49b1145e505ca5781b95ac3ed8ba500f1872d4c76b1e04a234b6d8c68ea92"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0xffac19da42c808edea3d41075853a5cd0c1d373157dd3fa77b6bbd664eec0824","s":"0x643969db399b3cf17021e783b5417651a00147da14902369344560f3bae95cce"}],"v":"0x1","r":"0xcc20e033b6bbb704c8d013033dc140cb39cd10300f5c4e34ef2f1cb357e98d1c","s":"0x6bf7614fab2ea3e76ab5c0cd090d045ebedb69ee1d75b5ec6ea7c9f3b06a76bd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x3239f090cc1037124e32608a0a3cbdce50b59d4acb030ba39ffed387087b5061","input":"0x","nonce":"0x124","to":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","transactionIndex":"0x11","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x85e3ae7b28a4221c3cbbef8ae7292f521307be82f7ade40b42c5fd46b8fad104","s":"0x349849ff2419de48bc660b19670e0b9c653c276774fa79ed3d3a127561acb9c9"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x3fa67e094ebfd138b10683116ac6b6c1e99ea7e68475cf80913926cbc2c8d56f","s":"0x5fdc573797413791114cdc05f6aab44c0552a93d4858d375d66f879971236e08"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xb6d9de0ca9fe63a94a0a7e3b815b64e84cdde8ebf651f19809ba2909bb23dd4c","s":"0x1c77da4dc8cdc44f6af146a19ffc93da773f2a1e7b941d4d47518a6d115b2ccb"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x1cdaa21800bc79425cffb56930c8ec3351d1d27ef0cfe7571a87d7066044e835","s":"0x1f2318b2e4552fde26f64df05aab16f8f40746d046f100eba034af099ce3908a"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xab5e7575ca6720de527ff1437073b3c29e81a31b7d68deec840862a3af9b252c","s":"0x4bc515daea374440ad86b13783c4b1442fa5c481c1d3f966aa6137d1d04bbde4"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x5a9ea82ecebe5b2aedc5dbd25d3f0673d7c32cd8efaa3081c18ea99f80ffbd00","s":"0x46858e5d89f77da65bc1f6cf0c187cf3396101cef41d4095cffacb4fb208821f"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x86b4a3df3f08b2dd783a605b257b5538930b650b744edaabbed3fdc572d5fc3e","s":"0x5ed21e1db3e168bfdef32f6ed71ec20766e8e2ec3286cb3db5263691e952fc30"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xd769518bf8947a09ec1729d9966e47efa579fbad4dd4bafbe0f0945f7a02df85","s":"0x451dc81aa426813cbe217b86bf743bd8c17a9d6625b043f40971dc9245f4ac8b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf2078416d387147defe9e588d4bb978ba14f77186985325bad8381c939e0055a","s":"0x32cd85bee47df9b5a0e92c70fc8b6007c3c4d52d0de07f82605c46d45283714b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf83ac2e43a654f7846cdb2b7a62842fbc87d4b6960dbdb7b14df8f39c3a47fa1","s":"0x714e90d5f38cfb349fa7d7b8c311ec9faeb637a341e9b19b2c028610a83d87cc"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x779e279529a428ab9519fab51139595e757b58cc109d26fae9762972c82b3167","s":"0x2a84d5206624826f89ed09420a48a1f78e9782bee51f562ac36354f39de478e1"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf6fe877cc13968326bb70c7de248936b444aa1159009bf78c7586ef3456e677e","s":"0x3aeb48996ad97edb267a59c2d3138783348df362a3a356f8c547087286786b1d"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x800612502b4292bb9fd852f8edb1bec090ec091317ad60c8462b7205a500465e","s":"0x1921cfcf0889e6bef52a705113714d0a4151c258e49c50dd8c41e81ce49cb816"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x2832af1b36d7fff8238d66720dee4354acdabcb597af74389e2a237383eb4dcd","s":"0x6b850e9fb4d4f45b1be534fb1c41d2e9fb34c3a3eb2d9ee71161c51ce9ee2719"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xa36cd3d8e317c2fb16d6fdfab3f12246e9c3d8cd5df03daf9cc662e79865766a","s":"0x1c020dd9062065e085f30ef98d1533ad8452bdd8d106c9151210b9d09653c6ef"}],"v":"0x1","r":"0x7fffedbc1e27dee4970ae07b6ff94054f13e1827c6ced8eed9be8cbf1bd14bf4","s":"0x47bc1eb3525d9e805943294a80eef0c36e9a2c623272cf1cce18edb2a4b2b302","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbeaafb428bb29faf943f4050258e6f4cfd351b47799c931196766afcc1c473b1","input":"0x","nonce":"0x124","to":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","transactionIndex":"0x12","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xafd84500ba746bd3149116cef1800e8a6b5c22c730a81c98c7fea99df3e37f04","s":"0x62ae783b90dc1c74f8a1583bbacefd927e5189a017ead5f03a9aa17770baeb3"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x57d4b333d6cce5dac434acb06116507d2fd9b11e0054df09e0d3d4388c23b38c","s":"0x543169ac006411fa2f5d2d1ac93784d9ae3768dd125c15592e74efa17e15e8f5"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x7c74db0c9027c5d9fbf01ba6367f983a6a938380073376bb2f3a1a24e65357f0","s":"0xe61a3b2fc68053d069a92b6bc810934cbd4008de34460f639f1b3e9a0fc82c4"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x848f1a9103b9cfad86c3e6d2e40e36ff431c4e8948fecfda69e69c37fa5bf50e","s":"0x6f6f99a1a5ad26324517a939980c8fa06bf5e10fabdf05dc24b56bbf538a75ad"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa31db6b92a29a6077a2d99a95945e097d02972830989a46868f52a696b9318a7","s":"0x442bed56943bc5fb75f6dd1694aa8ec6cfd5ac803e3c4746d6e6759a92073e31"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa4e10a3625ed18afc772e495fa50493414e4a9300ca2ab6a7ea9195c578c8e5a","s":"0x67a45755b00cff16b1dc3d774adc92e7a7c133182f743d60562d8d6e740e4694"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x1819ace82a1bed6ac538c4e1acf4946f67f4dada66b34435bf1c2abca6e0101c","s":"0x63ac6ecd8cd95fc7c2a41517f2051e2326ccb7a34fb67c3d6450dd1499146119"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x9a768545d76b1fbc94d5001941003532acc73151b5436ea0f56c91dc65a8bb36","s":"0x4804ce4c01a0a22ade14de7ed423ed809627e45b0c7ed29982bb75982f33c08b"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x19fc3f0ff88c9646cfd568b211d9116b58752cdf6f9c2087e201b31a269e6563","s":"0x12f1ec72b5dcfee614edd802ece0f6292fd491ae317330f70888c430258e200c"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x3b09cd8444c3274255c1d11feea2187f5288482fca2d869f9db1e995bce19e4a","s":"0x41d5422f3de36b63e992233cf4554a7314a46eed8c342f1a73731523ec126866"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x95718f22bab2f658562f816cff3e7814b5fb10aff2875db9ca63c61c212868ce","s":"0x73c6082d04a77e3fa21e80861c0348b16d8f1020a58ac664401ca0d635ab1e12"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x5f0b61b046465366383ecb0772a3c076f5fa66831e92576fda6cec37d3973433","s":"0x453255fe2422328cb222eaadb6fb1ba486df7dc404300f14dfd11f662467fb51"}],"v":"0x1","r":"0xaf4ada6b20e3279dd305cb53553e3241b7d59047b52e812a1696e854c4c375de","s":"0x2f9a95f298737aa73f3388565479f6f1fb7f4a55c6ab279b7a4e4d643acfae2c","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xc71914db9254a81a555383496715cc79cfd85e0c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x99687892078f793a426b27b24571b1e490c98c8b4ad79e0a37c82acfd4261aca","input":"0x","nonce":"0x124","to":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","transactionIndex":"0x13","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x320407c470985065994edddd6fa944b9d581f01fa7e1b75bab6ee3b7cb119cf7","s":"0x5be32bfd1c9cd6942ca5194d7547acdd319c9795f1a85d4b457b9f81c85b2a97"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x6c9eb8b4ad58140dc7cf062c79cc74993c96ddaf96322e6596c1322b65237424","s":"0x25a82a103a030cf356e683dedc52f3afe0adee52b97385a01f4845b7a97a19a4"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf4aa6db0f2eac702055833f49c97ae455693dd8a90ed6805b4b594b7351cbc5e","s":"0x766bc678c6b2c7b7d6dc1cc33788de679806163a97d31b4bb65bf6251147e8eb"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xebdb7024a02ddb38103771ed44b07892479b709ebf8880f1b7071456864e6d0d","s":"0x5ece7846e1c56b1bfae65bdfa3e44fc15816147d4f59379061d16348302f072f"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf421c7edb2c13498d6157e2988fc11c6f5838fa1d815f7717c4460ea4699c00f","s":"0x418e2cfe0a05c477ef90e254e8a132e5065ab8846df78dc2ee45b57ecb536a0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x415f560e688c08fd8ac5fe38f2da732f23406957612ac78e53b3a70ef2fa3222","s":"0x6635b7aef3059288c79ee48198451d068d0ec68fb7c00ad5b867fd8b1a11824a"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xe8b3867ecd1a540dff4d3c2d2186c4e0ff9add68fd0b8798d9fb6572ab908acf","s":"0xf394bd92215e273e3d2596ed95c02069a7867f6760feaa6bda33d1dde8b5ff0"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xcb3e476ba96de1b5f05591e07f0b3fe55af538d5384d75042725740a2fcd446c","s":"0x5b5d98417c9b330c92bb3dd4714b9a5986f801a1c0250982bfd7491e37453f05"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x56b4dc02146296d589ec766964684c27d2ccf9f95d48bb74710d126c9dbc4bf8","s":"0x28bced0a64513e851e6f4d9e7b19676e7122d01fe0a9c714a5d3f1174a3fac0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0x6b0283aaf57a0a4f6c1c86c463059218ea99c992b24b31e5129052db33ddd377","s":"0x586015e888050498bd77837597fcd2bc26716dc96b43e2f0e5c9e62058a775af"}],"v":"0x0","r":"0x3be3e48a873bb7805fd4e5b96ecfc72287b38741bee16a0c759e00c43b078a79","s":"0xf2dfd7f9c45465eaf2506b4c26a3667776e894e34196487cac907c1b6628bf6","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x73f245ceca5d8315aa2c7e2923322c106ed7858caa65336477bb10d47f316af5","input":"0x","nonce":"0x124","to":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","transactionIndex":"0x14","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x89a96807d2dfd02cbd0e23ae010e1a64c91e7ae3404e4122b917c09d9f091b2f","s":"0x19381b5a357c6209709e3c3cd9f91c2aff2536714d4ac0875b45ebb5a00585a5"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x46e0dcaece4a468b6b49092d3c0f9d1ff255d3d89ef1fb061d678a05b30dd1b8","s":"0x449469a344936350d3fdca8e73c33ef6c298bbe1f132c34900b7f55dc07a4794"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x725e78a6fe75202ff0954f3c6d34295a6b42a8eefc8a63e5703b549ce9dd79f4","s":"0x4ae75a4e767fb13ddd3bd186d7253f44ce3131dabad0a32ebd92380c291c87d8"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0xdfb6d37617116343a43ad5128512e112586535203199abeea067c8d8954c8a4b","s":"0x3a9000a0036e86592f84ee42408a60adc022e4688eeced1c0542dbce8fce93cb"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x7768555593a0fca44b02e32c20d27118ab4644fca1402c0096937249589dbe66","s":"0x4a205eac4cf2d23f1532bd99845060bed18174c86909dfa7119bf15a0ead9049"}],"v":"0x1","r":"0x382bd0fe9fa3bee706ec591a885d129b41781d44566976fcf55e9c0e4a22f4f6","s":"0x2786048618bd61daa8dab3f029da729539557d65763bbaa542bc42cb05ae5082","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x758f6a2266ea552511d200a190f41c571cf5a2a6651ce6ff38efc83cb608a485","input":"0x","nonce":"0x124","to":"0x854c42e38402dc9db7557cfc061474f06925af45","transactionIndex":"0x15","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x55b56469bfc8538e70107d64166f4690020c139410e68e758880aa28c0a4b555","s":"0x47a8cc4aa3cf2d2b5194a1efc9a2bd4a4f111796f2e02c2f86db5e1e8690eee9"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2791ff79bfc80ccffc5e96f452f6c16acc593d9c3865e21edcb5c8aab85ffca","s":"0x2fd15f05ec11029bd2c1ab61452f3e91c9af07e48f73e5921a995f44706ee7a6"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x30f14b71619d5db2a1d929b36a3d265c759afde418c07a3b13f5ab0866684e7c","s":"0x2cf0d97ccad75624594729226399569cb9804b93c9df819771c319b3927dcd0b"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x70c0756e58985fa36e4ac189f87436cf78b50718a8fa24383ea7ef91a41c7bfa","s":"0x3f27f274095ca8f92fe90150d348fbc6e633a3d68dd18abb7b4370422ce0cf81"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x49ff88942859bf10ddc900b6af41d26e08e30b4892a1d0b2076a3813776c2ac5","s":"0x6f6da0be82e0571c3531b327a59cbd2416833b3bdcbe9787b47702905a1e1831"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x609629a392255c0b2efea27fd7dac1225acf90ab84fe20188ca629927dd4f5e0","s":"0x1eb44d5ddb185be3fc234bc3688803ab7bfbfbebab06dd4de8dc5b27549b8b33"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x2a39851f663a3a25cabfdea2f4a0b2eac146e10b547af46e1c271649cbc77c09","s":"0x558fa2fa34612e79d1f650169edc3a92d335ae3e08554cb288ba8548f3a9fa01"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x91ca20a029881e09978fe208327da5cf70e7ba7a1bd51a048ad239d13ee414a5","s":"0x5ed33f3484853154d83f4aa196f2aa3e00fa328497876bc366f3a26087b92a23"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2afb0afcc1bdceb28356328b97eac586219e1aea0c9b16663b3b7ef4a90409c8","s":"0x49a4a17185dec5620637ed4e9e077674bb18ac235179c3b7cf446afca14889b8"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x7635ef846db79f5cbb38dafeec56753f983a1fc7133ba3283bac3853c38142d5","s":"0x337dd6e8374335f29e2adfac30899a696645fe57d4a8e760807e2c1fcb4efd16"}],"v":"0x1","r":"0x70131c98ba270fa4863e3f4f5d0ae1c0e014731bc45150aa09cd218b9deb5fd5","s":"0x20581b1fc9dd66ddfdfb79588b0eb0402c80f694d5b5f4a5c8aa8d7fa98cf3c1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcbbfca7340dd1a6b71b5a2040c140ae3d7630cce91ccaab258f7777cdae75d0a","input":"0x","nonce":"0x124","to":"0x1d009de960092a8ba788d484fbee9b4c7438721d","transactionIndex":"0x16","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xb060c5729337941ed9a27f9b1e20e878a665bc01fabff173c163aacbfd7a7e0c","s":"0x4dac092758c1b42c2780a90b0948f8b1aac633fc0135a7f80391fe0dd662f39c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x35d3bd2259ab04e1907ab967adf3d4062a31ab05549393555acc01930599c18","s":"0x1d0f651a22da4844643c71707162a704b4eb97b035d1282b1e40dd6967fe11a7"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xd48f3549fa0771dbdd10238eebcd2e76bc107b200cac9dcdc50e440955e6aa16","s":"0x3f04b7b29dfbf13ce692c569d9e98bc30debb75d8ae83bbce010fae62601109c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x6bbb1c39fb37e82288404d4f53ef6e3bad2f636e466c612c28d4a6b666ffa3b7","s":"0x457b0b8efca84fca6c62464d0423a9cb5e18d9a6d1b5829f88211fe9935c8054"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x15c27604821cb2a0260c1b6720c9c3a93b4110a94ba37594f7b04293eed29870","s":"0x738a7594ef98f69b1d4d7d92b2325192bfdb433b82fd773d7cce0ed682412792"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x23e061f90068473d0095d481750a129f359b781be3a5c52dfa98af387086b35c","s":"0x5c38467a5e2292f8f6431b49cef14ae9cf761b470f09270621d387b1f8706d2e"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x4b8b533f543392692e33913a8e2c1a8d6e0ebaac06a420a96b809d3156fdb45f","s":"0x14e7a0733be1d6f6f2017bb070a4baf35d014771e31301deb3391e6a05716058"}],"v":"0x1","r":"0xcf6d6d78179088824d75956ad7d3b740de9313ecdb554226ef86d528453de0fe","s":"0xe0b87018b53952511d02310d42e7a8e4a30bc8580c3884464097a7a32fe54f9","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x34a8fac6801a269bae275edd41a65156289277edc84a48defb95349893fd8c16","input":"0x","nonce":"0x124","to":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","transactionIndex":"0x17","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x11045048c570b159e6205736b45d23fd82fa53eec296c9efecc8771084500af9","s":"0x8cd193e1e0175c2cd23c87650b1c608ab141fd5d3a25d344a898a90e35ce844"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x1ec3007c0c169fa24b41b5cdffe14c25713971b5a896b3c2722a629582b135f3","s":"0x8a27cbeb4fefaf03f28ba1552c32ce1f8ff3e82130efec0f98804e725d0984c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xc6a21bec802d5b4c05ff17268d7341f066120a8d4ba9b7e4c95803dcd2d1fbf9","s":"0x6b0ee67a03b3474bcd020ab07aa3f2899f049ce4906a417be7393bfcb0fd452b"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xf53521cc7a9c0ad92c570d78594ca1a04682b4067611e0d8e814a94e120f5a35","s":"0x67bff6a9e0f279c88f29bcba610ca0ef907e459fd1152839deeb7cf99514fa2c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x827844d978fb2afb8322e03f031943140a9906ff6246d189a08661cf6089fce1","s":"0x2f56de9af01b41913ff3cce23c02fd04ffe0bbe090494bc12ee21f3d5c60bf8a"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x4fc47a88ca11fac3305afa574a17e709ce0cbaa242aa8407beaca8e4a727d5d8","s":"0x3ec68ebb5bd8bfbc1aca853c158e217bdeab5290d99c9dff604a39e82d6a8fb9"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x63827a86a80720bc7ac2a79216da4482272d3f72e7d276b07ec72e0b1904dca7","s":"0x67952954c83a1bc76f891975c4db612e3390abf43039b5ca35f70fbf6428a5b3"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0xb50cab6629c4e355b99f81524f72eb9c2c83bcce600b8bb389bb97cb993ae293","s":"0x24198e35814fbdd30cea47db661f5f5ce6ff2cf360c0cf163808a39a5e7b7fa4"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x21bea6ce5d680e37dd9ead2c5febf0b0739cdac22c770d6d0cbed769548e71c5","s":"0x50154a3c529f679e57085e9f5d7ae160b6c9ba25943f3becedd158dd460a9891"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xe9850bbd20f0d9fae42b24e104ef211957f7248524a60d7870c8e59dcbac019d","s":"0x17b98abca63a7c95478037d5e87958a87031d14285469b2cf6df54e5c07806ca"}],"v":"0x0","r":"0x72f4f42473bb135569413dd477893a2c56199a58e95b4d02998506ee81ade52d","s":"0x250ded7b0dee527212736239eb6838f9b7eece5d1a39e3f9249140608e2178f7","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa297e3e19b9f0c10a353e31027c8812524866b17","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x9092064fbe87759718b5dd9b414e65f7e92afb1de022b7da5d4aef5b68ca6b71","input":"0x","nonce":"0x124","to":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","transactionIndex":"0x18","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xf95df0c73a155c4c9cdabeaba002e43a0de56b9c655a43bc74a0e4cc6c11faa4","s":"0x6635c92f8645f01ab004f15ffbab2bbc9b9bd761f821fc7ee067d8c3a5e088a2"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x3162c53b547a316b472b441b77853b7a2dde7dd16051dfe0f4de773ce2cd3078","s":"0x568c20dddf284fd60e0f4dd3243a0a7a5ba763d89ae4b4a18f2df89b7d474927"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xff79d0bb1759104996bd8f586f7d3e3b0f424cf94ce4da70b80fd7a3a4c3970f","s":"0x21187958d69221908a5a2792ce59b5fbb34c593d8730623ecffd86414067b9b9"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x6a27c1e029c59fa3e2eee98114709315b44befe4361db8adf61670c0e6defdd7","s":"0x2ffc8a9d65d881aa0b370dd2782df700a2e7ee4077c7ff002e69376c755bcd13"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x6adcf3f467773dcc4e23be7293d2e89c38c7b5f56ce040c33e3dea328a54cb44","s":"0x467bb825b2a3ce44db986bd3f068dce0cd336bc298fcf6a8bbe55b0678e3ec36"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x4dc925276dbc1309d2cb664c95ee59550146b912439082930b6f3c51545487fb","s":"0x6545589fabe7e2cee44f740f78455f22182d9040b8e6d95d417177588d5fab2a"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xa7a50488500575591b08e7bec3eea67adefbd741cf178b0cc962abbbff528721","s":"0x2cfd4276113884fd5d285ed35ba4fbec4850e8893b09eb4a8536bc27b4758d0c"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x1b27d6b28ae2c41491ed28c233020492563e471834f611e4331d5dd950411754","s":"0x616eec96d984b0ba84e2b913010cf5a342fb501f7571c7cc2849d8b098100ba6"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x9084b96d63fca2d2eac1ec0f9ddf7302d3d491515fb5e1467c306e26da023b2c","s":"0x63bc43aaf840651f78dd9a11f07d9ca73b8b8664c2a7149a895c73141e50286e"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x803fb41064501ff53d117444112314a22c0b5fc6655754bc0a393ddc639bb422","s":"0x3d7691bd669f88ae4abd82f989bae0624fe049abf2ebf324882cdf46ca14c128"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x2a1c8950b0942969d6274a298178fe7299bdf1e43ca32023300e4b82c25e6b35","s":"0x36e09a984e53bd5d3e79e77207bdae71de144ad41388793d36768f7aa90a79db"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x89907f93c072770bfa2fcb543dc09eaf8bc4c63959fe095f93fd2232ec96feb9","s":"0x6e8445a7bc4cc0c6c2195414e59401fafa9a23d475da09826786df3ef736da6b"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0xc964fb00d980652c5517435dfc32172f04cd31117f6e7f8cced279793de0944a","s":"0x3c0820e869fda57c1727b52f1c0481416d43f009decbd56a2b43f32b035d1ae5"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x3713c2e2fc71f43b511e6896569df9356dbfde72538f1370bbf608491bd0b89b","s":"0x15ed2b8b4018e84f0d5144aae98e5577395db8290c68eb33efb3656d08b7d082"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xdb2edeab7f9ec59315b17a0620b61800bdbdb31412c37d1f386436d83c441220","s":"0x734d62dc03bb6b96f072230831113463a1762c5298267c60195ae73e0a3dafb3"}],"v":"0x0","r":"0x86303c179da28981cc8b2b71b2f7b3ba53d8a834e35389c77d196e67d4ab8fcb","s":"0x6a595915136497983bac95329a6713f6b47ad4b9526b6b4c601e76a63c354113","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x0fed73b4d94982b8b359c1d07451da7bb9cde23c61c8dc6dac17a4a69cc0bb45","input":"0x","nonce":"0x124","to":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","transactionIndex":"0x19","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x9d1da0ad529a5dfcf455da954a2e821eeee9b5d347709270dae1e77a8530bb30","s":"0x57472a551c898810ea06b2224e2623ad23b4989798638254af51a894a269f754"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xae62bc1ce0906c1646c34d27b67131c9e8c9d13d62868d2c16585124aaf74b75","s":"0x66782605564f5fd733d9cdec0b47398bc2feacd4ff6c054ba818941ae24b21f9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb292ff047a54cf1153bdf366cea5875dc74a5494e757186e2bca5900c2644eb5","s":"0x62f227ae65d83164ab11c565cc27bb582f592cefdb2965919d5c02df2b8a2172"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x8465235072ba03983403cec4a784336e9a24cbb8401424557d7640ed33ebee1b","s":"0x6ca7c6ae7dd46e39272d5d361840f652140b7f008bb52c0bb7f292369f2f46b9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x2044d412d537750249ca8a5266590d38a180adc699cdd3a43ea0951f5491dd36","s":"0x29690397d40990bfb60ade2173e2310bb6fe6e0cdb3c87830a30b52d03bed44d"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xf409d31b36d8c2441b05b67a2e91c611b7d9e18a9a4f1361380a00ca8b240b0d","s":"0x69a1628bff55eb1be2134c8f300c922169af39a1dd7d8d6eadd89b78c12bf1f"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x393f7ece3db7f06b55d071803728c462026fb7f74ea8f42572049af82a91dded","s":"0x18ab0e87de56a71596db0a2ff5efda91fdad6dc4085b21eb8488c3c621622c81"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb01ad4eed1e05cdca0fb1d5092b21843440dcd8d4077312ab2a8ea7c5986c540","s":"0x175fc8f02114bc5e67002ef82a218f5620e31643ece8c6f44cea0740ffe0833"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xed5df724441bf0d70735f5608f666d6e473f4d387fe25a282130cbe1dcb6886c","s":"0x636ec1e68260f5180b18c26c5459cc402c63526f34ea852e6dd93ee7e4b6b55"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x1436af905af2c3b9093c67758854cde16635a8c07c7c0bc86951f05f5450ce13","s":"0x6cbe0dbe7a53d9a2b115f192169b13681d7bf6df4f4802e44f832ed6a210b3af"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xcb81c9939ae637b0a4a10ce372f9c73967007badcbc80c3f2ac714914cd18bf8","s":"0x646fb5c03c018f20e54ab6104f98f4b0360610acc4b2c4911b507259d2bc6a88"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x111b0975176a92fe13e02c501d384a7c9d26ebec09d1e3b1eb10af788ef61483","s":"0x1c317e24a97c03bef64e70babb8900e0e2dbbadedf0111040c74058a9bb4c389"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x6979ad66c764cee9983c766d9afc52b7c0a0aafddbdcd8dfd1368d8ef97ae098","s":"0x4769f289ad6396d212204433b77e332a682c39472e5919450cf7a7ccabd06b9a"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x55260a853d700eb2957db104c82892b2b09b6a8040492db769d7da5294ffce68","s":"0x10c7cdcf3985226a92485624ac710f820bc6173f08c67111ea5a0e34ea098d9e"}],"v":"0x1","r":"0x1ec8113238a869aa8d2ca8ecadeb58acdd29ead429e6de1f39392ffb0dfb1ad2","s":"0x6c1ed1fbda0b5c858749d90bd9da1f59de34c893037402bf92e2f707e6a7f3e1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x854c42e38402dc9db7557cfc061474f06925af45","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x597f6b5e6febb2ad78bbcdd22016396b2890352c241dfe18509661fb4c5f77cd","input":"0x","nonce":"0x124","to":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","transactionIndex":"0x1a","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0x5f81f09d2e53e38384c29fd9e08833b8d208a228e68a6218565f95310fef0f17","s":"0x2d840d00f2a6a31ee1f06323174d19634b77e4afd12468d9c5ce2927a772d34d"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xacc3ead55c5fae9ed9ed4cda995539a0f51ba51b7265307dda7bc8992735b496","s":"0x365dd298b82195e80f2556dd56137954b4f01aeb8a2499718b38dd672c7a74b1"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xf4dbbbbaae759616a1538b68d9c58a0bb60de9d00c897c153d735e4fd441e1c2","s":"0x61328d974c4e289ca63701999892b027be036822c140d109d52af31165f6f9e7"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xe13c2d7f15307c7a546e4be770eb6306d3d059ceb94a1e30abf32d7ded0779eb","s":"0x52059cc2081c0cd3ff0669efff3054470ce19471a1a88639c7d833be767d025b"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xcdb24c124d43d5630f39fc4c25e4007559c50ef5b8bd7c9c550e4df2c3fe4488","s":"0x75a040a060b76e03c15fb3978f350f201fb81101763a219e37b7ff35e46c7fcf"}],"v":"0x1","r":"0x7645d5e55bba9acf3d6e5c70390f8a939d8bbbd9bb30c23653674b120d6c6343","s":"0x5c95c89680bf9d5ba69c756077995628f0523ae3433d5f7942cbc07de4ddcb45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x39c49544946c502049fd63e4f7217fbdd2a2c224a41d5a65145dbf2248f4d323","input":"0x","nonce":"0x124","to":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","transactionIndex":"0x1b","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x1ed240dcc6f6afa02ca576cf7123bc12019f889e55dddaeacbffa6c36a0ed7e4","s":"0x430842fcf01cb761e30ed3a9fe3ee3b213e4eac055a65de447e65d5e96d61227"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xd6822962567256c48342a686fc6b03c53a76c5f9f66f66d9b4e982cfba6b26c7","s":"0x124b8d69ef2a9d1283f4408a4e6dd5efd81cf3720fd3d62d6e9e3740766655cd"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xde59e4c2b13c14e67fc098d2f7cd31d29586807ad62c12d4461dc54cf02a71b7","s":"0x74b6b7faa6798fa9fba36f9c1c604fa34f5a2ac760bb044737541dea14649a02"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8addc1ff8e9ba9c2785f1174ca4e7252d1e4a49ecbe7de2ec30e7a3f059118b4","s":"0x7596f0d219135b721594284944fe69bd8aea45ce6423c88db8ad92b1923fb7d2"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x33ac67330b1486808978d5c62a69f807092d60dbe45227939fa23ab907f328fe","s":"0x7a8f6a8faa33011ab8b4f0c883566d7187c0c7a7c7af7771e799ebcb49860406"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc940c8e817544b11f13c442f0b09bca2f874dbe2965070a3621eccdb049ccc28","s":"0x2097a1d5cb803c40047013cb950c6a806323c14674f31015a36131e9b917349e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x904bd058e6ecd2b3d5b28ebe3cc93a01735ff923ddba48050a3140063ce83899","s":"0x55dd12d04531512adb8b4c71990b18759e6eb516df8d64f420de30c166060881"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x785abd7f946005c85c2ef2b10d600c17352675055788c11ad583a2657ba35c0b","s":"0x21f53dd9a3572a8094171d7175a815d5077c5b03041ef7cd3a894482098b03ef"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x441ac343e3fccc2272aa5647d11c2bdb073123d040968d24f87f09d349a300f6","s":"0x464358e128ae12cb8c8af4e0374ed5bf7d4deb683192e1432541db2a7f24d8f"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc00b6af3e06ac8ddb68a611e582117c3cdd63592c4f183105bb092ab6a1c216f","s":"0x1a44d0785dc8cb7ffe5bd093b4dfc8b7d3ac680f783a55dec676ba91e1d4fe8a"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8a22cfe42dca09d25149e71491bb3c847967ded6ed15f366bae49e9d7e53f869","s":"0x48bb749b521bf05b0fc1a6e8fdf8dd8fd648108f8561d76241eb192ce9026e6d"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x9f8e4a394f9016a6d35385b77ffa2a290f0b60bc62486f433362a4bb98d41ae4","s":"0x1102b36ad4a049149bf1d19d2717a550b058a6d198da97e815fadb2335545d2e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x521303def7981b14d2fd2b163c3154d8da9abceb3ec6a04ef8475bd1e0822eda","s":"0xaeaab37fe9d16e73547c3331b26a675d5e1452241fa6c6e8ee535bd5b78ec74"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x7784de6fb17f6f5f115b534d71d1b79f492cb5b56ce4f9be908efe39c5af17e7","s":"0x66612018b830550a561463277f4e66527d2b1e744d9d7ad582528c0a4aae054b"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x6e1d1d83f7a57536269d84706088c938c0d1c1c7884beec87b7ef9b172ca3b8d","s":"0x35ad2651dd814f3c148fc7f32b860113bf2840976966ddd717e4f6fca327fc8d"}],"v":"0x1","r":"0xcc309e6da75a4df39795191d385aefe548f64ba62dba0616e0150e74f575ce8a","s":"0x32dd4d854ab47e5205fcb05756bfb768148845673be8bc4aa45c2b5645254402","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xd9ed58ee5bc043335f498650333e7d0cb0a0203c0cd5668ec6caabc44a349132","input":"0x","nonce":"0x124","to":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","transactionIndex":"0x1c","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x639bcbb37acd4798702d1dcea48bd7dfdef1a8d1378c0226f82aa6ae8aeb0d6a","s":"0x55ff1a94f32acde65a6222e3268b1526e88967e1b14f4e1003b92f3a6c03c755"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x3b74df05c774ceb5870a60d88a11aecc0afb7bd55ff6b91e9e080f4481d6d893","s":"0x4bc7c2215cca20635d9251a9e55098c5e61a6b92a20c1556d4afc1ac63b292dc"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xa79376ac67d5604c0bd127b6f3b9e8a1a93489b3847abdf68b9189ecc4cf5bb5","s":"0x51953ddfdfe7655799924e7e58cd7f293880ea0d04f5981221d2630338c38b20"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0x9fc014d9f71645b7e52ea1f1e9859b61b110d6bb61230a7365f7b1306b9cad39","s":"0xf410ef618aad2f1d2a05464eb2260adbc7952061b5328f460c50005e92eb4a"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0xf48afbe3f1c1dfa9369940b75f10c1cfd3542da3f69adb703c809bafa1e892c4","s":"0x6df3616b09d7ba3e25a8f56ff22d2f93a218ce819e610dc4aaa5307b372e2bd4"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xcf412a533c119271052e1de3aedb7a9f00c8d944ff2320b03ed4813f635ebe11","s":"0x53969863aa3f847c5febea8474c60bdd79d255ddf7b5b2d32b12309f522b3d24"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xfdcc83cfa24d123615c9b30b4ba883b3606a305bc063861a0dedbc1e570de461","s":"0x4bea3572d7076226ec55b042bae76f3e8ef9f66deb40e13d6bc9019094a83f7f"}],"v":"0x1","r":"0xb3c97db67122be5011d51eba4289871cd4dbe5f0252e96b1c07edeaf43b4485a","s":"0x4303b1df2ab458f1fb76a296e9fea4453f1643c80906ccbbb4f1552f968a9dd0","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5e3ef44a9a721e7bbe61a5913e0a0e5ab6655bfbfdd005ade79e9623298a9cff","input":"0x","nonce":"0x124","to":"0xc71914db9254a81a555383496715cc79cfd85e0c","transactionIndex":"0x1d","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfc9fec1b3cc9ec8b8bc386154af2c4774d2127fbcb65960701c9b4fbc1fe29d","s":"0x5c9f12fa5dbfdad0c6a164b25a07c5ee12a84d7a4d57233a64319f392eecf6dd"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x7e06432dc4da4fe86ea629ed6adf35c402fac553f34764d6abf366b50b599496","s":"0x26aafacbffe2e2d6e522ebdd2e67d9414e96cdd7b643fa976ea197fa1340ee7a"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xfc3bee6912545b3f5c5c2a799fa8cda2ba57d9fbe671c2fb7ffd82c58880f424","s":"0x18dd846a978d8ed025c3c82eed83e87599699d9c1791a3fb51d6241b008bc6d4"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0x4c7e13757f606286bc88538e737bb213837ee1090b84b48634f67ca5b24e7fd1","s":"0x7d68cd6e06730e40cf4feb86c7912460e68a30f85606f147b77e0f6556b48c83"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xb709bfd0fbe5082811f48d3fdd0f19c8c9f2479acb077bc925b7226ede49e2cf","s":"0x73715cf4159caf76d044c415976702378f3bd210d961a34a97d701392068faec"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x3daac9f5f5d6a831ec7914a0145e9ed50e1b40d04c757bb1f7be9c36d687a9c3","s":"0x40b56d444ed74d3c6630c81e138f8deabc0079fe07095f96774986bf66d2f50b"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfeff58fa33af0c2080f005c10ffb2808186f65a6dd6cb309ed7493afcf8c4c8","s":"0xc539ccd83955545cfa6a6d3d5641bcfb9be5d6f7c470bdea3e5072994c93de2"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xeaf90ef23b69b86b711b8bf3fe4aadee523bde275bafb61532a387ab6e36bf83","s":"0x4831fb9da21a53c8f1468ee3f2f52a80e215f8fecbdcbecd2f265cd4f251beb4"}],"v":"0x1","r":"0x27dffa1aa6883d71266ad01305ea7e7eb1b48109e399d6e078b815a0f5668a21","s":"0x42307aea0c7814785df08789fce006dc7ccf3199a05cde3764820b9b9a63339d","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xda089537370f75401a128af4945c9e483752359be57be9d2a1356d5acd61c7ae","input":"0x","nonce":"0x124","to":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","transactionIndex":"0x1e","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","nonce":"0x0","yParity":"0x1","r":"0x6d293ccb516bf68ed7cff2cab88e814966dd6f6ff36edd714d375fc24546aeba","s":"0x63370c927d6d079139328ea35d674929ab9570ef1bbf68bfa1e9be997a6b0253"}],"v":"0x1","r":"0xc23ff262f8b5c75f6c27b2799ed066aa75d27d69906a40bf5a2e10c0d669ed7f","s":"0x759cfc38fb81281477014189e19f9f165f82a20e56c83f2813ef8e20a744b867","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x96ab4d97881732824d2ad894dacc17a547b2407b7a408e13196f52f4c32c43f9","input":"0x","nonce":"0x124","to":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","transactionIndex":"0x1f","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xe83bdf2111be9cf5ada368d13a9182ea6ccae4c92f3bffa560f51c3d3a930171","s":"0x133c0fb709b2e0e5ac59ed5e7229e26a1dbc94f000dab468f98f83c5bcec40f8"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x3635db7b4d54e442ddf0b6311676ce1b4b8cbd059d85f5cd5a177fcf8f8b6ec4","s":"0x1d84e3220067a61ed13b40021ea6b5d002bf38481ede5baa7d48b9c83c5c9708"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x14983e7e498756f561b7c224e03a0a20772050fce83c23ffb8d9f8dd375648b6","s":"0x220267959973e61181dfa9660a82c1d6fd51246406dacf6ab7754d5e80b6413a"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x78827c5f3a34ea9117e33b8618d6fcd869e62fefb88d8f0c76e1f292acf39291","s":"0x73f3e8117aca4288650d6f367106e3edd92f0cc5abad9a2037580e0bcc40887d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xd2b72d68a38c523cf8a2634be33d82f9f5e2591dacc9aa272fae13a4abb5c6c","s":"0x34dd1c56261ee45df20c9d0af4ad5badb9bebfb41bdfb28b19114cb9951f09f4"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc859b5e259d31cbb9a2221dd64e0012da2f45221e1f1c012904604117ab825db","s":"0x40b07e9a173cff09393afae16cda7e3d9d2ce0b8b02599f9dae50a87b5be0f00"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x104e2108d6f5ee9434839a11663c0dab3d6e0f5f8e85f4a5639e0126db91b9d5","s":"0x6049a338cb934f54dd44d87244d727812a3395ff632033e096f5af40183526a9"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x207114f9ced1345c5be18842807a9286d9c3d25e5016671e007774b611223c65","s":"0x6b73d1d7d135404fd5bb179ff264539858c9b9dffc38035f18684604f3f7849d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xb07bd17e0787523b5dfa0d87da640440eeddf05972c4322eca921f41de206b0","s":"0x73ddd28e019bf7d46150e6f49d013169cd52c4155c3924c84f21272b493c39ad"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc7740ea10bb19ff26be23f54d4f6e7557e1b3d2a0e4888c8bc1c72ca91fc14c9","s":"0x2478f830f47050c9a6ae80858762762c70604768a25108047c4937f237d13ae6"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xafd9ed8f3c99596160870a32a551b73fccee63bbe87b2c395777632814fef87e","s":"0x33daac653723a6dd377b03cea0be21b0992b21ebf628a5eaf69ad09a2b61b299"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xfba3146333b5fe1494b4d736be1d77280d7c251843f33298f50f6007d82524f5","s":"0x5b10ba35b11c78805a754491db1db0f6a26fd88f5c79c0652224f47e3ece6bab"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xdff137a6620e6cd1fe2a9a872f8b209af8f2ec52d8a755c6b5ec658b531ebcdd","s":"0x28a032cdace93183789604d878cd057fd4dee8c0d01a99753cd70898843c8291"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xe772f7a5537803eacc0de4f4f19c2edd94eda1a484cb08421da821334c2ed029","s":"0xbfb2709166e460c9d00352250507422cb3e358d5c4bdc90c377293f8425104b"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xa29c20d9ad2e3a3fbb28496b6ed573927f9503411716c25c5c0eb4582028299d","s":"0x1ab3de5aec82eabbfb7ae8ccb6dc552eb6c08f86eb4e19a2f698e22c93a6dcf5"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x46147301e5ac0bf4ad4c0114c853be2d3882db0a32d62e5162b913f5c4855df2","s":"0x389fa25d3f272da262be47702f5a8b19c9dccf22fd61590bab2ec37a902dcfa"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x54314bdd6792e2bc2d1a0c5fc00d6abc73fd4ac2b76100f7f6c3d3707bf39667","s":"0x250f24209069b9c6cbb6098b608ce7c61530370b922c2fa5ebbfb9c0dbc3255c"}],"v":"0x0","r":"0xb5a0ef06b1645b2df6e7deb53591dc306e056e64755d7d01c4a601c4ed636298","s":"0x58606ac4f981eb780687ab1e7ba25371cea0bb48b6f2eac32563c85f62a9e7b0","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5186ac1b71b7eb0ac92d756d1983b20c3f9de7564a7e96bebc3fb1d79e13cc3b","input":"0x","nonce":"0x124","to":"0x293f2460cebdc5de195af3edc173e5d42b438532","transactionIndex":"0x20","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xd5c27e980b8a1ab07cbe2739badbdba6e7c56c6c8d07aa2120c74c6de6c7cb4b","s":"0x19daa3215e05d602cec58953087b0fcd918a67ef1f3e43e8bece598235a95ee6"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xb15f9ab1b23af74b7d106108f77dd476595309525ed260de3794466257ed5aba","s":"0x17da86d18abf4951027e2848a58a28da1fd568614e0da442f441b73ceaa07882"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xef22115063dfb780b9bb74afbd3cfc1a986f4a7f49786d1aa8cfbc88ced04f9e","s":"0x5d32e0d218b19bc68037b21a3694fd96cbd0c3ffe419d8d0f12e07933eaab0cd"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x96b21f3a0d6ca839728334af7c63e629e7ef3dd4273b45b19c87d576fff3afa","s":"0x3fd7a11b7ca3a8bf788b019021957371c7c76fdb5f88ab0b39cc2f8e750820b9"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x53f02d528948075781f2ec67d1dd250ae98562276be499815d7cb1b83465f90d","s":"0x724f85cbba27c80b75036dccc9ea6a805967fea7a0a6ecd0a44ddcd2a8ea5d40"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xb5b495ce281fa7fef320eb9e8c08bc8109a2a8074d21f875a412345e62407ae","s":"0x3ae22244776c301faa965c23d129e657414bdfeb256c1fca85fd34b792e17fa6"}],"v":"0x0","r":"0x74b60296bbc5cc92ec0a9ffe02a5f2dbf11f09e94da0e3ae63668e0165bfcde9","s":"0x24c588a57cca4a6c509db9488ff17542747245366c84e8d1c2c691d2a8396b81","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbd789003b95829d7c77c2693e1040a564844da28bb1e918b1d07d35a3d7878bf","input":"0x","nonce":"0x124","to":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","transactionIndex":"0x21","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x48826f0d31c55d4a1503a8c5231d2b1df8a61af32b4867b786d87825eedd505","s":"0x7b824aca70c8d966fde0b84d476a2b9fa7dcfd8fac392f53fe50e38ea4c73e61"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x461d5f259bf0a2370455053d26ec36a99a6b5dee48da563e90ff774f0bc9d3d2","s":"0x19a53538afdbdce3536387800cbe04078bb689295bbbe7e234d7b87fce613f14"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0x67d3a60bc88b9892d3b6252f7715cffba4c50aba131cab5fa268ef1ebc490f7d","s":"0x79980999bb402d451a3cf90641e8e8bc79dda5d96833692ead85fcd253de8e23"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x9516a5d7a14931f0070036cc0681017f9801857a7cf5f97e7b87e3f9dfac9edd","s":"0x3abb63aa54907af22fd642184cba1b02bc05722c23ffe6b9c71edb63566bbe3a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x381a81298ddd37b8bbf70291d30da343a6dc8f3743d909b95de85499276748b0","s":"0x771e67447d7886c5e2f1a13c89233b895a234d71e284124e44f93d390afd0566"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xa4a689358968d7d8a7e5aeec98f37069cda073066f1a1273db00fc90e3145519","s":"0x6b9c302206b7a8cab87bc5d4136d988769180a9d9eccb4dc406204ea55a40842"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x2cac5ff558e2706350ff9b70bcb28fc6970dd8632e5103b6fe5e2553fef8b3b8","s":"0x5b5fb6a3a66be0b4c68927f1cc8d1ac52559c5b7f01c038c3db5302853720a9a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x862c9f862bef2a22bd3677eee58b47ab44f78431d7a8b0b288a5f755df7ce75e","s":"0x10d09f9abbe644ade0db8403b0b1e0cc1a856f1cc9a60eb620df6c3ef3ecc24"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6729c399e6ac51ba508145120653ff6ce66c07b516eba42037a47b02f863e7a8","s":"0x285393c657918ff81ebf4b72edb5be5f903f8e3e22c2d5a4eba85729051fe210"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x4fa026d167a7b7938bcc93151fb9318b2b74ad6a5b87501481727e00c0660687","s":"0x2bd343889ac377e4ddd92bdf20ba883bf3b93a5338ae13caae748f604076c9e5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xc06ae7e692b7b84db56887d6eb9edac7365aef64c18bdd33adee55ab4749dbd","s":"0x7840167e29e0e035b5a25eb49c4cff21ec776038acb812c0c2bbb779e62c3333"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xd7fca9269c950334cfb87a3393d107784452056ebefe4bb1d937e80a87e5d373","s":"0x225c11d044676c4613cee9c141846bf431cd4f60d92d8ab976030698f9d75585"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6495fec7a9e354caeea2bca6874e0cc56c988a310cb791594c2e2cb12a78b6f6","s":"0x6beba6fdb25be4341a98e9aa7853d349efd1f6c1719fd85d786a8249263e9ce5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xbc1f7fc16bfb89fe2fa0b9986d2ada8d1b0274879159b3145789150a156857dc","s":"0x5512237416179e5708ab7303244d072d6a69e31062ba977a21c6c78e7744868d"}],"v":"0x0","r":"0x8c32440547bd878d19978cc4400546e89c4aed37c6ad127cb2ce5e4210aaeb33","s":"0x77651f2cb2e62806d9e243d66656a615a4c6dc5e6c683d6b63a218294041223d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x293f2460cebdc5de195af3edc173e5d42b438532","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x71aa110108e9012a9ef1e54ebba79d4d508fef72b1cf4b4e31b5fa5201832d4e","input":"0x","nonce":"0x124","to":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","transactionIndex":"0x22","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x1425c0009ff73833611e17204e73816643918800806efbb01f9596285efcbbe7","s":"0x715e0711aebe77d2f7948f21d7a963399ed76df375be44272a08744c8059a298"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xb3bebdd82f299e58aa38522074bae88bcdcfde09b6e6961d79d44a61a3aad9f8","s":"0x12ab97610fef8dec7f390a3f731717d05a6d0b214e4493113d8bc79443ca16b1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x5ceab90370918652b1d148d48274b0d5280f3e4c645d15e4ba3c29ec6f28e357","s":"0x108b0a345d460e0c9d3b152b38ba8881c2ad56aa46fc611819a2d233995e236e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x7d0ad926428abb3c9bd11c4f5e41695201739b35abbe3a689a82a77fb13231d5","s":"0x541a4af7fe44fef23e122e8ee2ee835fca31ca4f6e3c1634a575fbbab4ffe96a"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x2c4257492d50ad959cb660f2e1eda9ddfba313173c2e28cd20b298658e2aa72b","s":"0x37f1abe85b6732baa9e22ebc93af3373dac2cf4ee8d5c0df9cfbe88ef56bc821"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xf9e2aa0d77d4ea79587113fd08656723fbc6891ead834a4b758aae6b7cbf90f4","s":"0x7467c7714f1bbeb2749b6741359cc3c8f2a9f1c7e24bea4e1db811d6e9d2a092"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x77f16aea7405d9ecda17c77bc4b831295f1929639683a32a13b981be2a2afb84","s":"0x6216f87bbbb43e8e45ab1582e7e82ebd2f6996a659f985b95672cab0cbead5ba"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x9832a1c1b5195ceaf4b9ad237121204d99a4811e9007a2200b1e82ff266cca4","s":"0x7a378c113e6a3d86652103b97c4d2a65575d1aade14067d86dafe2e77bbd25b7"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x92c331e4f15b86ccdf1788aff42e6800ad7ca562161e0b2f2d031aca364a52a0","s":"0x33b895dc06d806112756fa89dcbd58e5960896abb031413b4ba6f1f4a85b2892"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x47e985611e3901aad5fd6b8a2c3e3a5c910798799ea87080fc2236c9d49218f5","s":"0xb20550130cb20b73b3606431d70327956aece01702c54fdaa4363858cf053f3"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xc9aa6bcd05029439508d9da688253f61455e50530737b55c9be96d3da3ebbc5a","s":"0x3767a9cb915b9e2d5ee1d8b4367c6d4a7e136515de72ab5f3660a0b9f3e00c27"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xd5cd750c5293efa983c056a6a81a7a129fba4ec6d47bce3803e56a204e174e54","s":"0x15ccdd950db4abf3ac309b450224d1dce2ff9bc789de2f8d353045afd6be6234"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x6c7ca4936db83c03654a1049bb791c542794b4e41c6c5e67bf43236a71535833","s":"0x29b912714cef0785325f3bc374ac9e78b5434f462495e6a2d4d281147e134aa9"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xd52242b6c0a2b3d383361d1ad43c38807650664a8c683c4bab44c1f6328fea57","s":"0x271b119cebfaaf9564e273b36e56b18f280deb1d50ef08a1285d63eb631622f1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xe79170300d94b552137128485b379af15b014e80064cf84bfec9392c25c63e","s":"0x53c77b423edb0ee41ed7ed59f751f423f7c2f0a1661b6fdcd1c9f9837510199e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x91bb9192cd43729472d43a9506744052cd645ab21bcca454cbde893f6b3f19e1","s":"0x200eb8ff4e7e09bb713140b3f67edababe9d6ed59a928f385b72b2c60a03a032"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x49a37bb268043bce2c6b8d3b50d6f715059cf63073bae5b92dee918f17404531","s":"0x3b3a44a1c218f26c57e71c1c14b4c8741ac7c7c36ae9e4e32dd392d948c003bd"}],"v":"0x0","r":"0x3c7dfa6b5dd6f0e4d453330de8038be32d6704a29bebbaff664e6485a9fe263a","s":"0x5265140c2318167faeb60eae0c68839343bd5d01453dd6149f1f05d88e2fbe22","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1d009de960092a8ba788d484fbee9b4c7438721d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x8e1cd7b74960a4eaa95598461b421eff66443c3c5f88fc29c6e2f43839d72fbf","input":"0x","nonce":"0x124","to":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","transactionIndex":"0x23","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xecdb53b4a1024ca85086b37e34511569819ecbc33f2c960a2928fd2d1ba9a9b3","s":"0x51546311bf7b54d2a85adb9b08f2ba287e51cace0faeb43fa1d606cb14fd56ab"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xad1708308d9a13630eb39327f96b8af4a3305e0b8aeb5d62d2902b58b4133ac1","s":"0x48b77c7adc95239c400aeb0160ff61530fd919de95632e602d9926e9c1c4f442"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xc97b988b67ad55cf7a63673cba02b942485e2e9f542ad6df6c782a129e10f21c","s":"0x45a67e5530354d6046b76a396384e38ea288447a58afa3110e3f2841e91c9eb2"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x6bb19a57977e46f91ea8e162c51b340cb978fc9b62ee2e66e7f7556171cb9f82","s":"0x6927d7990c269ba457ffa84ce189d596fd410a79ab4881fc5f5e8fc8d6779f6d"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x372470d1f685bfb4a7ef4937511c3dfd80708485875c02fa361b482b2f439557","s":"0x2ade5799a1a9f121517193b0ba99db87c7d172eca6cdfb53c037b2258aa21135"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfb51d2c42edc120fe56609564e92ec30f140bf4056f77b765845fb56c8e6f341","s":"0x2ba7286baca5ed4b90dfebd7bd39000a7563c75756db4f85c0978dbc908d6ba3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xd279a74bf87ba2f14acd8f0bb3fcf818fc49666c775b2cd57c0fc9fe56cdc249","s":"0x32ed9d3907cee8940bc9dd7ae9aa764c8adeae20a3a37a08b35ff6908f935721"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x366e66ba2c7b096f41dc1251c40686ea8fa7d6b83b5a78a5ab05a9c365595a12","s":"0x2eb806154a01cc88744b8294eab3ee5c50b20aabc83eff6be68f8b9ac87550b6"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfa53403f8118da374d24b670394700a9a3804995c24c21e17525329d3c741c69","s":"0x3512e7b78b3adf8d49b3a7b1644e076b54a1c5dfa7dc9a945d65f3758078c3b9"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xdcaf740a589fa9a7889ca23be03458ca0d2a922bca77aa6ea24b11cee4412553","s":"0x379dc3b687ba8ef0a966e80e1caedd32302844d54bc2b812640e56d75729b592"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x7878b6cf5d4548b33339f050397f25fa982c3f680932ac5d89e28d6044e4d223","s":"0x431be9ee89fea4083eb1b48b8ac83ba69c0491f27168a4bdf90f706f9cc56780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xf8ab527fe8dad430700ba5867aa8e1a34bacaefd45e3a1a2665e3240782026cc","s":"0x5f1ace49c2e6e27810b2a98f457432b5e58f8749f23b1b6b8bd3f278a962b780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x955a6bd2b8f7cbefe8d4cf01c3321b8991478de22b2d9b458d84fee9a824a6ff","s":"0x5ddd6e2eb7ae2954cdfeda015787fbfc9efafced5afc98cbf5265a1f44300929"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xc5e7e967e2c93003206e1062cc13a19d699934b7254e29a1b244b80775df1ebb","s":"0x7cb86f003dea67728597f0ae720095e2f593ed9e615d12bf13810deaf1a053e3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xedf2958228e2ce984313e31754910bbaccf61fb6a3f7ba49cfc4d238345e862f","s":"0x5d74a5132415791b08942ff0707b870376079fbc1323c439213283441d7b1160"}],"v":"0x0","r":"0xa9a6ae3933c5f5b99b67857aef7d3ece85c911c1a107357d46738bf12ddd656a","s":"0x3dc11a9a54774d6ccd67ddd31cd9eb28679529fe6af06c33cc6469989622384d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xe665f3433c951ca6f0cd6717de9a2103a3d5f888aa56780476d87652c13db933","input":"0x","nonce":"0x124","to":"0x0667517a45f4dac84446c829438d5bf551823547","transactionIndex":"0x24","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xb0a4d13e2c0dd6062934190e764e0b7e31f4686087c2bc73b0fdfa71502db27c","s":"0x4a159aaa3c3f78b1f6a507163f7a6e170bf05853699a2448e9b39e0df5f9c855"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x89705eb6c37858e772ee964d704a1826e71cc02fb711895dc931b5c171fee9b9","s":"0x7f258027f5dc1a4c518fb6560cac7867944f586ec9cb632d4cd0c061cbb80be5"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x50b5376bf91e84a3476d0876da8aa7a8e8640cd41ca3041c2248526459bb5dd7","s":"0xa378dc6e70e93abf251310fea38c01b2703e1eb766f83aedc5c6f0d872d6896"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa1e9eef142eea1d9ccd27499541a3f7af33bff3d31a4ec4fb8ad18f7c91120b","s":"0x18a19bf7c540db1f953afeadc566021a2073dcc96e151e7008641728f8044175"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe08e2b3d66c709106b5d5fa775b5a2fcbcb6e08cf9bb5309ce1037522852dce9","s":"0x5c7178752619a4ad21b6c1b4b40a0e073f99dd01ebd2c5852b39110dfe6a98e0"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe239c2a9c490de7814d765e3a0d0f47bd54b5c4006c2c1aa7a5cb96a522b62cf","s":"0x437649fe8e842e7d88eefff3301d76bdd96ac9891b7e72fdfca51b01eed83dea"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782f06379f4971311d05b6a07f06772c56eccd11190896d7fe4475b7007715e6","s":"0xb51a80118ddc8e4e9753a5b1073bf8d05983808f80b8452c2c926e06db7aa92"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x190373b965c31e10a9cce753c5ed5eb4771581580ef1a5e2dff875372f235ae8","s":"0x505d9fc3665f7a6dff3b20609bab71d906cde01fb777bcaee622224acfde05b1"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x66fd452218a3373cdf138520a821b1ac210aae2be8861370f8bbdb0d30a0dd81","s":"0x25475f5e823b139a6ba0176835eb8edc82767be14ec57da44af02f374d00094a"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa095dd56792c466d7b3ef560cd6700681397d4107416db79ed4b7309b99d7afd","s":"0x2e8213744780496d50d60f1e275c250cc1e95190e05762acad6e8ae68dae85bf"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x84f5fb52a1bd74b82c27d357da34f3350376e1d5c748bbe0c4484316ad7c1f93","s":"0x2252e139156f5a12858d47f8d194a269ef3c61f44c962ff52e7cf4c490cfb9d8"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782213ff850398d670fdd6e456d3f7a518532e0795f1ecc44d50930763809fb8","s":"0x503a78b701db1d28a704599368541719c4d3cfc8b73e8be7d9de3f53da9b3a95"}],"v":"0x1","r":"0xc737b52363049f791a207a0fc88fd7ea81598251bf3e8304fd3cc95f04b45499","s":"0x36db01bef8c6d3ad49f79d1328e4ab7a7f5d0c3bd018c32bb1287f9faa2b518b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x4e3d7f35d4d8d2c471c2da522579143ded0c9dde4ea1c2ee515f86754f1f7f53","input":"0x7f4504101dd94d473d6f4a9788ec3b9324281e6c31cf85fdd984781be08bda0b3c6036527f7c47824e363650cd8fb520b7cfbda1e6f84b65b0bac5e8d3be7461cd23bfc4866056527f68019ce8f1f877620feeab27eee757726e14db711f2d40d028b58a10324b4386607652608f609653609460975360f86098536071609953","nonce":"0x382","to":"0x01abea29659e5e97c95107f20bb753cd3e09bbbb","transactionIndex":"0x25","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x013c95c22d3c6bd765712a821b08ec6f727c3ebd9ae66ab80c2f10f6ea485a51"],"v":"0x1","r":"0xc38319750d48ce5ce01c1d859592dbe9725b3f6444fec7d75d9bbbf36884ea46","s":"0x7f2ecc58f1bfa9f77733a6010a2023952051ba48e18a829d1ac875d338b93802","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xf91e74405a2d1786450135920460f4a4cf3ee3d3f2c443f8c07ede1eb449037b","input":"0xf4600060f95d600060b55d7fdc8c5d392fd3cdf4845998b61158fd372e6ba00d2123fe20fdeba31c66586bd76082527fdac0d26d6301f8c5d27a487cc47224b615f4753b3b6020f6f522e37700c78f7660a2527f638867b7c25da0fec6cd6cb80484642fc328ab9255efa82cc246e2bc29186d7d60c2527fe4064c68e42451f5","nonce":"0x383","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x26","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e4f926dc335bf2ac1881a1e0a4e87fc9817689c8ae322e2b06546dc6b4e507"],"v":"0x1","r":"0x635e10a0f3593b8e908c4ef490eada430171b07f8fbd3f6e9264600e1deaf34a","s":"0x4718fc67ecdbf1717830df73acc6e31bbf7ea6460e3a6d2533e2e6d4b073314b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x72e88529e8541b54356737cd6ac7d193d1fb47a1a06bd05734cb7316628f9291","input":"0x60e6497f97cf8da4c4ffd9275cf07b4969c5f06102400cacc9444dc2ed40a4b95b4c1c0960ac52609460cc5360b960cd53601360ce53600d60cf5360a860d053604060d153601360d25360f160d353607860d45360b360d553601e60d653607d60d753609b60d853603460d953607260da5360ef60db5360bb60dc5360bc60dd","nonce":"0x384","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x27","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x017d3b3a04cdded9035dd0c886c427cd23374ffdb65e5a0c2890d3d88f32c0cd"],"v":"0x1","r":"0x88c85d5702c708480ec1deb36f1f959d94205364f571a15a278359ace171fafb","s":"0x2b0f7e43fdfbdee7a36f725f7033f207a449696a66a2782bd84f0bbb835c8807","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x9ae3d4a0cac29e3282de68c9be082d8de2e426043ffccf73d3d85e7438830d85","input":"0x609763ce8242a353605663ce8242a453604563ce8242a553600063ce8242a65360d563ce8242a75360f163ce8242a8537f73764bb123a25b5d5602644fefaf149d037bd43c25899a03676602fcde9ae252607952605960995360c4609a536012609b5360da609c536039609d536070609e5323600060cb5d7ff4c43cae738591","nonce":"0x385","to":"0x7a40026a3b9a41754a95eec8c92c6b99886f440c","transactionIndex":"0x28","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01f42bc0613d994dff0c63884f74cbf6a4d9bdfcddd8290dec62f8523e5417f5"],"v":"0x0","r":"0x9c6e40ed154f497d39872c10e31c4d7b57a3a1b56a9e3fc216fa24f90015bcd2","s":"0x46993d82ff68fa4d57109bb5c901cf011065ab23b85898d553748df790f6bf19","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x1d3903f025928fd26aa342f6677e5027886c634d1af7116ec49eefcc9a06242d","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x386","to":"0x09fc772d0857550724b07b850a4323f39112aaaa","transactionIndex":"0x29","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0128ff42601c64b028404114c257fa554b2972f5586a1bad0c0fb1f9abde5dd3","0x0168dae2848c0731e74be3718137a78596e1fe5b3bcb27f769acc34ccf9547b6"],"v":"0x1","r":"0xf962b1186bbb76051e35ac3852101204040cbb2f606d237ef9f63d7149e5565f","s":"0x46c70b6c68b7c7df34b05fcc3e5ba1145b118d9091bf04923c72889ea2cd1dfd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xa95b2b346c1dfc18ee5e960f8797112c51c1ebfc449b79b3c258ecddc4162595","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x387","to":"0xc92d81729f35231d2cbddf1664e70691d1f8b389","transactionIndex":"0x2a","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0124d35a551ced9320cb1e36d1b6135b433e37782f17f9e4cd4cd0481ff69ccf"],"v":"0x1","r":"0xe27cb11819d48227ae9c5c7dc0244ec2cf1264324519401868c888fa12b0f5f","s":"0x104a33a96115659d0223755d741cd0ee8bc70055be86b5d31035dbf26ea87bcf","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc07c60993cf689438b8c85f86b0ed938dca77ea","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x3d012b0819c6846b10449300a35777d1339070539eafc6603432f49fe9ef357f","input":"0x6000603f5d600060675d7ff8be64b5f996351c8fae8b538661f73d365776ea65bb4308895112386ce885ea60d8527f57518b95fbaee3694b3cf3fc3267ae5856c6782d7176ca28ca5dcbaa7b600e3360f8527f20665b8964a6307ab36fc24f1ac3e1ca966cf4597fcff39fd82975978ef37ebd610118527f3869374f5e3d88cc","nonce":"0xcda","to":"0x056a78e32e992cdb7a24eb405ce6e92eb1d7e177","transactionIndex":"0x2b","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0145278fec3b40013f973bccecae5432fbdc1e482f58699d93989724ea69cebe"],"v":"0x1","r":"0x6cba5340d5963219def0e84f07320f2de95566bdd4c520fc33cf71348925bb61","s":"0x69a232c70e01dd35b8e6968238b6a82b66cf1aaa2e17a7afc6ea1840758a5642","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x4dc4ec6ac43c8c45777292db987203c0248e17b7","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x0628c063b4290de6eab4b6772a800215ec0e75476a9d081c114956fcc085827c","input":"0x600060af5d7fe2bbd47b2086e4cd6d29e9e3681b7dd9ad913b6e53fc4a10490fe640b7db402d60be5260d760de53607160df5360be60e053609c60e15360d460e25360fe60e35360c960e453603360e55360d960e653608160e753605860e85360ae60e953608a60ea53604f60eb53600060ec53608f60ed53609e60ee5360a6","nonce":"0xddd","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x2c","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e712f72b9f5b03cadb98dbe6892166d78051671fa088e955ef8fc8252fe753"],"v":"0x0","r":"0x276a245882a4df9bbbc5e021f382274948419eabee4ffb945ec522fa064e10a5","s":"0x3f3138d798bcf9388688817f36e63d9e0cccfaedbf3aa934afbd68931d3ff35b","yParity":"0x0"}],"transactionsRoot":"0xc5864e1053c5147b97d8f3edff27fb0c0b4e1536756ed1e3634536465ab7bd78","uncles":[],"withdrawals":[],"withdrawalsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"}`),
+		},
+		{
+			// This block has had its base fee removed, so does not match its hash.
+			name:  "Mismatch",
+			input: []byte(`{"difficulty":"0x26ef28d3882645","extraData":"0x486976656f6e20686b","gasLimit":"0x1c9c380","gasUsed":"0x5e5cd8","hash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","logsBloom":"0x382052020800021414804040a208102000806c00010460008007020c2832010308010010009026a00508118000494140030200900908641d093400405262000c050000404040a5684980516f834040606a052008080804c01000301080460441809004080242201040f010902010891063000418183a804442040090400801064000010dc020200408d019280580040404a2002721a484780d1288c088910cc0270ac44902002803146040c28010084060000011a00648a0090006428000010080510422000000408000a081224210f40c0860800020221522c008818100200040193021090810a0020200601024500604288020011424400408a09000040210","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x15be7bda2fd66ef3c634bab035a8454365ed31d090dd99c76ac1910151ba2b9c","nonce":"0x9ed675789be2ead0","number":"0xcf6d38","parentHash":"0x3560ee45703a5c4d352b1d6d8f3c642d3f1db6c56b9db3069e9121ad63a241c7","receiptsRoot":"0x55411c42653944bbadad219f8ded587754015f1a584822fd4ed2a0d7e09db364","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0x7785","stateRoot":"0x825f84a5098241041e4e45bde4c1cdab05de1aaf3be13d5cff14d5495a2561fb","timestamp":"0x618cd7ce","totalDifficulty":"0x73d8abc71b22d49d973","transactions":[{"accessList":[{"address":"0xf57e7e7c23978c3caec3c3548e3d615c346e79ff","storageKeys":["0x6459af213787bf9f6b0a98a594fa7561fcb9683d973fcb0d3989329f847d2a5a","0x51b97ab35998078a460d0edc2fc4dc5b9566cd699eb5194af0d679b7a1c66f9a"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x99497bcfb22873205303e5094c7e4090544e9d00091b117f8b71b66ded95576d","0x5d0acd573b7113ecfa1f0c53f3e038c6a2acdc8bb31e36d94969ed8bac354c46"]},{"address":"0xfd76be67fff3bac84e3d5444167bbc018f5968b6","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000004","0x3e5fec24aa4dc4e5aee2e025e51e1392c72a2500577559fae9665c6d52bd6a31","0x0000000000000000000000000000000000000000000000000000000000000008","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef7479","0x0000000000000000000000000000000000000000000000000000000000000001","0x0000000000000000000000000000000000000000000000000000000000000002","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef747a","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef747b","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef7478"]}],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x654fae4aa229d104cabead47e56703f58b174be4","gas":"0x217d6","gasPrice":"0x2bf3d74bc7","hash":"0x09d9578f3a3cd87099b9a5c979842efa6fbc80fc2c6a8044d55a2a7b90a6b14e","input":"0x000000093560ee45c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20100000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff000000000000000000000000fd76be67fff3bac84e3d5444167bbc018f5968b60027100000000000000000000000000000000000813f374b029ca36900000000000000000000000000000000","nonce":"0x5d6","r":"0xdeca797e43ebc4bb2e2dc7b830239ff5d5d57c67c7e5130f202a00470bea565","s":"0x451c4328e67174d778512c0876e181f269d9cfaee5b48c7f4f45284a64e67a03","to":"0x00000000a1f2d3063ed639d19a6a56be87e25b1a","transactionIndex":"0x0","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfeec32efba1f7ae305ec3ea0b694a5f07b39f390","gas":"0x3c806","gasPrice":"0x2c6b0cdfc7","hash":"0x013420b2141e24aa551f4d5526deeeafb552f953d97ba567c018229eda7fec16","input":"0x414bf389000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff0000000000000000000000000000000000000000000000000000000000002710000000000000000000000000feec32efba1f7ae305ec3ea0b694a5f07b39f39000000000000000000000000000000000000000000000000000000000618cdc57000000000000000000000000000000000000000000000000c249fdd327780000000000000000000000000000000000000000000000000256b3331a3e83288f780000000000000000000000000000000000000000000000000000000000000000","nonce":"0x8e9","r":"0xc5a59f05e41a7ed4550b1d721852e13f2d0e9c5630a36108794ba8990ff9a8b8","s":"0x54f574a603dc9e0b8aa97fac400f3dba8ba4563c1a3ebfec0c036277fdb7dcea","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x1","type":"0x1","v":"0x0","value":"0xc249fdd327780000"},{"accessList":[{"address":"0xfd76be67fff3bac84e3d5444167bbc018f5968b6","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000008","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000004","0x0000000000000000000000000000000000000000000000000000000000000002","0x3e5fec24aa4dc4e5aee2e025e51e1392c72a2500577559fae9665c6d52bd6a31"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x99497bcfb22873205303e5094c7e4090544e9d00091b117f8b71b66ded95576d","0x5d0acd573b7113ecfa1f0c53f3e038c6a2acdc8bb31e36d94969ed8bac354c46"]},{"address":"0xf57e7e7c23978c3caec3c3548e3d615c346e79ff","storageKeys":["0x6459af213787bf9f6b0a98a594fa7561fcb9683d973fcb0d3989329f847d2a5a","0x51b97ab35998078a460d0edc2fc4dc5b9566cd699eb5194af0d679b7a1c66f9a"]}],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x654fae4aa229d104cabead47e56703f58b174be4","gas":"0x2036c","gasPrice":"0x16740c11372","hash":"0xa4905adcf14540f0e214cc8bffabab0834bbb5b91f00cb31a092568a66dabdcf","input":"0x000000093560ee45f57e7e7c23978c3caec3c3548e3d615c346e79ff0000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000845dd62ea358758efd76be67fff3bac84e3d5444167bbc018f5968b6002710000000000000000000000000000000019d57114bf4c253ee5b00000000000000000000000000000000","nonce":"0x5d7","r":"0xe311efeeda5e4b0b71b6fefd7d43989977fb74f2606a21d7a748de46721f2f8a","s":"0x449fac9584ae65449f784f8c73942f74eeffdbcd6fb645cee0c13397990d1ceb","to":"0x00000000a1f2d3063ed639d19a6a56be87e25b1a","transactionIndex":"0x2","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x032415c695a7a927c99b74ec74812b83b4d9b952","gas":"0x24d29","gasPrice":"0x2c4d3f7ac7","hash":"0xad59a3d517782a770742e9c372fc3cc445c5fa0f21fc95b2f9f772e543d9e7b8","input":"0x38ed1739000000000000000000000000000000000000000000084595161401484a0000000000000000000000000000000000000000000000000000000000001260de8b9100000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000032415c695a7a927c99b74ec74812b83b4d9b95200000000000000000000000000000000000000000000000000000000618cdeaf000000000000000000000000000000000000000000000000000000000000000200000000000000000000000012bb890508c125661e03b09ec06e404bc9289040000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x55","r":"0x14a17537ef38d677904445128197559c3189f0e845b0711a68fe72695db4c4ac","s":"0x5d73bfc9b401bf7df60f1b6533b09362843a16c868948b0c438fbd95f0ddda44","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x53d8a6a3368ffe7b844782d8a037012497110d81","gas":"0x4c826","gasPrice":"0x67360f2dcc","hash":"0x45d9c5833a07096083714aaab66379b3967d6445e35835342f4c08ea33dd8260","input":"0x94655f2b00cf6d380000000000000003efe37ec187f35185000000000000000056753281918aef1603002a11b815efb8f581194ae79006d24e0d814b7697f6000000000000000000000006c4f59909000051700fc86c46299cf2a8fd86edadae3f57014351b00000000000030776b66e662c863e0f4e01000052127470de72e5ef77ca962d23125cbe096e2d81000000000000000057c63c8654126cc1040003","nonce":"0x587a","r":"0x41d4bd728e913aabd2d4b51dd04448963d191ae378c86b8116d6f9ec73bced28","s":"0x178441dbd75309c38ad89c5510f43213a3179e7f6b3901b5358230efd7f0deb","to":"0xe0a9efe32985cc306255b395a1bd06d21ccead42","transactionIndex":"0x4","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xc0cef6da40144bc148f744c6c07c5fcb75b92521","gas":"0xba41","gasPrice":"0x2c3567fab0","hash":"0x4378d013038b20e5491daab55d02c2f63fb3ce6a007ade9e492a9ecbfc80df16","input":"0x1cff79cd0000000000000000000000004f91ad1a0397b763fc653b4cfe4f836915bfcd8400000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000064f5537ede000000000000000000000000514910771af9ca656af840dff83e8264ecf986ca00000000000000000000000056178a0d5f301baf6cf3e1cd53d9863437345bf90000000000000000000000000000000000000000000001674d6b23db4fbb340000000000000000000000000000000000000000000000000000000000","nonce":"0xde0","r":"0x435a06705542c97437092a47f2dfb1880865a16885912d72fee165012d82c6da","s":"0x6f4101aee12bc57211595ae64ac86433b4c3ea18e5b63c026608e68aa4acaedd","to":"0xfa103c21ea2df71dfb92b0652f8b1d795e51cdef","transactionIndex":"0x5","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6beef2b2fe00fddca12a8cda2d4b00435b0ba3b6","gas":"0x32bfb","gasPrice":"0x2c4d3f7ac7","hash":"0x6f9ced3adb92682db1f2a3e81182d2c84249d0aaa641ea263084417fe9fe11af","input":"0x7ff36ab50000000000000000000000000000000000000000000000000046aa3f1292cd6700000000000000000000000000000000000000000000000000000000000000800000000000000000000000006beef2b2fe00fddca12a8cda2d4b00435b0ba3b600000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x6d7","r":"0xea8a4e60c8b14ebaa9b190dbdbfbb45058844b1a416a8bc3992c6ee78648a72f","s":"0x4f935a9109278696fe293fc9da95ff7b0691c629c46b3db44cf9a61b28c2169c","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x6","type":"0x1","v":"0x0","value":"0x254e34b9c119000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xa2b37de633292d5cdef5ba5defe6e0f8c342e05b","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0xc0e1fa71208c0d2bf173df56f42bb7f865cebddcff7c1548a6de90a2918f579f","input":"0x","nonce":"0x16b","r":"0x30ad4b89b9e0579b841b0cb5774499c3de2686a2510a1b3f3c9966a2ade01c3d","s":"0x3adf11bf54ebb4451b5341190d917422677b68c2bbd55de6771300a96d4576b0","to":"0xde10091bd2d28cf09c4ce0ee4d88d39da7017691","transactionIndex":"0x7","type":"0x1","v":"0x1","value":"0x1d7d843dc3b480000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6317183476e1ebc7634f8fdfe481d94e3a4c6561","gas":"0x37d95","gasPrice":"0x5a84c51bc7","hash":"0x65231527913be7832119787df4649bdb6e981e6f79436556152e486d9dd5438e","input":"0x7ff36ab50000000000000000000000000000000000000000000000000f363d9f8a0bec8c00000000000000000000000000000000000000000000000000000000000000800000000000000000000000006317183476e1ebc7634f8fdfe481d94e3a4c656100000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x462","r":"0x9aa6a744a6fafc04ec4165d41d0e2b6bcf23c7cf9fc44d9f30e285fee708d9a8","s":"0x2e667d8b7c9c66f5e78c860631a8923d9a387240afb43e0135e1d2efa310ecf3","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x8","type":"0x1","v":"0x0","value":"0x8ac7230489e80000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfcf6a3d7eb8c62a5256a020e48f153c6d5dd6909","gas":"0xda59","gasPrice":"0x4d8858c200","hash":"0xb7ce11ea38e6079689241b9c0b507407dc785883d50378b5efcab84e176496b0","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x1d8c","r":"0xbf2d141d9702cc5be03df29b32e568863bf4cd0edce8c1fa8ca11e3670490ce9","s":"0xe72c8741a3a5f8fd21ca99daa383e05efefc430f432ab00539e994a4977d208","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x9","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x13f5d0fd545dd26122aa7734ca2505c178521981","gas":"0x34377","gasPrice":"0x48619170c7","hash":"0xd932d8a9705b158887d0302ce77e8b87b44038b7807889fc6e1ba02c1850015f","input":"0x7ff36ab50000000000000000000000000000000000000000001c206230e80a63c7060f20000000000000000000000000000000000000000000000000000000000000008000000000000000000000000013f5d0fd545dd26122aa7734ca2505c17852198100000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a2667a0fcac45713029a60e4e97104064d9349e2","nonce":"0x8","r":"0x222ab5b2eb84972d13421c93aefc8960c35a755df99e427e8e682c92dfdf10fa","s":"0x54d2274bc64f0717190fddc8a9c38db575c2be83097d93613b4e02af819e5ff0","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0xa","type":"0x1","v":"0x0","value":"0x853a0d2313c0000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x2c65dba0b5ed7ed82b70ce086f4f206625e0fdc4","gas":"0xb210","gasPrice":"0x33aca67f57","hash":"0x76782fe533f4ad8d4a2213e2992d0774b44f2c5d655706bd7d24349d537ff3fc","input":"0xa9059cbb000000000000000000000000fa72bb90a768852c9e4756a4ecddc587af0cfa72000000000000000000000000000000000000000000000000046314724d31c000","nonce":"0x55259","r":"0x1210b03b4335f8ed2c2fb207d4076bf13410cbcfecdc7575e6e2b1b62f21e633","s":"0x1cc49f822a2c6e6de776a7e3b91addb7d560572f297b351391fb1011d45acbfe","to":"0x653430560be843c4a3d143d0110e896c2ab8ac0d","transactionIndex":"0xb","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x2c65dba0b5ed7ed82b70ce086f4f206625e0fdc4","gas":"0xb210","gasPrice":"0x33aca67f57","hash":"0x78303c100a3eedf92aa41ec3edf7d4c735c09a6f5cedc3c16de1aca195215e54","input":"0xa9059cbb00000000000000000000000054dc77f7b3233465bedb5c904a435040aa4fcb07000000000000000000000000000000000000000000000000356a3c146ef3e400","nonce":"0x5525a","r":"0xafba721503588593704baa5a7f3b875568407572f4ce559164ea926899df9c37","s":"0x2df11212ab106303c1593c4e651c6c43edc602590ec338d03174cbfdfa9e993c","to":"0x653430560be843c4a3d143d0110e896c2ab8ac0d","transactionIndex":"0xc","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xf66852bc122fd40bfecc63cd48217e88bda12109","gas":"0x15f90","gasPrice":"0x32fd6ace00","hash":"0x5a1cb4c353ff0ff1de52d14376f5bfc841a566ee58fd197102006977efbd7386","input":"0x","nonce":"0x1f071","r":"0xd1d8ba0b4de388d1acd1edc18b137eb054c6a928bd8b81f50010e186717b68e","s":"0x6762b81cf59d14a9f0ee6894b79e189f0a15ecbe78d24ea0e1bdc4fa74a0a458","to":"0xd56eef796c63c4a28cd345ff76219971c08b655b","transactionIndex":"0xd","type":"0x0","v":"0x25","value":"0x22a392c68f600000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xadb2b42f6bd96f5c65920b9ac88619dce4166f94","gas":"0x19a28","gasPrice":"0x32fd6ace00","hash":"0xcd231be11688fc0ef459c51c70229f20f44ab9b401b879ea76523a749f133f45","input":"0xa9059cbb0000000000000000000000002d67dcac773ce71ca90b42555be08d61640d25740000000000000000000000000000000000000000000000000000000b331a4580","nonce":"0x1a65a8","r":"0x43a389008ff770a37edfb045348845061f244c947f91e32d03d7b895435d1f8e","s":"0x7430546c310c3333c8810e807a411505d0b755d32db1463cb7f9de1d9c8e44ee","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xe","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x91962711a4d2e4a830b366ce7276d99001e8564b","gas":"0x5208","gasPrice":"0x31e9739473","hash":"0x7a1439bc2a44ff7570924b4f78d9558234436a1247a79a30c4020fe3e7bdf4a7","input":"0x","nonce":"0x2992a","r":"0x35c8efeba8c1681f16e3c329345009f52e2f3098359cd3baa58edf1c8c70d3bb","s":"0x2337ed9b36468ad060d0e20a734519140f42623f2273372bd6c2494469ba02cc","to":"0x7e639afba252608548edb5c20588b1a36e611357","transactionIndex":"0xf","type":"0x0","v":"0x1c","value":"0x45ba89f06d0e000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3158e4a3e5d878ba2a055c60b8c7c19936997069","gas":"0xf4240","gasPrice":"0x2f7f58f800","hash":"0x5ea0401b40377ea4a7690f2ff7d256c3892d5cfd20a4953017b3483dacf2fc03","input":"0xa9059cbb000000000000000000000000af42405d3fdfcc7c391178b350d0b86a395c099e0000000000000000000000000000000000000000000000000000000013019f38","nonce":"0xd27","r":"0x14401e3b54c0829dba44246e27c9a8a4547c4971622446f1a4ae4b3494745a1b","s":"0x2ee1520766cd1628e4d8e9cf413734e646d379757fed73c9e72c4850fc22b3c7","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x10","type":"0x1","v":"0x1","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x58d28d4f52e9f5d2723c65f99b37bd74b054e5be","gas":"0xb4bb","gasPrice":"0x2e73e2de59","hash":"0x02965b8f5d46a239560cdd1a8435bbc3cfcb12ff26cbd730050a6250269ff3fe","input":"0xf14fcbc8d826bb8e66a5b2041f2eeb0327103d2038e17c94784a8a0c341af336c15406f3","nonce":"0x0","r":"0x4511b0b72c706dbdd906ab3831cd5c349a6b77a406146f5f00d717ba0f45e41f","s":"0x17ab91da91b78919edbda58ad30cbd84e2ff92cd23017965aa156aa55599277","to":"0x283af0b28c62c092c9727f1ee09c02ca627eb7f5","transactionIndex":"0x11","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x2dde1d7200","hash":"0x06297e870b52babeada6cdfac98c46dfbaba284e15d9691e7f82221e1ea16d2f","input":"0xa9059cbb000000000000000000000000ef017b8acdd23c0acb84e0516e16f59811af41b500000000000000000000000000000000000000000000000896fa23fae2f88000","nonce":"0x1d12cf","r":"0xc3bb30e1a318cc66a4ca5388c9850988ea492f34ca613f03f21fcaa1dae585dd","s":"0x1ebec0db970e0a9c5d087db4a4ce21d9fcf975322b628c2ccb7aca30599817fb","to":"0x75231f58b43240c9718dd58b4967c5114342a86c","transactionIndex":"0x12","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xa7efae728d2936e78bda97dc267687568dd593f3","gas":"0x33450","gasPrice":"0x2dde1d7200","hash":"0xa0b026ac2b423264e8d1005a7bb1c80810cbf78356259143ca716fdc435c7193","input":"0x","nonce":"0x657e2","r":"0x2ec24330a32c6ee907ebebdce8c076edcd65933a60997b975c469f1ce0b7b88b","s":"0x38062b3dc982f8eb5a1a07cfc208bd690f777ae2f2267ecc6694824a63f28e10","to":"0xf3b3745028b013b60485db42c1b5401dfbd55dc3","transactionIndex":"0x13","type":"0x0","v":"0x25","value":"0x360ba19d5af7000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x13969b82413a192438c01d0eff4b3f65e5fe843d","gas":"0x2bf20","gasPrice":"0x2d9696e600","hash":"0x27b4ef7e92b8c0d199a7089a479e32512778367fd7ce769c9ae0d0d7ead3bbbf","input":"0x7612290300000000000000000000000000000000000000000000000a88c5f5eb5d4a7000000000000000000000000000983110309620d911731ac0932219af06091b6744000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000128d6ddb1f637b3175822b0c25800b603b236712a3fe15ee6f5966affc3ed51c0e404ffa64c7ae761a27029d84ec146d4adfce081e42a94006a12af5da4ee8a2a9d8ff0dcf9e5d2d6dcd8087ea2263508aa3e911ada84fa8b3db783a1b34c89475e9f0af29ac2a51f442f4e11bad1588c627dd3596d5c07ea6aa0c3ac32677fe78ed95da5bf3edfe0e57259a48310a96cb8aa56abe652c9e0156592533867b36adc36b402abff7d17ac76ed9a702b73e3166d20866a8d9fb159d0b4fcf9d38c0e1268ee39c4b9f7522d7c0a9ab3260ce6e105952a6184fc5c3a7fe098d4fb7c3c337be97cdb6c1c42053dcb065d20b6a3c56e93a2e1bb936b8e217fe08c26bb03981f8b052ef97746f7bd55e2fd86c0d04f3af916fe679f0d39638e5ebf9a42cd97e25ac553a63ef47786d4a1da9a5c213586c8aee2633777d232038b4b51bfee5dd84d518da84a06ab0558010cf446a4c433deef8ee64dd3d30d25815be9cceb5ccd419094966a7bb140e7ad41fb17448667ca9dd761c7d67d4eb5cecfa88dba850606ad26b4ced935bfd45c4cc0dc5e43d121136f7565316f27751d810d3f9ec63cff419c14c1597ac2b51231125472b462fb4a540692db0035984e6b0ac6d5b0d1256dab3842b9276b640916bc880643adecea6f052b1fa0d6533cb0ad86eae0cc5f3bc832f9b3950932b134b4966600963ab0b19d885642144ae57b6706ea78954ecdc07c99ac4a862d008e5dd179f19c899c1b923f33cae8eb31910352a450b8e3f753c0ae0482b1980cbad3eac8d727ae0c0f40d9c23f2e8c8b08e4e9d7b","nonce":"0xd","r":"0xfc362163a023050c59bb8c027c93799f9042840f8d15047d4e56730657a8f8a1","s":"0xa257f2bc6786d414b5f33091f6ad736aa397ef887770c6089af28e237bd7e95","to":"0xc18360217d8f7ab5e7c516566761ea12ce7f9d72","transactionIndex":"0x14","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x78c91d88846b1df25d100a3bcf013a9585494bdd","gas":"0x37d86","gasPrice":"0x2d79365b94","hash":"0x5856200e70a34e82dcd079d088ce2830ac9ae428115d81545052343096d0b58c","input":"0x7ff36ab500000000000000000000000000000000000000000000000000529c066aeba335000000000000000000000000000000000000000000000000000000000000008000000000000000000000000078c91d88846b1df25d100a3bcf013a9585494bdd00000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x59","r":"0xf0f0088f7975f7d20dd8ebbf061750bd3a9e25f9a5a1335d9b2d1d24476a936a","s":"0x6a63c26bd7f845a621c17cbcd124ef8adbff962e9c6710b08cd36951f0263b5e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x15","type":"0x0","v":"0x25","value":"0x2c68af0bb140000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x28573","gasPrice":"0x2d79365b94","hash":"0x6f83209b698c225128c25d94116930a36df61eb0fc1e76f34af68b408360185f","input":"0x0175b1c4bca4a1109bcb3d7e17cdad1698e31e05e2a140cb5bc6fa4baf209297146782d2000000000000000000000000818ec0a7fe18ff94269904fced6ae3dae6d6dc0b000000000000000000000000a78d2c69b34cab96cace31967afe52013dfcb6040000000000000000000000000000000000000000000000000000000033b390b00000000000000000000000000000000000000000000000000000000000000038","nonce":"0xf58","r":"0xeacc246c58d38699ab3e7367c1578d5ad7d806e382bcb504ac4b7e4ef8e695df","s":"0x5fe49965460bda4570f34c9ae5df29ee74a2749af6ef03dedff7f739eb7c99d4","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x16","type":"0x0","v":"0x26","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x60f9e80d0d40b2958ac39006635de782096866c3","gas":"0xc350","gasPrice":"0x2d1dec8007","hash":"0xf0a396e0da6eb0f21bb438af0fc8a9031df366ed9c0e41583e3360a7845e2888","input":"0x","nonce":"0x58a0","r":"0x7828e098e596168e69597ab8760c88657c8095e0a4595c46e470a46389588273","s":"0x62768035ca58a43b2f1699bcc956998ad59951664794086c9066f97b72d37c88","to":"0x748010fbe056562ef466901f1d38ce481f11b539","transactionIndex":"0x17","type":"0x1","v":"0x0","value":"0x40c16a1c998800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8fedbeed1b90c5a72e06407c8cf316d76c500ca9","gas":"0x32ddb","gasPrice":"0x2d1ddd3dc7","hash":"0xfa47d2956cef04d8e646c57eec7d84e3329fe3a3caae6ff23ea86ebbe484eaa2","input":"0x2e95b6c8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006f05b59d3b200000000000000000000000000000000000000000000000000000f87c2799d6884650000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000100000000000000003b6d03407782397f13a9a649cbea2b132498feafefdefc4de26b9977","nonce":"0x1fc","r":"0x3a4f89b17641c9878ac16b4e48c1e5b2cebcc90ed2260b264612313af1887bd9","s":"0x2d1615d7a0e8054f78575ae40a0411af38ff7eecf55a50fb74f76da61a9d75f7","to":"0x11111112542d85b3ef69ae05771c2dccff4faa26","transactionIndex":"0x18","type":"0x1","v":"0x0","value":"0x6f05b59d3b20000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8b5e270c19eb8f28050a561d0be08690cc33e73d","gas":"0x3b006","gasPrice":"0x2ce24273c7","hash":"0xdfacc3e5bcabc6c7665dddeadb00f6c2343f5cc77be32b9f0ea023f87136eda3","input":"0x7ff36ab5000000000000000000000000000000000000000000000000063490c47b864f4900000000000000000000000000000000000000000000000000000000000000800000000000000000000000008b5e270c19eb8f28050a561d0be08690cc33e73d00000000000000000000000000000000000000000000000000000000618cd8d30000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x1e7","r":"0x4c198a776a509704be79bf77d487d32093832523769da30ccfe89067674f790c","s":"0xe47962c1b70814d9481f744049a160dacb370d7af4eb39e73ef30f5f2c5b92f","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x19","type":"0x1","v":"0x1","value":"0x214e8348c4f0000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x433acb9148a3418cedf924a4529f84b815c60a79","gas":"0x3b006","gasPrice":"0x2cddd0a700","hash":"0x0f29b8168328beb7dc485cadf40056da2cde6ce885c121b0ec8784a690b328d8","input":"0x7ff36ab500000000000000000000000000000000000000000000000004fa2fb4e1093b5d0000000000000000000000000000000000000000000000000000000000000080000000000000000000000000433acb9148a3418cedf924a4529f84b815c60a7900000000000000000000000000000000000000000000000000000000618cdc230000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x1d1","r":"0x22b5e3bca2c92cae2389383eb13627b1e55ee57f7c6b502a568579943d27232d","s":"0x4f95d1fdccd3ace143f35765103f2923618284af67d3758566af3c7efee81a58","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x1a","type":"0x0","v":"0x25","value":"0x16345785d8a0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x91b44850bae3d99c6dd6d97c1ada2bdaeba6c3c4","gas":"0x30d40","gasPrice":"0x2ccc34b347","hash":"0x015e81c596f8b47021c8907037f3854ef5f080bccc16fc06aaf640dddf495938","input":"0x7ff36ab500000000000000000000000000000000000000122d0ab68b99fdfa6982a2fa24000000000000000000000000000000000000000000000000000000000000008000000000000000000000000091b44850bae3d99c6dd6d97c1ada2bdaeba6c3c400000000000000000000000000000000000000000000000000000000618cde7b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a184c89d44dfe9692a71f9f936cc80749cde42af","nonce":"0x69","r":"0x590bed324e0bda6ee8c59063e7a36c23cec08341cddd5f30b63d7911c6dc8d99","s":"0xb987df48b1c327b5b033288dae3cb2196d722e0226faf06a65baea1eafea7cb","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x1b","type":"0x1","v":"0x0","value":"0x470de4df820000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x7eb04a849878bca27a86ccf4febb05fa7c60d5f963053f5a65e553c4a07884be","input":"0xa9059cbb0000000000000000000000004c167cc2cc270399be98b9734cedeebf3bd11353000000000000000000000000000000000000000000000000080577f0082d0000","nonce":"0x260dcf","r":"0x7599308dac7be69d204409e264adfd60a017f754dbe73249116cb9d9d0d5fb11","s":"0x66d8dfaa32debf257c43151a00a90e9dfb83fc7744204c6039bd028567e0e708","to":"0x767fe9edc9e0df98e07454847909b5e959d7ca0e","transactionIndex":"0x1c","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x486b7fca0da550e0f15792fe25681c43f6fd43b562f9b47dc128496bc6a32523","input":"0x","nonce":"0x1b1232","r":"0x25aca5b06fa4f663f254730fe7a568a2b348542a6728328ace447e4ef083a721","s":"0x7b7c0519ed2bdda86c8607346c224e63e806d17005d0ed05a541a7628a3d8e08","to":"0x54f03e27b7f327e339c084fab74c3552a03f9967","transactionIndex":"0x1d","type":"0x0","v":"0x25","value":"0x281fd54a17e000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x4976a4a02f38326660d17bf34b431dc6e2eb2327","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x5c612f5589b07daaecaffa78c6d6d908ea4a0c73a606876351b628b2ec8db2e8","input":"0x","nonce":"0x712a0","r":"0x505b46e125f68290d943ba53546a9cfd6c904edd8bc26318b86663b7a6625c5b","s":"0x4b38818e32dc7e19f7bd97ca7899d1dbe44f19e4d5ab150b8a27ffc70e8dd0c2","to":"0x81d99fa15e4f420f77ca66805e31c60685ad8337","transactionIndex":"0x1e","type":"0x0","v":"0x26","value":"0x15783c6dd20dffc00"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x21a31ee1afc51d94c2efccaa2092ad1028285549","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x6e77c3f64025b4d57b26bdab4d96c643ccef02e240a6f06058ef0807e3fef7ac","input":"0x","nonce":"0x226e29","r":"0x7654f358224e0a8d0c7d7d3b73a4a954aa07a4834cf13e1075cace2e7612e8b8","s":"0x68558dd40724865e6d26770e34a5cc71683bdf87433cdd6c9d58a2c0261e0879","to":"0x595c74da6602c24bc7c993be1151016090d575a6","transactionIndex":"0x1f","type":"0x0","v":"0x25","value":"0x1531c11d3e60000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x9696f59e4d72e237be84ffd425dcad154bf96976","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xe1b50104e8394a737aa1ab40da31c654aac5e005d221cc1ff36b119df6bf0960","input":"0x","nonce":"0x19dacf","r":"0x58494a9eb56616ad444635f3dc60e8f642e3f4435a0a8ea7c6aa4d4d64210a5d","s":"0x8dc3d2de76457de2d2fa4abeaeb3e800b9b284277a4f024fb1742f74678c7b1","to":"0xf7fa72a3ef7fb44a0bf46e1c795a017fb4be47ee","transactionIndex":"0x20","type":"0x0","v":"0x26","value":"0x252b969bf668000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x2653c3f5bdeb00e3a31a45ea95d10015b8be46f4a514b8d1d30378a30e8a3f40","input":"0x","nonce":"0x260dd0","r":"0x83f87af5448e6d84d32a8c77a424a7c05acdbb18194623573f871a80e4da9694","s":"0x262852117174858befcfad85b89287e55ebc2f7ee48f7280b9fc94e058d2c308","to":"0xd50b894394ca7b2eb1b4fbcb3d036e7e09b64b9e","transactionIndex":"0x21","type":"0x0","v":"0x26","value":"0x19ff1a388159000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xdfd5293d8e347dfe59e90efd55b2956a1343963d","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x130287e30a02cf004a8ce8f0d5dc5bf31267b58dad0f153116883222af67e379","input":"0x","nonce":"0x2017b7","r":"0xcb078ab6531c3ce5d4d298603003aa976cac527479d5da116c0714255d7e2fb4","s":"0x37bfc40ffaf0da8f3db8e049d6d49109e1c79009b3a76e635c7fb92eb565f9e4","to":"0x6ce372e73148d314321784ad787f22ef094928e5","transactionIndex":"0x22","type":"0x0","v":"0x25","value":"0xc6badc211f98000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x4976a4a02f38326660d17bf34b431dc6e2eb2327","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x2c9a1bc9baabf5c1fcdd50455fd389b5237edf2883040843d805d1207f646338","input":"0x","nonce":"0x712a1","r":"0x3469747e9b4ed237895e3122687834b9ca6d176bc4a319802dc920acff5594c5","s":"0x6f6fd61ef1995b25d745653714160ff2be4f87fd3e42c316e34b578c7f915f76","to":"0x8abe4274dcbad3f2d4acc2915dd2ac32ea441531","transactionIndex":"0x23","type":"0x0","v":"0x26","value":"0x10278b6ae991c00"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x660a34be1b0ed3bc78c6a722705fcee753092ba86ba20fa2be2488d4e2498473","input":"0x","nonce":"0x1b1233","r":"0xc799d5027607e6d01742aae10dbace2b5ed78e239eb655030e71bb1aaec9dcc4","s":"0x3048ac97b108d89ebcb22ed9535e3a203b8c7b555a727530173d3c0d986ba26c","to":"0x0c8dafb1ef6b55819bca5b33c457ae944b166d14","transactionIndex":"0x24","type":"0x0","v":"0x26","value":"0x6a94d74f430000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x9696f59e4d72e237be84ffd425dcad154bf96976","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x135f02bb2d674e2be1d451728a289faa41c2d55f166941d3a179b9f266200fd1","input":"0x","nonce":"0x19dad0","r":"0x6a820d2b216f5cdb32916cf038d3ce3517fff8e5b98cd4f16af9950c5d4ca52a","s":"0x3e7d2e634df7720df2777ec00262445beb84659ebe1d85669b7cf3429f55ad8a","to":"0x12b7c21cc1cf67d80e7714c71433313a62e1721e","transactionIndex":"0x25","type":"0x0","v":"0x25","value":"0x1188ad971c11800"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x21a31ee1afc51d94c2efccaa2092ad1028285549","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xb45af5562729a8c41a48586dc0f30456f5c06394bfd0e2aecee04a119d8ed025","input":"0x","nonce":"0x226e2a","r":"0x536ca45bcdfe1e29d862208d858bcd0cb2770d5da7e15ac636aff6d0208b99e","s":"0x7a6d58a82870b1f546c246d57ea718389d05a6d0e05138b684ce6a4cc579b63","to":"0x006a60000880a8f80342b5c419a899949162e046","transactionIndex":"0x26","type":"0x0","v":"0x25","value":"0x15181ff25a98000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xdfd5293d8e347dfe59e90efd55b2956a1343963d","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x971217c104bddc13b247f3f36d594eb31dc40675526ab7b179272b6da902c640","input":"0x","nonce":"0x2017b8","r":"0xf383e67e7c9db55b10cc22508bf3d506c407a0aeb148303fa2a678abf2dc95dc","s":"0x2acd720d444915cf790f458824dc05df8f65d6d2e7a7c8404b2ca660d452fe82","to":"0xc98948540aaa139a09004461e5ca1062ed92089b","transactionIndex":"0x27","type":"0x0","v":"0x26","value":"0x7c585087238000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x12c55d26843510ff68f6dd24ae3ab59046b0b33a36d7f4afff04b122164460b7","input":"0x","nonce":"0x260dd1","r":"0x762e4750f1a88bc76b7356c4d1bef221f67446b37c991116ad7c9ea2d1ff0d6","s":"0xabd6f93d69c9be1f81184ec9a135b099798dbf7d7dc82bde1dc71ec761bdf38","to":"0x0b403f5bd859b666fc4b9bd02474ed7a37169467","transactionIndex":"0x28","type":"0x0","v":"0x26","value":"0x16d436c8cf848000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xb3217684aa4d651c883978418a51171eee8b7da44307f883fe0b542d866c29ab","input":"0x","nonce":"0x1b1234","r":"0xfeb9c709ad0eff418409920c1fab4472ce8adcffba7cf3964cb1f963b80a6c31","s":"0x4308b60d16ac81fdab1d164f8a4e0a6cdba11b59175eff0b707786ca2676d470","to":"0x5d1b3145120f12669283050940afffb6aecb46d9","transactionIndex":"0x29","type":"0x0","v":"0x26","value":"0x71afd498d00000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xbecf88ebffb178923d778561ef4fe060d39ef28c","gas":"0x5208","gasPrice":"0x2c90543a00","hash":"0xb6d56d13c808e9db96dce8523dea01a255f2b8ab445056be1d0fe4e8deb5c20f","input":"0x","nonce":"0x0","r":"0xc03ccedb92e7eaefc7e5216564bf5f70aac1a0bf26c731f8621313f694a800e4","s":"0x46ac2a8254a41d0d7092ea9729f2c921785fdc41f7ea32f020b693ceba641abe","to":"0xa1f22abf08a74d279b294a3701ad0447e3fd5b22","transactionIndex":"0x2a","type":"0x0","v":"0x26","value":"0xdf814443b26400"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x42ad5aaaf1b94eff0776f3f7f86234dd1c124456","gas":"0xaae60","gasPrice":"0x2c88da44c7","hash":"0xf9ba476b3e8f22e538f6f0713996652dd7e81644b8b87c48ee7a7d7462ecf423","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x2bd","r":"0x264e4e68ea16508d0641d6c3778b0b20d86429c10eed2a26f1afc76a0c4af1b9","s":"0x4705e959629d40503bf6fc2a313e4f740e43fee88789e392df86283ecedfd0af","to":"0xcea8a10df595a20fceca4f6f2c23384eec1e4073","transactionIndex":"0x2b","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xa1dbf7151e6c63879885d6791939ee998f02b685","gas":"0x662b5","gasPrice":"0x2c76f8a1c7","hash":"0xad112f358c7ce6564f7fef1476a9135971f3b9c3987dd90db360d7defdca9aaf","input":"0x7c02520000000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001800000000000000000000000004e3fbd56cd56c3e72c1403e103b45db9da5b9d2b000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000005767d9ef41dc40689678ffca0608878fb3de906000000000000000000000000a1dbf7151e6c63879885d6791939ee998f02b68500000000000000000000000000000000000000000000005c0b3c1516577f00000000000000000000000000000000000000000000000000000000000d75057ce30000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000fe00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000500000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000002e00000000000000000000000000000000000000000000000000000000000000a400000000000000000000000000000000000000000000000000000000000000d60800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000a4b757fed600000000000000000000000005767d9ef41dc40689678ffca0608878fb3de9060000000000000000000000004e3fbd56cd56c3e72c1403e103b45db9da5b9d2b000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000002dc6c027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000247a18c9b233d617a6701b1b098f273b4c54cc121b87e39f5597a6e689eb1d5fe837a73e8200000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000006a4b122f1c5000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016080000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000064eb5625d9000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000003ef51736315f52d568d6d2cf289419b9cfffe7820000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000008000000000000000000000003ef51736315f52d568d6d2cf289419b9cfffe78200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000002e47478523800000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000260000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000618cd83000000003cf806c00000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000006423b872dd000000000000000000000000945bcf562085de2d5875b9e2012ed5fd5cfab92700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000d8c8dd80e00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000945bcf562085de2d5875b9e2012ed5fd5cfab927000000000000000000000000000000000000000000000000ad098094bbc5a800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041a5f6590369bce7bd7c9e12d6bbd325260975bd428b2e1b1ed345331ee8216645450167b50a535de5dcd3da8c0f395a183496e1b9a1da573ef9465ebd63f7535f1b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002800000000000000000000000000000000000000000000000000000000000004480000000000000000000000000000000000000000000000000000000000000640000000000000000000000000000000000000000000000000000000000000064ec77bbdb000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000003200000000000000000000000000000032000000000000000000000000000000000000000000000000ad098094bbc5a8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000002647f8fe7a00000000000000000000000000000000000000000000000000000000000000080800000000000000000000000000000000000000000000000000000000000004400000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd200000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000a405971224000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000100000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004470bdb947000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000000000000000000000000000d8b8f8237000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000184b3af37c000000000000000000000000000000000000000000000000000000000000000808000000000000000000000000000000000000000000000000000000000000024000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000100000000000000000000000000000001000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000044a9059cbb000000000000000000000000a1dbf7151e6c63879885d6791939ee998f02b68500000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e26b9977","nonce":"0x47","r":"0xf2ba16a316c1522b68dfa65d8969d42ef9b7c969d24f1d8a90b4bcebc00c957e","s":"0x712da2d4da711d2267dd6689115dc9b2c41cbd1f9ee2bf97ecd7408b8744482c","to":"0x11111112542d85b3ef69ae05771c2dccff4faa26","transactionIndex":"0x2c","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xc5d9af16b76edc1372de0d8dcb9f38f097dfb6dd","gas":"0x851f7","gasPrice":"0x2c6ebcff12","hash":"0x791893ff90b97ab6c93ad4a234b011c6c77bd9908bdd931dacc849c9314345b0","input":"0x7c5264b400000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000c29f90c26840d553d10b901603eaa3c331144a00b4bb7ef9e47ebef42277124003d024950889a1bbf1302915bff05d917d95169da0fc3a0fa8798219cfb5c14f185ec11b1ee945268252c9afa1b8a1ecf1a4740a21cc41c671d5e793fbfb6dc4f5639df68717f575e51df8c592bc71af89e136f440a12f61a891ba08d50ea654442d02b9d5a3590b6f8aa7891f00ed7826a645c1aa8d79b2689864d5f42d2cc1d77baf1185fcf9aefc859a8e95c58dd8f8cc2ffb1401965cbee61c416b82f18387213d4332f2b3f54f6df68a033783600a733859c594a4d4ab07b1353fd021654c912d2ddca6214345a984d71bd37587ae98f447757b8cf7670099349d0643725d4c79cf93677036a6541c90a7953363e00e597456ec6e01aaaf2af4df9e4a8a98f99293bd2af0a7656e6b990cefad4e508c098b9a7e1d8feb19955fb02ba9675585078710969d3440f5054e0b5f5ddc8ce437c492c96136a4cbaeb64d95114f4718c252ed908b5b8dd24f8858401430cb884618a829fa0b67b6231bf03b5d3b6a2c36246cb4aa72eb5ddd7d32d4c73ecfd1552f45dbbb4a0c02ab99cf1fa2fd98587989da8a2ebdbd32ace98db3d994ca059a29cee46b952b9046cf904690183be8456b9010000000000000000000000000000000000000000000020000000000000000000000000000000000000000810100000000000008000000000000000000000000000000004000000002000000000000000800000000000000000000100000000000000000000000000000000000000000000000000000020000080000000001000000000000000000000800000000000000000000000000004000000000000000000200000000000000000000000000000000000000000000000000000000000004000000000000000000001000000000000000020000000800000108000200000000000000000080000000000000000000000000000000000000000000000100000f9035ef9013d940000000000000000000000000000000000001010f884a0e6497e3ee548a3372136af2fcb0696db31fc6cf20260707645068bd3fe97f3c4a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000000000000000000000000000000000000000001010b8a000000000000000000000000000000000000000000000001ce4dc640b8334000000000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000001e1ede4b254723e0b8707e750000000000000000000000000000000000000000000000001265d8ac0bb6ca4800000000000000000000000000000000000000001e1ede680a2387ec3ba47e75f8dc940000000000000000000000000000000000001010f863a0ebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4fa00000000000000000000000007d1afa7b718fb893db30a3abc0cfc608aacfebb0a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6ddb86000000000000000000000000000000000000000000000001ce4dc640b833400000000000000000000000000000000000000000000000000001265d8ac0bb6ca480000000000000000000000000000000000000000000000001265d8ac0bb6ca48f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000007b5000af8ab69fd59eb0d4f5762bff57c9c04385b8a000000000000000000000000000000000000000000000000000035eb829e5040000000000000000000000000000000000000000000000001cf7459b6fb8cfce4800000000000000000000000000000000000000000000596a93950858c524d3c300000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000000000596a93986710ef09d7c3b905fcf905f9f8d1a0b174784408272b04f658b210964dff62cbfc72dd401ba4e7e5e23d12ee0dad35a0bdc70fe3a6b10c5466f9c1b3519b36d7f7ea8bb761c23b4f845eda84f8c2cfada00a52a19ab441cd8a72731bf02d756fe60553c23a52ee80967b9bd969028ce3a4a03e3eee6389038435aba94523fd16764f2e6c1705c538b6e966161a13dffb177ba06d8c2a14b01518ccca2db4ee5c33db0f88a9f0d8259e0bfaecc9241fb43a080c808080a03085c12b2b13e234683b07a9e61946f66ea06186ab4acf79c50b658b1e2a0fca8080808080808080f8b1a0e5d3f4fb0dee2d87988eb0fd5b9d702875c2efb6eabf029611bdd4ea7c1d5809a0cae178dbeea42f26e8182db8e273af7a00db705545b8f8dbff8c0c6d7487e169a02c8d5d3cc7ae614446f25e90b989c0fcdce696aa5652cd38529be7af93755e70a086f7958bb7f24189c3042a717004fc7cab2f7e7eceb2122d236a4eb4835952f5a0ba8eb94f588e4acf854f22e7e360e13a0d2933fc356c799cf7c4fb33c6443137808080808080808080808080f9047020b9046cf904690183be8456b9010000000000000000000000000000000000000000000020000000000000000000000000000000000000000810100000000000008000000000000000000000000000000004000000002000000000000000800000000000000000000100000000000000000000000000000000000000000000000000000020000080000000001000000000000000000000800000000000000000000000000004000000000000000000200000000000000000000000000000000000000000000000000000000000004000000000000000000001000000000000000020000000800000108000200000000000000000080000000000000000000000000000000000000000000000100000f9035ef9013d940000000000000000000000000000000000001010f884a0e6497e3ee548a3372136af2fcb0696db31fc6cf20260707645068bd3fe97f3c4a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000000000000000000000000000000000000000001010b8a000000000000000000000000000000000000000000000001ce4dc640b8334000000000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000001e1ede4b254723e0b8707e750000000000000000000000000000000000000000000000001265d8ac0bb6ca4800000000000000000000000000000000000000001e1ede680a2387ec3ba47e75f8dc940000000000000000000000000000000000001010f863a0ebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4fa00000000000000000000000007d1afa7b718fb893db30a3abc0cfc608aacfebb0a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6ddb86000000000000000000000000000000000000000000000001ce4dc640b833400000000000000000000000000000000000000000000000000001265d8ac0bb6ca480000000000000000000000000000000000000000000000001265d8ac0bb6ca48f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000007b5000af8ab69fd59eb0d4f5762bff57c9c04385b8a000000000000000000000000000000000000000000000000000035eb829e5040000000000000000000000000000000000000000000000001cf7459b6fb8cfce4800000000000000000000000000000000000000000000596a93950858c524d3c300000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000000000596a93986710ef09d7c3820041010000000000000000000000000000000000000000000000","nonce":"0x34","r":"0x67fdbb9b467db1a528845795e81133c986db016159f2494dd48c5355b2ec0425","s":"0x7ba490dc06fb8a81d4d359854403d941b7e4adb07866f0495d658fe715ade0ea","to":"0x158d5fa3ef8e4dda8a5367decf76b94e7effce95","transactionIndex":"0x2d","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5b98b06e4570e66a6db9976059b564fe6c39cd49","gas":"0x30d2a","gasPrice":"0x2c6b0cdfc7","hash":"0x5f2cd9ddc16571cdc6a2957e4200c262a17c4a0c2871ad540b69db91ee5be37c","input":"0x7ff36ab50000000000000000000000000000000000000000000000000c59f4be6f408d2d00000000000000000000000000000000000000000000000000000000000000800000000000000000000000005b98b06e4570e66a6db9976059b564fe6c39cd4900000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x561","r":"0x3607eac234b5fe35155a92cd4fc9c3a373ba802088e52c0597b1acc92c0c2d95","s":"0xf9af0e168b435783c18241c72fae0bd88c739cd47121691be01183afc918cae","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x2e","type":"0x1","v":"0x0","value":"0x429d069189e0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x51ec652e62d4164811ef81b32cf146da91268833","gas":"0x124f80","gasPrice":"0x2c6b0cdfc7","hash":"0xf7646018e728bbaedf5caffe072c214d7848cfc20ce13c906204c36a446b9869","input":"0xa2abe54e0000000000000000000000000000000000000000000000005a777b7b37a7b4e300000000000000000000000000000000000000000000000000bd2fd0fabfc9900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000000200000000000000000000000018cd890f4e23422dc4aa8c2d6e0bd3f3bd8873d8000000000000000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000026f20000000000000000000000000000000000000000000000000000000000002710000000000000000000000000fd76be67fff3bac84e3d5444167bbc018f5968b6000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002710","nonce":"0x2863","r":"0x8e87dcf38ec95fa8243987d40654e668b0b0566088f7c160cfcaf04fdf61f658","s":"0x143a696ae40e52932bd5f323836968add77fbc5ec500959fbd6271680af13196","to":"0xe37b5e4de6c4c6e3a1b5d914722a7daaf37590d0","transactionIndex":"0x2f","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6458735613c27e565709194088a64632d980e5fe","gas":"0x35a6f","gasPrice":"0x2c6b0cdfc7","hash":"0x110c827394f45457e59041dc317a45ad535ec9e5402b0b55bd8114e81f0fed75","input":"0xfb3bdb410000000000000000000000000000000000000000000002115c52050236ce1e0000000000000000000000000000000000000000000000000000000000000000800000000000000000000000006458735613c27e565709194088a64632d980e5fe00000000000000000000000000000000000000000000000000000000618cde7b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000001e2f15302b90edde696593607b6bd444b64e8f02","nonce":"0x6a","r":"0x104a8cb77ac755291758d8ae79567ed44f67f98a88b02bb71b7d8ea27138ea16","s":"0x1cf2bc9f4635d1ef24fa7ce46677fc326e415461a4514ab7b9efb2611de00319","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x30","type":"0x1","v":"0x1","value":"0x2312d24ab54dfde"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x9b1a1d93cbc4c3a5584b7e58656e6dac00b4fcee","gas":"0x3aff8","gasPrice":"0x2c6b0cdfc7","hash":"0xf7b9e23593d491b851b4f735aa8352597a0a88a811e11e6983e5d119ddd12668","input":"0x7ff36ab50000000000000000000000000000000000000000000000003405a833a51942ca00000000000000000000000000000000000000000000000000000000000000800000000000000000000000009b1a1d93cbc4c3a5584b7e58656e6dac00b4fcee00000000000000000000000000000000000000000000000000000000618cdb2b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x118a","r":"0x5252af9dcedcef0c16472adfc76602d223052e5594274a354e79e996cabe6465","s":"0x1ba64904ff40e18b5a2a79341d96db7b0b06611ce3beeb6fb554b13abf544ab5","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x31","type":"0x1","v":"0x1","value":"0xde0b6b3a7640000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x2a6704abd642917ef20ec90b88a9493995b6b2c9","gas":"0x32c26","gasPrice":"0x2c6b0cdfc7","hash":"0xdc857550e278dd14b8e8bb8f5639dce1a951af0e23b5e0eb56c7728eebb4165e","input":"0x7ff36ab500000000000000000000000000000000000000000000000001468d5e80a2eb7400000000000000000000000000000000000000000000000000000000000000800000000000000000000000002a6704abd642917ef20ec90b88a9493995b6b2c900000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0xae","r":"0x108cde6a5479fcb04d0ff4ee676b6fa6a1ec7877731ca80a75b1a72b0d9dd2f","s":"0x7afcaa0b59399970004786d6519facb8ce1fe2f0bbd5bc9606ebc87b63a68adb","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x32","type":"0x1","v":"0x0","value":"0xde0b6b3a7640000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x4dcd03a25c9f03522b22d3a0e9708ee0f13e9269","gas":"0x3aff8","gasPrice":"0x2c6b0cdfc7","hash":"0xe0383f61d7d5b4232ec4e85aceefe621609e4aab8a09958d93da3c9e2e66a4a6","input":"0x7ff36ab50000000000000000000000000000000000000000000000001e2e03a400a19b9800000000000000000000000000000000000000000000000000000000000000800000000000000000000000004dcd03a25c9f03522b22d3a0e9708ee0f13e926900000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x13","r":"0x38f4e7c6e9741a18ade6b9669743e62186008fab70392d1c5e0e4447ae7c23b3","s":"0x78afd6a87eac9a71d0607d71c7c528f4a7c975da61fa59b61fa833ba420cd926","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x33","type":"0x1","v":"0x0","value":"0xa688906bd8b0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xda721a685570e13d867e4b815f01d7310100be08895eb29035feb8edc8d07bf0","input":"0x","nonce":"0x3e3c84","r":"0x79053831d9c0a7c857e0051e9161cfccacdce1ad266736d01be6a6bbaa96978d","s":"0x3dafe04bd1dd96699e7c761c84ca7243a2250f0afe4dabdf849f69af6f6e66eb","to":"0x583d4a15e56017a27f1e94e62978d9708b6a1836","transactionIndex":"0x34","type":"0x1","v":"0x1","value":"0x3fdc675b0b9800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xcc7b3a8d67e2f968eec59052521ef876202edb166a056d2f4fccd1411eef6546","input":"0x","nonce":"0x3e3c85","r":"0xf3709c07a153dc5586d40787a681adae28390b57f84e7decd6784d26367ca303","s":"0x3c6ba8ef3c303784eb584cb9e89c38b93d30bac0d77cc401250ee7a9506226f3","to":"0xa1628e810526f320b07269d6758e42ec78fa237e","transactionIndex":"0x35","type":"0x1","v":"0x0","value":"0xc08c5ffa25a9c00"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xb96f51f2f565230bc1ac0f3c9823ee3f9f1d5de572d82b4fc70aaa625bb5a90b","input":"0x","nonce":"0x2d7519","r":"0xe1a547f17aad6d5c8de3164e83a1bcfa793eee5a52ecba437a43e1fb47331e70","s":"0x7d3c0a485786f9670d4b8812651b27e1b00af33d8356286f3f1a775dea74ab9f","to":"0xe115f4e7d49acc941502d3dbf1163de1aabc44e0","transactionIndex":"0x36","type":"0x1","v":"0x0","value":"0x3a98497a3bec00"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x503828976d22510aad0201ac7ec88293211d23da","gas":"0x3d090","gasPrice":"0x2c6b0cdfc7","hash":"0x3be57f9143ec090f5584772543ea3ed412b33b818c2fd75e05d5295c847c071f","input":"0xa9059cbb00000000000000000000000095163b57dd46dc9f792f7357efd116ca4c07fe8f00000000000000000000000000000000000000000000001c69ee3aa20df09c00","nonce":"0xd8bef","r":"0xc342367fd4b4992585031aaa9a0bea60ffaf4704b6c406168e52a2cf2db55e7c","s":"0x561b9471a5cc08ac874c363247b8621991e272c73f0e738857a15a0b3a0dcb69","to":"0xd26114cd6ee289accf82350c8d8487fedb8a0c07","transactionIndex":"0x37","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xddfabcdc4d8ffc6d5beaf154f18b778f892a0740","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xd9511045cef7f901d652794c2fba8b193b9a2e4e692419fd7edd8328c1fffd69","input":"0x","nonce":"0x2e6710","r":"0xfa6bbfbda22737744bbf47640c63a6ffd55e0131780901301e96de69e239297b","s":"0x1ce9ea361f504071caeb023414fe70123170aec1288726cd6bfbdaa04e42206c","to":"0xc9464b8f40c8c1bcbee59959e581627e5ef1e654","transactionIndex":"0x38","type":"0x1","v":"0x1","value":"0xaf6e239362c800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x01fd82278fb04b5c0990eff17959b00d81c486bd","gas":"0xda59","gasPrice":"0x2c6b0cdfc7","hash":"0xa1bcc29d8739462cedf235605b3fb5a98345914941706caebbf88933c5be3efb","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x18","r":"0x9d21947069e85ebffecbe498f32b11dea66ae3572ca347a3082541dea4096d0f","s":"0x7586be5c932549192d8413377239879a32f3a552a14c054f8b91e62720a3cd00","to":"0xf98e38c3f287304a1f2d4879e741d2bf55474e84","transactionIndex":"0x39","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6529e683828ed15d33f7f7d549cb030c27d9bc98","gas":"0x3b006","gasPrice":"0x2c6b0cdfc7","hash":"0x34c704014f31157694ee1b6c616b365b7e0cdc31e4cd903519ccd200be454dcf","input":"0x7ff36ab50000000000000000000000000000000000000000000000002c2f4c889447778b00000000000000000000000000000000000000000000000000000000000000800000000000000000000000006529e683828ed15d33f7f7d549cb030c27d9bc9800000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x49d","r":"0x6fa59d3ccbe529fc90bbb229f934bacb19ca6d6ecfe0414ea4958b15e1480c40","s":"0x4f227dd82d2986604b818f0da6d8910a1d78779c311f4688e9a94db122e8f396","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3a","type":"0x1","v":"0x0","value":"0x429d069189e0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xcfa06f49da4baa4aca821bae90c0b2ceb86251b0","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x0f35868fb6cfe598103ea8a3ab4692a9a6459379db7d8f7d909dad4679d8c384","input":"0x","nonce":"0x16","r":"0xdec4d54b6d201d47770866fab8f18303be6cccba555b473bbf0ceb9f41821f3","s":"0x36deeeaf8b87f14672aeab1517728901bcab0eae0e22c06c1990b659f814c3e","to":"0xa4b3ec54873ea83f56dfa0b02e76caa5593bec76","transactionIndex":"0x3b","type":"0x1","v":"0x1","value":"0x58d15e176280000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x337de124ea37e31702ba33419cbea311904351f5","gas":"0x48614","gasPrice":"0x2c4d3f7ac7","hash":"0x9fa2fee58d8218dfaed7a4becd564c65914aafd72fcd7cedaef12ba925521ef4","input":"0x38ed17390000000000000000000000000000000000000000000000000000000059682f000000000000000000000000000000000000000000000022fc7c4a428684b1788400000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000337de124ea37e31702ba33419cbea311904351f500000000000000000000000000000000000000000000000000000000618cdc230000000000000000000000000000000000000000000000000000000000000003000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cea8a10df595a20fceca4f6f2c23384eec1e4073","nonce":"0x2b3","r":"0x15d486de18c682846a429dec5653c21173338e7bb4c155c969dd1c4987355046","s":"0x4f22a49174ef00f6b78d92f6c8811671261a94d2ca850324277a67c95282a996","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3c","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x31998a4cc1b5c81a7a3ec5e1712a68b2e75e5f20","gas":"0xcd6e","gasPrice":"0x2c4d3f7ac7","hash":"0x251615f10abe599e93038182a543eec1bfe999ef87c3c45f6c68880f60e7e6aa","input":"0xa9059cbb0000000000000000000000007f2e8168b63ac87a72e5eac5d266b1c92020f05f00000000000000000000000000000000000000000000054b40b1f852bda00000","nonce":"0xf44","r":"0x2a642b63d706a0bebfd218b6ea5487de5b548ec853f73af4d26489216647c129","s":"0x172f1346442e08e81240b40ca6175bc3cb25686a8f01ac8527e8d5fb7a9de0d5","to":"0xf8e9f10c22840b613cda05a0c5fdb59a4d6cd7ef","transactionIndex":"0x3d","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x04d63662227e4742236a46399d005f988e0883cf","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x447cf4729f88fd08061a41903a0358c1746a820c017bf7c8b7c08001b3fd0caa","input":"0x","nonce":"0x108","r":"0x13eea07f666d6009c6d81751b52cace9cc8d74e336a5bc496c557a3bb869b8fa","s":"0x3043bfa8fce408919464c46fe6873a191ef68834f794fef4eb4553604fdd079b","to":"0x465a74c545592f66b6a8a25b3b8a5b8714eaef45","transactionIndex":"0x3e","type":"0x1","v":"0x1","value":"0x11c37937e080000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3a7b9c393dd57d0cfb22cbead0eca9f7d66c633e","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x12fb22392ce64c06ec8cd4ff4373390ad464dfaea5edd509e807bfa60c9f31be","input":"0x","nonce":"0x14","r":"0x9ad3e7793bae979a08320e5f6395e8209d33b5e8644cf3c467ee91b89af62e3b","s":"0x442097b67f2618cc05408773db91aa8c67c7a57efd4d5e7d496aab1a64bbd151","to":"0xdb65468fbfca2aa5d9e8c3496ea4cc5ff1b24fee","transactionIndex":"0x3f","type":"0x1","v":"0x0","value":"0x62cecc40e68874"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xe08c164be51664bebf0624a1179ecd7e77c2ecf0","gas":"0x3431c","gasPrice":"0x2c4d3f7ac7","hash":"0xe0597bde33efa088e1b5d2a85db7444776acb900b655fcd34395a94447a4cd20","input":"0x38ed173900000000000000000000000000000000000000000000000008af3c3d778ef5a60000000000000000000000000000000000000000000000001f9df139fa735bfa00000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000e08c164be51664bebf0624a1179ecd7e77c2ecf000000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x361","r":"0x5868c8661707be280476c970cb0f6dd12726d3c6a90a2e769c584b1bc6973fcb","s":"0x66670d3c4437c2cc0f0dd0d47eb7c80bb300f1431ca1a46607c677bfa2b7bcad","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x40","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8a269a76a98b1f83a71856a9a0e0ddd506736986","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0xa11b8598f41c7e0b93127cf285282bbbfda6c9cc3feca9dc29c65363a7c1c639","input":"0x","nonce":"0x149","r":"0xf480da5e9c18e64b4961c296a062b98b2f3c61c06603db195bd5165cd01c625a","s":"0x692bdc674283295557ed94ac42bad1640b957ab47ce1778438ed56a7f367266a","to":"0xe9f7ac82f8554580a5505cec5c5abbb99e2eb8ae","transactionIndex":"0x41","type":"0x1","v":"0x0","value":"0x14d1120d7b160000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xbca566ce41e0a3b05dd6adb557e47e34c7110303","gas":"0x2bb64","gasPrice":"0x2c4d3f7ac7","hash":"0x092bb11cb0c69e4fc60f6d7c3f9828eb30cbd8d7ad9b26e43e7326a32740074a","input":"0x7ff36ab5000000000000000000000000000000000000000000a3b0fe926444cf6dd3abb70000000000000000000000000000000000000000000000000000000000000080000000000000000000000000bca566ce41e0a3b05dd6adb557e47e34c711030300000000000000000000000000000000000000000000000000000000618cddea0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000bedcc435dcdff96c37b7d570ed0df7eaf957d041","nonce":"0x40","r":"0x52636faece887fd654cd0233a451c021c74341eee6f9af796c9cc56c85e007ee","s":"0x401a947585f890c26b1ceb02ca29c00c73d66a7f5717d89a634760a9215fa068","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x42","type":"0x1","v":"0x1","value":"0x6a94d74f430000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x570af957165de2de810642628933dc6299230261","gas":"0xda59","gasPrice":"0x2c4d3f7ac7","hash":"0xc4f0872da507a92e1360c83cbaee86f9c8826f7f7ce53082d353e99e278fe977","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0xdb0","r":"0x7d033f255d7181ca7f156c96189e00ad55b6d95bc71ed1d522685baf613b2662","s":"0x115b09e577896b63bce38e2cb87a8cec48c346d94b833e2bd59bc430be50b12f","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x43","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xdbbdb4d45b5880df4bd515c1ef5dbb6592aeb078","gas":"0xdd53","gasPrice":"0x2c4d3f7ac7","hash":"0x59e615a036324abc0d426231978ffb014a5c6cd0d7a1e61d8b1341a8898ba360","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x232","r":"0x72aeb0f7d0347fddc9cad3e816f3309bc5f4c477a183a964726612ec50d42c9e","s":"0x380462aa68e19f28279d855c551556e36d0d7cee773cf579c22f62287d78115a","to":"0xa184c89d44dfe9692a71f9f936cc80749cde42af","transactionIndex":"0x44","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x4f5a842f2decfbc36f1ae17721a6c1d5fed81228","gas":"0x229a8","gasPrice":"0x2c4d3f7ac7","hash":"0xd747966887d228e4f71b26c8f79f7f5aeb5aa47b5aa0e6fb6acc5a38eea04b96","input":"0x4faa8a260000000000000000000000004f5a842f2decfbc36f1ae17721a6c1d5fed81228","nonce":"0x2","r":"0x3b70fda45a4127ab00eaa879d9c43c29b46fd5a51d33a69ecd395d9bb52a8111","s":"0x18277b983d9e48edc8ceea2e1612d361e60c3e06c1e014aba9ab0e94b446ecff","to":"0xa0c68c638235ee32657e8f720a23cec1bfc77c77","transactionIndex":"0x45","type":"0x1","v":"0x0","value":"0xb1a2bc2ec50000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfd7998c9c23aa865590fd3405f19c23423a0611b","gas":"0x3795e","gasPrice":"0x2c4d3f7ac7","hash":"0x6d4409686e5262997cddc374d7ba2f3468c2ddd2c0a3354eb3ef6222c4d71ed0","input":"0xfb3bdb410000000000000000000000000000000000000000000000001bc16d674ec800000000000000000000000000000000000000000000000000000000000000000080000000000000000000000000fd7998c9c23aa865590fd3405f19c23423a0611b00000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x48","r":"0xf097bed51f7d19bd66989391f040176934b385e85a7464bc70b8af894121ef","s":"0x14865427bdaeaa77a40615b0cc26b994c69dd723dcb5b501e11215a673e1bf18","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x46","type":"0x1","v":"0x1","value":"0x793c14b77315458"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5093852b185c1bdf2a87cb76824ca43611400a02","gas":"0x6bc26","gasPrice":"0x2c4d3f7ac7","hash":"0xde57d44abe03cb144b936ebea8ef605dcb192dd84c0c6aecdfa3aeecc8a463bd","input":"0x000000ff0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000019c00000100000000000054a78dae49e200000306006901c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2dac17f958d2ee523a2206206994597c13d831ec711b815efb8f581194ae79006d24e0d814b7697f6000000000000000000047959f9971eee80000000000001f4700fc86c46299cf2a8fd86edadae3f57014351b000007e01700fc86c46299cf2a8fd86edadae3f57014351b001000000000000000000000000000000000000000000000000000008d7158b0f2e000000000000000000000000000000000000000003f2f1d2633920a000000000000000000000000000000000000000000000000052127470de72e5ef77ca962d23125cbe096e2d8100007e0152127470de72e5ef77ca962d23125cbe096e2d8100000000000000000000000000000000000000000000fafe25e2cb8dc00000000000000000000000000000000000000000000000000000001c9e2bef0979ba0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x7dec","r":"0xab51a350caa8e56c24f6d53702455f670371500dcf89148c5af2245aada79a92","s":"0x27426997d404de857717fb4923ea0115bf80e1d590975b72bd7a98e82b49bd1a","to":"0x00000000c2cf7648c169b25ef1c217864bfa38cc","transactionIndex":"0x47","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x20cf5827899fd20af5dbded4ffe300a40b603f40","gas":"0xc350","gasPrice":"0x2c4d3f7ac7","hash":"0xed0d346c3f7740662e182e89001d53fb909e70640df9004ba0feb5249097d01f","input":"0xab834bab0000000000000000000000007be8076f4ea4a4ad08075c2508e481d6c946d12b00000000000000000000000020cf5827899fd20af5dbded4ffe300a40b603f4000000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb90000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b5f3dee204ca76e913bb3129ba0312b9f0f31d82000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007be8076f4ea4a4ad08075c2508e481d6c946d12b00000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb900000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000b5f3dee204ca76e913bb3129ba0312b9f0f31d820000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007c585087238000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000618cd70d00000000000000000000000000000000000000000000000000000000000000008b7ffecc1c733bd0da696585edbf1ce4e68a782d8027f4bcc9c4dc212d5c95db00000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007c585087238000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000618c1ae600000000000000000000000000000000000000000000000000000000627a0fe100a67920f1928896e16d760a57fb60857d97fcbd1902596e4b5fdd737bd72f4c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006a0000000000000000000000000000000000000000000000000000000000000074000000000000000000000000000000000000000000000000000000000000007e0000000000000000000000000000000000000000000000000000000000000088000000000000000000000000000000000000000000000000000000000000009200000000000000000000000000000000000000000000000000000000000000940000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b06653073002da02e9e744fd59a495a23aa29fb14bf120ef6e5c9779461b979fa2aa81cd84102e01e77bbd537ff6930a4f8065aadf3ba27ef287a5650e8bd902e06653073002da02e9e744fd59a495a23aa29fb14bf120ef6e5c9779461b979fa2aa81cd84102e01e77bbd537ff6930a4f8065aadf3ba27ef287a5650e8bd902e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd000000000000000000000000000000000000000000000000000000000000000000000000000000000000000020cf5827899fd20af5dbded4ffe300a40b603f4000000000000000000000000000000000000000000000000000000000000000e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd00000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0xb","r":"0x35252f386ea8f2df8642f7430273564dbf3ad316176f3b730d4cf30ea0e15733","s":"0x2e82fff836f2933bb60b4a4363f9427d42671f519d90fc7f1ac83272c67ff4ae","to":"0x7be8076f4ea4a4ad08075c2508e481d6c946d12b","transactionIndex":"0x48","type":"0x1","v":"0x1","value":"0x7c585087238000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xdb73f95dead836753518b0a6e6d533c1b2cea475","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x703d7ede7bd6acc15db43809b440e2981a1c8804f7622475c08ae11206f91604","input":"0x","nonce":"0x0","r":"0xa7d9c451ec3eb12e5c99ab8ae884804a94ffe2f8ca59294223d8e4f53fdc0b","s":"0x21e5fe84995b408a3c4c67b478f73bbad806864d0efad415d8a539593e2cea9c","to":"0x1de3c66999bc0783d9b9b30d19d94ad2de6180c5","transactionIndex":"0x49","type":"0x1","v":"0x1","value":"0x190ca75db0168bd8"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x1b5402ee203b5263bd8dd7517a28e99158247807","gas":"0x4b36e","gasPrice":"0x2c4d3f7ac7","hash":"0x534bb6b62e4b088f8f58529b2f68e7034d65c677b78f451089abe297cbe183ef","input":"0x38ed1739000000000000000000000000000000000000000000000000000000003b9aca000000000000000000000000000000000000000000000000000b0ba47090277d0400000000000000000000000000000000000000000000000000000000000000a00000000000000000000000001b5402ee203b5263bd8dd7517a28e9915824780700000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000003000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x5","r":"0x9f0f7c5aa897f9f2f514b03947e5cea227a639af84e9713098dd4e88feece163","s":"0x3f66312ab78622aed682c3f37d1ee5fe381fb783897d8fc395947b7c1db4cb3e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x4a","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xe6d8f17a280a559011990c829ede958ae0131df8","gas":"0xda59","gasPrice":"0x2c4d3f7ac7","hash":"0x63d9a85b3a93800f654b92989797ce3ce065e265e93ba0e0390a903035744437","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x23","r":"0x153155aaa0844812382565873685453ba15b23a7a723fa97ce631ebf477996d4","s":"0x3fb9b58e60feb8bd8f4e32b0faff2d55ed9b4ba3c2ee47e1d5a05ee4773227ac","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x4b","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xf76b1ed3e35c6e7c04ab2098001bf7909fd252a3","gas":"0xda19","gasPrice":"0x2c4d3f7ac7","hash":"0x6c44bbe9dc08990d25a1a96d5bc17d888b663980154764d951ec7748ac189cb4","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x15e","r":"0x194d050a11379759cd0bdcb59b5bf37dfd1aa8ca8c5083ba05d584450b19ce5a","s":"0xa86d98ddb1b30bc289fb99a5830cd106554f87fb8d654ae828e6b6d3eac6e52","to":"0xbedcc435dcdff96c37b7d570ed0df7eaf957d041","transactionIndex":"0x4c","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x92a89a5a8ffd673c491c3d8b167a1715e7a2e5f1","gas":"0x3d1c2","gasPrice":"0x2c327f18a6","hash":"0x55703664c47f1a27514fc82f64405d7191700941a1f5ae8b6707bfe04d5da13c","input":"0x7ff36ab500000000000000000000000000000000000000000000000007e40d0eea7e913a000000000000000000000000000000000000000000000000000000000000008000000000000000000000000092a89a5a8ffd673c491c3d8b167a1715e7a2e5f100000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000061b5c3aee3a25f6f83531d548a4d2ee58450f5d9","nonce":"0x122","r":"0xcdb339dee9da9da318d7f4453e54223a2fdbae3621be81056a519f2b39588ff6","s":"0x6f783a395418ffa7098eb1e9cc8a517160f2990621b43f0d45ee1428d8b816af","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x4d","type":"0x1","v":"0x0","value":"0x1bc16d674ec80000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5129042dda19b6d644bc045e996d5e76379f55a7","gas":"0xccac","gasPrice":"0x2c2f7215c7","hash":"0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec","input":"0xa9059cbb000000000000000000000000c0c432d713a2068c4f21b7b1942cabed712e7d820000000000000000000000000000000000000000000000000000005d21dba000","nonce":"0x11","r":"0x7cfb3d34c694785d5b0970cbac887065e45b597bdee64adb5a9908c9069ab7d9","s":"0x2de9af8870f2e4ebbd7987750d7cc3d643545df2aafe0e3141438cb94f395315","to":"0xb9eefc4b0d472a44be93970254df4f4016569d27","transactionIndex":"0x4e","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6ce87df7564550ad76e38026b5cc51802c01e836","gas":"0xda35","gasPrice":"0x2c2f7215c7","hash":"0xd000cfc8efa8398d42c60cf3f63b0dd1fcc80b798c559593b7b7610cf684212d","input":"0x095ea7b3000000000000000000000000e592427a0aece92de3edee1f18e0157c05861564ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x28","r":"0xc0c31ea997b8a845ade98b3c9080b8d26d09356e8ea82f7d192c5cc43f40b725","s":"0x43ffc08ba738edd79f00e1de4fd2b65b5f90d385c24cdf70fa60764ce7d89c00","to":"0x95ad61b0a150d79219dcf64e1e6cc01f0b64c4ce","transactionIndex":"0x4f","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x147e1cb1a048634e154b8c3cdc1b232a3fdf1cd4","gas":"0x74411","gasPrice":"0x2c2f7215c7","hash":"0xd77841e366954d70bbdcdfd76b844f0aa7a1debbd1d2cf1c3f8ff1ef8c636a2d","input":"0x9304c934000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000003d70891b8994feb6cca7022b25c32be92ee372500000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000a434f4d504f554e442d41000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000c450bb9a750000000000000000000000000000000000000000000000000000000000000080ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006574254432d42000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x6","r":"0xee694b29e68921b268b8f4dac4da488a77e0864339bb740c3b0e5890319a434d","s":"0x441fca1d878a8ea83a199ffcef8af0e37bd281a6beec0939e066c429279e3cb6","to":"0x79563fbbb386de99dcec18b51ffb11a4383cf154","transactionIndex":"0x50","type":"0x1","v":"0x1","value":"0x0"}],"transactionsRoot":"0x4bff2452c0f0ad55fe59b70187d74eed6416d6176e77ec40a93e55bfd8e5d549","uncles":["0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec"]}`),
+			err:   "hash mismatch: block reports 0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f, computed 0x39e1caf903a334c42ae0dbeed758b027e8bf87d609784cbbfe17aaa216cd7acc",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var block spec.Block
+			require.NoError(t, json.Unmarshal(test.input, &block))
+			err := block.VerifyHash()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlockVerifyRoots(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           []byte
+		transactionsErr string
+		withdrawalsErr  string
+	}{
+		{
+			// This block has had transactions altered, so does not match its root.
+			name:            "Berlin",
+			input:           []byte(`{"difficulty":"0x26ef28d3882645","extraData":"0x486976656f6e20686b","gasLimit":"0x1c9c380","gasUsed":"0x5e5cd8","hash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","logsBloom":"0x382052020800021414804040a208102000806c00010460008007020c2832010308010010009026a00508118000494140030200900908641d093400405262000c050000404040a5684980516f834040606a052008080804c01000301080460441809004080242201040f010902010891063000418183a804442040090400801064000010dc020200408d019280580040404a2002721a484780d1288c088910cc0270ac44902002803146040c28010084060000011a00648a0090006428000010080510422000000408000a081224210f40c0860800020221522c008818100200040193021090810a0020200601024500604288020011424400408a09000040210","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x15be7bda2fd66ef3c634bab035a8454365ed31d090dd99c76ac1910151ba2b9c","nonce":"0x9ed675789be2ead0","number":"0xcf6d38","parentHash":"0x3560ee45703a5c4d352b1d6d8f3c642d3f1db6c56b9db3069e9121ad63a241c7","receiptsRoot":"0x55411c42653944bbadad219f8ded587754015f1a584822fd4ed2a0d7e09db364","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0x7785","stateRoot":"0x825f84a5098241041e4e45bde4c1cdab05de1aaf3be13d5cff14d5495a2561fb","timestamp":"0x618cd7ce","totalDifficulty":"0x73d8abc71b22d49d973","transactions":[{"accessList":[{"address":"0xf57e7e7c23978c3caec3c3548e3d615c346e79ff","storageKeys":["0x6459af213787bf9f6b0a98a594fa7561fcb9683d973fcb0d3989329f847d2a5a","0x51b97ab35998078a460d0edc2fc4dc5b9566cd699eb5194af0d679b7a1c66f9a"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x99497bcfb22873205303e5094c7e4090544e9d00091b117f8b71b66ded95576d","0x5d0acd573b7113ecfa1f0c53f3e038c6a2acdc8bb31e36d94969ed8bac354c46"]},{"address":"0xfd76be67fff3bac84e3d5444167bbc018f5968b6","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000004","0x3e5fec24aa4dc4e5aee2e025e51e1392c72a2500577559fae9665c6d52bd6a31","0x0000000000000000000000000000000000000000000000000000000000000008","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef7479","0x0000000000000000000000000000000000000000000000000000000000000001","0x0000000000000000000000000000000000000000000000000000000000000002","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef747a","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef747b","0xb0dd468d7981b568307383914ef952874eb4bf8118bb1bdd997cf646b0ef7478"]}],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x654fae4aa229d104cabead47e56703f58b174be4","gas":"0x217d6","gasPrice":"0x2bf3d74bc7","hash":"0x09d9578f3a3cd87099b9a5c979842efa6fbc80fc2c6a8044d55a2a7b90a6b14e","input":"0x000000093560ee45c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20100000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff000000000000000000000000fd76be67fff3bac84e3d5444167bbc018f5968b60027100000000000000000000000000000000000813f374b029ca36900000000000000000000000000000000","nonce":"0x5d6","r":"0xdeca797e43ebc4bb2e2dc7b830239ff5d5d57c67c7e5130f202a00470bea565","s":"0x451c4328e67174d778512c0876e181f269d9cfaee5b48c7f4f45284a64e67a03","to":"0x00000000a1f2d3063ed639d19a6a56be87e25b1a","transactionIndex":"0x0","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfeec32efba1f7ae305ec3ea0b694a5f07b39f390","gas":"0x3c806","gasPrice":"0x2c6b0cdfc7","hash":"0x013420b2141e24aa551f4d5526deeeafb552f953d97ba567c018229eda7fec16","input":"0x414bf389000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff0000000000000000000000000000000000000000000000000000000000002710000000000000000000000000feec32efba1f7ae305ec3ea0b694a5f07b39f39000000000000000000000000000000000000000000000000000000000618cdc57000000000000000000000000000000000000000000000000c249fdd327780000000000000000000000000000000000000000000000000256b3331a3e83288f780000000000000000000000000000000000000000000000000000000000000000","nonce":"0x8e9","r":"0xc5a59f05e41a7ed4550b1d721852e13f2d0e9c5630a36108794ba8990ff9a8b8","s":"0x54f574a603dc9e0b8aa97fac400f3dba8ba4563c1a3ebfec0c036277fdb7dcea","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x1","type":"0x1","v":"0x0","value":"0xc249fdd327780000"},{"accessList":[{"address":"0xfd76be67fff3bac84e3d5444167bbc018f5968b6","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000008","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000004","0x0000000000000000000000000000000000000000000000000000000000000002","0x3e5fec24aa4dc4e5aee2e025e51e1392c72a2500577559fae9665c6d52bd6a31"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x99497bcfb22873205303e5094c7e4090544e9d00091b117f8b71b66ded95576d","0x5d0acd573b7113ecfa1f0c53f3e038c6a2acdc8bb31e36d94969ed8bac354c46"]},{"address":"0xf57e7e7c23978c3caec3c3548e3d615c346e79ff","storageKeys":["0x6459af213787bf9f6b0a98a594fa7561fcb9683d973fcb0d3989329f847d2a5a","0x51b97ab35998078a460d0edc2fc4dc5b9566cd699eb5194af0d679b7a1c66f9a"]}],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x654fae4aa229d104cabead47e56703f58b174be4","gas":"0x2036c","gasPrice":"0x16740c11372","hash":"0xa4905adcf14540f0e214cc8bffabab0834bbb5b91f00cb31a092568a66dabdcf","input":"0x000000093560ee45f57e7e7c23978c3caec3c3548e3d615c346e79ff0000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000845dd62ea358758efd76be67fff3bac84e3d5444167bbc018f5968b6002710000000000000000000000000000000019d57114bf4c253ee5b00000000000000000000000000000000","nonce":"0x5d7","r":"0xe311efeeda5e4b0b71b6fefd7d43989977fb74f2606a21d7a748de46721f2f8a","s":"0x449fac9584ae65449f784f8c73942f74eeffdbcd6fb645cee0c13397990d1ceb","to":"0x00000000a1f2d3063ed639d19a6a56be87e25b1a","transactionIndex":"0x2","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x032415c695a7a927c99b74ec74812b83b4d9b952","gas":"0x24d29","gasPrice":"0x2c4d3f7ac7","hash":"0xad59a3d517782a770742e9c372fc3cc445c5fa0f21fc95b2f9f772e543d9e7b8","input":"0x38ed1739000000000000000000000000000000000000000000084595161401484a0000000000000000000000000000000000000000000000000000000000001260de8b9100000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000032415c695a7a927c99b74ec74812b83b4d9b95200000000000000000000000000000000000000000000000000000000618cdeaf000000000000000000000000000000000000000000000000000000000000000200000000000000000000000012bb890508c125661e03b09ec06e404bc9289040000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x55","r":"0x14a17537ef38d677904445128197559c3189f0e845b0711a68fe72695db4c4ac","s":"0x5d73bfc9b401bf7df60f1b6533b09362843a16c868948b0c438fbd95f0ddda44","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x53d8a6a3368ffe7b844782d8a037012497110d81","gas":"0x4c826","gasPrice":"0x67360f2dcc","hash":"0x45d9c5833a07096083714aaab66379b3967d6445e35835342f4c08ea33dd8260","input":"0x94655f2b00cf6d380000000000000003efe37ec187f35185000000000000000056753281918aef1603002a11b815efb8f581194ae79006d24e0d814b7697f6000000000000000000000006c4f59909000051700fc86c46299cf2a8fd86edadae3f57014351b00000000000030776b66e662c863e0f4e01000052127470de72e5ef77ca962d23125cbe096e2d81000000000000000057c63c8654126cc1040003","nonce":"0x587a","r":"0x41d4bd728e913aabd2d4b51dd04448963d191ae378c86b8116d6f9ec73bced28","s":"0x178441dbd75309c38ad89c5510f43213a3179e7f6b3901b5358230efd7f0deb","to":"0xe0a9efe32985cc306255b395a1bd06d21ccead42","transactionIndex":"0x4","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xc0cef6da40144bc148f744c6c07c5fcb75b92521","gas":"0xba41","gasPrice":"0x2c3567fab0","hash":"0x4378d013038b20e5491daab55d02c2f63fb3ce6a007ade9e492a9ecbfc80df16","input":"0x1cff79cd0000000000000000000000004f91ad1a0397b763fc653b4cfe4f836915bfcd8400000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000064f5537ede000000000000000000000000514910771af9ca656af840dff83e8264ecf986ca00000000000000000000000056178a0d5f301baf6cf3e1cd53d9863437345bf90000000000000000000000000000000000000000000001674d6b23db4fbb340000000000000000000000000000000000000000000000000000000000","nonce":"0xde0","r":"0x435a06705542c97437092a47f2dfb1880865a16885912d72fee165012d82c6da","s":"0x6f4101aee12bc57211595ae64ac86433b4c3ea18e5b63c026608e68aa4acaedd","to":"0xfa103c21ea2df71dfb92b0652f8b1d795e51cdef","transactionIndex":"0x5","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6beef2b2fe00fddca12a8cda2d4b00435b0ba3b6","gas":"0x32bfb","gasPrice":"0x2c4d3f7ac7","hash":"0x6f9ced3adb92682db1f2a3e81182d2c84249d0aaa641ea263084417fe9fe11af","input":"0x7ff36ab50000000000000000000000000000000000000000000000000046aa3f1292cd6700000000000000000000000000000000000000000000000000000000000000800000000000000000000000006beef2b2fe00fddca12a8cda2d4b00435b0ba3b600000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x6d7","r":"0xea8a4e60c8b14ebaa9b190dbdbfbb45058844b1a416a8bc3992c6ee78648a72f","s":"0x4f935a9109278696fe293fc9da95ff7b0691c629c46b3db44cf9a61b28c2169c","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x6","type":"0x1","v":"0x0","value":"0x254e34b9c119000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xa2b37de633292d5cdef5ba5defe6e0f8c342e05b","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0xc0e1fa71208c0d2bf173df56f42bb7f865cebddcff7c1548a6de90a2918f579f","input":"0x","nonce":"0x16b","r":"0x30ad4b89b9e0579b841b0cb5774499c3de2686a2510a1b3f3c9966a2ade01c3d","s":"0x3adf11bf54ebb4451b5341190d917422677b68c2bbd55de6771300a96d4576b0","to":"0xde10091bd2d28cf09c4ce0ee4d88d39da7017691","transactionIndex":"0x7","type":"0x1","v":"0x1","value":"0x1d7d843dc3b480000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6317183476e1ebc7634f8fdfe481d94e3a4c6561","gas":"0x37d95","gasPrice":"0x5a84c51bc7","hash":"0x65231527913be7832119787df4649bdb6e981e6f79436556152e486d9dd5438e","input":"0x7ff36ab50000000000000000000000000000000000000000000000000f363d9f8a0bec8c00000000000000000000000000000000000000000000000000000000000000800000000000000000000000006317183476e1ebc7634f8fdfe481d94e3a4c656100000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x462","r":"0x9aa6a744a6fafc04ec4165d41d0e2b6bcf23c7cf9fc44d9f30e285fee708d9a8","s":"0x2e667d8b7c9c66f5e78c860631a8923d9a387240afb43e0135e1d2efa310ecf3","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x8","type":"0x1","v":"0x0","value":"0x8ac7230489e80000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfcf6a3d7eb8c62a5256a020e48f153c6d5dd6909","gas":"0xda59","gasPrice":"0x4d8858c200","hash":"0xb7ce11ea38e6079689241b9c0b507407dc785883d50378b5efcab84e176496b0","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x1d8c","r":"0xbf2d141d9702cc5be03df29b32e568863bf4cd0edce8c1fa8ca11e3670490ce9","s":"0xe72c8741a3a5f8fd21ca99daa383e05efefc430f432ab00539e994a4977d208","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x9","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x13f5d0fd545dd26122aa7734ca2505c178521981","gas":"0x34377","gasPrice":"0x48619170c7","hash":"0xd932d8a9705b158887d0302ce77e8b87b44038b7807889fc6e1ba02c1850015f","input":"0x7ff36ab50000000000000000000000000000000000000000001c206230e80a63c7060f20000000000000000000000000000000000000000000000000000000000000008000000000000000000000000013f5d0fd545dd26122aa7734ca2505c17852198100000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a2667a0fcac45713029a60e4e97104064d9349e2","nonce":"0x8","r":"0x222ab5b2eb84972d13421c93aefc8960c35a755df99e427e8e682c92dfdf10fa","s":"0x54d2274bc64f0717190fddc8a9c38db575c2be83097d93613b4e02af819e5ff0","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0xa","type":"0x1","v":"0x0","value":"0x853a0d2313c0000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x2c65dba0b5ed7ed82b70ce086f4f206625e0fdc4","gas":"0xb210","gasPrice":"0x33aca67f57","hash":"0x76782fe533f4ad8d4a2213e2992d0774b44f2c5d655706bd7d24349d537ff3fc","input":"0xa9059cbb000000000000000000000000fa72bb90a768852c9e4756a4ecddc587af0cfa72000000000000000000000000000000000000000000000000046314724d31c000","nonce":"0x55259","r":"0x1210b03b4335f8ed2c2fb207d4076bf13410cbcfecdc7575e6e2b1b62f21e633","s":"0x1cc49f822a2c6e6de776a7e3b91addb7d560572f297b351391fb1011d45acbfe","to":"0x653430560be843c4a3d143d0110e896c2ab8ac0d","transactionIndex":"0xb","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x2c65dba0b5ed7ed82b70ce086f4f206625e0fdc4","gas":"0xb210","gasPrice":"0x33aca67f57","hash":"0x78303c100a3eedf92aa41ec3edf7d4c735c09a6f5cedc3c16de1aca195215e54","input":"0xa9059cbb00000000000000000000000054dc77f7b3233465bedb5c904a435040aa4fcb07000000000000000000000000000000000000000000000000356a3c146ef3e400","nonce":"0x5525a","r":"0xafba721503588593704baa5a7f3b875568407572f4ce559164ea926899df9c37","s":"0x2df11212ab106303c1593c4e651c6c43edc602590ec338d03174cbfdfa9e993c","to":"0x653430560be843c4a3d143d0110e896c2ab8ac0d","transactionIndex":"0xc","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xf66852bc122fd40bfecc63cd48217e88bda12109","gas":"0x15f90","gasPrice":"0x32fd6ace00","hash":"0x5a1cb4c353ff0ff1de52d14376f5bfc841a566ee58fd197102006977efbd7386","input":"0x","nonce":"0x1f071","r":"0xd1d8ba0b4de388d1acd1edc18b137eb054c6a928bd8b81f50010e186717b68e","s":"0x6762b81cf59d14a9f0ee6894b79e189f0a15ecbe78d24ea0e1bdc4fa74a0a458","to":"0xd56eef796c63c4a28cd345ff76219971c08b655b","transactionIndex":"0xd","type":"0x0","v":"0x25","value":"0x22a392c68f600000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xadb2b42f6bd96f5c65920b9ac88619dce4166f94","gas":"0x19a28","gasPrice":"0x32fd6ace00","hash":"0xcd231be11688fc0ef459c51c70229f20f44ab9b401b879ea76523a749f133f45","input":"0xa9059cbb0000000000000000000000002d67dcac773ce71ca90b42555be08d61640d25740000000000000000000000000000000000000000000000000000000b331a4580","nonce":"0x1a65a8","r":"0x43a389008ff770a37edfb045348845061f244c947f91e32d03d7b895435d1f8e","s":"0x7430546c310c3333c8810e807a411505d0b755d32db1463cb7f9de1d9c8e44ee","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xe","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x91962711a4d2e4a830b366ce7276d99001e8564b","gas":"0x5208","gasPrice":"0x31e9739473","hash":"0x7a1439bc2a44ff7570924b4f78d9558234436a1247a79a30c4020fe3e7bdf4a7","input":"0x","nonce":"0x2992a","r":"0x35c8efeba8c1681f16e3c329345009f52e2f3098359cd3baa58edf1c8c70d3bb","s":"0x2337ed9b36468ad060d0e20a734519140f42623f2273372bd6c2494469ba02cc","to":"0x7e639afba252608548edb5c20588b1a36e611357","transactionIndex":"0xf","type":"0x0","v":"0x1c","value":"0x45ba89f06d0e000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3158e4a3e5d878ba2a055c60b8c7c19936997069","gas":"0xf4240","gasPrice":"0x2f7f58f800","hash":"0x5ea0401b40377ea4a7690f2ff7d256c3892d5cfd20a4953017b3483dacf2fc03","input":"0xa9059cbb000000000000000000000000af42405d3fdfcc7c391178b350d0b86a395c099e0000000000000000000000000000000000000000000000000000000013019f38","nonce":"0xd27","r":"0x14401e3b54c0829dba44246e27c9a8a4547c4971622446f1a4ae4b3494745a1b","s":"0x2ee1520766cd1628e4d8e9cf413734e646d379757fed73c9e72c4850fc22b3c7","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x10","type":"0x1","v":"0x1","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x58d28d4f52e9f5d2723c65f99b37bd74b054e5be","gas":"0xb4bb","gasPrice":"0x2e73e2de59","hash":"0x02965b8f5d46a239560cdd1a8435bbc3cfcb12ff26cbd730050a6250269ff3fe","input":"0xf14fcbc8d826bb8e66a5b2041f2eeb0327103d2038e17c94784a8a0c341af336c15406f3","nonce":"0x0","r":"0x4511b0b72c706dbdd906ab3831cd5c349a6b77a406146f5f00d717ba0f45e41f","s":"0x17ab91da91b78919edbda58ad30cbd84e2ff92cd23017965aa156aa55599277","to":"0x283af0b28c62c092c9727f1ee09c02ca627eb7f5","transactionIndex":"0x11","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x2dde1d7200","hash":"0x06297e870b52babeada6cdfac98c46dfbaba284e15d9691e7f82221e1ea16d2f","input":"0xa9059cbb000000000000000000000000ef017b8acdd23c0acb84e0516e16f59811af41b500000000000000000000000000000000000000000000000896fa23fae2f88000","nonce":"0x1d12cf","r":"0xc3bb30e1a318cc66a4ca5388c9850988ea492f34ca613f03f21fcaa1dae585dd","s":"0x1ebec0db970e0a9c5d087db4a4ce21d9fcf975322b628c2ccb7aca30599817fb","to":"0x75231f58b43240c9718dd58b4967c5114342a86c","transactionIndex":"0x12","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xa7efae728d2936e78bda97dc267687568dd593f3","gas":"0x33450","gasPrice":"0x2dde1d7200","hash":"0xa0b026ac2b423264e8d1005a7bb1c80810cbf78356259143ca716fdc435c7193","input":"0x","nonce":"0x657e2","r":"0x2ec24330a32c6ee907ebebdce8c076edcd65933a60997b975c469f1ce0b7b88b","s":"0x38062b3dc982f8eb5a1a07cfc208bd690f777ae2f2267ecc6694824a63f28e10","to":"0xf3b3745028b013b60485db42c1b5401dfbd55dc3","transactionIndex":"0x13","type":"0x0","v":"0x25","value":"0x360ba19d5af7000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x13969b82413a192438c01d0eff4b3f65e5fe843d","gas":"0x2bf20","gasPrice":"0x2d9696e600","hash":"0x27b4ef7e92b8c0d199a7089a479e32512778367fd7ce769c9ae0d0d7ead3bbbf","input":"0x7612290300000000000000000000000000000000000000000000000a88c5f5eb5d4a7000000000000000000000000000983110309620d911731ac0932219af06091b6744000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000128d6ddb1f637b3175822b0c25800b603b236712a3fe15ee6f5966affc3ed51c0e404ffa64c7ae761a27029d84ec146d4adfce081e42a94006a12af5da4ee8a2a9d8ff0dcf9e5d2d6dcd8087ea2263508aa3e911ada84fa8b3db783a1b34c89475e9f0af29ac2a51f442f4e11bad1588c627dd3596d5c07ea6aa0c3ac32677fe78ed95da5bf3edfe0e57259a48310a96cb8aa56abe652c9e0156592533867b36adc36b402abff7d17ac76ed9a702b73e3166d20866a8d9fb159d0b4fcf9d38c0e1268ee39c4b9f7522d7c0a9ab3260ce6e105952a6184fc5c3a7fe098d4fb7c3c337be97cdb6c1c42053dcb065d20b6a3c56e93a2e1bb936b8e217fe08c26bb03981f8b052ef97746f7bd55e2fd86c0d04f3af916fe679f0d39638e5ebf9a42cd97e25ac553a63ef47786d4a1da9a5c213586c8aee2633777d232038b4b51bfee5dd84d518da84a06ab0558010cf446a4c433deef8ee64dd3d30d25815be9cceb5ccd419094966a7bb140e7ad41fb17448667ca9dd761c7d67d4eb5cecfa88dba850606ad26b4ced935bfd45c4cc0dc5e43d121136f7565316f27751d810d3f9ec63cff419c14c1597ac2b51231125472b462fb4a540692db0035984e6b0ac6d5b0d1256dab3842b9276b640916bc880643adecea6f052b1fa0d6533cb0ad86eae0cc5f3bc832f9b3950932b134b4966600963ab0b19d885642144ae57b6706ea78954ecdc07c99ac4a862d008e5dd179f19c899c1b923f33cae8eb31910352a450b8e3f753c0ae0482b1980cbad3eac8d727ae0c0f40d9c23f2e8c8b08e4e9d7b","nonce":"0xd","r":"0xfc362163a023050c59bb8c027c93799f9042840f8d15047d4e56730657a8f8a1","s":"0xa257f2bc6786d414b5f33091f6ad736aa397ef887770c6089af28e237bd7e95","to":"0xc18360217d8f7ab5e7c516566761ea12ce7f9d72","transactionIndex":"0x14","type":"0x0","v":"0x26","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x78c91d88846b1df25d100a3bcf013a9585494bdd","gas":"0x37d86","gasPrice":"0x2d79365b94","hash":"0x5856200e70a34e82dcd079d088ce2830ac9ae428115d81545052343096d0b58c","input":"0x7ff36ab500000000000000000000000000000000000000000000000000529c066aeba335000000000000000000000000000000000000000000000000000000000000008000000000000000000000000078c91d88846b1df25d100a3bcf013a9585494bdd00000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0x59","r":"0xf0f0088f7975f7d20dd8ebbf061750bd3a9e25f9a5a1335d9b2d1d24476a936a","s":"0x6a63c26bd7f845a621c17cbcd124ef8adbff962e9c6710b08cd36951f0263b5e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x15","type":"0x0","v":"0x25","value":"0x2c68af0bb140000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x28573","gasPrice":"0x2d79365b94","hash":"0x6f83209b698c225128c25d94116930a36df61eb0fc1e76f34af68b408360185f","input":"0x0175b1c4bca4a1109bcb3d7e17cdad1698e31e05e2a140cb5bc6fa4baf209297146782d2000000000000000000000000818ec0a7fe18ff94269904fced6ae3dae6d6dc0b000000000000000000000000a78d2c69b34cab96cace31967afe52013dfcb6040000000000000000000000000000000000000000000000000000000033b390b00000000000000000000000000000000000000000000000000000000000000038","nonce":"0xf58","r":"0xeacc246c58d38699ab3e7367c1578d5ad7d806e382bcb504ac4b7e4ef8e695df","s":"0x5fe49965460bda4570f34c9ae5df29ee74a2749af6ef03dedff7f739eb7c99d4","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x16","type":"0x0","v":"0x26","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x60f9e80d0d40b2958ac39006635de782096866c3","gas":"0xc350","gasPrice":"0x2d1dec8007","hash":"0xf0a396e0da6eb0f21bb438af0fc8a9031df366ed9c0e41583e3360a7845e2888","input":"0x","nonce":"0x58a0","r":"0x7828e098e596168e69597ab8760c88657c8095e0a4595c46e470a46389588273","s":"0x62768035ca58a43b2f1699bcc956998ad59951664794086c9066f97b72d37c88","to":"0x748010fbe056562ef466901f1d38ce481f11b539","transactionIndex":"0x17","type":"0x1","v":"0x0","value":"0x40c16a1c998800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8fedbeed1b90c5a72e06407c8cf316d76c500ca9","gas":"0x32ddb","gasPrice":"0x2d1ddd3dc7","hash":"0xfa47d2956cef04d8e646c57eec7d84e3329fe3a3caae6ff23ea86ebbe484eaa2","input":"0x2e95b6c8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006f05b59d3b200000000000000000000000000000000000000000000000000000f87c2799d6884650000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000100000000000000003b6d03407782397f13a9a649cbea2b132498feafefdefc4de26b9977","nonce":"0x1fc","r":"0x3a4f89b17641c9878ac16b4e48c1e5b2cebcc90ed2260b264612313af1887bd9","s":"0x2d1615d7a0e8054f78575ae40a0411af38ff7eecf55a50fb74f76da61a9d75f7","to":"0x11111112542d85b3ef69ae05771c2dccff4faa26","transactionIndex":"0x18","type":"0x1","v":"0x0","value":"0x6f05b59d3b20000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8b5e270c19eb8f28050a561d0be08690cc33e73d","gas":"0x3b006","gasPrice":"0x2ce24273c7","hash":"0xdfacc3e5bcabc6c7665dddeadb00f6c2343f5cc77be32b9f0ea023f87136eda3","input":"0x7ff36ab5000000000000000000000000000000000000000000000000063490c47b864f4900000000000000000000000000000000000000000000000000000000000000800000000000000000000000008b5e270c19eb8f28050a561d0be08690cc33e73d00000000000000000000000000000000000000000000000000000000618cd8d30000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x1e7","r":"0x4c198a776a509704be79bf77d487d32093832523769da30ccfe89067674f790c","s":"0xe47962c1b70814d9481f744049a160dacb370d7af4eb39e73ef30f5f2c5b92f","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x19","type":"0x1","v":"0x1","value":"0x214e8348c4f0000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x433acb9148a3418cedf924a4529f84b815c60a79","gas":"0x3b006","gasPrice":"0x2cddd0a700","hash":"0x0f29b8168328beb7dc485cadf40056da2cde6ce885c121b0ec8784a690b328d8","input":"0x7ff36ab500000000000000000000000000000000000000000000000004fa2fb4e1093b5d0000000000000000000000000000000000000000000000000000000000000080000000000000000000000000433acb9148a3418cedf924a4529f84b815c60a7900000000000000000000000000000000000000000000000000000000618cdc230000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x1d1","r":"0x22b5e3bca2c92cae2389383eb13627b1e55ee57f7c6b502a568579943d27232d","s":"0x4f95d1fdccd3ace143f35765103f2923618284af67d3758566af3c7efee81a58","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x1a","type":"0x0","v":"0x25","value":"0x16345785d8a0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x91b44850bae3d99c6dd6d97c1ada2bdaeba6c3c4","gas":"0x30d40","gasPrice":"0x2ccc34b347","hash":"0x015e81c596f8b47021c8907037f3854ef5f080bccc16fc06aaf640dddf495938","input":"0x7ff36ab500000000000000000000000000000000000000122d0ab68b99fdfa6982a2fa24000000000000000000000000000000000000000000000000000000000000008000000000000000000000000091b44850bae3d99c6dd6d97c1ada2bdaeba6c3c400000000000000000000000000000000000000000000000000000000618cde7b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a184c89d44dfe9692a71f9f936cc80749cde42af","nonce":"0x69","r":"0x590bed324e0bda6ee8c59063e7a36c23cec08341cddd5f30b63d7911c6dc8d99","s":"0xb987df48b1c327b5b033288dae3cb2196d722e0226faf06a65baea1eafea7cb","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x1b","type":"0x1","v":"0x0","value":"0x470de4df820000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x7eb04a849878bca27a86ccf4febb05fa7c60d5f963053f5a65e553c4a07884be","input":"0xa9059cbb0000000000000000000000004c167cc2cc270399be98b9734cedeebf3bd11353000000000000000000000000000000000000000000000000080577f0082d0000","nonce":"0x260dcf","r":"0x7599308dac7be69d204409e264adfd60a017f754dbe73249116cb9d9d0d5fb11","s":"0x66d8dfaa32debf257c43151a00a90e9dfb83fc7744204c6039bd028567e0e708","to":"0x767fe9edc9e0df98e07454847909b5e959d7ca0e","transactionIndex":"0x1c","type":"0x0","v":"0x25","value":"0x0"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x486b7fca0da550e0f15792fe25681c43f6fd43b562f9b47dc128496bc6a32523","input":"0x","nonce":"0x1b1232","r":"0x25aca5b06fa4f663f254730fe7a568a2b348542a6728328ace447e4ef083a721","s":"0x7b7c0519ed2bdda86c8607346c224e63e806d17005d0ed05a541a7628a3d8e08","to":"0x54f03e27b7f327e339c084fab74c3552a03f9967","transactionIndex":"0x1d","type":"0x0","v":"0x25","value":"0x281fd54a17e000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x4976a4a02f38326660d17bf34b431dc6e2eb2327","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x5c612f5589b07daaecaffa78c6d6d908ea4a0c73a606876351b628b2ec8db2e8","input":"0x","nonce":"0x712a0","r":"0x505b46e125f68290d943ba53546a9cfd6c904edd8bc26318b86663b7a6625c5b","s":"0x4b38818e32dc7e19f7bd97ca7899d1dbe44f19e4d5ab150b8a27ffc70e8dd0c2","to":"0x81d99fa15e4f420f77ca66805e31c60685ad8337","transactionIndex":"0x1e","type":"0x0","v":"0x26","value":"0x15783c6dd20dffc00"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x21a31ee1afc51d94c2efccaa2092ad1028285549","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x6e77c3f64025b4d57b26bdab4d96c643ccef02e240a6f06058ef0807e3fef7ac","input":"0x","nonce":"0x226e29","r":"0x7654f358224e0a8d0c7d7d3b73a4a954aa07a4834cf13e1075cace2e7612e8b8","s":"0x68558dd40724865e6d26770e34a5cc71683bdf87433cdd6c9d58a2c0261e0879","to":"0x595c74da6602c24bc7c993be1151016090d575a6","transactionIndex":"0x1f","type":"0x0","v":"0x25","value":"0x1531c11d3e60000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x9696f59e4d72e237be84ffd425dcad154bf96976","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xe1b50104e8394a737aa1ab40da31c654aac5e005d221cc1ff36b119df6bf0960","input":"0x","nonce":"0x19dacf","r":"0x58494a9eb56616ad444635f3dc60e8f642e3f4435a0a8ea7c6aa4d4d64210a5d","s":"0x8dc3d2de76457de2d2fa4abeaeb3e800b9b284277a4f024fb1742f74678c7b1","to":"0xf7fa72a3ef7fb44a0bf46e1c795a017fb4be47ee","transactionIndex":"0x20","type":"0x0","v":"0x26","value":"0x252b969bf668000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x2653c3f5bdeb00e3a31a45ea95d10015b8be46f4a514b8d1d30378a30e8a3f40","input":"0x","nonce":"0x260dd0","r":"0x83f87af5448e6d84d32a8c77a424a7c05acdbb18194623573f871a80e4da9694","s":"0x262852117174858befcfad85b89287e55ebc2f7ee48f7280b9fc94e058d2c308","to":"0xd50b894394ca7b2eb1b4fbcb3d036e7e09b64b9e","transactionIndex":"0x21","type":"0x0","v":"0x26","value":"0x19ff1a388159000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xdfd5293d8e347dfe59e90efd55b2956a1343963d","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x130287e30a02cf004a8ce8f0d5dc5bf31267b58dad0f153116883222af67e379","input":"0x","nonce":"0x2017b7","r":"0xcb078ab6531c3ce5d4d298603003aa976cac527479d5da116c0714255d7e2fb4","s":"0x37bfc40ffaf0da8f3db8e049d6d49109e1c79009b3a76e635c7fb92eb565f9e4","to":"0x6ce372e73148d314321784ad787f22ef094928e5","transactionIndex":"0x22","type":"0x0","v":"0x25","value":"0xc6badc211f98000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x4976a4a02f38326660d17bf34b431dc6e2eb2327","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x2c9a1bc9baabf5c1fcdd50455fd389b5237edf2883040843d805d1207f646338","input":"0x","nonce":"0x712a1","r":"0x3469747e9b4ed237895e3122687834b9ca6d176bc4a319802dc920acff5594c5","s":"0x6f6fd61ef1995b25d745653714160ff2be4f87fd3e42c316e34b578c7f915f76","to":"0x8abe4274dcbad3f2d4acc2915dd2ac32ea441531","transactionIndex":"0x23","type":"0x0","v":"0x26","value":"0x10278b6ae991c00"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x660a34be1b0ed3bc78c6a722705fcee753092ba86ba20fa2be2488d4e2498473","input":"0x","nonce":"0x1b1233","r":"0xc799d5027607e6d01742aae10dbace2b5ed78e239eb655030e71bb1aaec9dcc4","s":"0x3048ac97b108d89ebcb22ed9535e3a203b8c7b555a727530173d3c0d986ba26c","to":"0x0c8dafb1ef6b55819bca5b33c457ae944b166d14","transactionIndex":"0x24","type":"0x0","v":"0x26","value":"0x6a94d74f430000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x9696f59e4d72e237be84ffd425dcad154bf96976","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x135f02bb2d674e2be1d451728a289faa41c2d55f166941d3a179b9f266200fd1","input":"0x","nonce":"0x19dad0","r":"0x6a820d2b216f5cdb32916cf038d3ce3517fff8e5b98cd4f16af9950c5d4ca52a","s":"0x3e7d2e634df7720df2777ec00262445beb84659ebe1d85669b7cf3429f55ad8a","to":"0x12b7c21cc1cf67d80e7714c71433313a62e1721e","transactionIndex":"0x25","type":"0x0","v":"0x25","value":"0x1188ad971c11800"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x21a31ee1afc51d94c2efccaa2092ad1028285549","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xb45af5562729a8c41a48586dc0f30456f5c06394bfd0e2aecee04a119d8ed025","input":"0x","nonce":"0x226e2a","r":"0x536ca45bcdfe1e29d862208d858bcd0cb2770d5da7e15ac636aff6d0208b99e","s":"0x7a6d58a82870b1f546c246d57ea718389d05a6d0e05138b684ce6a4cc579b63","to":"0x006a60000880a8f80342b5c419a899949162e046","transactionIndex":"0x26","type":"0x0","v":"0x25","value":"0x15181ff25a98000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xdfd5293d8e347dfe59e90efd55b2956a1343963d","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x971217c104bddc13b247f3f36d594eb31dc40675526ab7b179272b6da902c640","input":"0x","nonce":"0x2017b8","r":"0xf383e67e7c9db55b10cc22508bf3d506c407a0aeb148303fa2a678abf2dc95dc","s":"0x2acd720d444915cf790f458824dc05df8f65d6d2e7a7c8404b2ca660d452fe82","to":"0xc98948540aaa139a09004461e5ca1062ed92089b","transactionIndex":"0x27","type":"0x0","v":"0x26","value":"0x7c585087238000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x28c6c06298d514db089934071355e5743bf21d60","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0x12c55d26843510ff68f6dd24ae3ab59046b0b33a36d7f4afff04b122164460b7","input":"0x","nonce":"0x260dd1","r":"0x762e4750f1a88bc76b7356c4d1bef221f67446b37c991116ad7c9ea2d1ff0d6","s":"0xabd6f93d69c9be1f81184ec9a135b099798dbf7d7dc82bde1dc71ec761bdf38","to":"0x0b403f5bd859b666fc4b9bd02474ed7a37169467","transactionIndex":"0x28","type":"0x0","v":"0x26","value":"0x16d436c8cf848000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0x56eddb7aa87536c09ccc2793473599fd21a8b17f","gas":"0x32918","gasPrice":"0x2cb4178000","hash":"0xb3217684aa4d651c883978418a51171eee8b7da44307f883fe0b542d866c29ab","input":"0x","nonce":"0x1b1234","r":"0xfeb9c709ad0eff418409920c1fab4472ce8adcffba7cf3964cb1f963b80a6c31","s":"0x4308b60d16ac81fdab1d164f8a4e0a6cdba11b59175eff0b707786ca2676d470","to":"0x5d1b3145120f12669283050940afffb6aecb46d9","transactionIndex":"0x29","type":"0x0","v":"0x26","value":"0x71afd498d00000"},{"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","from":"0xbecf88ebffb178923d778561ef4fe060d39ef28c","gas":"0x5208","gasPrice":"0x2c90543a00","hash":"0xb6d56d13c808e9db96dce8523dea01a255f2b8ab445056be1d0fe4e8deb5c20f","input":"0x","nonce":"0x0","r":"0xc03ccedb92e7eaefc7e5216564bf5f70aac1a0bf26c731f8621313f694a800e4","s":"0x46ac2a8254a41d0d7092ea9729f2c921785fdc41f7ea32f020b693ceba641abe","to":"0xa1f22abf08a74d279b294a3701ad0447e3fd5b22","transactionIndex":"0x2a","type":"0x0","v":"0x26","value":"0xdf814443b26400"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x42ad5aaaf1b94eff0776f3f7f86234dd1c124456","gas":"0xaae60","gasPrice":"0x2c88da44c7","hash":"0xf9ba476b3e8f22e538f6f0713996652dd7e81644b8b87c48ee7a7d7462ecf423","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x2bd","r":"0x264e4e68ea16508d0641d6c3778b0b20d86429c10eed2a26f1afc76a0c4af1b9","s":"0x4705e959629d40503bf6fc2a313e4f740e43fee88789e392df86283ecedfd0af","to":"0xcea8a10df595a20fceca4f6f2c23384eec1e4073","transactionIndex":"0x2b","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xa1dbf7151e6c63879885d6791939ee998f02b685","gas":"0x662b5","gasPrice":"0x2c76f8a1c7","hash":"0xad112f358c7ce6564f7fef1476a9135971f3b9c3987dd90db360d7defdca9aaf","input":"0x7c02520000000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000001800000000000000000000000004e3fbd56cd56c3e72c1403e103b45db9da5b9d2b000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000005767d9ef41dc40689678ffca0608878fb3de906000000000000000000000000a1dbf7151e6c63879885d6791939ee998f02b68500000000000000000000000000000000000000000000005c0b3c1516577f00000000000000000000000000000000000000000000000000000000000d75057ce30000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000fe00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000500000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000002e00000000000000000000000000000000000000000000000000000000000000a400000000000000000000000000000000000000000000000000000000000000d60800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000a4b757fed600000000000000000000000005767d9ef41dc40689678ffca0608878fb3de9060000000000000000000000004e3fbd56cd56c3e72c1403e103b45db9da5b9d2b000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000002dc6c027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000247a18c9b233d617a6701b1b098f273b4c54cc121b87e39f5597a6e689eb1d5fe837a73e8200000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000006a4b122f1c5000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd2000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016080000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000064eb5625d9000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000003ef51736315f52d568d6d2cf289419b9cfffe7820000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000008000000000000000000000003ef51736315f52d568d6d2cf289419b9cfffe78200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000002e47478523800000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000260000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000618cd83000000003cf806c00000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000006423b872dd000000000000000000000000945bcf562085de2d5875b9e2012ed5fd5cfab92700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000d8c8dd80e00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000945bcf562085de2d5875b9e2012ed5fd5cfab927000000000000000000000000000000000000000000000000ad098094bbc5a800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041a5f6590369bce7bd7c9e12d6bbd325260975bd428b2e1b1ed345331ee8216645450167b50a535de5dcd3da8c0f395a183496e1b9a1da573ef9465ebd63f7535f1b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002800000000000000000000000000000000000000000000000000000000000004480000000000000000000000000000000000000000000000000000000000000640000000000000000000000000000000000000000000000000000000000000064ec77bbdb000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000003200000000000000000000000000000032000000000000000000000000000000000000000000000000ad098094bbc5a8000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000002647f8fe7a00000000000000000000000000000000000000000000000000000000000000080800000000000000000000000000000000000000000000000000000000000004400000000000000000000000027239549dd40e1d60f5b80b0c4196923745b1fd200000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000a405971224000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000100000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004470bdb947000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000000000000000000000000000d8b8f8237000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000184b3af37c000000000000000000000000000000000000000000000000000000000000000808000000000000000000000000000000000000000000000000000000000000024000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000100000000000000000000000000000001000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000044a9059cbb000000000000000000000000a1dbf7151e6c63879885d6791939ee998f02b68500000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e26b9977","nonce":"0x47","r":"0xf2ba16a316c1522b68dfa65d8969d42ef9b7c969d24f1d8a90b4bcebc00c957e","s":"0x712da2d4da711d2267dd6689115dc9b2c41cbd1f9ee2bf97ecd7408b8744482c","to":"0x11111112542d85b3ef69ae05771c2dccff4faa26","transactionIndex":"0x2c","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xc5d9af16b76edc1372de0d8dcb9f38f097dfb6dd","gas":"0x851f7","gasPrice":"0x2c6ebcff12","hash":"0x791893ff90b97ab6c93ad4a234b011c6c77bd9908bdd931dacc849c9314345b0","input":"0x7c5264b400000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000c29f90c26840d553d10b901603eaa3c331144a00b4bb7ef9e47ebef42277124003d024950889a1bbf1302915bff05d917d95169da0fc3a0fa8798219cfb5c14f185ec11b1ee945268252c9afa1b8a1ecf1a4740a21cc41c671d5e793fbfb6dc4f5639df68717f575e51df8c592bc71af89e136f440a12f61a891ba08d50ea654442d02b9d5a3590b6f8aa7891f00ed7826a645c1aa8d79b2689864d5f42d2cc1d77baf1185fcf9aefc859a8e95c58dd8f8cc2ffb1401965cbee61c416b82f18387213d4332f2b3f54f6df68a033783600a733859c594a4d4ab07b1353fd021654c912d2ddca6214345a984d71bd37587ae98f447757b8cf7670099349d0643725d4c79cf93677036a6541c90a7953363e00e597456ec6e01aaaf2af4df9e4a8a98f99293bd2af0a7656e6b990cefad4e508c098b9a7e1d8feb19955fb02ba9675585078710969d3440f5054e0b5f5ddc8ce437c492c96136a4cbaeb64d95114f4718c252ed908b5b8dd24f8858401430cb884618a829fa0b67b6231bf03b5d3b6a2c36246cb4aa72eb5ddd7d32d4c73ecfd1552f45dbbb4a0c02ab99cf1fa2fd98587989da8a2ebdbd32ace98db3d994ca059a29cee46b952b9046cf904690183be8456b9010000000000000000000000000000000000000000000020000000000000000000000000000000000000000810100000000000008000000000000000000000000000000004000000002000000000000000800000000000000000000100000000000000000000000000000000000000000000000000000020000080000000001000000000000000000000800000000000000000000000000004000000000000000000200000000000000000000000000000000000000000000000000000000000004000000000000000000001000000000000000020000000800000108000200000000000000000080000000000000000000000000000000000000000000000100000f9035ef9013d940000000000000000000000000000000000001010f884a0e6497e3ee548a3372136af2fcb0696db31fc6cf20260707645068bd3fe97f3c4a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000000000000000000000000000000000000000001010b8a000000000000000000000000000000000000000000000001ce4dc640b8334000000000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000001e1ede4b254723e0b8707e750000000000000000000000000000000000000000000000001265d8ac0bb6ca4800000000000000000000000000000000000000001e1ede680a2387ec3ba47e75f8dc940000000000000000000000000000000000001010f863a0ebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4fa00000000000000000000000007d1afa7b718fb893db30a3abc0cfc608aacfebb0a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6ddb86000000000000000000000000000000000000000000000001ce4dc640b833400000000000000000000000000000000000000000000000000001265d8ac0bb6ca480000000000000000000000000000000000000000000000001265d8ac0bb6ca48f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000007b5000af8ab69fd59eb0d4f5762bff57c9c04385b8a000000000000000000000000000000000000000000000000000035eb829e5040000000000000000000000000000000000000000000000001cf7459b6fb8cfce4800000000000000000000000000000000000000000000596a93950858c524d3c300000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000000000596a93986710ef09d7c3b905fcf905f9f8d1a0b174784408272b04f658b210964dff62cbfc72dd401ba4e7e5e23d12ee0dad35a0bdc70fe3a6b10c5466f9c1b3519b36d7f7ea8bb761c23b4f845eda84f8c2cfada00a52a19ab441cd8a72731bf02d756fe60553c23a52ee80967b9bd969028ce3a4a03e3eee6389038435aba94523fd16764f2e6c1705c538b6e966161a13dffb177ba06d8c2a14b01518ccca2db4ee5c33db0f88a9f0d8259e0bfaecc9241fb43a080c808080a03085c12b2b13e234683b07a9e61946f66ea06186ab4acf79c50b658b1e2a0fca8080808080808080f8b1a0e5d3f4fb0dee2d87988eb0fd5b9d702875c2efb6eabf029611bdd4ea7c1d5809a0cae178dbeea42f26e8182db8e273af7a00db705545b8f8dbff8c0c6d7487e169a02c8d5d3cc7ae614446f25e90b989c0fcdce696aa5652cd38529be7af93755e70a086f7958bb7f24189c3042a717004fc7cab2f7e7eceb2122d236a4eb4835952f5a0ba8eb94f588e4acf854f22e7e360e13a0d2933fc356c799cf7c4fb33c6443137808080808080808080808080f9047020b9046cf904690183be8456b9010000000000000000000000000000000000000000000020000000000000000000000000000000000000000810100000000000008000000000000000000000000000000004000000002000000000000000800000000000000000000100000000000000000000000000000000000000000000000000000020000080000000001000000000000000000000800000000000000000000000000004000000000000000000200000000000000000000000000000000000000000000000000000000000004000000000000000000001000000000000000020000000800000108000200000000000000000080000000000000000000000000000000000000000000000100000f9035ef9013d940000000000000000000000000000000000001010f884a0e6497e3ee548a3372136af2fcb0696db31fc6cf20260707645068bd3fe97f3c4a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000000000000000000000000000000000000000001010b8a000000000000000000000000000000000000000000000001ce4dc640b8334000000000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000001e1ede4b254723e0b8707e750000000000000000000000000000000000000000000000001265d8ac0bb6ca4800000000000000000000000000000000000000001e1ede680a2387ec3ba47e75f8dc940000000000000000000000000000000000001010f863a0ebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4fa00000000000000000000000007d1afa7b718fb893db30a3abc0cfc608aacfebb0a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6ddb86000000000000000000000000000000000000000000000001ce4dc640b833400000000000000000000000000000000000000000000000000001265d8ac0bb6ca480000000000000000000000000000000000000000000000001265d8ac0bb6ca48f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000c5d9af16b76edc1372de0d8dcb9f38f097dfb6dda00000000000000000000000007b5000af8ab69fd59eb0d4f5762bff57c9c04385b8a000000000000000000000000000000000000000000000000000035eb829e5040000000000000000000000000000000000000000000000001cf7459b6fb8cfce4800000000000000000000000000000000000000000000596a93950858c524d3c300000000000000000000000000000000000000000000001cf7423cb78eeaca4800000000000000000000000000000000000000000000596a93986710ef09d7c3820041010000000000000000000000000000000000000000000000","nonce":"0x34","r":"0x67fdbb9b467db1a528845795e81133c986db016159f2494dd48c5355b2ec0425","s":"0x7ba490dc06fb8a81d4d359854403d941b7e4adb07866f0495d658fe715ade0ea","to":"0x158d5fa3ef8e4dda8a5367decf76b94e7effce95","transactionIndex":"0x2d","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5b98b06e4570e66a6db9976059b564fe6c39cd49","gas":"0x30d2a","gasPrice":"0x2c6b0cdfc7","hash":"0x5f2cd9ddc16571cdc6a2957e4200c262a17c4a0c2871ad540b69db91ee5be37c","input":"0x7ff36ab50000000000000000000000000000000000000000000000000c59f4be6f408d2d00000000000000000000000000000000000000000000000000000000000000800000000000000000000000005b98b06e4570e66a6db9976059b564fe6c39cd4900000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x561","r":"0x3607eac234b5fe35155a92cd4fc9c3a373ba802088e52c0597b1acc92c0c2d95","s":"0xf9af0e168b435783c18241c72fae0bd88c739cd47121691be01183afc918cae","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x2e","type":"0x1","v":"0x0","value":"0x429d069189e0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x51ec652e62d4164811ef81b32cf146da91268833","gas":"0x124f80","gasPrice":"0x2c6b0cdfc7","hash":"0xf7646018e728bbaedf5caffe072c214d7848cfc20ce13c906204c36a446b9869","input":"0xa2abe54e0000000000000000000000000000000000000000000000005a777b7b37a7b4e300000000000000000000000000000000000000000000000000bd2fd0fabfc9900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000000200000000000000000000000018cd890f4e23422dc4aa8c2d6e0bd3f3bd8873d8000000000000000000000000f57e7e7c23978c3caec3c3548e3d615c346e79ff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000026f20000000000000000000000000000000000000000000000000000000000002710000000000000000000000000fd76be67fff3bac84e3d5444167bbc018f5968b6000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002710","nonce":"0x2863","r":"0x8e87dcf38ec95fa8243987d40654e668b0b0566088f7c160cfcaf04fdf61f658","s":"0x143a696ae40e52932bd5f323836968add77fbc5ec500959fbd6271680af13196","to":"0xe37b5e4de6c4c6e3a1b5d914722a7daaf37590d0","transactionIndex":"0x2f","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6458735613c27e565709194088a64632d980e5fe","gas":"0x35a6f","gasPrice":"0x2c6b0cdfc7","hash":"0x110c827394f45457e59041dc317a45ad535ec9e5402b0b55bd8114e81f0fed75","input":"0xfb3bdb410000000000000000000000000000000000000000000002115c52050236ce1e0000000000000000000000000000000000000000000000000000000000000000800000000000000000000000006458735613c27e565709194088a64632d980e5fe00000000000000000000000000000000000000000000000000000000618cde7b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000001e2f15302b90edde696593607b6bd444b64e8f02","nonce":"0x6a","r":"0x104a8cb77ac755291758d8ae79567ed44f67f98a88b02bb71b7d8ea27138ea16","s":"0x1cf2bc9f4635d1ef24fa7ce46677fc326e415461a4514ab7b9efb2611de00319","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x30","type":"0x1","v":"0x1","value":"0x2312d24ab54dfde"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x9b1a1d93cbc4c3a5584b7e58656e6dac00b4fcee","gas":"0x3aff8","gasPrice":"0x2c6b0cdfc7","hash":"0xf7b9e23593d491b851b4f735aa8352597a0a88a811e11e6983e5d119ddd12668","input":"0x7ff36ab50000000000000000000000000000000000000000000000003405a833a51942ca00000000000000000000000000000000000000000000000000000000000000800000000000000000000000009b1a1d93cbc4c3a5584b7e58656e6dac00b4fcee00000000000000000000000000000000000000000000000000000000618cdb2b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x118a","r":"0x5252af9dcedcef0c16472adfc76602d223052e5594274a354e79e996cabe6465","s":"0x1ba64904ff40e18b5a2a79341d96db7b0b06611ce3beeb6fb554b13abf544ab5","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x31","type":"0x1","v":"0x1","value":"0xde0b6b3a7640000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x2a6704abd642917ef20ec90b88a9493995b6b2c9","gas":"0x32c26","gasPrice":"0x2c6b0cdfc7","hash":"0xdc857550e278dd14b8e8bb8f5639dce1a951af0e23b5e0eb56c7728eebb4165e","input":"0x7ff36ab500000000000000000000000000000000000000000000000001468d5e80a2eb7400000000000000000000000000000000000000000000000000000000000000800000000000000000000000002a6704abd642917ef20ec90b88a9493995b6b2c900000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000c5019e129b75d380d3d837b8e609dec6c8f5d044","nonce":"0xae","r":"0x108cde6a5479fcb04d0ff4ee676b6fa6a1ec7877731ca80a75b1a72b0d9dd2f","s":"0x7afcaa0b59399970004786d6519facb8ce1fe2f0bbd5bc9606ebc87b63a68adb","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x32","type":"0x1","v":"0x0","value":"0xde0b6b3a7640000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x4dcd03a25c9f03522b22d3a0e9708ee0f13e9269","gas":"0x3aff8","gasPrice":"0x2c6b0cdfc7","hash":"0xe0383f61d7d5b4232ec4e85aceefe621609e4aab8a09958d93da3c9e2e66a4a6","input":"0x7ff36ab50000000000000000000000000000000000000000000000001e2e03a400a19b9800000000000000000000000000000000000000000000000000000000000000800000000000000000000000004dcd03a25c9f03522b22d3a0e9708ee0f13e926900000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x13","r":"0x38f4e7c6e9741a18ade6b9669743e62186008fab70392d1c5e0e4447ae7c23b3","s":"0x78afd6a87eac9a71d0607d71c7c528f4a7c975da61fa59b61fa833ba420cd926","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x33","type":"0x1","v":"0x0","value":"0xa688906bd8b0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xda721a685570e13d867e4b815f01d7310100be08895eb29035feb8edc8d07bf0","input":"0x","nonce":"0x3e3c84","r":"0x79053831d9c0a7c857e0051e9161cfccacdce1ad266736d01be6a6bbaa96978d","s":"0x3dafe04bd1dd96699e7c761c84ca7243a2250f0afe4dabdf849f69af6f6e66eb","to":"0x583d4a15e56017a27f1e94e62978d9708b6a1836","transactionIndex":"0x34","type":"0x1","v":"0x1","value":"0x3fdc675b0b9800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xcc7b3a8d67e2f968eec59052521ef876202edb166a056d2f4fccd1411eef6546","input":"0x","nonce":"0x3e3c85","r":"0xf3709c07a153dc5586d40787a681adae28390b57f84e7decd6784d26367ca303","s":"0x3c6ba8ef3c303784eb584cb9e89c38b93d30bac0d77cc401250ee7a9506226f3","to":"0xa1628e810526f320b07269d6758e42ec78fa237e","transactionIndex":"0x35","type":"0x1","v":"0x0","value":"0xc08c5ffa25a9c00"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xb96f51f2f565230bc1ac0f3c9823ee3f9f1d5de572d82b4fc70aaa625bb5a90b","input":"0x","nonce":"0x2d7519","r":"0xe1a547f17aad6d5c8de3164e83a1bcfa793eee5a52ecba437a43e1fb47331e70","s":"0x7d3c0a485786f9670d4b8812651b27e1b00af33d8356286f3f1a775dea74ab9f","to":"0xe115f4e7d49acc941502d3dbf1163de1aabc44e0","transactionIndex":"0x36","type":"0x1","v":"0x0","value":"0x3a98497a3bec00"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x503828976d22510aad0201ac7ec88293211d23da","gas":"0x3d090","gasPrice":"0x2c6b0cdfc7","hash":"0x3be57f9143ec090f5584772543ea3ed412b33b818c2fd75e05d5295c847c071f","input":"0xa9059cbb00000000000000000000000095163b57dd46dc9f792f7357efd116ca4c07fe8f00000000000000000000000000000000000000000000001c69ee3aa20df09c00","nonce":"0xd8bef","r":"0xc342367fd4b4992585031aaa9a0bea60ffaf4704b6c406168e52a2cf2db55e7c","s":"0x561b9471a5cc08ac874c363247b8621991e272c73f0e738857a15a0b3a0dcb69","to":"0xd26114cd6ee289accf82350c8d8487fedb8a0c07","transactionIndex":"0x37","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xddfabcdc4d8ffc6d5beaf154f18b778f892a0740","gas":"0x5208","gasPrice":"0x2c6b0cdfc7","hash":"0xd9511045cef7f901d652794c2fba8b193b9a2e4e692419fd7edd8328c1fffd69","input":"0x","nonce":"0x2e6710","r":"0xfa6bbfbda22737744bbf47640c63a6ffd55e0131780901301e96de69e239297b","s":"0x1ce9ea361f504071caeb023414fe70123170aec1288726cd6bfbdaa04e42206c","to":"0xc9464b8f40c8c1bcbee59959e581627e5ef1e654","transactionIndex":"0x38","type":"0x1","v":"0x1","value":"0xaf6e239362c800"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x01fd82278fb04b5c0990eff17959b00d81c486bd","gas":"0xda59","gasPrice":"0x2c6b0cdfc7","hash":"0xa1bcc29d8739462cedf235605b3fb5a98345914941706caebbf88933c5be3efb","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x18","r":"0x9d21947069e85ebffecbe498f32b11dea66ae3572ca347a3082541dea4096d0f","s":"0x7586be5c932549192d8413377239879a32f3a552a14c054f8b91e62720a3cd00","to":"0xf98e38c3f287304a1f2d4879e741d2bf55474e84","transactionIndex":"0x39","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6529e683828ed15d33f7f7d549cb030c27d9bc98","gas":"0x3b006","gasPrice":"0x2c6b0cdfc7","hash":"0x34c704014f31157694ee1b6c616b365b7e0cdc31e4cd903519ccd200be454dcf","input":"0x7ff36ab50000000000000000000000000000000000000000000000002c2f4c889447778b00000000000000000000000000000000000000000000000000000000000000800000000000000000000000006529e683828ed15d33f7f7d549cb030c27d9bc9800000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x49d","r":"0x6fa59d3ccbe529fc90bbb229f934bacb19ca6d6ecfe0414ea4958b15e1480c40","s":"0x4f227dd82d2986604b818f0da6d8910a1d78779c311f4688e9a94db122e8f396","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3a","type":"0x1","v":"0x0","value":"0x429d069189e0000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xcfa06f49da4baa4aca821bae90c0b2ceb86251b0","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x0f35868fb6cfe598103ea8a3ab4692a9a6459379db7d8f7d909dad4679d8c384","input":"0x","nonce":"0x16","r":"0xdec4d54b6d201d47770866fab8f18303be6cccba555b473bbf0ceb9f41821f3","s":"0x36deeeaf8b87f14672aeab1517728901bcab0eae0e22c06c1990b659f814c3e","to":"0xa4b3ec54873ea83f56dfa0b02e76caa5593bec76","transactionIndex":"0x3b","type":"0x1","v":"0x1","value":"0x58d15e176280000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x337de124ea37e31702ba33419cbea311904351f5","gas":"0x48614","gasPrice":"0x2c4d3f7ac7","hash":"0x9fa2fee58d8218dfaed7a4becd564c65914aafd72fcd7cedaef12ba925521ef4","input":"0x38ed17390000000000000000000000000000000000000000000000000000000059682f000000000000000000000000000000000000000000000022fc7c4a428684b1788400000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000337de124ea37e31702ba33419cbea311904351f500000000000000000000000000000000000000000000000000000000618cdc230000000000000000000000000000000000000000000000000000000000000003000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cea8a10df595a20fceca4f6f2c23384eec1e4073","nonce":"0x2b3","r":"0x15d486de18c682846a429dec5653c21173338e7bb4c155c969dd1c4987355046","s":"0x4f22a49174ef00f6b78d92f6c8811671261a94d2ca850324277a67c95282a996","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x3c","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x31998a4cc1b5c81a7a3ec5e1712a68b2e75e5f20","gas":"0xcd6e","gasPrice":"0x2c4d3f7ac7","hash":"0x251615f10abe599e93038182a543eec1bfe999ef87c3c45f6c68880f60e7e6aa","input":"0xa9059cbb0000000000000000000000007f2e8168b63ac87a72e5eac5d266b1c92020f05f00000000000000000000000000000000000000000000054b40b1f852bda00000","nonce":"0xf44","r":"0x2a642b63d706a0bebfd218b6ea5487de5b548ec853f73af4d26489216647c129","s":"0x172f1346442e08e81240b40ca6175bc3cb25686a8f01ac8527e8d5fb7a9de0d5","to":"0xf8e9f10c22840b613cda05a0c5fdb59a4d6cd7ef","transactionIndex":"0x3d","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x04d63662227e4742236a46399d005f988e0883cf","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x447cf4729f88fd08061a41903a0358c1746a820c017bf7c8b7c08001b3fd0caa","input":"0x","nonce":"0x108","r":"0x13eea07f666d6009c6d81751b52cace9cc8d74e336a5bc496c557a3bb869b8fa","s":"0x3043bfa8fce408919464c46fe6873a191ef68834f794fef4eb4553604fdd079b","to":"0x465a74c545592f66b6a8a25b3b8a5b8714eaef45","transactionIndex":"0x3e","type":"0x1","v":"0x1","value":"0x11c37937e080000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x3a7b9c393dd57d0cfb22cbead0eca9f7d66c633e","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x12fb22392ce64c06ec8cd4ff4373390ad464dfaea5edd509e807bfa60c9f31be","input":"0x","nonce":"0x14","r":"0x9ad3e7793bae979a08320e5f6395e8209d33b5e8644cf3c467ee91b89af62e3b","s":"0x442097b67f2618cc05408773db91aa8c67c7a57efd4d5e7d496aab1a64bbd151","to":"0xdb65468fbfca2aa5d9e8c3496ea4cc5ff1b24fee","transactionIndex":"0x3f","type":"0x1","v":"0x0","value":"0x62cecc40e68874"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xe08c164be51664bebf0624a1179ecd7e77c2ecf0","gas":"0x3431c","gasPrice":"0x2c4d3f7ac7","hash":"0xe0597bde33efa088e1b5d2a85db7444776acb900b655fcd34395a94447a4cd20","input":"0x38ed173900000000000000000000000000000000000000000000000008af3c3d778ef5a60000000000000000000000000000000000000000000000001f9df139fa735bfa00000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000e08c164be51664bebf0624a1179ecd7e77c2ecf000000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x361","r":"0x5868c8661707be280476c970cb0f6dd12726d3c6a90a2e769c584b1bc6973fcb","s":"0x66670d3c4437c2cc0f0dd0d47eb7c80bb300f1431ca1a46607c677bfa2b7bcad","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x40","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x8a269a76a98b1f83a71856a9a0e0ddd506736986","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0xa11b8598f41c7e0b93127cf285282bbbfda6c9cc3feca9dc29c65363a7c1c639","input":"0x","nonce":"0x149","r":"0xf480da5e9c18e64b4961c296a062b98b2f3c61c06603db195bd5165cd01c625a","s":"0x692bdc674283295557ed94ac42bad1640b957ab47ce1778438ed56a7f367266a","to":"0xe9f7ac82f8554580a5505cec5c5abbb99e2eb8ae","transactionIndex":"0x41","type":"0x1","v":"0x0","value":"0x14d1120d7b160000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xbca566ce41e0a3b05dd6adb557e47e34c7110303","gas":"0x2bb64","gasPrice":"0x2c4d3f7ac7","hash":"0x092bb11cb0c69e4fc60f6d7c3f9828eb30cbd8d7ad9b26e43e7326a32740074a","input":"0x7ff36ab5000000000000000000000000000000000000000000a3b0fe926444cf6dd3abb70000000000000000000000000000000000000000000000000000000000000080000000000000000000000000bca566ce41e0a3b05dd6adb557e47e34c711030300000000000000000000000000000000000000000000000000000000618cddea0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000bedcc435dcdff96c37b7d570ed0df7eaf957d041","nonce":"0x40","r":"0x52636faece887fd654cd0233a451c021c74341eee6f9af796c9cc56c85e007ee","s":"0x401a947585f890c26b1ceb02ca29c00c73d66a7f5717d89a634760a9215fa068","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x42","type":"0x1","v":"0x1","value":"0x6a94d74f430000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x570af957165de2de810642628933dc6299230261","gas":"0xda59","gasPrice":"0x2c4d3f7ac7","hash":"0xc4f0872da507a92e1360c83cbaee86f9c8826f7f7ce53082d353e99e278fe977","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0xdb0","r":"0x7d033f255d7181ca7f156c96189e00ad55b6d95bc71ed1d522685baf613b2662","s":"0x115b09e577896b63bce38e2cb87a8cec48c346d94b833e2bd59bc430be50b12f","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x43","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xdbbdb4d45b5880df4bd515c1ef5dbb6592aeb078","gas":"0xdd53","gasPrice":"0x2c4d3f7ac7","hash":"0x59e615a036324abc0d426231978ffb014a5c6cd0d7a1e61d8b1341a8898ba360","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x232","r":"0x72aeb0f7d0347fddc9cad3e816f3309bc5f4c477a183a964726612ec50d42c9e","s":"0x380462aa68e19f28279d855c551556e36d0d7cee773cf579c22f62287d78115a","to":"0xa184c89d44dfe9692a71f9f936cc80749cde42af","transactionIndex":"0x44","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x4f5a842f2decfbc36f1ae17721a6c1d5fed81228","gas":"0x229a8","gasPrice":"0x2c4d3f7ac7","hash":"0xd747966887d228e4f71b26c8f79f7f5aeb5aa47b5aa0e6fb6acc5a38eea04b96","input":"0x4faa8a260000000000000000000000004f5a842f2decfbc36f1ae17721a6c1d5fed81228","nonce":"0x2","r":"0x3b70fda45a4127ab00eaa879d9c43c29b46fd5a51d33a69ecd395d9bb52a8111","s":"0x18277b983d9e48edc8ceea2e1612d361e60c3e06c1e014aba9ab0e94b446ecff","to":"0xa0c68c638235ee32657e8f720a23cec1bfc77c77","transactionIndex":"0x45","type":"0x1","v":"0x0","value":"0xb1a2bc2ec50000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xfd7998c9c23aa865590fd3405f19c23423a0611b","gas":"0x3795e","gasPrice":"0x2c4d3f7ac7","hash":"0x6d4409686e5262997cddc374d7ba2f3468c2ddd2c0a3354eb3ef6222c4d71ed0","input":"0xfb3bdb410000000000000000000000000000000000000000000000001bc16d674ec800000000000000000000000000000000000000000000000000000000000000000080000000000000000000000000fd7998c9c23aa865590fd3405f19c23423a0611b00000000000000000000000000000000000000000000000000000000618cdc570000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x48","r":"0xf097bed51f7d19bd66989391f040176934b385e85a7464bc70b8af894121ef","s":"0x14865427bdaeaa77a40615b0cc26b994c69dd723dcb5b501e11215a673e1bf18","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x46","type":"0x1","v":"0x1","value":"0x793c14b77315458"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5093852b185c1bdf2a87cb76824ca43611400a02","gas":"0x6bc26","gasPrice":"0x2c4d3f7ac7","hash":"0xde57d44abe03cb144b936ebea8ef605dcb192dd84c0c6aecdfa3aeecc8a463bd","input":"0x000000ff0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000019c00000100000000000054a78dae49e200000306006901c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2dac17f958d2ee523a2206206994597c13d831ec711b815efb8f581194ae79006d24e0d814b7697f6000000000000000000047959f9971eee80000000000001f4700fc86c46299cf2a8fd86edadae3f57014351b000007e01700fc86c46299cf2a8fd86edadae3f57014351b001000000000000000000000000000000000000000000000000000008d7158b0f2e000000000000000000000000000000000000000003f2f1d2633920a000000000000000000000000000000000000000000000000052127470de72e5ef77ca962d23125cbe096e2d8100007e0152127470de72e5ef77ca962d23125cbe096e2d8100000000000000000000000000000000000000000000fafe25e2cb8dc00000000000000000000000000000000000000000000000000000001c9e2bef0979ba0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x7dec","r":"0xab51a350caa8e56c24f6d53702455f670371500dcf89148c5af2245aada79a92","s":"0x27426997d404de857717fb4923ea0115bf80e1d590975b72bd7a98e82b49bd1a","to":"0x00000000c2cf7648c169b25ef1c217864bfa38cc","transactionIndex":"0x47","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x20cf5827899fd20af5dbded4ffe300a40b603f40","gas":"0xc350","gasPrice":"0x2c4d3f7ac7","hash":"0xed0d346c3f7740662e182e89001d53fb909e70640df9004ba0feb5249097d01f","input":"0xab834bab0000000000000000000000007be8076f4ea4a4ad08075c2508e481d6c946d12b00000000000000000000000020cf5827899fd20af5dbded4ffe300a40b603f4000000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb90000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b5f3dee204ca76e913bb3129ba0312b9f0f31d82000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007be8076f4ea4a4ad08075c2508e481d6c946d12b00000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb900000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000b5f3dee204ca76e913bb3129ba0312b9f0f31d820000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007c585087238000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000618cd70d00000000000000000000000000000000000000000000000000000000000000008b7ffecc1c733bd0da696585edbf1ce4e68a782d8027f4bcc9c4dc212d5c95db00000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007c585087238000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000618c1ae600000000000000000000000000000000000000000000000000000000627a0fe100a67920f1928896e16d760a57fb60857d97fcbd1902596e4b5fdd737bd72f4c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006a0000000000000000000000000000000000000000000000000000000000000074000000000000000000000000000000000000000000000000000000000000007e0000000000000000000000000000000000000000000000000000000000000088000000000000000000000000000000000000000000000000000000000000009200000000000000000000000000000000000000000000000000000000000000940000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b06653073002da02e9e744fd59a495a23aa29fb14bf120ef6e5c9779461b979fa2aa81cd84102e01e77bbd537ff6930a4f8065aadf3ba27ef287a5650e8bd902e06653073002da02e9e744fd59a495a23aa29fb14bf120ef6e5c9779461b979fa2aa81cd84102e01e77bbd537ff6930a4f8065aadf3ba27ef287a5650e8bd902e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd000000000000000000000000000000000000000000000000000000000000000000000000000000000000000020cf5827899fd20af5dbded4ffe300a40b603f4000000000000000000000000000000000000000000000000000000000000000e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006423b872dd00000000000000000000000034387d6481bf1ac0f8ac3341bd3724252f05fdb9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000064000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0xb","r":"0x35252f386ea8f2df8642f7430273564dbf3ad316176f3b730d4cf30ea0e15733","s":"0x2e82fff836f2933bb60b4a4363f9427d42671f519d90fc7f1ac83272c67ff4ae","to":"0x7be8076f4ea4a4ad08075c2508e481d6c946d12b","transactionIndex":"0x48","type":"0x1","v":"0x1","value":"0x7c585087238000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xdb73f95dead836753518b0a6e6d533c1b2cea475","gas":"0x5208","gasPrice":"0x2c4d3f7ac7","hash":"0x703d7ede7bd6acc15db43809b440e2981a1c8804f7622475c08ae11206f91604","input":"0x","nonce":"0x0","r":"0xa7d9c451ec3eb12e5c99ab8ae884804a94ffe2f8ca59294223d8e4f53fdc0b","s":"0x21e5fe84995b408a3c4c67b478f73bbad806864d0efad415d8a539593e2cea9c","to":"0x1de3c66999bc0783d9b9b30d19d94ad2de6180c5","transactionIndex":"0x49","type":"0x1","v":"0x1","value":"0x190ca75db0168bd8"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x1b5402ee203b5263bd8dd7517a28e99158247807","gas":"0x4b36e","gasPrice":"0x2c4d3f7ac7","hash":"0x534bb6b62e4b088f8f58529b2f68e7034d65c677b78f451089abe297cbe183ef","input":"0x38ed1739000000000000000000000000000000000000000000000000000000003b9aca000000000000000000000000000000000000000000000000000b0ba47090277d0400000000000000000000000000000000000000000000000000000000000000a00000000000000000000000001b5402ee203b5263bd8dd7517a28e9915824780700000000000000000000000000000000000000000000000000000000618cdeaf0000000000000000000000000000000000000000000000000000000000000003000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000d3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","nonce":"0x5","r":"0x9f0f7c5aa897f9f2f514b03947e5cea227a639af84e9713098dd4e88feece163","s":"0x3f66312ab78622aed682c3f37d1ee5fe381fb783897d8fc395947b7c1db4cb3e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x4a","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xe6d8f17a280a559011990c829ede958ae0131df8","gas":"0xda59","gasPrice":"0x2c4d3f7ac7","hash":"0x63d9a85b3a93800f654b92989797ce3ce065e265e93ba0e0390a903035744437","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x23","r":"0x153155aaa0844812382565873685453ba15b23a7a723fa97ce631ebf477996d4","s":"0x3fb9b58e60feb8bd8f4e32b0faff2d55ed9b4ba3c2ee47e1d5a05ee4773227ac","to":"0xd3e3090fba4a8137e0d193f9b3cc4d5fd124c11b","transactionIndex":"0x4b","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0xf76b1ed3e35c6e7c04ab2098001bf7909fd252a3","gas":"0xda19","gasPrice":"0x2c4d3f7ac7","hash":"0x6c44bbe9dc08990d25a1a96d5bc17d888b663980154764d951ec7748ac189cb4","input":"0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x15e","r":"0x194d050a11379759cd0bdcb59b5bf37dfd1aa8ca8c5083ba05d584450b19ce5a","s":"0xa86d98ddb1b30bc289fb99a5830cd106554f87fb8d654ae828e6b6d3eac6e52","to":"0xbedcc435dcdff96c37b7d570ed0df7eaf957d041","transactionIndex":"0x4c","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x92a89a5a8ffd673c491c3d8b167a1715e7a2e5f1","gas":"0x3d1c2","gasPrice":"0x2c327f18a6","hash":"0x55703664c47f1a27514fc82f64405d7191700941a1f5ae8b6707bfe04d5da13c","input":"0x7ff36ab500000000000000000000000000000000000000000000000007e40d0eea7e913a000000000000000000000000000000000000000000000000000000000000008000000000000000000000000092a89a5a8ffd673c491c3d8b167a1715e7a2e5f100000000000000000000000000000000000000000000000000000000618cde900000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000061b5c3aee3a25f6f83531d548a4d2ee58450f5d9","nonce":"0x122","r":"0xcdb339dee9da9da318d7f4453e54223a2fdbae3621be81056a519f2b39588ff6","s":"0x6f783a395418ffa7098eb1e9cc8a517160f2990621b43f0d45ee1428d8b816af","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x4d","type":"0x1","v":"0x0","value":"0x1bc16d674ec80000"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x5129042dda19b6d644bc045e996d5e76379f55a7","gas":"0xccac","gasPrice":"0x2c2f7215c7","hash":"0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec","input":"0xa9059cbb000000000000000000000000c0c432d713a2068c4f21b7b1942cabed712e7d820000000000000000000000000000000000000000000000000000005d21dba000","nonce":"0x11","r":"0x7cfb3d34c694785d5b0970cbac887065e45b597bdee64adb5a9908c9069ab7d9","s":"0x2de9af8870f2e4ebbd7987750d7cc3d643545df2aafe0e3141438cb94f395315","to":"0xb9eefc4b0d472a44be93970254df4f4016569d27","transactionIndex":"0x4e","type":"0x1","v":"0x0","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x6ce87df7564550ad76e38026b5cc51802c01e836","gas":"0xda35","gasPrice":"0x2c2f7215c7","hash":"0xd000cfc8efa8398d42c60cf3f63b0dd1fcc80b798c559593b7b7610cf684212d","input":"0x095ea7b3000000000000000000000000e592427a0aece92de3edee1f18e0157c05861564ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x28","r":"0xc0c31ea997b8a845ade98b3c9080b8d26d09356e8ea82f7d192c5cc43f40b725","s":"0x43ffc08ba738edd79f00e1de4fd2b65b5f90d385c24cdf70fa60764ce7d89c00","to":"0x95ad61b0a150d79219dcf64e1e6cc01f0b64c4ce","transactionIndex":"0x4f","type":"0x1","v":"0x1","value":"0x0"},{"accessList":[],"blockHash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","blockNumber":"0xcf6d38","chainId":"0x1","from":"0x147e1cb1a048634e154b8c3cdc1b232a3fdf1cd4","gas":"0x74411","gasPrice":"0x2c2f7215c7","hash":"0xd77841e366954d70bbdcdfd76b844f0aa7a1debbd1d2cf1c3f8ff1ef8c636a2d","input":"0x9304c934000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000003d70891b8994feb6cca7022b25c32be92ee372500000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000a434f4d504f554e442d41000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000c450bb9a750000000000000000000000000000000000000000000000000000000000000080ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006574254432d42000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x6","r":"0xee694b29e68921b268b8f4dac4da488a77e0864339bb740c3b0e5890319a434d","s":"0x441fca1d878a8ea83a199ffcef8af0e37bd281a6beec0939e066c429279e3cb6","to":"0x79563fbbb386de99dcec18b51ffb11a4383cf154","transactionIndex":"0x50","type":"0x1","v":"0x1","value":"0x0"}],"transactionsRoot":"0x4bff2452c0f0ad55fe59b70187d74eed6416d6176e77ec40a93e55bfd8e5d549","uncles":["0x8ebd1bd59436b6cd9a88674338734d6bd7c8045afae1b2d7515459b91a0252ec"]}`),
+			transactionsErr: "transactions root mismatch: block reports 0x4bff2452c0f0ad55fe59b70187d74eed6416d6176e77ec40a93e55bfd8e5d549, computed 0x12c7ab1e8edf84015da79315cf9abc96c0e856258782fdfb987f261c2708a7e5",
+			withdrawalsErr:  "block does not contain withdrawals",
+		},
+		{
+			name:           "London",
+			input:          []byte(`{"baseFeePerGas":"0xbad4cab72","difficulty":"0x2e6cfc89e63894","extraData":"0x486976656f6e20686b","gasLimit":"0x1caa7ec","gasUsed":"0x8ff415","hash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","logsBloom":"0x6ea00a06218969a910081683c9012433c1ef3430830104da232f2100241211139760011a50b0eb16840e7800681201023241c84a2828a800062347180c642690014406459c428a2a998625291734a32402202000095280012a831c6680004404a2222500a3210081290e9403300028df08c88e014809a41831882274409d00401483413e39201037354800000981700514a06087d158e1c91c206671885808220eba988b0022a80196cb6594acec00027269444a42b4034190f82408b11b22b0c1166463400385e0a090290a40065880040d6ab10a1b081a00802162082a660481313148640420028a0c544a0ee0988301780001f0c0504c301b4a32437258b6","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x9cc6673f8d971f37ccd89f0f7296d213fc7e05bd6318695e5cfde210ce7e22e6","nonce":"0xa4c0db72ed33f298","number":"0xdc3207","parentHash":"0xdc49656f3dc58120579f8b33624811cc029470ad872d777e0903065a78a2d34b","receiptsRoot":"0xaf4441e57f0061a7d5a787ac275327120b761d36f776007be09768fc29446413","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xb1c3","stateRoot":"0x127449324fa85284b46401a7162872bb2c19a0e0a4005234c17e15c60605178c","timestamp":"0x6238a4cb","totalDifficulty":"0x96400ed9c41106d7ac6","transactions":[{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xdd21d4ddf6af83fdc8efc0d59508451299c8d79c","gas":"0x7a120","gasPrice":"0xc601d0972","maxPriorityFeePerGas":"0xb2d05e00","maxFeePerGas":"0x12a9705772","hash":"0x4a0c27774c83ab57c0486a76bb3ed1a98f68adcaec796d7be6067f8215de5c01","input":"0x7ff36ab5000000000000000000000000000000000000000000001c336bdb82eda6000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000013307b8854a95946b54a904100afd0767a7a577b000000000000000000000000000000000000000000000000000000006238a9740000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000fc979087305a826c2b2a0056cfaba50aad3e6439","nonce":"0x921e","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x0","value":"0xc7d713b49d9ff9c","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5f6813c8a31c7fdf41adac3c74aa891e5763a06aacf53498145d0278b2d7d825","s":"0x10e3cd58fb4f1044ac6b69c302aefa6eb113a0aa3936fec0af02b7e1cb0d1d03"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x58295bba59b4bf056cb99a900da9bde190606eea","gas":"0x489c3","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x46cbf60c285cd2988801c15bcd033144e2ac281c08b5bf118c5e5459da72b366","input":"0xac9650d8000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000000c4219f5d17000000000000000000000000000000000000000000000000000000000003314800000000000000000000000000000000000000000000000025a70c645a3330fa0000000000000000000000000000000000000000000000056bc75e2d630ffff300000000000000000000000000000000000000000000000024953da4c60754090000000000000000000000000000000000000000000000050f29401c07776931000000000000000000000000000000000000000000000000000000006238abb500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x419","to":"0xc36442b4a4522e871399cd717abdd847ab11fe88","transactionIndex":"0x1","value":"0x25a70c645a3330fa","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe6aefd9b048aa7e0cb7fbe08f0ae48228d1e9c8342746b6c07b6a901c6a91e16","s":"0x2c2bd2c61019448aadc1c1cb7d5b94ed571384cc7fba56be91351a0e7fc7697"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x43e4715ae093a4c86b5ecddb52216c4f879e9672","gas":"0xdbba0","gasPrice":"0x3f45b8ae66","maxPriorityFeePerGas":"0x33986c02f4","maxFeePerGas":"0x9bc0df626e","hash":"0x25f368ad2fa5a476bf15405f76ff05a60d2c2a5b27a1fdee4259946096e8aac6","input":"0x","nonce":"0x184f","to":"0x43e4715ae093a4c86b5ecddb52216c4f879e9672","transactionIndex":"0x2","value":"0xae03","type":"0x2","chainId":"0x1","v":"0x1","r":"0xa602a4b4b017d2728339f679f98d5dc8910f9bea1bcfcf76ad353a4753f2cdec","s":"0x6c9420b53e9b178a803dc02a1b3850d350488a995d6ae57dca273db201f7c6a2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf92dc08d81900626ac56edf96e2f3802615605f1","gas":"0x46b7a","gasPrice":"0x32af9882f0","maxPriorityFeePerGas":"0x27024bd77e","maxFeePerGas":"0x781422bcc7","hash":"0x8c018c3d1a4879e9946db629f689a51f9dd1891b3508d8c4ca5dfd55c51bf826","input":"0xd66decf80000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000005ccb6a6c355a6c00000000000000000000000000000000000000047e50e664c29680000000000000000000000000000000000000000000000000047eba4b89cfc80000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x939","to":"0x8aff5ca996f77487a4f04f1ce905bf3d27455580","transactionIndex":"0x3","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe64d119a3b9ee324fa180053e87a6cf3cdd9626738a93f1b37f631fd682665f8","s":"0x4f54811b85637b216ce08f2ecaf51340cf665ad64774d44de24ca37884ab1c48"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x429bf8ec3330e02401d72beade86000d9a2e19eb","gas":"0x15f90","gasPrice":"0x2794ca2400","hash":"0xa799b24dfc5b36d2d504641a07c22e11b448377efcd582c2170349bb52acd7c6","input":"0x","nonce":"0xc827","to":"0x01c50cda35358f0e3b6e8d9c14b24105eeb3a68f","transactionIndex":"0x4","value":"0x4ead713a08dc000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xec721c583f519593eea111a57d258dbb964688182aaae0eecea03f4aa9b0855f","s":"0x73686ad2e3dab47c545da4986d6cb7b3f770014129ec1f652f1f4204f339d5a8"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff","gas":"0x4ff64","gasPrice":"0x174876e800","maxPriorityFeePerGas":"0x174876e800","maxFeePerGas":"0x174876e800","hash":"0xb659c1c1396d08e54fc1103a823c6be8f39a4535dbca0f9decbd7900cc812dd7","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd2420000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd24200000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a303fe4b5300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a45400000000000000000000000000000000000000000000000000000000000000002849bd9dcc8763054f3cd4e80b691c0ccf02bd62a47942eb063a98c844b6c97300000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a303fe4b53000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000062358e910000000000000000000000000000000000000000000000000000000062ac35da4b7c5190b99f76230f0a1c86ee39a04ade1e0d1899bcaec1ab4d689d27f14b380000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b445c35e6d06f3abfec543b21f314df911cd8f2c3940537431dee7c6e73c4274e26d50cbaf15f3e0fdf3e10470a4fd9f1a03552b9a6c2ac8a3c296cc3f58623c9445c35e6d06f3abfec543b21f314df911cd8f2c3940537431dee7c6e73c4274e26d50cbaf15f3e0fdf3e10470a4fd9f1a03552b9a6c2ac8a3c296cc3f58623c9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000999ecaa9d4e45d117b46c48bf7ad67efac2ab9ff0000000000000000000000000510745d2ca36729bed35c818527c4485912d99e00000000000000000000000000000000000000000000000000000000000002f2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000708d32f7d6b6c4300bd63b1ae8cda2d947ebd24200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000510745d2ca36729bed35c818527c4485912d99e00000000000000000000000000000000000000000000000000000000000002f2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x69","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x5","value":"0x2a303fe4b530000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x195935aad7f9da2fbe26b3607c74202e53168389a33907b3160c9687343276ce","s":"0x15ac92c582dfa2d67845ca990ac9e5dae03b392724abb4cc87d316ed6870dc60"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb63d434fafc6240e928928f27cdfcec90eba69d6","gas":"0x1ab3f0","gasPrice":"0x13d3764972","maxPriorityFeePerGas":"0x826299e00","maxFeePerGas":"0x174876e800","hash":"0x594a996178caac0461cfe3f97d07a71d0eee8cd2d4800433f1879e58376322ce","input":"0xbf0843d90000000000000000000000000000000000000000000000000011c37937e08000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000006238a7190000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a3f13f1572ff1e263758fb1bca22548a64fdc3a50000000000000000000000000000000000000000000000000000000000000005000000000000000000000000b63d434fafc6240e928928f27cdfcec90eba69d600000000000000000000000095cda79caaf7986a0818744d636ee0c67c9354070000000000000000000000003aa928d20c4db91ec8d385520b93d92e8ddf1c27000000000000000000000000e988ff2ed601f9fbae48386f466faf1dcce54f5900000000000000000000000027cb427ec93653a6d4f2ed5d1171323df186a201","nonce":"0x3f5","to":"0x9a25d26ce56a336ede8e415dbf43216de98f73f5","transactionIndex":"0x6","value":"0x4db732547630000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x29eab89d73eadd5d14dd9476628bae27e939820c64d575dbb1fde34211bcc527","s":"0x20abaf15cfebd4a253c74d88ebe5807b1ef0cccbd8519703f85aaad290b0e00e"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc35fb86f962ea955751a793a007b5cdd44f798d7","gas":"0x5208","gasPrice":"0x12fd05a98c","hash":"0xc7870df61248fb56e13f01b62541859576255ac65e50c85d3974b70a36fc8d5e","input":"0x","nonce":"0x25e66","to":"0x9971f9bb0c07460b3d968452fdb5211d986bfd90","transactionIndex":"0x7","value":"0x7f246a103fc000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x75e66b105b44fdc160b32259245fdf17b56384067be332a2a2393fa097f287ac","s":"0x29f7a661a9d0d68dd5bd594ad292308325af2d87860ab6853d8d0dd08de57caf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbaefcfd3ce5e1e00a7181f1a098ef036317c1ca4","gas":"0xcb20","gasPrice":"0x12a05f2000","hash":"0x66a451afebfe107a24710dc746bb64477211ae97a56e9c611807b243f317b5dd","input":"0xa9059cbb000000000000000000000000d1cf9597a15d68284e8713a2749113382f18289f000000000000000000000000000000000000000000000000000000005ed4bbc0","nonce":"0x2f","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x8","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x64c101f493f03cebc75c2379d1763f90f505548f6c9eab32a3404daf43f2d7d0","s":"0x63057059129368def92ca4945dff18575a355d99609b0a45258844ebdce661c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x1264c45600","hash":"0xb13dac42c19007528f195ff8de33958d7b617a0888f3443bcda22de220db649c","input":"0xa9059cbb0000000000000000000000001671a3e4a2519a653e66e827ef6eae690ee8672900000000000000000000000000000000000000000000015f1c47d3b458e48000","nonce":"0x1f0c69","to":"0xa117000000f279d81a1d3cc75430faa017fa5a2e","transactionIndex":"0x9","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0xd5d18614c98ce095b692d6d02b77dcfd71044d333cb6d07752f742357960972f","s":"0x7e28bd083718b54b2f8924608385fb759323075f599cb1b4d9b5d7a0452d2bfd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6cc5f688a315f3dc28a7781717a9a798a59fda7b","gas":"0x668a0","gasPrice":"0x1264c45600","hash":"0xbe2f7190f3e7a985720a5c495d4229d561434a96b0ee03d85e341d61f020b0e9","input":"0xa9059cbb000000000000000000000000f22235e9542de0ad61052776517ceda5f78b7c660000000000000000000000000000000000000000000004b2c943ab2526f68000","nonce":"0x1f0c6a","to":"0xf17e65822b568b3903685a7c9f496cf7656cc6c2","transactionIndex":"0xa","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x5cd393f4dedb80e105268f75cb768bbdad6c6dfdb88efe167282249e321b6b72","s":"0x571ab27c3f9a06015fd590c3f9d0e3281de465b8fc2103cf9cbe48f6edcfa521"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x46705dfff24256421a05d056c29e81bdc09723b8","gas":"0x19a28","gasPrice":"0x1176592e00","hash":"0x38ed9b0d4749fe82c1d072af49095567dc1e42a15d53fed234c8e615f9cad8c9","input":"0xa9059cbb0000000000000000000000009c6e1f593705f7a4b3d95c6f0ed27ff98cc6426000000000000000000000000000000000000000000000000000000000585fda0e","nonce":"0x1c2c68","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xb","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x852050d4fd26ba6e2012d8a1b0b0be81e09622057300e73a3dbbebb716b468d","s":"0x74b7365ec0f5914da618fa930f274f8de18c210337b96a9599742bfce68ad90a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6e268ff674f9e3952e9430de8878f57a22fa7dad","gas":"0x11820","gasPrice":"0x1140725f72","hash":"0x63a38c53f39325b3c36b84b8ee23d12df115e23a2691c82ffed2d6f3b278d0f8","input":"0x7373e4eb0000000000000000000000003ce563cae22d057f6199ebd54ec881cafdb955ed000000000000000000000000bc29bd4cc8a53642091523d94fcfc8b86bc03df40000000000000000000000000000000000000000000000000000000073717f50","nonce":"0x201","to":"0xca309b85a5a0559f61e767fc102a0a0a374ca5ca","transactionIndex":"0xc","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xd59a7a55a4526735f2efab14b879917d9ac7bf4eb9c1b34c10b66767ed5754a9","s":"0x24e6fd84c7836aa54deee6ceb10727036e6366b0525d2d46d226214742ab1314"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x000f422887ea7d370ff31173fd3b46c8f66a5b1c","gas":"0xc350","gasPrice":"0x1140725f72","hash":"0x8e68439d9756ee0d04fb0c726559331e2c09a67c5cc5392b3ecf22208844b790","input":"0x","nonce":"0x5f8d9","to":"0xf2d66d4e7235b1811b07a4320b9f3771780a4ccd","transactionIndex":"0xd","value":"0x15aaea40875d3c3","type":"0x0","chainId":"0x1","v":"0x25","r":"0x652dac908063e4d92b32ab02f9c9ab59ee45f4b51c181e7f870767deb71a8332","s":"0x13bca7282ed6e48a3e9ab04a078a07658230f2fe751e5440f70094c732732e8c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc564ee9f21ed8a2d8e7e76c085740d5e4c5fafbe","gas":"0x15f90","gasPrice":"0x11246e20d9","hash":"0x956679ffd3fcbd0445ae8c63ae8786926479196ce19d2a05d574ffb46a180aa1","input":"0xa9059cbb000000000000000000000000258473a955e900385f984fb3fbfd7480d5949cd700000000000000000000000000000000000000000000000000000001849f4e00","nonce":"0x16485","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0xe","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x6626dd51a3920e6711b2cf5d2c48861a46eef13cda16153c76d7e376739d687e","s":"0x3e60da6ae7c6b156f5f1a33e40547dfe7d0e47a2d5bf9f9f40272fca6aa0d25"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7b769ea5428d2797a5d220797318a6850e4f4cf2","gas":"0x5208","gasPrice":"0x1096d4b880","hash":"0xbe5eb4b298079f825f8c1752c89f6a2bddc74d34a2427b8a31b2091208b77b1c","input":"0x","nonce":"0x0","to":"0x6dfc34609a05bc22319fa4cce1d1e2929548c0d7","transactionIndex":"0xf","value":"0x72d647848efc00","type":"0x0","chainId":"0x1","v":"0x25","r":"0x4f7435a3d559ffb32971e90f7fb01802d5e1c86e7ab79d48cf0271bd4bcbfe34","s":"0x72b09a8fe928675fa9c4613395a2e11bb9c9b83a94f57471f4c1d39cc5822abf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x9acbb72cf67103a30333a32cd203459c6a9c3311","gas":"0x15f90","gasPrice":"0x104c533c00","hash":"0x72c4ea9180e70bfc82d2781bc5bf12d8ed8f15032a7ff013428c7f4b08dc5ec6","input":"0xa9059cbb000000000000000000000000121e656d8f61c7699b67654c76d2888758f6f7b600000000000000000000000000000000000000000000000000000000566d3e80","nonce":"0x89603","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x10","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x3a18701d0034062f7776ae55f0d3868287ae54edf65437728fbe02bef37228db","s":"0x55810f47652e3afc6c7e00a43d1396863aef74173fcb6338fad1eb8f33464134"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5f35e8a2783df2100ba7435d3126c2a2bb33dfc1","gas":"0x5208","gasPrice":"0xfd2da0d4a","hash":"0x286bbb06008de1c6ff1ef00c26af2122a105f03bce4865d830fe3513d27846ac","input":"0x","nonce":"0x5","to":"0x40bbe207ef4e4da653bea94228130dee1b99d908","transactionIndex":"0x11","value":"0x1a914dbf2725000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf724f63f50dec5b7eac96a5b5ce61565232a0ac1c28d20437cec1ef04c48e6fc","s":"0x3fe2a93a3fc06b38f11e847ced32380ce7916fd0c665ad9505e52f3a2b305f22"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x30c5312d9cf0d873994f000e72f1cbf561d0209c","gas":"0xb9755","gasPrice":"0xf43159f80","hash":"0xdd0b8bb00d357af60668df4c6433ee802a595cb52acd9e48f7a520b9f7ee9c42","input":"0xac9650d800000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001800000000000000000000000000000000000000000000000000000000000000104414bf389000000000000000000000000ed35af169af46a02ee13b9d79eb57d6d68c1749e000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000000000000000000000000000000000000bb80000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a94800000000000000000000000000000000000000000000d27f529a1126395898000000000000000000000000000000000000000000000000000e10dc0b8f11e849000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004449404b7c0000000000000000000000000000000000000000000000000e10dc0b8f11e84900000000000000000000000030c5312d9cf0d873994f000e72f1cbf561d0209c00000000000000000000000000000000000000000000000000000000","nonce":"0x5bff","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x12","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xc3696fc6532a668f8b88d4e12dd901c053dac0647c727d9c36549fdfca61a4ea","s":"0xa79d7aa39ff5de4d01299048f267141afa9f5df1f5e90664ec0116752f72260"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc715a60f627c14277478a4b6e2bf71fa82b9402f","gas":"0x5208","gasPrice":"0xeab17b600","hash":"0x6c75efc160589a5336352b0a65e70d4d5a6a87afe549342848fe9fe89d82923f","input":"0x","nonce":"0x16","to":"0xdf404f252ff07095f7846f2f72918fd3f72ffef0","transactionIndex":"0x13","value":"0xc074bc54809940","type":"0x0","chainId":"0x0","v":"0x1c","r":"0xa042430549308f676b69407045307918e54f95e724a817379fec148a565ec1a7","s":"0x6aecf235e5eb7e77b98be1b27fe6a4482371fe7ba88fbe621ac5f1faffaa8eb"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe33f0f552dec1edf75272f15d4ea37f50b8bff5d","gas":"0x5208","gasPrice":"0xe6f7cec00","hash":"0x7df7001f983fb23561ce7e8b2cdc5f6030660865c90c058c5f12eb0016e77c08","input":"0x","nonce":"0xc3","to":"0x3ce4d4f2b731c9c8ee91ec12cfe310a1f3d1726c","transactionIndex":"0x14","value":"0x1aa535d3d0c0000","type":"0x0","chainId":"0x1","v":"0x25","r":"0xa02e545ab33592cc79428cc7db6025b661ea995a237dbe4c5457099848d06764","s":"0x6134ea9e4ac7577ca6136ec23fe3c40d852279cd55123c5d66dc8f5390da5998"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x07f0eb0c571b6cfd90d17b5de2cc51112fb95915","gas":"0x2a7b0","gasPrice":"0xe6f4a3b6c","hash":"0x17f37232e6be59b6b5fa2cd4fd495a979bac0d573fdfabf6cf01fd0fc9638bf9","input":"0xa9059cbb0000000000000000000000004c8e372c5d05d4fadcb2800cc7fa979de0a8420e00000000000000000000000000000000000000000000000000000005ce81fd70","nonce":"0x12580","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x15","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x907068550c8d11cb6bcde3479fffb6e3ce439e42d6d24d2eb9c7c5f93c09e491","s":"0x4df1944f3726e5fe329213903320346e416f6aeacf65f5b078c56f37bf944028"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa978eadb605761725d11d3b3a4045cf5859e2d3c","gas":"0x7a120","gasPrice":"0xdf8475800","hash":"0x061f9ca5ad9544c8f34829b49f56dd5726b733080b93de1bc50ad5790cfeb10e","input":"0xefef39a100000000000000000000000000000000000000000000000000000000000000cf","nonce":"0x31","to":"0x47e312d99c09ce61a866c83cbbbbed5a4b9d33e7","transactionIndex":"0x16","value":"0x2c68af0bb140000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x18604c1641fb98aab76c1844301dff05b6e62ffb2a5cfd5eab4e7c6160c86d71","s":"0x706fe38c6ffca1f49677ffda199fea11ffa34c6089bea980aa6d6ce3d454a869"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa070425ab2e7610a0cdfd928a5f474d6e17f8aae","gas":"0x3e5f8","gasPrice":"0xdecbfe2bc","hash":"0xefa2f95e3ae47b1dee48b04ac62aac78631daa8d13982e205fa45dea72f0083e","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000a070425ab2e7610a0cdfd928a5f474d6e17f8aae00000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e500000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf00000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000031bced02db00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a457000000000000000000000000000000000000000000000000000000000000000077be4f6d168e752d8cbf921a8d4860eb57c23af9f1ab8da9251531e8a2393985000000000000000000000000000000000000000000000000000000000000041a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000031bced02db000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000062369d0800000000000000000000000000000000000000000000000000000000623f35e7962d9715fbea77cc185d8ab9b5293425cf557d0057940da0abc8bf84557717250000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c4db4acb77d3a791c326b2bb361be23649d316b9c0ce7d45f55deaa27f99abcba3af3cbe78c056962b919f8675f3c5c207c56b3ec379d3b4a66f0b0af8045f5a24db4acb77d3a791c326b2bb361be23649d316b9c0ce7d45f55deaa27f99abcba3af3cbe78c056962b919f8675f3c5c207c56b3ec379d3b4a66f0b0af8045f5a2000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a070425ab2e7610a0cdfd928a5f474d6e17f8aae000000000000000000000000daeecd365bcec74656bd4068879db469e815641d0000000000000000000000000000000000000000000000000000000000000a46000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000077d7a0237819072e0ead5e754aaa4edef35bcfcf0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000daeecd365bcec74656bd4068879db469e815641d0000000000000000000000000000000000000000000000000000000000000a46000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x8d","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x17","value":"0x31bced02db0000","type":"0x0","chainId":"0x1","v":"0x26","r":"0x564a4a6a2cf0ebca9ce31e5c9e17fcc9f86733b76ff97d2346d18266fd1b03d8","s":"0x28c91de72e47053c740de2fc8100b205d467b09e971127af073d0b6b82e89caa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe78388b4ce79068e89bf8aa7f218ef6b9ab0e9d0","gas":"0x30d40","gasPrice":"0xd8a22fb72","maxPriorityFeePerGas":"0x1dcd65000","maxFeePerGas":"0x15f1bcde800","hash":"0x24205d8327ef12c144861fb96b6f88272f3cb44c80bb9b7a70ea8c2d151a528f","input":"0xa9059cbb0000000000000000000000003178aa92bf66355664c92684f1dcfe1857a873a300000000000000000000000000000000000000000000000000000000395ae182","nonce":"0x1b033","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x18","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1bbffd0fc54bb2835fd3f6a47a4c98963f538565a2c35859b45d35282bd867c0","s":"0x299137f40f24529d6184eb02917ddf6d38751b6b8042fd1477d6a7aaf89ad059"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xabd8eed818ddd601f71d0330d96dddc12ab5be32","gas":"0x5208","gasPrice":"0xd8111c400","hash":"0xc6ba4c554a670fcb62e42505c6ee70a41c3a96372cf8546598d6194c6396b1b3","input":"0x","nonce":"0x0","to":"0x077d360f11d220e4d5d831430c81c26c9be7c4a4","transactionIndex":"0x19","value":"0xb008da65823400","type":"0x0","chainId":"0x1","v":"0x26","r":"0xb6d53517a93418e4ebca8faa4c4e4b11d9c7aeebf3e9bd7b35999bf53d77c2fe","s":"0x5697b7480f9b7eaa2a9f2b2bb349dcd18112be401b32dafc109e3272a88bfa85"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x077d360f11d220e4d5d831430c81c26c9be7c4a4","gas":"0x15f90","gasPrice":"0xd8111c400","hash":"0x233c5c49ef9376976fe4949183d94b92689369b06755416f600d28ef22fb7663","input":"0x","nonce":"0x4aebe","to":"0xddd1939707a98bcf66b5a695a72aacbb8a7fd442","transactionIndex":"0x1a","value":"0x1d6651758a6fc00","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf9a4d44856a5fd5392337727d51021b66f1ab7d6b08cb0048e412d4047f7510c","s":"0x6d8034a6d7bd83d1d60f967987fcaa3d3f7d80c0f97043e5611c3d4d7fa9b0a9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc098b2a3aa256d2140208c3de6543aaef5cd3a94","gas":"0xf618","gasPrice":"0xd5051f4f2","maxPriorityFeePerGas":"0x1a3054980","maxFeePerGas":"0xe8d4a51000","hash":"0xdd6f65c69131418db9ac1698a6a9a79d82366d1bbe3001c0013229c7fb87a7bb","input":"0x","nonce":"0x17feb1","to":"0x266d1b791d8418974c0bb614d33ad6621489f8b7","transactionIndex":"0x1b","value":"0x1a1e52b6f2e400","type":"0x2","chainId":"0x1","v":"0x1","r":"0xef1ab86960451e988754c7e0f2e512150cd1ea8fcb5ae4cae59acd5a38655735","s":"0x2add3f986e81c0c216bc078f57ba10f4271610bd8adc5f2e2a6d43475d19cfb7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x543500e7477c7bcbe64637b6f6d5130ebc86107c","gas":"0x2acf6","gasPrice":"0xd4e883172","maxPriorityFeePerGas":"0x1a13b8600","maxFeePerGas":"0x138eca4800","hash":"0xf76971a9c5d67804fb13b30d7d2e1e68cdf7031c5d95618138c78bac89511a93","input":"0x5cf5402600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000000000000000000000000000051e71558c07a53100000000000000000000000045c2f8c9b4c0bdc76200448cc26c48ab6ffef83f000000000000000000000000000000000000000000000000000d3c9e99fae7cf0000000000000000000000000000000000000000000000000000000000000128d9627aa40000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000051e71558c07a5310000000000000000000000000000000000000000000001ac62a9a0c6c12a9cc100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee00000000000000000000000045c2f8c9b4c0bdc76200448cc26c48ab6ffef83f869584cd000000000000000000000000382ffce2287252f930e1c8dc9328dac5bf282ba10000000000000000000000000000000000000000000000f49a425a816238a4b8000000000000000000000000000000000000000000000000","nonce":"0x7","to":"0xe66b31678d6c16e9ebf358268a790b763c133750","transactionIndex":"0x1c","value":"0x52badf426028d00","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8915e453b1acaed622ed027504e1eba675333c2a1268f4e36234cf3bb49b7466","s":"0x753b67f0d609c5ef875a96e5d2f54fb1dc1fc4bff33d4372a3283d2b9f2a36dd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc098b2a3aa256d2140208c3de6543aaef5cd3a94","gas":"0xf618","gasPrice":"0xd37f4ceb4","maxPriorityFeePerGas":"0x18aa82342","maxFeePerGas":"0xe8d4a51000","hash":"0xffd0f412a002ef80d44a606cf22347a590f8321f60bfa48b9c52a8c08192ae09","input":"0x","nonce":"0x17feb2","to":"0x307ec02bf5674caef56af71a3ceaeb68771b70f3","transactionIndex":"0x1d","value":"0x12a7ca26624a800","type":"0x2","chainId":"0x1","v":"0x0","r":"0xf44abeea1bcd68b30446f0f8f7cfb3790ca59a5d5aa1ffa4a7bcbd8fad7b098f","s":"0x75848eecf16e1d1d480799b7cdc6a3bfc8856b1a2e0b8c6593223bff9541e9ed"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x71ec583a7442b97cfd30b398976b019f10683dbd","gas":"0xd176","gasPrice":"0xcd7529d72","maxPriorityFeePerGas":"0x12a05f200","maxFeePerGas":"0xe8587b33c","hash":"0x9caad3c037921295f3c1a64f3db0ae276a31c572087943c5c4a03402fbc074a4","input":"0x095ea7b30000000000000000000000001111111254fb6c44bac0bed2854e76f90643097dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","nonce":"0x2d9","to":"0x614bbb02e7fd360ee393de19cbfe11847e2c021e","transactionIndex":"0x1e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3fccf1b07419dd476a4fbbfb265b85419a9738cdee97f352038bf56401df5363","s":"0x3796afb38f1493e363000b7b008e01f483495b7dda4ae59c9b54f44251eaeaa2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1733ba1a96aa7c44be180a032c09d665f024b921","gas":"0x1d4c0","gasPrice":"0xc9bb7d372","maxPriorityFeePerGas":"0xee6b2800","maxFeePerGas":"0x22ecb25c00","hash":"0x4b0d83b9d2e49fd74633ce415213c8d6ed4627394d86e48d8b067fcb59c72573","input":"0xa9059cbb0000000000000000000000005770815b0c2a09a43c9e5aecb7e2f3886075b6050000000000000000000000000000000000000000000000000000000130f2ca70","nonce":"0x4","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x1f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x28951ddae6b218e278d8156ece5fa5f575d702112f7cf16c149bb07352e4c3a4","s":"0x9bec9905c83518b5213d61baa28dc5579a61fa8afd78eeb8f2126a3bd88538b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5d232a6011452bf2a7dcbaa4087e7e410a07fd48","gas":"0x14820","gasPrice":"0xc9bb7d372","maxPriorityFeePerGas":"0xee6b2800","maxFeePerGas":"0x22ecb25c00","hash":"0x212ff3b885bcb80a7953929e3d40b91d13291140f0f96350f22a3c61c30bc5e8","input":"0xa9059cbb0000000000000000000000005770815b0c2a09a43c9e5aecb7e2f3886075b60500000000000000000000000000000000000000000000000000000004c835e52a","nonce":"0x1","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x20","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x5a8109182247837e390e798fa705ab66bd1126663612dd22813d86a9262d7d19","s":"0x17020505873a29207384409db3155c0c486c73c538604ac6f892b8bb8ccbbdbb"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf1ab8efebb0f8cc494047bcd05bf3671492a3082","gas":"0x16ffb","gasPrice":"0xc92a69c00","maxPriorityFeePerGas":"0xc92a69c00","maxFeePerGas":"0xc92a69c00","hash":"0x53871098486061cc09dd109b947b02ded1659d4ef27284c995cd7a22d9ee725a","input":"0x4447e48c000000000000000000000000000000000000000000000000000000000000009d52afb6bd1679c1b9d3187a39448eafe3048e105ee568596a21c82e9e2e9a91fb4147706ed94fa5c51a200a0487d7fe3e87565b4c49f3056bc6f0a7a3a9e2d16f00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","nonce":"0xf6","to":"0x33eecbf908478c10614626a9d304bfe18b78dd73","transactionIndex":"0x21","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xfbfc6b2eaa2f954564f79caa125324345fc1eca9325049a22957423510b32ec0","s":"0x4b3923d685c8f9d209299b86ae066baf458f6f4b144a92605e3c3089752b083a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd0bd91168ad1743e930e151c7327adf1245f4e74","gas":"0x5208","gasPrice":"0xc92a69c00","hash":"0xe661f7350a6008207ca78dd16f89d6cb67445165ee6dfb666b490bbd344451b3","input":"0x","nonce":"0x21","to":"0x4de23f3f0fb3318287378adbde030cf61714b2f3","transactionIndex":"0x22","value":"0x8e1bc9bf04000","type":"0x0","chainId":"0x1","v":"0x26","r":"0xaa97cdceb6ecbf3c22081e7cd4b4150f90778799a7d73ef9ab864066caf2132","s":"0x511b02a97cf1b92db48af7c473ba3a4d2c37a91bff6e1cad9a9be7afcfabb682"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x25b5118f646583caa577a5d14f4b78f658eee127","gas":"0x124f8","gasPrice":"0xc8ba9f46b","hash":"0x7dd7658b4d31e29e12555d90e4c91eab6dd1b326cd165677e52c23a9be733154","input":"0xa9059cbb000000000000000000000000ffec0067f5a79cff07527f63d83dd5462ccf8ba400000000000000000000000000000000000000000000000000000004a77f3180","nonce":"0x0","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x23","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x1515f3adcc9fad706aa3ba71086200516c899f45fa95bc7254882567a5defa8","s":"0x2af497f027d1cca322d4821437da899dd53e2b340e37d6ee0f82890f5b1da8a3"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa29fed2b3f3bb68c21e9d725c069964fb261bb8d","gas":"0x493e0","gasPrice":"0xc8ba9f46b","maxPriorityFeePerGas":"0xc8ba9f46b","maxFeePerGas":"0xc8ba9f46b","hash":"0x2137283becdf77aca1a123cbf8913f01d3b791cbf79e831b8559462a059f1eca","input":"0x18cbafe50000000000000000000000000000000000000000000000f2dc7d47f156000000000000000000000000000000000000000000000000000000035a0b3cdd64b6b200000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000a29fed2b3f3bb68c21e9d725c069964fb261bb8d000000000000000000000000000000000000000000000000000000006238a51f00000000000000000000000000000000000000000000000000000000000000020000000000000000000000003106a0a076bedae847652f42ef07fd58589e001f000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","nonce":"0x36a","to":"0x7a250d5630b4cf539739df2c5dacb4c659f2488d","transactionIndex":"0x24","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x7ba9318c153daad837c26a23e29ea1d105cfaa116f966f956597bf6eccc32597","s":"0xf6a5fc94920eff9db0c42be46d26810d5fe739851bba844a19997333763594d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x0fa667ff71a47dd0edd2fa59d7f760adf4deda7d","gas":"0x124f8","gasPrice":"0xc8ba9f46b","hash":"0x7c8e0ba17b09f261b2a4f350add0ac2d3b6f30fffe87e641846a33c8b00f4a75","input":"0xa9059cbb000000000000000000000000ffec0067f5a79cff07527f63d83dd5462ccf8ba400000000000000000000000000000000000000000000000000000001472ae200","nonce":"0x1","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x25","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x665a9def84fef0c8ae3e450c3c89f7a6e3aed59a95bb339068e9f6eed76be7b0","s":"0x4945ad0bd796101fff04de815da8ed7585e570a04f2e5d35b9592587786cf69d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x506abcffb687bf37ecc3380bb7cd4af6f7fcf238","gas":"0x17237","gasPrice":"0xc83256a25","maxPriorityFeePerGas":"0xc83256a25","maxFeePerGas":"0xc83256a25","hash":"0x6498a9eed9fbdbe4a417169050a95cbcf5e0cad48fb23f20214d6664ed8a2012","input":"0x696d48200000000000000000000000000000000000000000000000000000000000000001","nonce":"0x2b","to":"0x4f2c9b6e18f84c618910eb3edba1b5327c4e7851","transactionIndex":"0x26","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xd13f2890b6e7f5415cf8ea06f01e4db5cd27478a327ceb128ebcd905c0fb5d77","s":"0x557f8bd00c5a0c2d13162d1a5e6c5d17e6989665006fad93272dd5d813d518a7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc36315c5c42bbc57ef6d9b1fefa800c689fb75de","gas":"0x1c9dd","gasPrice":"0xc83256a25","hash":"0x75d3401662b36f2dd05632306820864905175e333f870854355bd1c1de0fd559","input":"0x","nonce":"0xe","to":"0xc36315c5c42bbc57ef6d9b1fefa800c689fb75de","transactionIndex":"0x27","value":"0x0","type":"0x1","chainId":"0x1","v":"0x1","r":"0x90765b5190f26b7231b00093fe64c98164eada8c8d8b977c6718b04c3ce5e691","s":"0x3490fe8c3d3752b4dd511b8235d31f2e9331e32d6160e20ce5f4ce0349e43405"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbbd0d4d067d5af2065b1b6fd936d93237ae1c56c","gas":"0x5208","gasPrice":"0xc6f9c5272","maxPriorityFeePerGas":"0xc24fa700","maxFeePerGas":"0x14f5885a18","hash":"0xd10ee5cbd1c7e4af94d9ecce08a6b4b3a15f96ab52fa4855124edcc0d47b775e","input":"0x","nonce":"0x360a5","to":"0x793c9be48a8a0c4bc38c85e5e3a3b9fdc363f729","transactionIndex":"0x28","value":"0x236be4232f0a08a","type":"0x2","chainId":"0x1","v":"0x1","r":"0x9b6a261e267a8a799b82bd1304e62a7bea14a14f6153af55e0373497301e9345","s":"0x16c8d1736da9766f7a2c7e07e6c1467f7bdbd8c1e46360689a033e0a8d13508f"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x88dcdd4a0a58b7e2208805d547043c37dca2b6dc","gas":"0x5208","gasPrice":"0xc6f9c5272","maxPriorityFeePerGas":"0xc24fa700","maxFeePerGas":"0x14f5885a18","hash":"0x664da484e3b6cb4abd79a42b23502eebaf6fbeaad08fc53b807e1c557deed52e","input":"0x","nonce":"0x14779","to":"0x4ae3280e25b61404fbb81c6a196a8299eb593c7c","transactionIndex":"0x29","value":"0x351ff44245f24da","type":"0x2","chainId":"0x1","v":"0x1","r":"0xbed2b47f0d351e3ea0af333ed48fee46202ce005dc3de3a564ccddb8443939f8","s":"0x60ef5dcdd813780c23b110e1c7f0c3cec8ae7da540c1f34bf23ee6b887f45c68"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xbf627404ceabca0cbcc018810c75d8f8f31d6add","gas":"0x2f7f0","gasPrice":"0xc570bd200","maxPriorityFeePerGas":"0x1a13b8600","maxFeePerGas":"0xc570bd200","hash":"0x767763a4cadaf2bce9cf5174c0f3fe787a530b1399cd753e6e2c8acc37c49884","input":"0x5cf5402600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000000000000000000000000000000040172ac66deb000000000000000000000000f0bc1ae4ef7ffb126a8347d06ac6f8add770e1ce000000000000000000000000000000000000000000000000000000a5ba9d27e50000000000000000000000000000000000000000000000000000000000000128d9627aa40000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000040172ac66deb0000000000000000000000000000000000000000000000000000000000597cd800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000000000f0bc1ae4ef7ffb126a8347d06ac6f8add770e1ce869584cd000000000000000000000000382ffce2287252f930e1c8dc9328dac5bf282ba10000000000000000000000000000000000000000000000534623483862389fad000000000000000000000000000000000000000000000000","nonce":"0x2f","to":"0xe66b31678d6c16e9ebf358268a790b763c133750","transactionIndex":"0x2a","value":"0x40bce56395d0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x874375c2b8dc0bbc6be0039966c98adbe923486310e17be3d29b8be5c9c13509","s":"0x1754a0dec595aa856574ced1bbaac085ed9893f2bf3e6c6ec148e5e61164df22"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x22b91","gasPrice":"0xc43708adc","hash":"0x3e580c334e947c6dbaaa383f48225cc694f84f793dbc12f7f7a3419967f8f05e","input":"0x0175b1c46d19114c567d6a8b8d478abd3ffe3af2c1f3cc0c250260cf8a66ce12476624fe0000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea00000000000000000000000067ebc532d648e6164a6fd726ff0b39e69180dafe000000000000000000000000000000000000000000018dd6dd351e795e5c1dc200000000000000000000000000000000000000000000000000000000000000fa","nonce":"0x2b4d","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2b","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0x6de5da8aae4a8b115af477a99335b36b45d625ad74ace40338642cf1b1ccf64","s":"0x105939f5d06d38bcb2d4a2239a9bbe40a83981e93a0d995d423ad450977d4039"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x283a8","gasPrice":"0x11246e20d9","hash":"0x1836cd3d1a4e79c6ab880eb7db88c4a589f5737de62655b721cd798679c96532","input":"0x0175b1c4bcea3dde489beba90e96a971222fdb5a00e883a5543e63afd5d9fc33cf7cf9600000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea0000000000000000000000006f0a19d563fdf85ce1dce18f8c852c96b9ecd6bf00000000000000000000000000000000000000000003968ede5643bbf3550000000000000000000000000000000000000000000000000000000000000000a86a","nonce":"0x2b4e","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2c","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xd7e4a696c36ab18c7d8748d3b82e726d69c65e95242c1f08cf8247d175842676","s":"0x2f166bf0c19ac438ccf4f3517edeee2fa8b28d3428630c42595c4e7fe63fc025"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x647dc1366da28f8a64eb831fc8e9f05c90d1ea5a","gas":"0x283a8","gasPrice":"0x1012c7f034","hash":"0x0e5483bf79bceb722ff8c07a9186668921b328ff06141cd572bf7eed208519e1","input":"0x0175b1c4f3cc58640469461a0fd35233070f886caab7306f9bc340b4ceb4cda99489e50a0000000000000000000000004e840aadd28da189b9906674b4afcb77c128d9ea000000000000000000000000cb018790c03bdfd33a82354dee4e320f1398d66f0000000000000000000000000000000000000000000190fccdc5503c1168000000000000000000000000000000000000000000000000000000000000000000fa","nonce":"0x2b4f","to":"0x765277eebeca2e31912c9946eae1021199b39c61","transactionIndex":"0x2d","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x1748021875eaae26c2837ff6cab749c745e084e64d55280ddbbd6de4b7d64bc7","s":"0x28e6a94a7723f84b912758a93e54317b6798a9bfdd50dc3f0b6707d458596bef"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xff62478469962006bfaddb2edbc537ec1d5cda3f","gas":"0xb45f","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1d0b8d94d6","hash":"0x74d895439176b2727b9cca6fc1e10a11c0504b3f0de722f93e8272a1f963189a","input":"0xa22cb4650000000000000000000000005f3ebea72c25d286de8d4787cfddaeb0aa68a2a20000000000000000000000000000000000000000000000000000000000000001","nonce":"0x445","to":"0xc0423cd6d1e19d21f1f031895867e4ca36a14af3","transactionIndex":"0x2e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3a5830fb54d970e7ffb381d4efd7ad146ef240828caed1764f3b61c0b6c2ca25","s":"0x4b020fcb502fc420720b5badbef59389a1d0284c9a29e175c7ad07946d32ff28"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1425425563615b5b7654319dcc4488c46c9e09ac","gas":"0x107e5","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1d0b8d94d6","hash":"0xfea61304b28a38cf01ed744b6a8e6251f621c93e225521d5520745d6f2aa3ac0","input":"0xd0e30db0","nonce":"0x2","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x2f","value":"0xde0b6b3a7640000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x272f7937f5ba5372999a0086d4b2487dd94dcde0fd4e8592f4d8964f07e3e9a7","s":"0xeb19a22d07f7cdf8fd907348e4915bb492f27c8b95006d9279d2a385ffe6b1d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1bbadc48b976a38537729ecfb5a221c3255be14f","gas":"0xb6b1","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1b4c067b78","hash":"0xaad637e097fae3d0dce6ca90eb71b3f45c83ba8861905e24a9af374bd14235e3","input":"0xa22cb465000000000000000000000000e19184397acd38fa12425c7471c63a2fcf48f1790000000000000000000000000000000000000000000000000000000000000001","nonce":"0x28d","to":"0x7cba74d0b16c8e18a9e48d3b7404d7739bb24f23","transactionIndex":"0x30","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xdb8ddc9e607e4fff16371bb0e85dbe858fdfff5084d74e8dabf85aba36f37360","s":"0x32acefd0bc0c4fdf7fa67552af867e923766e099e29ac3913f69c6bff0c62892"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x59a5208b32e627891c389ebafc644145224006e8","gas":"0x62ad","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0xe3678dcbb","hash":"0x7d2e7c14af591cbd4eb9309382557209741d1a2e0da392407908c02f102dc802","input":"0x","nonce":"0x1beb37","to":"0x6c34c12eaab761ed34bebf6aaf5bcf4a8a659819","transactionIndex":"0x31","value":"0x4d75ba7905a3600","type":"0x2","chainId":"0x1","v":"0x1","r":"0x88f6ac1c3e039199ba2d141586cf65df837998b103fdba53c102794971081253","s":"0x20bf4e88ef6af62faeb5a8eec4248bf4b9107a8d2997a435c42c5a03cfeac193"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x0f5e6c11c553bb43c47be504b7b06bde8652678b","gas":"0xfef6","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1aa855f7d8","hash":"0xafcfb073bf6d00100d9893207b3ec8db23c412da6fcbea01eddbdd4985be011a","input":"0x42842e0e0000000000000000000000000f5e6c11c553bb43c47be504b7b06bde8652678b0000000000000000000000003e09132aa15b450e2ca237bb2a91d75cea93a7290000000000000000000000000000000000000000000000000000000000001d71","nonce":"0xb7","to":"0x5501024ddb740266fa0d69d19809ec86db5e3f8b","transactionIndex":"0x32","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xd0e229e6cf5b306f6a3f2786f1f82b629f8670b28f54c67bef2afddd596e5812","s":"0x3778878628a800b487b66ebb822fd1a7e17fe32b58feec71bc0585454b54b1fc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x34be15adbbcff56abd05bea96c95b483ff9454df","gas":"0x27b20","gasPrice":"0xc424fa472","maxPriorityFeePerGas":"0x9502f900","maxFeePerGas":"0x1aa855f7d8","hash":"0xefbed26e5c3c9f36c51d2bc2a83225000e678cb32c8d749f7651783cebf8bbc2","input":"0x57db6e1d000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","nonce":"0xe3","to":"0xfdeef424c147e869a9bb2723874186f06f36b386","transactionIndex":"0x33","value":"0x354a6ba7a180000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xc150a5adaae2ba1944e3d734669624d6a292d6ea0a84b42b7827195e43f88044","s":"0x6617188c91354cfa169e9d6b3224aba7e7e1e6c1aa4d3e8152c3e9059ebe220d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf94ebf72c7335fae118c43387c34bc8c783d2e10","gas":"0x5b8a4","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11d491759b","hash":"0x7a69fa6c7717e85b798a3b65b7eb259afa7a729eeddf723310fdd773e7c44010","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000e4472b43f3000000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000bb0b777ecb9110000000000000000000000000000000000000000000000000000000000000080000000000000000000000000f94ebf72c7335fae118c43387c34bc8c783d2e100000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000a3f13f1572ff1e263758fb1bca22548a64fdc3a500000000000000000000000000000000000000000000000000000000","nonce":"0x776","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x34","value":"0x8e1bc9bf040000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xbfb84dfcfaaa298f3468fbbfd12d242f0104e4d41bdc69ff3f833232518a6169","s":"0x48bcea25ae56eafbcabe8212cf446bf437655a74939d3870e0152c32c2d6a260"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf7a4e60ca56980c6d94b4fa07cb3e215c477d033","gas":"0x5ae44","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11b1f3f800","hash":"0x2fb2c8bc358f4c33f2c6a40fddf4dcfeab0ab31a00a0aa96d29e3fa8277c5527","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000f7a4e60ca56980c6d94b4fa07cb3e215c477d033000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab700000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000905438e600100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4490000000000000000000000000000000000000000000000000000000000000000b183c5f945d750d3852ae373d585d32cf2ff611b02aed6164ffd37355f69d26000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000905438e600100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237eaa800000000000000000000000000000000000000000000000000000000623bdf601c169769334aa7d046bfc0fdaf818079a59ba4c067845b29fce8e9a2159c50c70000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b0e23b8acc764d7d547933196fe6c85701ad54ff6013369f04d46ff8b27ebe1e511d698dc6297961d54d2891e8144e4e6e5d347d93ca4b2f90468b9bb4598dc510e23b8acc764d7d547933196fe6c85701ad54ff6013369f04d46ff8b27ebe1e511d698dc6297961d54d2891e8144e4e6e5d347d93ca4b2f90468b9bb4598dc51000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a5950000000000000000000000000000000000000000000000000000000000000000000000000000000000000000f7a4e60ca56980c6d94b4fa07cb3e215c477d033000000000000000000000000920a8d9e9f0defd6f86e4388a5503b04cac83b57000000000000000000000000000000000000000000000000000000000000066d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000501c9f7267b70dfe2a96a7e8c69aba25f016bab70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000920a8d9e9f0defd6f86e4388a5503b04cac83b57000000000000000000000000000000000000000000000000000000000000066d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x4","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x35","value":"0x905438e60010000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xa272165aaf915b58a82d07e09a65b00d7e1711b07dc90dcb6d06954f512f26a7","s":"0x91db600923f276ff079d0e0ac0cb02fd7080b186549e998b3375de6850e32b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x998f16cfd8efb044f66771cd3efed4641a592770","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x11b1f3f800","hash":"0x09235e08b1bcde423b125441accbf73e2e7c5c5de3d883803269218e5677ba5c","input":"0x","nonce":"0x4","to":"0xd37259e8651ea6af19f81bd663f31280e2b3411f","transactionIndex":"0x36","value":"0x5eb106c4ddf0e0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xed4c904a32de3040b148d2fb627305f01e941c7fbac5c46645eef5a2954ac3d7","s":"0x6d805aa856ecb009a3c8f1e47065a799540fa264a2d10be61f9169a386f23764"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1ba56eaa78","hash":"0xac114adde331c975e23688cef3c59768cab22d4cb8ac1141ad4cd4b83aa15e3a","input":"0x2da034090000000000000000000000009b44cb0e212f78f5e33eeafaadbafa888e47f86d000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a499f","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x37","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5907cb2b3c4278f59e1a34030c455b7e3ecffd6d2616d55ad4b9f7f56221d95c","s":"0x6e139c895cdb1bc27a3b0d7be886729692a972099a8cdefa0f56aa27bfa3f3ed"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1d1a94a200","hash":"0xaacf3b5856b75493d2951b0b9c406ea215e43dbb93f9570bde1338105b04434c","input":"0x","nonce":"0x4d20d5","to":"0x7eeca051c3f086b16ee743094af9b245cf5d0082","transactionIndex":"0x38","value":"0x9addcc147946000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x369caaf5907470fa22858b99e37f7a314902c4520fdbd9fd41a73e83ca74b9b2","s":"0x32f53a9c00d88d2204dc081f194843f90e295ba4367a9a7cba5f097540d2f6cd"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x25ad0fde4dc379b2c0e51dc5e70e0174bd8ae123","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x17bfac7c00","hash":"0xf83226339db9fe6028226c017c076244212b7343d7f3a353fda26f99596ae080","input":"0xa9059cbb00000000000000000000000071660c4005ba85c37ccec55d0c4493e66fe775d30000000000000000000000000000000000000000000a0541eafe5d9aead80000","nonce":"0xe72","to":"0x090185f2135308bad17527004364ebcc2d37e5f6","transactionIndex":"0x39","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x5896e029fcd486801f5efa7d7fbf5e635e9fa226c286695b892be77070f9a82d","s":"0x220d248d56db2cc9059e4837da9df7c98cfc7f4859074cb8673a726d51d0f954"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x77696bb39917c91a0c3908d577d5e322095425ca","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1b3dbe5200","hash":"0x08a7094041b3d1d79de412a4a6d66d62993e576d33abb7404f093a351df08883","input":"0xa9059cbb000000000000000000000000035226b054828d3e14a972584d952e99f5de230b000000000000000000000000000000000000000000000000390a3187f92fbc00","nonce":"0x317e9","to":"0x0f5d2fb29fb7d3cfee444a200298f468908cc942","transactionIndex":"0x3a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xa289bd8af7f7983402c6b93583470b79992340738ef3a45e876de9458c0ec6","s":"0x35369713b94f938e8d4caa7476e51ea1f5d18429a043d3539b27dfa58a11c596"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x66b870ddf78c975af5cd8edc6de25eca81791de1","gas":"0x183ac","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x1144cfc53709422dd3ddea66f706701a3e426736a37df8d9e332b5aafa88d42d","input":"0xa9059cbb00000000000000000000000000e5a16631c257366e26005de7eea1457cc35414000000000000000000000000000000000000000000000000000009195cafafed","nonce":"0xeb0","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x3b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x4db3aa0a2e031f73fb926f258c953c37ff909e4788f625d114e2239ccf393cfa","s":"0x649c2b5f611161fd346ad8704ba7c1eda928fad31d59b5a8e4c61b74f8cf8bd2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb2629a2734e272bcc07bda959863f316f4bd4cf","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x866e7dacda4520969628bf11d4301845bfa3ff1d189faafa6392b732242b4ba2","input":"0x","nonce":"0x4d20d6","to":"0x9ef31dfb18a2e5588dc7f02480f96d9c063de8f6","transactionIndex":"0x3c","value":"0x186cc6acd4b0000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8d68af18d4c6f85448fcb8e6b97f88bbc6d44e2bea8f6c17c225836e6f0ee369","s":"0x95da3a57e25b5adba8f2c8485fa724bb03e43f349943269d3239765eec0a60c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb739d0895772dbb71a89a3754a160269068f0d45","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x24ad3710fbf2656bc4972f0a8461bbf187703cf8c6076730c2c3b346a265dbfc","input":"0xa9059cbb0000000000000000000000000c92b036caea02b436594434ca36c0e92750aaf600000000000000000000000000000000000000000000000000000021b86805c3","nonce":"0x566ae","to":"0xa0b73e1ff0b80914ab6fe0444e65848c4c34450b","transactionIndex":"0x3d","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9aa6eaf6d8bf94e16053db2de3dc920f09f55daafa6a577865c19ed05ff06ade","s":"0x798d02eec0e8e0eff1fd764eccf1d6a868ce78dbaec286607d71bf07e0797f19"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x88d041e262b66524719e75fbc4313747f6772f4ca843a26563c9647196716ce5","input":"0x","nonce":"0x68619f","to":"0x82f25f227997823dfaa35afe6244c0e9b0347d88","transactionIndex":"0x3e","value":"0x70b87055631c00","type":"0x2","chainId":"0x1","v":"0x0","r":"0x88031e6e9926fe5787e749a720b822166615efa8b8206ca8c03730400bd72b7","s":"0x1617245ecd75f624ef84133f85b453c5502e6e306abc471056bb384bc19bdcae"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x877d935f53567f907c6289c43e0c308e376a84e7","gas":"0x8caf","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x116a2b8673","hash":"0x1fa8e6fd96049a879f9158bf522d9aafecc8efeebb0823beccdf902c0337ee8b","input":"0x2e1a7d4d0000000000000000000000000000000000000000000000000429d069189e0000","nonce":"0x7","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x3f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xecc6a7d411d36d801057226e677a83bf9a879cba7c7ed6bb12dc05422d8a6233","s":"0x5e2a0515e9a05670d9c9ca865205d92650b26fbd5ac5a982d73772f368b2b9a7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x77696bb39917c91a0c3908d577d5e322095425ca","gas":"0x3d090","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0x6eb68c1f1d088d97d8d804215d2763da7f37bc6e5fc00dc5c9692499ac33952e","input":"0xa9059cbb000000000000000000000000803d6a65840dbe84995bd5bd6744a56f0bd561b8000000000000000000000000000000000000000000000001067b31c479d6c800","nonce":"0x317ea","to":"0x0f5d2fb29fb7d3cfee444a200298f468908cc942","transactionIndex":"0x40","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb6aaa62ff34f5ecf991fb9eca824ceb71e6be5e93220b20d5f06a2cb569433d7","s":"0x79b7d038698a13e05133433d6c94674da2f98b8f6aff85cd02f6896d72f65104"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3cd751e6b0078be393132286c442345e5dc49699","gas":"0x5208","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0x1a8aedf400","hash":"0xc39f499c69f2f2cddfcb383566df014791b6cdec464c4b16facc678fccb4ea2d","input":"0x","nonce":"0x6861a0","to":"0x9cced5391143a3d838a7ec054a4e2fdf3c58502a","transactionIndex":"0x41","value":"0x1e5a6ecdfafc800","type":"0x2","chainId":"0x1","v":"0x0","r":"0x4e59c261ef26f4cc2e3ab885a712ab21a26d00728af578600749ca132742debb","s":"0x464c0ce24160cce18feaaf32b7af17f87ea140fb9c0e85ce7c76c135fcaf265d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf93e4d693715374929bea688784fd17ea30e1aa1","gas":"0x3cdbc","gasPrice":"0xc24823f72","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xfd51da800","hash":"0xf9203d227da3e154bd82ad26ea8a3b3180a0e384b8cf0a3c5aa3c413e21d2fc4","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e45023b4df000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cfef8857e9c80e3440a823971420f7fa5f62f0200000000000000000000000000000000000000000000000000000000000002710000000000000000000000000f93e4d693715374929bea688784fd17ea30e1aa1000000000000000000000000000000000000000000000001bc16d674ec800000000000000000000000000000000000000000000000000000054f5b641a5ae54c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x53","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x42","value":"0x54f5b641a5ae54c","type":"0x2","chainId":"0x1","v":"0x0","r":"0xafa385acb782785fff2275089d60f1b01d2cf8ef969e679a7954506627c33fd","s":"0x59e1447051de06da5c2cca67d37864cb246d3caa4f29ed29640ca2fc9aea7ccc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x42f028e4d830b8961373bafdd83acaf08ba08d37","gas":"0x5208","gasPrice":"0xc1b710800","hash":"0x5bab0f789dc85f7dc8d42257c163ec868bfdcaaa60bf9f95ed807c4e88e1fcec","input":"0x","nonce":"0xc1","to":"0x65dae44c9fead4c807cfe684613c0dbe7c55dfd2","transactionIndex":"0x43","value":"0x58d15e176280000","type":"0x0","chainId":"0x1","v":"0x26","r":"0xba29a019be60e7787b0ce0285b34b0a3a6aebd320abcf53ddc99851e39732a01","s":"0x681a37910f527a56d5e1f1d6af9dec7ca5837b506f05d2ef66a66e2dc9aac74a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb2644b8c5d4301759616d34c2c60e1e39dd5712d","gas":"0x300d1","gasPrice":"0xc1b710800","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xc1b710800","hash":"0x6a57e1c8fb7e65ef934b58426cecf5721b5b18b0c038781a9a17db4b0b0d3d8b","input":"0x27ed3dce00000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000000e7e428eb56ea16e9fcc7cb5449eccc09a065311a47d3043dfd4576012607a1cf50e0177098f4b3e991235cafdf1b8fbb8ff4c471cf5ee46c7f61832c52684bdf4b7b9ac0f696c136abb028a037120f3d0db403124546b948fccb660863fc3fbaf3d4cf208d24d7a25104d2109c0e60c66cbfc4a1adda588dc9f80c4151290586feeb379677bf5526e2934a0e7d2d1112cb015e541b3408dae48fa0401b86a6ee88e2c53c692f99462e582be569d3a7fb1a5b4a02ba15c44c018adcfad06450c991619138821d2c3a34b557eb277e442794d604d20d0b8fd0af7387e49c67f781ed4948b1ede17afcbacb12874818863e2a4e05d0f4ff3d821269df3b32d7f3e461c648cf7c11a3f6f7be39346492a15511b81712db751797588fd58d50fb0b404b507475a35fd07cf06f8fbba131157a2c7f921b1f06d002b562d511a5f3cbacef11ff4e50bdc44a5a3d3eda714b2eaf5633ebb50665b394b0afe79b737c5ccce8d8075adc6451fb8feea97486fd42ba3fcb0acd08355355c26492620730700a7d2b9325aa9f7052716eb632d91c05d4348cdc742b9211adbd9b1ab52e1e18bc1a854211dc3a9a696246df40d309afaa46fbb550793473d831078206b6b561992","nonce":"0x27","to":"0x19cb5b009bdad0dad0404dd860b0bea75465e678","transactionIndex":"0x44","value":"0x2df69673c818000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x76d044f0d44a532644fda2919ac0bbb801b1c80050f671ee2292846243b4d62c","s":"0x9c55cfe0359a9232b0fc5a049a3658bb413cdbdde2406a282273027ae98f425"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3494edd48b90f8aa91186ac356c956e658b78848","gas":"0x61730","gasPrice":"0xc1a0f452d","maxPriorityFeePerGas":"0x77359400","maxFeePerGas":"0xc1a0f452d","hash":"0x198371baa808ee9a0e242dbca67c952ae0addcda98a468ec652a161ab854884f","input":"0x5f5755290000000000000000000000000000000000000000000000000000000000000080000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c0000000000000000000000000000000000000000000000085e62e27c31e2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000000c307846656544796e616d696300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000440000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000085e62e27c31e20000000000000000000000000000000000000000000000000000668abf97c6c416a4000000000000000000000000000000000000000000000000000000000000012000000000000000000000000000000000000000000000000000eee3650a7561e0000000000000000000000000f326e4de8f66a0bdc0970b79e0924e33c79f19150000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000030877725df6000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c00000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000085e62e27c31e200000000000000000000000000000000000000000000000000006772785362d4ab57000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000160000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000337fea5e0ff7e5aca000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000990f341946a3fdb507ae7e52d17851b87168017c000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000526643c9b3263a5370000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000002b990f341946a3fdb507ae7e52d17851b87168017c000bb8c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000000000000000000000869584cd00000000000000000000000011ededebf63bef0ea2d2d071bdf88f71543ec6fb0000000000000000000000000000000000000000000000776e38f2a46238a03f00000000000000000000000000000000000000000000000092","nonce":"0xe8","to":"0x881d40237659c251811cec9c364ef91dc08d300c","transactionIndex":"0x45","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb8589903018f0a1bc1a1302216d72ed7fc5d4416fbc4689e92f6a9a8110ab5","s":"0x3c6b4a13223b4e7a90bef125bec37307fb8f3ecee7750077ffba341c7d020b69"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x230a1ac45690b9ae1176389434610b9526d2f21b","gas":"0xf4240","gasPrice":"0xc141e14b1","maxPriorityFeePerGas":"0x66d1693f","maxFeePerGas":"0x15d3ef79800","hash":"0xd054facb9d0288d483ebefddb075aae27d08b5334bd52e2a41f9fca773b1fa5b","input":"0xd57eafac000000000000000000000000d4109bab1e296b5d11bc17efaa5b7537e3f51cd40000000000000000000000001b84765de8b7566e4ceaf4d0fd3c5af52d3dde4f000000000000000000000000000000000000000000000005a7ba096c979f66ff00000000000000000000000000000000000000000000000340aad21b3b7000000000000000000000000000001116898dda4015ed8ddefb84b6e8bc24528af2d8000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000028a56c4000000000000000000000000000000000000000000000000000000006239f62d5f39f6c80473e5b2a22165b32df83c0e0449264cf650f5bf79cb7682d74cbb9c","nonce":"0xc70d","to":"0x2796317b0ff8538f253012862c06787adfb8ceb6","transactionIndex":"0x46","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xfb047540bc24481eb7425dc05e429bd940feb7465d44478f1538d5f38ab8653b","s":"0x1bcf2f0d0605cdb1135736850a1cb0df80c2a508e3f4ee141db512dfb6fe42b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf42336e35d5c1d1d0db3140e174bcfc3945f6822","gas":"0x7a120","gasPrice":"0xc124c5868","hash":"0xbb881c3df1fe5beba942d3264199d7214d0601981829d211e5b4fbcf298aebb8","input":"0xc98075390000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000003e00101000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000026000000000000000000000001c0e29dcd78cd89afdca83fd726daed00002b331020a05080c03010704000f060d0b020900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000f0000000000000000000000000000000000000000000000000037628f64c3ddf3000000000000000000000000000000000000000000000000003773b6c25ad5b4000000000000000000000000000000000000000000000000003773b6c25ad5b40000000000000000000000000000000000000000000000000037838271f5b00000000000000000000000000000000000000000000000000000379447c81896680000000000000000000000000000000000000000000000000037b9a4296b537f0000000000000000000000000000000000000000000000000037c86bca4a2a000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d33e025a6e000000000000000000000000000000000000000000000000000037d82f475df5a80000000000000000000000000000000000000000000000000037d971629fa5440000000000000000000000000000000000000000000000000037e78a5f4fd6580000000000000000000000000000000000000000000000000037eb7254c3c0000000000000000000000000000000000000000000000000000037f2fdadf6b33a0000000000000000000000000000000000000000000000000000000000000006e7a8b325f9c005ecf33810ebb29d21480f9b1262ef63ed90bff7f38a2c29ea6a215327e550d5a0988be95c176517f3b8d0d040d347528d3f847a5b4221937d945a1288a53c180fac4b0dd6d1db50224808bcc16f862a91f8dca8499c2b429446a228e4e16e3369db0c2abd61e06071585a3ed3026e5833bff279023f8da8331b6641b2da0aec7387efdf5b7c67d89b19585cd8d0017f069188b515b30d283bd86136da0a45b4ec7f494e59f85647df283d8c715ab9f1b3134c1fff373c0c410d00000000000000000000000000000000000000000000000000000000000000065ac5bdbec5002a8e3eaac92906761158cb5e8c021aa240b5dac4db1c42fdc5252f6d6102a817c1475342cf4c3dc1c3c740a9ed17e5e5b675907dd3cfd7d24adf65c194882a935881b7e61fac1eef21997b14e8a43a75e461da58c0a38068a0cf3284b65634e6d8723e4ed8b94438eb19938b997a78672ba46b28db97b4a108de6100315a9cefbf128946911a2a0216b33501b8fed82d7df85fe641a3a709478c0a730f24af974bbea68b192fd7abb5d666efaad05ef0513d990a3dc8c71ffa1a","nonce":"0x70b4","to":"0x57560ea88060739107399670d8905ffca3b57d08","transactionIndex":"0x47","value":"0x0","type":"0x0","chainId":"0x1","v":"0x26","r":"0xe0a6b0b7d62ccd031aaf8842ccda1d107eca591945b7113147088896f36192a","s":"0x12f4efac35a95288b61ad839334edc8a76ef34ee5dc3feceae29ff0fe3bd05b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x29d5527caa78f1946a409fa6acaf14a0a4a0274b","gas":"0xa410","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0xc943a05e9","hash":"0x05b76328d4270d6f1cb9da98e555f9d76f50ae9ae383f2fb3e9ce68b8621309a","input":"0x","nonce":"0x81ea8","to":"0x53a142efa0647f2a3d28358f8b9767c9f6821291","transactionIndex":"0x48","value":"0x474311e61383000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x275dd75d2c3c6775d456f724a004f4444b931ecebc1fcd63c1860df28fa582c","s":"0x1282abc0a3473ffec5d046d2baae99b58ed24daedbfe91f2e5211b0bc9bf4e32"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd1cd19598848809084585853a330a994bd191094","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xbf7c13184f6f94489ee2f108baa1f9350a600add04e165fd8851472506c20498","input":"0x","nonce":"0x4e","to":"0xc907b4c02ccebf64e17126b9f5ecd9fefc596b27","transactionIndex":"0x49","value":"0x247b3e9e4b943f","type":"0x2","chainId":"0x1","v":"0x1","r":"0xe95fa8a42d27ee422798a025b70871996bd3cbda97493e78091cc0627335a247","s":"0x391b9d2c90a506aef365fddf5325974c2045d421f1c5863d605630f00684d454"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x4ca19e8c5c6d2e7993ca0ac141cc26de76f1f8c1","gas":"0x7e313","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xe972a83dfda0e6ceb1364d9e6d9d3692125e25d921eee57e6ff8c2cfc9cb0718","input":"0xddd81f82","nonce":"0x13","to":"0xa5409ec958c83c3f309868babaca7c86dcb077c1","transactionIndex":"0x4a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xe0e38b0900d0fcbf48e84e4c579451e59fe8cc7b87fd5212e8a6970a4c5cff94","s":"0x17eb6206a4561a5e82ca1fe7e73cdb7d36cc4bd7f30c3e6d5bc230892ce76b4d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xc2092ac155700218c70fbdda708bee592fd3cb19","gas":"0x14036","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xa71a0b532890c54b2f015615eee13386f647acd3f872eb745d24ff673d034506","input":"0xa8a41c700000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000c2092ac155700218c70fbdda708bee592fd3cb1900000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004e200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010a741a462780000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000623852a3000000000000000000000000000000000000000000000000000000006238e751d32c89e86e2599e76eb07ba3f7d8446747b680cc1151c68829cf9eeaae0a5f530000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000034000000000000000000000000000000000000000000000000000000000000004600000000000000000000000000000000000000000000000000000000000000580000000000000000000000000000000000000000000000000000000000000001cf6b48539740f8a09a3d625316d93b0961d8a9547d0555c9ad8b935a6405a986d3b652b44a2b4ec54e57fedec4a9cda8489f69a7f8740faad72b1557dad17074e00000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000c2092ac155700218c70fbdda708bee592fd3cb1900000000000000000000000000000000000000000000000000000000000000000000000000000000000000008d4100897447d173289560bc85c5c432be4f44e4000000000000000000000000000000000000000000000000000000000000023e000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x33b","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x4b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0xb33256fcdede4daef7efb0084e3a3adca8ec14802c3b313aaac99e48e68c996","s":"0xe1fc3a60d098c48fbef4111099190267b8fc5328aed3e3c93963475c396f5db"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xb6cef19d36c1fb5283d81010454e5de30fd315ea","gas":"0x39a08","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x3e9c408e9d71272dce18902ce7656e08a72c5ca48246f199c02bf88cfbc89ee0","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238ab90000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e442712a6700000000000000000000000000000000000000000074ef6a373d4fc5f5c000000000000000000000000000000000000000000000000000000392d1c0d224ffa00000000000000000000000000000000000000000000000000000000000000080000000000000000000000000b6cef19d36c1fb5283d81010454e5de30fd315ea0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc200000000000000000000000055509c29853405d819ba611c393ce920bcca2c0b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x363","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x4c","value":"0x392d1c0d224ffa0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1263069356526f49d1932fd88e78aff9758a8fd717c71325d909577dd9a03225","s":"0x307b2bbf55e4c2213c1d176ce6b6d8acd872427c935cc7eecf883d664300e7f6"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x5c1985191349f409bbf80c0518d5499b1c009ba6","gas":"0x3e9e5","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xe95ae7de6162a797461af5dd939fcae3c2e5299f12bed29924d41aa8d48a2a21","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000005c1985191349f409bbf80c0518d5499b1c009ba6000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c869110000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c8691100000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b4f762e93f180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4550000000000000000000000000000000000000000000000000000000000000000983fdb5acfd0d05f6da669d7a495501e651bc3a3391e54a4eaaacdf233695daf00000000000000000000000000000000000000000000000000000000000002ee0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000b4f762e93f180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a19d000000000000000000000000000000000000000000000000000000006241dc772384d8932a9e908392decb605db610350a60d97dee53cc2c1f9ae757dc8388e10000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c63510e0f754b17c5f420c2316dbd239c2ef833a45836ccf8371d20afe898358f400bb5758f56f89c470e8fe5b9becfd567f2b379f78ed0c827ec93b9fe79888b63510e0f754b17c5f420c2316dbd239c2ef833a45836ccf8371d20afe898358f400bb5758f56f89c470e8fe5b9becfd567f2b379f78ed0c827ec93b9fe79888b000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000000000000000000000000000000000000000000000000000000000000000000005c1985191349f409bbf80c0518d5499b1c009ba60000000000000000000000007feb477600a03fd6ab1fe451cb3c7836a420f4ad00000000000000000000000000000000000000000000000000000000000006ba000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000736e0a7be8c4b8e96e9e300974f68d5ff5c8691100000000000000000000000000000000000000000000000000000000000000000000000000000000000000007feb477600a03fd6ab1fe451cb3c7836a420f4ad00000000000000000000000000000000000000000000000000000000000006ba000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x2f","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x4d","value":"0xb4f762e93f18000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9555cd3a766091ed54e71f9ce4b614c6fa401f11acc9eb36a396dd1d4a342f52","s":"0x626a73c8e1ed248593d130ed9bb39546c1bb6b79c4d120a18718f01ffb5edb1d"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xcafe3e8d011f5c672683b64ef9f89f17692e7fbd","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x12237ed066","hash":"0xdd2ae8e09b0ebcbef97bdbef01f247e9012c4f374de4f9634c8553a62e939243","input":"0x","nonce":"0x1","to":"0xf77770a3e837c96f2be6b76fd71e8b3a3915264d","transactionIndex":"0x4e","value":"0x378f4dad30db000","type":"0x2","chainId":"0x1","v":"0x1","r":"0x2c0b2a2922a39ab17f31c4824e05deeffdaf9acefd190cafe8869c0dcbb88d82","s":"0x687d8dde9099ead0c8bef5618fa58dfd10b3a9d2802ce80b543e3f90b2b6660b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7af0c539bad46e7ff84be8e11cd5e5bffbf191d3","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xeaba7e1f3d4a3d3ae4277c24b82d8cf671f550255a7d27c700a162a915ed2b31","input":"0x","nonce":"0x47e","to":"0x90e24d68e572900987b10865304cd0f63ba1c771","transactionIndex":"0x4f","value":"0x58d15e176280000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x6b3656926ae25a916558653eacaa3bf8d2ddd70675be444fd9ae0e966cb39d7d","s":"0x785112173d55864d5e8ba354a2a5d3ebe0ee67f1b9c09daab81a86f8396a1d86"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xcc8dd60a8cd21205d077a6feb43c9bc9ed70fa18","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x8074a55c289a95025e8abd7ffe0d4e2fa60e1602399f2ac5df68084e6485d6e8","input":"0x","nonce":"0x187","to":"0xfaa44ac43cd87c2a4393c3204f87a2f1ca10e5d0","transactionIndex":"0x50","value":"0x3e2c284391c0000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xa030bb8081db4109ae3467a090b7936db1c47b0b0f0efa7eb366aa36c3bf6dba","s":"0x62de097ab97c7bbc53df5b138cea7cf34351594f6e8f6d170bd21d064bed39b"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x8cd84d9c2e76a0c6f48732f8e0e2508430ae6e28","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xc33fb1daa43c0e214c618fbbb7e8704c623ec1c73b58affe32793631fa353c5b","input":"0x","nonce":"0x27","to":"0x04c56c70d9fb6ee29def6c48f729a1eeaaf95f5c","transactionIndex":"0x51","value":"0x16905bfa669d3c8","type":"0x2","chainId":"0x1","v":"0x1","r":"0x3ae865ff3d600dbfaa674ba2ec20d9718f39fe4a1fc45e58a00d7df5d9dfc976","s":"0x2b70f8099b69286acdb929d2fc91f2f4024591e9fccc8950a057b29be25db85a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x1f7c8e627cd8b378a1872a43cd47e92646c381b5","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x8028c301167b01bcf17fba2e7e444bf5e738fc45f62e9433ef74ec5af7cbf8d5","input":"0x","nonce":"0x3","to":"0x9b34753a1342fd26234f9dacd147138ece981740","transactionIndex":"0x52","value":"0x192abebf7366b2","type":"0x2","chainId":"0x1","v":"0x1","r":"0x8e1ba2060891ba4ca074d28b7b1b650229eb4f735ee9aa5d47f333915c2d5e55","s":"0x2c60182077a73cc872c33ab93ad462db23408cb87742e729934642304f1a13fa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x7306fd26ee946209539b16a0fb42aa6b017494e1","gas":"0x1839a","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x2b5d7d364fcdcb8a39002ea7c055854548c496ada57868615811b2c9af25a8bd","input":"0xa9059cbb0000000000000000000000008821c46cc0ab31cab33824b2b31a361109dd086f000000000000000000000000000000000000000000000000000000007d2b7500","nonce":"0x1f","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x53","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x75cf87e9b5e3391cc149610a5300eae40b03f5bea6bdbbc93d8008ce10b3e310","s":"0x7ab33dcfb94f5beb6996d03b2239bd487af944ade40f53e230b258734746c7cf"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x28fb2fd8b6a3de87aa6536e717375a65a94a21a5","gas":"0xafee","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xddd0dc4fd1276d4e474a469741c75f0d3f0ab7f5c0101e7dc76d62a310e5d560","input":"0xd0e30db0","nonce":"0xa","to":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","transactionIndex":"0x54","value":"0x1720d4b1c0931ec","type":"0x2","chainId":"0x1","v":"0x1","r":"0x4d424732d5b6481e3ae6275e0ede66a7c6f8adf5e3f5743780cb2aba8c1ff46c","s":"0x559e86042ef1ae76c25f94f8104df54f7d24fadcecae2d9213af6391b76808dc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6f55fa1aad4d453d1424a37a852c2892457e209c","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x6bac38996fbea54019a80706686ce568aabb053b5c27b56915c318670c297c9c","input":"0x","nonce":"0x35","to":"0x3adf2ce2f1dca86d6c843f3d8bdafcac441d5c21","transactionIndex":"0x55","value":"0x3e73362871420000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x6f03ac9ec84c2a77296cd8683dae5474a3152528de4de48dc22e1058f5ea0dd4","s":"0x4714c0845a52cdf7ac5ee872fba445f715047753eda30cfd1d6621374c4dfede"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x068c0b630fddb7e5d1e3dc0d5a0fd3ee64e88e51","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x12237ed066","hash":"0x7c5a2ae63925b2a6467f1a709bece1b9d4941381e1d22fbf48fb58504f0421c8","input":"0x","nonce":"0x120","to":"0x8c1685de0f6772cada26f4a89298c4bf76a1d97a","transactionIndex":"0x56","value":"0x14d1120d7b160000","type":"0x2","chainId":"0x1","v":"0x1","r":"0xcef74414d3a315f36321a72e7dc93c2f1d3469174fec198a1755f37957d77a57","s":"0x47bc4ce2abb83cc0163d65837777287408b4744c0db6d556689e3ef5263f7ebc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x93199f6930809b90e9454bdeb52b1a967a8eb563","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xbd1e4bd653befeb1dcae408f0738d889f474f9d1565669acbab529db39ba0cb5","input":"0x","nonce":"0xb3","to":"0xad4cc9fbc767249c37451afe588d69ff41f71752","transactionIndex":"0x57","value":"0x6f05b59d3b20000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xac87e7d76611f1568834d010aa6aa184749b871b5cabbec1c44e4eedcd5eca85","s":"0x5e58a25abe9082096aa2120ccface5f9ff656017888032192178b02374174ddc"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x27a42","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0xd041c9d905645e904f4291c6425283b93bc1213e53ab1318193072307ee99728","input":"0xfb90b3200000000000000000000000008d1f2ebfaccf1136db76fdd1b86f1dede2d2385200000000000000000000000000000000000000000000000000000000000e5cc5","nonce":"0x1a49a0","to":"0xffa397285ce46fb78c588a9e993286aac68c37cd","transactionIndex":"0x58","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x1a041757e8f947d6ae358a28109f1e35e7dfa2823b93dae4ec789670c59f8ee0","s":"0xbbddd3925cc308cb3d4c347a44d49e8cc95d215b63903053a07af9dad671de7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x30b4a5477314e3fbd0c22d6afcd71eecf4d9d22f","gas":"0x2c5de","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0xd3891003372d2d2fd611e8c18348b9f14c9532b77e724fc20592ccd070223cf0","input":"0xac9650d800000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001800000000000000000000000000000000000000000000000000000000000000104414bf3890000000000000000000000003845badade8e6dff049820680d1f14bd3903a5d0000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc20000000000000000000000000000000000000000000000000000000000000bb80000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238ab9000000000000000000000000000000000000000000000006c6b935b8bbd4000000000000000000000000000000000000000000000000000001cedea6da61c7ef1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004449404b7c0000000000000000000000000000000000000000000000001cedea6da61c7ef100000000000000000000000030b4a5477314e3fbd0c22d6afcd71eecf4d9d22f00000000000000000000000000000000000000000000000000000000","nonce":"0x46f","to":"0xe592427a0aece92de3edee1f18e0157c05861564","transactionIndex":"0x59","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x7cd59c10aa586d8c91266375d00143e76043055c8fee8d157a075131359fc2b0","s":"0x6ce0461f7222e17a281153dfa4386f252ac8b200d1c3ebb27a79ec76d2623348"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0x566c748f365028dd8f2e81431bc6433107bf0381d5aee885190238359b0c47a6","input":"0x2da03409000000000000000000000000c858a0d4d8f45e44837ab5a1059e527ff7656e89000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a49a1","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x5a","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x5831a562021023f173da0b389100a19453f54f3b17f75fd1bc47cd42e5641e23","s":"0x6cf87049d688cfb6205e217b806a11d1d4c0b368de762254caf99392c3680996"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd2c82f2e5fa236e114a81173e375a73664610998","gas":"0x7a120","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x1ac6235cd8","hash":"0x2d57a3d4f9cfdebd492144d1ac5ef09f9fade9369350c7c9fe5766a17db23789","input":"0x2da03409000000000000000000000000b495496f926c70c8d28d6aba79f7bbd5c6f43ea6000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7","nonce":"0x1a49a2","to":"0x8d1f2ebfaccf1136db76fdd1b86f1dede2d23852","transactionIndex":"0x5b","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x4b5c0607ec17e19b3f19b0b443de1d787f9f00c62c742c02ce473afe067a639b","s":"0x6956552e21b2e28de42deee24890a1e6d5fff2532e5aebd1941faa4ebe45cd1c"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x92e36bfa5654c996beff0ea59a0eacea4afd8c5b","gas":"0x250b2","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0x34e8b0cc9a6715cbbd509beeae9822df497e1d74186cfdb07a1ab00eabb01849","input":"0x9ee679e800000000000000000000000000000000000000000000003831972a7bd66a2e70","nonce":"0x40","to":"0x65f7ba4ec257af7c55fd5854e5f6356bbd0fb8ec","transactionIndex":"0x5c","value":"0x0","type":"0x2","chainId":"0x1","v":"0x0","r":"0x90d13bb82430c0fb2b4264e9d64cc36378a95f5d38080799dadf8b8c4b7f2194","s":"0x3ea3856475586804237ac536bbe1700ac5e69809524e4274e471579e41fa46c2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe8bad8e905fc748517cfb1b3f7d6a7d7b4ed3998","gas":"0xc7c6","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x7635603af13067c6715ffeba53cb7f6175246aafd8753dfb63cda4d54c6c3f7f","input":"0x215619350000000000000000000000008eb24307a8969650bf10b211008b8324ba3d65050000000000000000000000000000000000000000000000000000000000000002","nonce":"0x1d","to":"0xcda72070e455bb31c7690a170224ce43623d0b6f","transactionIndex":"0x5d","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x13b4a009e177428778dbc65d80dad60498338c11ca5c63a8dda9e57976d89e4e","s":"0x7171c515dd1f54255fb997498f7188f81055532196abc3e6577f01c2b59bc7a"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x901f4ba839381b8080917c616796f4fe3e1b84d3","gas":"0x12cae","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x10a57c0e47","hash":"0x97a1182cd4e6ad38a1f95f4c068e5614a5b52102a3153534e3d4ea6cc35b3f45","input":"0xa9059cbb000000000000000000000000367dd1b604553b3d4859d060434d53b7f74e20c600000000000000000000000000000000000000000001d8bb438d667d6e58bff3","nonce":"0xef7","to":"0x090185f2135308bad17527004364ebcc2d37e5f6","transactionIndex":"0x5e","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x30a247439a7b821721547871652ca7c9947c98ba5ab06493c8985bcd04992d69","s":"0xf189fbc6f4ae3134f958cfc40e83e252b6e49fdbcbca0765beee4f873eb273"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x66df76c2c2bf40c6f40195b67f34de880fae6b59","gas":"0x5208","gasPrice":"0xc06b4da72","maxPriorityFeePerGas":"0x59682f00","maxFeePerGas":"0x110bca608b","hash":"0xa1426b806bfb648e118958b27c1b18b7302840c355219ca74dca5a04b15c80e1","input":"0x","nonce":"0xc","to":"0x7bf8790509700b67f051d5f83eae759337ddf60e","transactionIndex":"0x5f","value":"0xde0b6b3a7640000","type":"0x2","chainId":"0x1","v":"0x0","r":"0xd0762b118a91ab0da307e4f9edfaebf1aed594ee53ce6319734e884a0683f94b","s":"0x5788567e1c61a573a0a1f8b4adc519db1213c72ba0ffeef27117afa20a319d0"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x80488154a508b0642670af4961a35588d2a33343","gas":"0xcbfd","gasPrice":"0xbf7ce27f2","maxPriorityFeePerGas":"0x4a817c80","maxFeePerGas":"0x1378da3c99","hash":"0x864d63323fd8621334206317f63fbd25d2269ba23429fa5e7dcb88dc80d49854","input":"0xa9059cbb00000000000000000000000042e9632ba96ea96ccede077f56185121459ce20b0000000000000000000000000000000000000000000000001fc2f56971d846f7","nonce":"0x8","to":"0x58b6a8a3302369daec383334672404ee733ab239","transactionIndex":"0x60","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x6e7280d975846b418514841c58730b2b430c5beb301a10c653bd0b442e056518","s":"0x3c5e49d550f6348c8900602d2537170efa91ff0475cf7f9ff88b5d9c8e2f75ab"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x92ad90f0f2e208df7fae055b7fe2f4e20a21e688","gas":"0x1e204","gasPrice":"0xbf5622ef2","maxPriorityFeePerGas":"0x48158380","maxFeePerGas":"0xc8fa023a5","hash":"0x323b7536f93929efff5ff956be89dc1413f8dd3e40bf93b52cb6d25e311142bd","input":"0x42842e0e00000000000000000000000092ad90f0f2e208df7fae055b7fe2f4e20a21e688000000000000000000000000c0d40ed9ebe45f52b485e037a675d1f6a745b5da0000000000000000000000000000000000000000000000000000000000000e46","nonce":"0x213","to":"0xe8f86613c0ae8edbcd540c8b9f9b3d33f0911cb2","transactionIndex":"0x61","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x56a9e2e4a4b8418997672f6096d305acb26e91f008ab60a0720a3f0a7dbc33d7","s":"0x32ce2ab0abb36ba9642fdc2baaae844c5b1a757d3cf219810a670acdfafbef98"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x70ff25c512a3cf8e53af58e2ca9eea727b3fcccb","gas":"0x424ab","gasPrice":"0xbece44592","maxPriorityFeePerGas":"0x3f979a20","maxFeePerGas":"0xd4941198c","hash":"0xdc34136baef97744e63ba4f679d05b4c77f162816945dc7fdcc124e2a21ae0bd","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e500000000000000000000000070ff25c512a3cf8e53af58e2ca9eea727b3fcccb0000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ea11e32ad500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a4440000000000000000000000000000000000000000000000000000000000000000542f3ec30bbeff2a45e44977d9dfec450f0d0d2a8f948fc3a5f8a5f36cb2e2b900000000000000000000000000000000000000000000000000000000000002ee00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002ea11e32ad500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237605600000000000000000000000000000000000000000000000000000000623ceef26527dd7221b16afd1ad877f15a85280d5a52085598e694bd755fe5cd983cc5300000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007e000000000000000000000000000000000000000000000000000000000000009200000000000000000000000000000000000000000000000000000000000000a600000000000000000000000000000000000000000000000000000000000000ba00000000000000000000000000000000000000000000000000000000000000ba0000000000000000000000000000000000000000000000000000000000000001b000000000000000000000000000000000000000000000000000000000000001b8d15b04288edb25c40fec94d806db5b1bbb2e74cd89df508be8934ba023a486428e2ff83d82e43f732ef2bea3f07663a93724646604ae871bdb99c38e4ade8258d15b04288edb25c40fec94d806db5b1bbb2e74cd89df508be8934ba023a486428e2ff83d82e43f732ef2bea3f07663a93724646604ae871bdb99c38e4ade8250000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010496809f90000000000000000000000000000000000000000000000000000000000000000000000000000000000000000070ff25c512a3cf8e53af58e2ca9eea727b3fcccb000000000000000000000000495f947276749ce646f68ac8c248420045cb7b5e2316214b077a5f29a95248d3f63074ce0a61e8ed0000000000012600000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010496809f900000000000000000000000000612a345bab8cb5b4daccc65e033c9375eea998a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000495f947276749ce646f68ac8c248420045cb7b5e2316214b077a5f29a95248d3f63074ce0a61e8ed0000000000012600000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000104000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x15","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x62","value":"0x2ea11e32ad50000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x9209db11fbda1befa716076b736a0c905d32ec80a74a14e00151129b9644b4e9","s":"0x2ffe78bf95f149babb053b0acfb7bba0d3a98ab678a19f9f7cde59a48597b2"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa29148c2a656e5ddc68acb95626d6b64a1131c06","gas":"0x19a28","gasPrice":"0xbeb248ff6","hash":"0x6745c68072d16d6ea232b8d5e291736ebd6ba2f167f45c4fd13ff747682042a3","input":"0x","nonce":"0xf7fd","to":"0x0816d200c779fefec677753fb53b93a1c53c44f3","transactionIndex":"0x63","value":"0x47b86c9e6738000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x9200a0c2c5a3059868eea5afadae08114f49ad5e995deab49df6c128586bc75d","s":"0x7a2913d3ae2142972753655fd297da675ede636cbb7b834b21bb24a680f31b91"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xf598b81ef8c7b52a7f2a89253436e72ec6dc871f","gas":"0x19a28","gasPrice":"0xbeb248ff6","hash":"0xfa62b4e94bb052e960fbf3a7ac4a19f44a0772888c7529fbfe8e0397b3b43542","input":"0x","nonce":"0x18e63","to":"0x5b66a38f29db71b90a4bfa495ee3fdc0e976ec4c","transactionIndex":"0x64","value":"0x5dd7732c63310000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x187f3436cbc13aa7fa4e743b12e58b8fd63327b3b391e2d508aa9e7a9581183d","s":"0x5b8dceaa8afd1f6f70484e10ec7b6ffa2b5192e332dabb5c2396c6df5c7d1de0"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe22619f6d538de6e0b6c1845174d45e90cbd3576","gas":"0x5208","gasPrice":"0xbe9eadbb2","maxPriorityFeePerGas":"0x3c9e3040","maxFeePerGas":"0xd7025908a","hash":"0x68ada9a8209368650560fb9745d2cdd0524375ca58862cd0116fc6333b492a71","input":"0x","nonce":"0x535f","to":"0x0e8ee0f87d317f45f4aba48532bba908cc625a90","transactionIndex":"0x65","value":"0x5d0d56f736c00","type":"0x2","chainId":"0x1","v":"0x0","r":"0x305d359eb1d904f27e0646c843d3a37d18e94019fcdb0dc97a43bff6c90a01c6","s":"0x3a784710d5333f4690151aa5fea364710c74dde2eb92020123fd51d432f7dece"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xe9b45883f5942511284e4a48a0b0c09e29dd3c4b","gas":"0x13012","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1043034b7b","hash":"0xa9f334cc57045f75e738075fae951643ae89905cb29f9603e7b953a45f6d1ea1","input":"0xa9059cbb000000000000000000000000ebdbd959f587aef05b42c6ae0fcb43a25c19a21f000000000000000000000000000000000000000009b18ab5df7180b6b8000000","nonce":"0x8","to":"0x95ad61b0a150d79219dcf64e1e6cc01f0b64c4ce","transactionIndex":"0x66","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xf154142f508cf1e3edc331daf9306f069ec3615e179d79837da5f6e0d24a5849","s":"0x600ab587d925f05ccf742e8792221d0da9e0550bc7359a1c599a06af1f7afa7"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x8c231e3ba0b31bdd6ba2596d44bda7f0f214f43b","gas":"0x2eec6","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1043034b7b","hash":"0x03b752802615995d378e6f59f081e7930d69d3445e78853158e79af8553380f0","input":"0x5ae401dc000000000000000000000000000000000000000000000000000000006238aba0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000e442712a670000000000000000000000000000000000000000000069e10de76676d080000000000000000000000000000000000000000000000000000001faeca95583b75500000000000000000000000000000000000000000000000000000000000000800000000000000000000000008c231e3ba0b31bdd6ba2596d44bda7f0f214f43b0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2000000000000000000000000cdca1b81dbb543baa92c97b701396cd3ba315e5d00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412210e8a00000000000000000000000000000000000000000000000000000000","nonce":"0x7","to":"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45","transactionIndex":"0x67","value":"0x1faeca95583b755","type":"0x2","chainId":"0x1","v":"0x1","r":"0xee15eef0e4485b6fea1b0075f6736560eb37d9cea206285de7c1b56b4b30900b","s":"0x294277bf1a282410cdc0050ea1a9fa14fbe7003817c260b8a12608bc51a46cfe"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x4c9af439b1a6761b8e549d8d226a468a6b2803a8","gas":"0x1d4c0","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x1567991dae","hash":"0x65e601dcc49c935b7ee2d51d2d912a38b138ffa1fdbe3ebf1f9da8b452185d41","input":"0xa9059cbb0000000000000000000000006794cf1e22d181a81d7c1ebb064573120adba8470000000000000000000000000000000000000000000000000000000012e7ef70","nonce":"0x771c","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x68","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x176480108c52c95ba4c219d114b012b8ca28cd10ab58b3bbeb94af7ede615811","s":"0x6b8bd6ed47f14d128197d47015980d52cb68f8ec9808d2bf8698d67f665847f9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xa815482080ae792e00d103246fe874c905811054","gas":"0xcaa2","gasPrice":"0xbe8e77572","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0x114f878de6","hash":"0x3c3be46ea96ddf0e98f4d8a386449e6a2b4e49e3b27056697ed3766473df7695","input":"0x095ea7b3000000000000000000000000d83f623a0a77e09dfc586b831012118bdf3b414100000000000000000000000000000000000004ee2d6d415b85acef80ffffffff","nonce":"0x3","to":"0x6c936d4ae98e6d2172db18c16c4b601c99918ee6","transactionIndex":"0x69","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0x6ca1d9806d162beb887efaefbde4bc017f25a69d754480b281f7132151cd49e1","s":"0x43630428ccf55900a46eadc6835391c1705d35fd8053d3a6dc91e32921795daa"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xeb9a192e14dbbeb31ce825b40db568e098ee5e9c","gas":"0x5208","gasPrice":"0xbe1c56e78","hash":"0xaf962411d291ffeae3e5755cdae186d16582c1ccda61389e9a0f06eb992aef15","input":"0x","nonce":"0x6","to":"0x0392b64b8bfda184f0a72ce37d73dc7df978c4f7","transactionIndex":"0x6a","value":"0x1c81974ed5ad25c40","type":"0x0","chainId":"0x1","v":"0x26","r":"0x1e8bd99b91c79f4648b1ddd444bf5aa13c1fe89f9408d843131e875feb15566e","s":"0x3541c2379ba7e1079a850d7dc4c26975cc23603c0171696b5682de653a801b41"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x6761d6684c550aaaaf9eaa410b8e24bb86ca7669","gas":"0x124f8","gasPrice":"0xbdfd63e00","hash":"0x7d791dc4008467fdf6c0365968d132a7d816897db0acf4d8aa5fb521b3b10990","input":"0xa9059cbb0000000000000000000000001d038448e82743b7894ae5ff88ed789b6851163e0000000000000000000000000000000000000000000000000000000023c34600","nonce":"0x53","to":"0xdac17f958d2ee523a2206206994597c13d831ec7","transactionIndex":"0x6b","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0xf03bdf1f1205957c9d209aa8ab28d167774f2bdf811e76ebc1fbd5efd64c997c","s":"0x33da7d06257a951b785e04075dd98e941bfe4b2ea2db87d5848ca506f6478955"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xebb6238c7d8f38451c60b09eafd6228b07549129","gas":"0x18462","gasPrice":"0xbdfd63e00","hash":"0x4798ae3d0f7b20c384a9b5522a269aabf51e78706d5192daadd0303cfeff64e5","input":"0x1801fbe57034dcc5c238c3bf191e027108e25ffc837e195f7623fe5b95c4b892b264566200000009dba4adbd01be0c031774b4d33b03412768f9ce16b3cec4e3d737c432","nonce":"0x13","to":"0xd9506121d67fb918ac47af0b883730694be9377c","transactionIndex":"0x6c","value":"0x0","type":"0x0","chainId":"0x0","v":"0x1b","r":"0x652c6dc75ac93344cfce240ef19378f25119dee15a1dfd62eed673f1d8708e39","s":"0x6b74fb86b92f0c68ecea485926f52e6ec4fb215c73fbc37f59b5095aa9e89376"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x3598fdaf00cfcebe3a23402a71bbb310d4ebeed8","gas":"0x50d17","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0xbdf94f712","hash":"0x04af2fbc454a6d8aff0cd64e3f676975ca08337c5ef6c36c77c799e1b2bc1fe4","input":"0xab834bab0000000000000000000000007f268357a8c2552623316e2562d90e642bb538e50000000000000000000000003598fdaf00cfcebe3a23402a71bbb310d4ebeed8000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a7000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000007f268357a8c2552623316e2562d90e642bb538e5000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f400000000000000000000000000000000000000000000000000000000000000000000000000000000000000005b3256965e7c3cf26e11fcaf296dfc8807c01073000000000000000000000000baf2127b49fc93cbca6269fade0f7f31df4c88a70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003520000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000214e8348c4f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006238a3b600000000000000000000000000000000000000000000000000000000000000006048cce0d9f655494cfce4da704ce99d3964ef3bc3e0ea35bac5b2ea7da034ca00000000000000000000000000000000000000000000000000000000000003520000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000214e8348c4f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006237ce7b00000000000000000000000000000000000000000000000000000000623bc34f16f2855bdb4204400974de828c0d0cc2c6f6eedf8be94e66981b3391af223a050000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000006a000000000000000000000000000000000000000000000000000000000000007c000000000000000000000000000000000000000000000000000000000000008e00000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000b200000000000000000000000000000000000000000000000000000000000000b20000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cc364593b7ef1b8a74799db5cd2ccd9c38024cc50fc58262d161543d3790eb5520625e6ea7d01c6e825fb61bd047477dc78e14bcb602b5dfa804d7364305e7cf9c364593b7ef1b8a74799db5cd2ccd9c38024cc50fc58262d161543d3790eb5520625e6ea7d01c6e825fb61bd047477dc78e14bcb602b5dfa804d7364305e7cf9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a59500000000000000000000000000000000000000000000000000000000000000000000000000000000000000003598fdaf00cfcebe3a23402a71bbb310d4ebeed800000000000000000000000073883743dd9894bd2d43e975465b50df8d3af3b20000000000000000000000000000000000000000000000000000000000000e17000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4fb16a595000000000000000000000000655d58024655e240c454dd1b0fae0e9ab129d0f4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000073883743dd9894bd2d43e975465b50df8d3af3b20000000000000000000000000000000000000000000000000000000000000e17000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e400000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e4000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","nonce":"0x4e","to":"0x7f268357a8c2552623316e2562d90e642bb538e5","transactionIndex":"0x6d","value":"0x214e8348c4f0000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x7b6bf6ac06f5b37f07c9bc5531e22058ee4e2ffb054e83a0838da6949234b84e","s":"0x2d7c9b4d4b74b6bbca68fd327202366af760e833d014cfd8f147eeafc52cac9"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xec2b0deb76b6c78a9773425ca2d903f1476bb51f","gas":"0x5208","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0xbdf94f712","maxFeePerGas":"0xbdf94f712","hash":"0x6f70c9fe1f29e1baccc9d36a3b76c4bfc213041a75e5896502898a74969324f5","input":"0x","nonce":"0x15","to":"0x4103c267fba03a1df4fe84bc28092d629fa3f422","transactionIndex":"0x6e","value":"0x38d7ea4c68000","type":"0x2","chainId":"0x1","v":"0x0","r":"0x3cb3d453060baa5c1c4ed28c484949e6f0fe21b62674103ce8b554424b4fcaa5","s":"0x46cc14be8e84c882f3232b5108e80afa52b2fb94b0b6786a70ae4ed773eef898"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd84095ef707152d8e3f86697ecd640a551e86d69","gas":"0x10353","gasPrice":"0xbdf94f712","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerGas":"0xbdf94f712","hash":"0xf0defdd7ae5a9450c74f0a51d8589767513965fdb898e916d38ab70737c9be3d","input":"0x42842e0e000000000000000000000000d84095ef707152d8e3f86697ecd640a551e86d69000000000000000000000000d4b52502ffab8174c5e0440e366b7d3b542886bb0000000000000000000000000000000000000000000000000000000000001534","nonce":"0x73","to":"0x6110cda0fa3e327b05727af9763cec44d39b5805","transactionIndex":"0x6f","value":"0x0","type":"0x2","chainId":"0x1","v":"0x1","r":"0xb5fa7948ee6ceb66ba5911a55a1298d6375652644b77360447a9fd66d2c967ef","s":"0x4d2200c0deffabb59eb95b69df2f640616f2ead39e84a3257a8bb3e066cc0456"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0xd4dcd2459bb78d7a645aa7e196857d421b10d93f","gas":"0x3d090","gasPrice":"0xbcbd1e4fa","hash":"0x33ba5c9086f4b6c6ea62c6cab6f7177caa383218f69ed11696ca7034f93ab395","input":"0x","nonce":"0x1ebf9","to":"0xabb9eac45b58ca19751ec6e4feebd40d9f8e288e","transactionIndex":"0x70","value":"0x1cc85402f0290000","type":"0x0","chainId":"0x1","v":"0x25","r":"0x8977c965cdf77d6b396508f3ad8cf9d2513439288a77759d4cc45edff2690ce0","s":"0x6f6607af89029ef56930a8e60ea6a0e31f32eb3d4e795a3da8131522151e252f"},{"blockHash":"0x88c0797ca5b6c8d68782c7910559f9dcd67f4598874cfd3b1a92430bc017054a","blockNumber":"0xdc3207","from":"0x007174732705604bbbf77038332dc52fd5a5000c","gas":"0x249f0","gasPrice":"0xbb13d4b93","hash":"0x6e5c9dc0440d3bfce3224eefd25f68c87dbe8f3369d0c4bba6b078165c5a17b6","input":"0xa9059cbb000000000000000000000000dd8244cc042a37e9907687a598761e8039816a20000000000000000000000000000000000000000000000000000000003439645c","nonce":"0x15f66","to":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","transactionIndex":"0x71","value":"0x0","type":"0x0","chainId":"0x1","v":"0x25","r":"0x3223ad301c63675e03f0895a0785b369030dd8f74804193b4f8650eff16c498c","s":"0x6873fa7fab0da59c460f3ad42cd468571809acc3383e1644414596a80cbbe371"}],"transactionsRoot":"0x526a2cb1c6bd30a154d3eae233621500cdffc6baf4f910ea003ff8ec79a42719","uncles":[]}`),
+			withdrawalsErr: "block does not contain withdrawals",
+		},
+		{
+			name:  "Cancun",
+			input: []byte(`{"baseFeePerGas":"0x8","blobGasUsed":"0x40000","difficulty":"0x0","excessBlobGas":"0x0","extraData":"0x9a726574682f76302e312e302d616c7068612e31342f6c696e7578","gasLimit":"0x1c9c380","gasUsed":"0x5c490","hash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","miner":"0xf97e180c050e5ab072211ad2c213eb5aee4df134","mixHash":"0x5854ff07a305068660b271d7661297e9703aab3388a0f3789c6348eef7b42e61","nonce":"0x0000000000000000","number":"0x50936","parentBeaconBlockRoot":"0xde460880db8fe723aba6e3c5d13bf93860161c6f63a855c94bbb625af3cc6ebd","parentHash":"0x4bb5d8423fbdfe66d13db89bebc4097c2f74314b5329a53c894f36f5b5189a9b","receiptsRoot":"0xb4a350c5a8bf9e47b02683403ad5d8e1a85f2c965f953b626611af16a4dcd610","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xce9","stateRoot":"0xe2ee0557bb3f616b86824ac8f6b9c4237e194d82f540ec68053982f65796ece4","timestamp":"0x65a6d1c4","totalDifficulty":"0x1","transactions":[{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0x5b5ac3b470c818077522ceae91785831a5f679bd","gas":"0x5208","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0x4a817c800","hash":"0x6b70ce13ce9c2617501720379a69a2c6d7e4fc16e0cb04291139c9259c6b822b","input":"0x","nonce":"0x866d","to":"0x578c5b6ba9e9779ccb804f812e806f2d70c4d357","transactionIndex":"0x0","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a1f0ff46","blobVersionedHashes":["0x016316f61a259aa607096440fc3eeb90356e079be01975d2fb18347bd50df33c"],"v":"0x0","r":"0x508248224d127dbf19d52fa936bf1a3480e50881ddb395472b5220f159a1f2c8","s":"0x20956b74c990233464f812c08a9a7e81cb2221c03dcfd9044bed21ab43737438","yParity":"0x0"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0x8895b6ba745c49c65ca7b99c6d5c6ee92081467f","gas":"0x5208","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0x4a817c800","hash":"0x9c285167bb1ebc7197aa95db94468ad1961830c20e261107dada8092ba8483f6","input":"0x","nonce":"0x807b","to":"0x5b5ac3b470c818077522ceae91785831a5f679bd","transactionIndex":"0x1","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a1f0ff46","blobVersionedHashes":["0x018eb585430fcbddbe2429bc09f6b7ab2c6d718bd8ccf3761e0c65645f792187"],"v":"0x1","r":"0x10f677b15ee303bec9d575ce984b4b79628ed263f610eb9d2a2c4699915584cf","s":"0x47735f72b92c24b5a0337b3c87ae889cf08df39e9fea55686d1b4ddd1dc3a0f0","yParity":"0x1"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x99e77d72222248a03f774feab88a6ed0e05354cecded5b911fd397c1d6b0bc8d","input":"0x","nonce":"0xc1ee3b","to":"0x6177843db3138ae69679a54b95cf345ed759450d","transactionIndex":"0x2","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xa4281b9997834059259791ee7996f0324a5883498080493661620c6a4bffb2f5","s":"0x73b58281b0eee3e4f8c7434daa7d87e575f9d660df1c0c7bbca9ef3d93b606c0"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x3cc7160b4145e6a0f8de9c602f76e698975e144583083a134b60f9fdfbf1bef1","input":"0x","nonce":"0xc1ee3c","to":"0x687704db07e902e9a8b3754031d168d46e3d586e","transactionIndex":"0x3","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x1f7b093257d434b3fa47ed99401f452a83e329a642aef6f5b5945b7c24cfa67f","s":"0x6e1bd159c617b1185e1cae376ec7a4a6ec2c84a17dcb93ee75781581e4153a1"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xfc4d5492e0d07a473d1fadaead632efb81d57a53029a7442c1b6159f53abcb1b","input":"0x","nonce":"0xc1ee3d","to":"0x15e6a5a2e131dd5467fa1ff3acd104f45ee5940b","transactionIndex":"0x4","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x2ccf6bab4c7f23d00c63749969a8716c2d2bfe251317270f9a4dd62e50ea7658","s":"0x5c68a5fd273712f6212081605cfbf41dceb96b16bdf64c797a76bda7a78d9936"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xb2c702d67429ebe71c9d6c9208583397bfdb93785b595d813a2b25e8bc66ee1d","input":"0x","nonce":"0xc1ee3e","to":"0x80c4c7125967139acaa931ee984a9db4100e0f3b","transactionIndex":"0x5","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0x254e252cf1d006d1c924ef57c27fe4e991a293eb310747fa43aec3c4822d63b4","s":"0x43a93e01b9d0e9a69c33229b61f737d24d0b96dc3b929bf58bda42b7ea4abe33"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x4ab48d3a39cb641bd181588adeece1e9a8b193003cc2f6900a4694edbf3b8297","input":"0x","nonce":"0xc1ee3f","to":"0xd08a63244fcd28b0aec5075052cdce31ba04fead","transactionIndex":"0x6","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xb83343f15462152f022f7130c526d8ce03909978884ae4447b9bb1bea24fdf21","s":"0x7cbe615cb63b1a852c1d90f1802c39f7e4707cdd9d7e7ce4717055a23ae63702"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0xf971694a14f080b67d689ef6d57e0a3a2c5a7cff3b5394186c20f70fe866fbaf","input":"0x","nonce":"0xc1ee40","to":"0x0b06ef8be65fcda88f2dbae5813480f997ee8e35","transactionIndex":"0x7","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xfb26a1c6ef40479f8bf62626719a660d71895b25eeb89fb0bc0078d261ee02e","s":"0x60bbc2d6112b6464528c58d7e2fa87360bf168699267fbb6d55e66ebaf41f246"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359407","hash":"0x6fde7e7e6013290df219715fbfa620a9953b81107d4ad8ac207d5a44c7328ce3","input":"0x","nonce":"0xc1ee41","to":"0x1cb96c5809da5977f99f69c11ee58bae5711c5f1","transactionIndex":"0x8","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xd45d991da32102416a896195136c3e1f6456c0d8b873c191b51a5873f6350b03","s":"0x782c2c6e2537f2fd16c6c13ab07bceaaf6e13fd0851bbdb71e82ccdd480eb8ca"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x52f3ff490d6a36e0b3f822362d7a5e6f3361bb902a51df9f2927f621089ae0b5","input":"0x","nonce":"0xc1ee42","to":"0x2aa48ee899410a6d97c01b0bb0eeaf1771cc435b","transactionIndex":"0x9","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xad1fb931de4ad80bd8d1d2f130920046798bf212f5409579bc9f30ace1ffe4b2","s":"0x7c7d6c210a4546d7443e0ab671c045d6efc6d81ca4e5d1f80d75f6b0958c1f61"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x2879ed1e8d03a202f933c0563c0c701a1144009162c7fe873a2b6d088a35ec6e","input":"0x","nonce":"0xc1ee43","to":"0x07b9d920dd8e8d83dc1125c94fc0b3cdcdf602fb","transactionIndex":"0xa","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x97fca44ab1dba1cbe023e38e110d061c4e450698108ff6251c9ca1d809769a0b","s":"0x30ce78cd9cc3b8802c051dccedacf35428f831cb71246cf50b8ddf34f5986979"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xc263f1fcc8b46592b173b592952f7a535cc6f719c87b8ec5b6b3ea449c502666","input":"0x","nonce":"0xc1ee44","to":"0xfcb6e353ad4f79245c7cb704abcffe2f48684241","transactionIndex":"0xb","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x9ff632c2d2dd08f2a2412ee3933911396213fdfabe65bed6f92542aafe8f9b98","s":"0x34853f43deec1b08dc881b1f37b12133e45268f0c10c8058125cb9a3f6fd014e"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xbaab8b9b916544d500513e0883324d74ad1a76ef84fefa5f191b680c044023d4","input":"0x","nonce":"0xc1ee45","to":"0x0d3de4256d6322683fdea9ee23765ccbfcb83da4","transactionIndex":"0xc","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0xfd7ec43a058d6d815b3e34c2e07d8f219b5b9fb9f8961a07fa9c9fe82be50b63","s":"0x39a7dec81cad734418d68cdda073a0f5bbb52163d8a91441155b4e7a1579b51e"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0xf5fb9ea53ff685840fa7d5f10a84b44749a5288e4fe4298ec125a22a86660c2c","input":"0x","nonce":"0xc1ee46","to":"0x6021752d8d9b2f221d4fea4349dea34ddbcfce50","transactionIndex":"0xd","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feb0","r":"0x3e21a976a4edd517890d77022d1ed135eb2fe73eff0ad4996b31ab78adae1da9","s":"0x2dec4ea07c615741de4a08e796cfa15a84e3ba94e859d6a0aa393ba8f2d2fe18"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x5565a192c53ad35ea124fd41a8839af3ed330bc66ab9d5351485d67df470f31c","input":"0x","nonce":"0xc1ee47","to":"0x61e296d527edc89e831cf593ec341f16197eeafb","transactionIndex":"0xe","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xe9fa15e673507235ebc2817992ba1251bd67d7659056c1c520118e599758ef19","s":"0x4f31e57680d0c3dcfa2cbd6b32eb1481e208ff92a8b68165911652ee9f83a89b"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x4af9e61a90c884151104840f1c24ab274239aa16e96e4167f0d6d26d21c746ed","input":"0x","nonce":"0xc1ee48","to":"0xcf7317ee7a3b497ecf634b94bff60ff91b925747","transactionIndex":"0xf","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x724cf1dac7784b9cb927f6a33fb9e17c2887c5df5952bbb3e0450cd8b7a9ec90","s":"0x58193b4602a969e8998dfac699b333d34482bbf6db51ffceb00ff6e9f46f2b0a"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x3a519e94eb42e020d81559c4ae0b973709cb25e380343cded099864dfac14e64","input":"0x","nonce":"0xc1ee49","to":"0x7e7b519df31f77ced83eea1b16aedb6dcb0f0b24","transactionIndex":"0x10","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0xf036f3ee37bf147610f1d6cd29270c24d6af1bf3d49174e3b016c5323a0150d0","s":"0x1fd0442746d1c71ab646e939a3523ef52ad77b7b1c58c08f4e0c403316566970"},{"blockHash":"0x4157a497c2d89b24eced0229efef28d256302e02c31680950d20920b6b25d83d","blockNumber":"0x50936","from":"0xd3248ba3e5492d767f8e427cb9c7b9d5c3972d7b","gas":"0x5208","gasPrice":"0x77359408","hash":"0x68983ab89ff46823610e2062d7f6e5cefafac2708b971c56da10762471a78125","input":"0x","nonce":"0xc1ee4a","to":"0x88a075e0fb1c9309a200a8bf0a88b214bf7ceb8d","transactionIndex":"0x11","value":"0xe35fa931a0000","type":"0x0","chainId":"0x1a1f0ff46","v":"0x343e1feaf","r":"0x79afb9855259cf6cea20c2f75f5981263734f54613acc37d98ff808f18daeda2","s":"0x12581878a1a62fb43e1f68364d4fb8c9c6b8a391e363b8d2369c91038b0780da"}],"transactionsRoot":"0xc4af8ac4bbfee0ecd6900efdec157be8df5505b8b0a108aa7b299f3b92538294","uncles":[],"withdrawals":[{"index":"0x443dd7","validatorIndex":"0xb00","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dd8","validatorIndex":"0xb04","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dd9","validatorIndex":"0xb08","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dda","validatorIndex":"0xb0c","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddb","validatorIndex":"0xb0d","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddc","validatorIndex":"0xb14","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddd","validatorIndex":"0xb1b","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443dde","validatorIndex":"0xb1e","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443ddf","validatorIndex":"0xb20","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de0","validatorIndex":"0xa94","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de1","validatorIndex":"0xa95","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de2","validatorIndex":"0xaa2","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xa22"},{"index":"0x443de3","validatorIndex":"0xaa5","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de4","validatorIndex":"0xaa8","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de5","validatorIndex":"0xab5","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"},{"index":"0x443de6","validatorIndex":"0xab6","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x511"}],"withdrawalsRoot":"0x68e83c7114fa8cd26a789927628a18985b83ed2c947ee8e6dbe46e8ad3259008"}`),
+		},
+		{
+			name:  "Prague",
+			input: []byte(`{"baseFeePerGas":"0x8","blobGasUsed":"0x120000","difficulty":"0x0","excessBlobGas":"0x4140000","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0xa883d9","hash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","logsBloom":"0x00200000000000000000000080000000000000000000100000000000000000004000000000000000000000000000060000040000000010000000001000010000000000000000000010000008000000200000000000000000000000000000004000000000000000000010000000000000000000000000002000000010000000000000000000000000000000000000000000000001000000080000004000000000820000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000001000000400000000000000000000800000000000000000000000000000000000020000000000000000","miner":"0xf97e180c050e5ab072211ad2c213eb5aee4df134","mixHash":"0x46d5ce10b05421d79262c145843242090c1f7a55ef914876799ef1b09009a422","nonce":"0x0000000000000000","number":"0x57d8","parentBeaconBlockRoot":"0xcfaf54cc45943f1a1af776d6f815c7f069be2b7529c6cca4330d4979b9a0a7e8","parentHash":"0x288c8ddd13847b99d034d553f34ed061a1895a8a2ed9ad8423fcbff7752c5d11","receiptsRoot":"0xf5bdb2ec0950a0100fffd7480fceec712e0ff7c812ff85134f2325222cc73e15","requestsHash":"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0xb31d","stateRoot":"0xddf65add46f097c6529129ac070f17a6858c0afe115dee292462ecdb622bff22","totalDifficulty":"0x0","timestamp":"0x67a564d0","transactions":[{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc7360b3b28cf4204268a8354dbec60720d155d2","gas":"0x249f0","gasPrice":"0x12a05f208","maxFeePerGas":"0x12a05f2000","maxPriorityFeePerGas":"0x12a05f200","hash":"0xd0941f23ed24d0f8658cdc073e84dae6fc31790835c2731d09bd8070861c0115","input":"0x0cc7326300000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000047d9073678e7bc5000000000000000000000000fdce481e976e548fb63dbba1490044c39fa68bac000000000000000000000000fc7360b3b28cf4204268a8354dbec60720d155d200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000fe03f4ce071cb8f8c405601777351c534cfd31ba0000000000000000000000009f2f86b887e060aec6464c8b2443b16a79863b29","nonce":"0x58c","to":"0x9fd1efb5b00accefc22d9f8e29e301cd4427153b","transactionIndex":"0x0","value":"0x0","type":"0x2","accessList":[],"chainId":"0x1a5887710","v":"0x0","r":"0x3da0950be7b5e06fc3b63fd5ee7e43ac8fd733b630165570e30be52db59e87d2","s":"0x7b8ecb94a4a6a84cae91290808c6e4a936cad032ac50fbb3566743033e769efe","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xfc278435313cc88059bf72b92952763e7fc511fb","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x83ca25c941a7f4687262a6b3204cf699bc0a2acefed262ec1162ba60810285e3","input":"0x","nonce":"0x124","to":"0x14389557e091c0fefa0686006faa1d7194692700","transactionIndex":"0x1","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb46862488912b8b80cf1b962dac36ca81f58f7fb32b5365729e96fb73e3048cd","s":"0x6783eadde8364bb04c9c758c0c4cb6481de01330d7a7114ff8a00227a65133c7"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x984984f1d9b288def2b0f977c8b4aef08833f3499965dee5c21489ec61eda76c","s":"0x3f3c408abe136b356b9d5508f9047b713bc121d84be25f242c89f1e295de0b32"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xf7ffeb622480c530b5d92367f62a00abf8db047949e2361f4637bd81552064a7","s":"0x4bbf3df5d502ee6143e039411c22d8496f1269b9934bee6c69c0c3fcc332cc7d"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5b054684e6172116f535263253d5374452cc8a7a86a08e48704d5ba3952545a","s":"0x7f647c6522cfa2933d0dc57504d74c330915df2a529b511da36dae7c3da3f637"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x9d33c2b63bd32037e3f3c87671d0d3517537a6ae4d26aeded6631d0db6e2749e","s":"0x2cd87b70912f79556822dd47f4745d996ca7ee849cf0fc4782ec983eeb5d0fd4"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xfe42e8d60c189aab22a6597c89e9048423b857a28c017850c2feb572ae66fcc1","s":"0x67bd96474b5473382efed233a690be0f62d37dc5be9abe9a556efc1930946edb"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x98324c3c17e459af076902307d3eec2899228ee8f8c7c6665a651893f9c8acc0","s":"0x36794cc01de004f32ef8ec52e2c43d93908c290750a831b1b0b481c1447e3cf"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x1af4918e6b1ad6abf73d955645f4594a10f20fd30f635e93f63e72d128792736","s":"0x360193f6e16c85f2d72e56a00ec0771201226c32ee7f61a5a561e07b6b5ba545"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x71a56816a47d905815a11df66e6294468a5ca2efe991ed7336221875351fec77","s":"0x43177fc4f34cf56b4149c49232cfb8590a468f5a1481bd80bfd6f9ffabb9b93c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x277635a8107ffdf815d3c1098bfc54656e59563643315ea019b0fe92e21518d7","s":"0x2daab5fc7c592d9b0b8989b6f5bf9ffff53f5be92d90f16f947db0859867bfa9"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xc17c007a7fd0cf3ee84318fe61c218cc927fc41a1413ab6f5f9582bb7321846b","s":"0x6b991503d0bdd91e8f779e4feea90600be262b0edd53de0a973294b661aef05"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xb0de0b89b0715d1446c7bc070fb36149de5b2ceffde855b4f279324d5a1d03aa","s":"0x65c9251ada29b5ebdf9fe484095e887eb8d1bd33ab847984f0e9839b5485ca88"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x59a03174ef2b81c63933850f5404ac23f81ea9e9a526dca83bac49cd0d31b4b7","s":"0x78d58a49066e4dede7ba1bcb63fc866f3251e0b76fa39436708742b5bef8617c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x6096577b2600e966371ec2774bdb08377ebc1add92f25efe7b7ddcd994f58ca8","s":"0x35364e9d81d6ff588ad2fd13be54624e157f79a15ae8a42d940bd71c653221f2"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0xe6e6672b6f979cf1efa844d3d1d7410e338720ef6c6948c240562d7a129ac76d","s":"0x30c6c8db55c5d7031ebd3da4f8774f2e3f4e6872d54de3c922ef6794f5ab445e"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xb93bdf34408a8daa1a733b2fa1b4f4fb2e8d1c89a329e1b611fd781aaa266222","s":"0x794d8f80c485dc36f28e056d6f0f549b2dcf5ce5420a54d3163736552b867671"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0x6c0a05b20386534b0387590405c156eff063bb744f0a223fd733a5ef2c0bbdf4","s":"0x121196c37d4dff2aec8b39e98eccc14278e1603d86a38f31d4aa28aac0469add"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x5208108df9d9dcbdde4771a4e552880519e89fdee7b6a2376a1a69b549eb9c0a","s":"0x103d3c4326a329a0a2023d1d21e01b3584619b3fb26a650ca16e0ad12d7dca0c"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x0","r":"0x10bf5e96b18d93fa08785058802b9ebd73da629257ae8a610d85f49ce67293d7","s":"0x6d7b18913b7887704af90bbba9a2578320e18ddf1eb158509147e7b6fccd50ea"},{"chainId":"0x1a5887710","address":"0xfc278435313cc88059bf72b92952763e7fc511fb","nonce":"0x0","yParity":"0x1","r":"0xbcf676b4d06a20998d60f199ebc5a658d6ecbb86fce2468c239b65d246e4e8ee","s":"0x177e0b39d98c7e6d8dc3927143714d52da8f5a0cc201ced9f5e7cc08751f58ab"}],"v":"0x0","r":"0xab23d457ab10e64e07d8f86745b8a3275696493a751a5aaeeb2ab97fb73f1a33","s":"0x7fe80b9a92f47c188c656345837e0f08b7002a931e1bd88a9a431df75a536921","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6b912a710ddaa0674f35482ccd0ac1fbcb827b102c2b40d8913611f547d1ac8f","input":"0x","nonce":"0x124","to":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","transactionIndex":"0x2","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x2fb0f500a26ff479d45f91901d9e4c3b709e4b137ed18ad8f46660e897596cbc","s":"0x515e5c1b9075f12b25d2b10bb51092e0659998ca32fdca7441d2612c7b355446"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x6dabb166f024ac6d303cbaede417360c8a094e5ea5950b4c0941efb1cf063bfb","s":"0x7d9910fe04727b3e21358885f17e64b59546f94de8f0fbe635e972a974465d82"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xee03fd40b8608865c83859ada692acbc31962c1db631c3b0dceff740ca9f80a2","s":"0x4ad25a9e85acb9794d8fd9825a0d36b978f372f0057da6c41412e7d31caef698"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x8f6fa3d4eb755f03bb664eeb04821c3dee602a197138e465c3a187e425202e8f","s":"0x2a80db1cf181b3ad80ae1e60aa770f2cc37baa790769afc776cff4905eaf58f"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x4a41d3482ad3ba541e3c1746c9cadf7dca3a9880bf54bf3c631015a1610e65f5","s":"0x6037c67b5571447b0cd2c4c20240374e534814883f5501b7a4ff253347d4a1d4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x90f07268c5623cbdf4a0955f2da64b3da7fce62391e9d0d5f793e6527213f626","s":"0x1145b1e36cb035daf63c2d2c139b68a9196d058e68ffccfa775698680e5b5d36"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xcab35aa41191cabcf343e0f17e356b1f24f2ab38522f582c2292d206328303ac","s":"0x22e4f1e4772fb614568872834766184e227df96d943995b15bf9860c030c1142"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x62ba9a793e35edd6023329f1685becc70445e6e0a0357d47d80fd20698b78c5a","s":"0x66e04dbcf3059f61a5a7554351cb46fd808cddb73087473a244d24a93991e23a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x762d7cde0dad47bbadcdc1b45b7fa7267fddc87384674242c57af69b856d23a2","s":"0x54f313e29893da99d2e889180b92bc9bac0ce9a23eed80b5a2810d4b28550a3a"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x52b644194ebe373c6b015ff4ec6c6c2c06eb8380b9e1a53797e34d66e75a8941","s":"0x6941e9997ad2e7faae99b0d3836b76eef4092a3f0d93c12e92125b4ad2671d63"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x7c414d1ba25dace23e90c31152d8684f9a62f226d7c0c56eadd3d5de6b2e97c0","s":"0x19c2dd9e626f1ff33912c871c451d3619c572ca6c32694fa18cd3bb1dcdd9ec4"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa464baeae4585545171839acb0b7790f477f709e0e3db73615667dda3b7e6895","s":"0x3033fd898f2003378f21a0f39c0135099171bd06692620890a91e445d803f153"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0x989f8c2c93b8835252cc7178c1dbe399aa0aa24917b53ea2f08c0117acd6dedc","s":"0x286017ed0dd164d9ffeb48fdc4ef3157b6c5581e8b57ed409a5a75db2b6cf960"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0x89ad23c01a8782d83237c6cdfcdce4834a6bddaee503295a9f11e04079d28276","s":"0x49dc77bcfe9cbff0a35eef1a4dc01d7a64f4a600db263250fe6592de2ac7c062"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xfc2af35a166eda16e917de8ca5d6f078a23c22471bd6585aa7c9baf8396e868f","s":"0x56b1ba14f27dcebf3df9c8b205141c3fc51d42cfe565e9ab57c5cd6de58984db"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xe38dedd1cb7701a28d1600a5ae800da0393bc794c961f63e02e1c57393dd7aa2","s":"0x52650e8492f039c7abd9f6b52c2c212c2e6cac3ebfb8165d3c67ad1be18458ee"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x1","r":"0xa4764f690f9073d956dc96f0297fca58abcaa8159769dcc4054c3c89dfdabfb6","s":"0x59e79b1ae93134cf083ba968e486f775c9a915153ae992d667fa6ca70b9e85fd"},{"chainId":"0x1a5887710","address":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","nonce":"0x0","yParity":"0x0","r":"0xb4d133a5caf3105429669d4d6c8062c3275749f22bc3761e33154da60784d98d","s":"0x2c008f662e741e49d51bed591dfa712c8d6401c9dcc7708e0702ff822cf011e2"}],"v":"0x1","r":"0x861ca08ce34e3496853a9198f249594a12917c711d28623e45ff4327b1975ffa","s":"0x311738727aab3d3fe4d2ddbe3e66b3b97cfd4f70d9fbc22e227187d04625d478","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdde31aa5d18352412bc18514578e35d4f930330a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xde70e26cc76958d449439f20cf388e1563a92ced3d7a21ceedb0efc74acbe68e","input":"0x","nonce":"0x124","to":"0xe50a9c0a5c743f4a1994c900a0c23405cf1cb958","transactionIndex":"0x3","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0x6c218235fc2e2a56e8da0159ef8cce3ccef7211175358faf111d205473271e65","s":"0x44b9747b97fbc6318cfbc0fc92f037e8f0c2ac8f9f30bb8ef18b1208ff416e2f"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xf76f3408029b65a75f24817d6939089e4ed89bb58379df9e356153267cfe6243","s":"0x39641ced57869b44c873d2781116bff66839135aeaf4f05a9dc8c329a334d297"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x0","r":"0xc3552a3f2eba4761ea5b4bebb592c61aa7f3d6e3d559ab229a7fb30751acdfe9","s":"0x47cc26afe7d02edbc81d5e4d62ae98b707bf2e7680ad7c609c707c593bd367ee"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xf49a4ed94c24adffe8e348804d1a82f069b11e9c4c02cbed0d5a0a0eb4c2a9de","s":"0x417ab689954888142c3d15b7bff1c583024c830f6e80f7e558c29ed95c399d0c"},{"chainId":"0x1a5887710","address":"0xdde31aa5d18352412bc18514578e35d4f930330a","nonce":"0x0","yParity":"0x1","r":"0xd4468d91e2db223d5f2c320227303f5774db257f1f45adee379b6151193c2535","s":"0x126cb8bf74832677730a7d9c6a22ba2cf87264b6b3ce21f10600a318fd5278c2"}],"v":"0x0","r":"0x188362980176a156196ee342b194cc47a92484bcbb70e70f1bb45ecbace2aca1","s":"0x236c1d4692713d8aa8a0ccb9e8e5b74456b6b2812ee4a2cc979bf66c5637c19c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xea9fb0c050f24a6b3d8ca9882012504da7fa89fe97b97d07f68cd8c5e3c8c03f","input":"0x","nonce":"0x124","to":"0xa297e3e19b9f0c10a353e31027c8812524866b17","transactionIndex":"0x4","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xe41e964a9d93f641fea6b59384e003433cf2d81f472a517598f439e931f2b550","s":"0x2d706a2470cda0098dc168ca90cdadf7f3f136015f57f1cc028ddc3b6b8c4566"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xd84a7c60987588cc72998c7899739f7c03bc782d19a09a574a461792ec045351","s":"0x6e23cf84b5d5aba745b9e6a3458102da35f565991757e16f74f31cff07a59bb2"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x7ba6ca4de1e290e6f9c2ed6d8cd22990b0eef85f0aec1d55a47075f17160ad12","s":"0x506516c08141b564e2613ed0027b211f4aea47372ddc8182f3e8cbade58b9df5"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x0","r":"0xb578f9c04c87fc25139d95fa0f04eeb688a3f38945e25d9c542ab3024b5e0648","s":"0x743247c67c15a390fdbb19e8d048a6777a39c5ddb10a51771a42159cd1b3dc7c"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0xab4fa6539aee07d55767ce4f5c13f3552ed4d8426ec7742501e984952e6c2548","s":"0x3416040964d0331367b677528ffa32a9b06e9313a416dd360156071776367125"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x322230c26d14a4fd0d25c86825c0dbeb35f14106ed3c40b90c7038311767be22","s":"0x5abef2e177fa4c3c89f12493a55b8a7f99ff73b105a3328cfd3b3acc09a1f19e"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x321ed70b45da33340940c33af3ed83cafc1f369983f2e9ce7c332a3772c4755e","s":"0xeeb3146f56c94c6a1350ae212c762e430934ceed3013cec0b3f01c44daaac04"},{"chainId":"0x1a5887710","address":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","nonce":"0x0","yParity":"0x1","r":"0x42d50a79d86b3ad832f220c2e5c506db892169f13c5eb00b5994cc7b1b06aff","s":"0x2b73ac3f7df5b835a501ac9dc42723c2d187c7216a618b7bfbbb5d6ca46d8f83"}],"v":"0x0","r":"0xa049bdef669b6a623ad00b3eb7d23f534f7f22e86a7ae7d74faa30a481025a18","s":"0x74693d149983c4d3232aba8b468f2e59c737d69ee629de44ecfb61e85e404ff8","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x747c8db37b09fc1651bd2da905887b4dfd60ab43fcc6d443d836860f2ac22fd1","input":"0x","nonce":"0x124","to":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","transactionIndex":"0x5","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xd89128e7d4da948a804cfea969af6b66ea66778d0166f09afee1841641cce19","s":"0x1bf717534ac82a72dec75add16b7ba23661b16a4f1de80384dd62cfb02eff7fd"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x792755753a73515512c7cef3e4299130295bb7e19b524fda816a52314390efc7","s":"0x6140ed97a596937025f6a77f399fcc04cfd4655f62abcf97a04f74201e92890a"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x8882f26c24748ce509b9ba23950ffede09466ef9c981c332fa6938a8871c8d78","s":"0x8cd03ca8189b4b9c2cba9b2b29bd8b2968cc0f3e06fb13eebe077f3785b1f74"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xe69e367bf478ae784a8dca97518e7634da068b6e1a2b5c2f0b2487526c77970a","s":"0x521227f189b29418559f778949c9290177bcdbcb989197d6af4b9cd4dc3d2fba"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xc9a476e2a9a0450c5f5d71ffaef61a6a5bec7acc5883d2c059847c1374234234","s":"0x500e96554604212483df4a2aeb82b4c494711475c4a3d611abf09a5be217a4a5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x6f6e81faf5cc88cae893193e8f237b89c513983cde1c8e896f3eedf199aea426","s":"0x2db949a612d270b8634f10bb7ee2262f2bc98effe164912d431ab004e085428f"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x69d68a1167245246fc964d21aa9edbdf0c88f9faee91f7e516af2ae1a27bf69d","s":"0x5f7d7f0191166cdce2ba9dc646586051255c6d9c0b88ceb868160c7e2c3d1bda"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0x78f316c4c823ed5caa1542345e7e3f877d8c58c0251f90fd3d82d852dbee78d8","s":"0x42e958ce75ea0ac80e17c0d5d888f2f9cc88b33d3c25b64e5d0ed2e9cb7e7751"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0x8159cc6840cda3e7c8392ed5731cfddeef79317d2805cfed714cf191818f0894","s":"0x2e28ba90df19df298a76add7afa976e110336d0e91b8770a87efdb7f99ae55a9"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x0","r":"0xaf014ae62b12f36888e1578d75cbeba5ddaa1dd0b179d748ebc11a135ca80c76","s":"0x18c1031265d12e3e4c74e5521771110872f7166870c6fd3fd6d7caa8a090dba5"},{"chainId":"0x1a5887710","address":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","nonce":"0x0","yParity":"0x1","r":"0xbce407f30ae5a667ac9d89a371189eee81d0e004852f4f8d456254ca7ccdad42","s":"0x342070cbb05653294208d852f3e69bed4b1fce129136bcf53bef83626fd4e05"}],"v":"0x1","r":"0x3da28822e8d87e08e5a8087d668975b9c2c2a512e3b28437a50fa621f0cee12e","s":"0xe7390d0ae737ec8836ededdaa0d332c8a9661abcd8e03c75f0f3a3fdb332926","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xf4873b4f63a61f54f6f0df95379191cce01c5b88cb02cc80d1686177be74f655","input":"0x","nonce":"0x124","to":"0xfc278435313cc88059bf72b92952763e7fc511fb","transactionIndex":"0x6","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x14580b1d14220ab37f47d1a2957e05333b625475091d4c60b8b60bc2b2cdb15f","s":"0x572a2bb7ec090f3a0bbe7304fe32724a984be1476477372c1670bdab11e13666"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd5ac931db7893cabb2bbf881c0186be1f77cd55aefc565bc849318f4570087f8","s":"0x1041dd9917e9f3de03131858311ff8c5ffa7efc8d6b8eb9e91c58f981be78218"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x478f0a9195c71be7354e637b7df8735fc29737a379fe622d1d7a90675610cdd3","s":"0x25d179d1838668f6b95e0753cbc0358a43d226abe193fca189400666edc66580"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0x7d8f9ff3e6798ec2ec011e15eb74272f236646021e8c378f74c2113580d20b2c","s":"0x51933a7bc83b100b1be51ce28384f11348818f5f05c079f37ff0d9e9faecc50f"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xa93e97a75b1ba28336aa1894906084baf4ba33ab9ee740808bc6c39b8db9f411","s":"0x72d149a99d8207b3145382b177f3c201a2da0d5cd1efb9956c2b8e7d3248bc54"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0x84c7f8d7260b7615c84d21463f19749c332cc015fb66b670ce7fb6fc41e05d4d","s":"0x76b887e8a5c05da9b7c2d235592d63656ff0cdc150ca7c2057742ed76cb30c7d"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xeba0993d1a5a5f7046799101c3bbf2ea7c2ff62d6186a79a1979f4a3dee1ef2a","s":"0x7788976a503bb0e3b3b4a0823773bc0c5e8a76ea693d9581243c6b27cb13cc63"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xb3486a9a3c30bb97bec2738518f5cc70149ec34bbe22f6ae57f92d08bc188cf6","s":"0x5a402ed50fe6b9c02074070f90663528cf1792e74cb6b9ca8c91c220eaa20c81"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x1","r":"0xcb54fa13255b6fb876379ae6ac134b27d6038b75026a2281d7cf4f655791cabb","s":"0x637c0b429c5cdf3f5646076b7ab3de437df6d37cbfc07dec04f89634fbac9a11"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xd7c8916557d4c5cccad35510027f007deea3ab48cbf92a2d741bbe1b76cb9e7d","s":"0x6731d146798c8f21cbbbd9cf2122970f6d87b24bc54c29ea1d0ec457878218c2"},{"chainId":"0x1a5887710","address":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","nonce":"0x0","yParity":"0x0","r":"0xaba8881bded36f752dd8106572322c31154b1e3c35dfc3903949baea6f0b0cd7","s":"0x5f8985f2d32b9f98d2ed28cf804e2a6efd86e21fab7d563c1e42207c45880865"}],"v":"0x1","r":"0x95b169ff9ea5f8ffe0f8ff89d51aae7b21eb6d9d7df5b17125f2448b0ffbcb92","s":"0x569cd3856f38e8d8be277ab01d638b623da34b60affd7143bd38ed8b748e1303","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x5730525874e27b91760cdb3e062ad1f07f765a13","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x549850cc50a38ff663a175c2820a62182842fa4c2cca4c7b87f4206a501d2bb5","input":"0x","nonce":"0x124","to":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","transactionIndex":"0x7","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x2b72ba828d7237c05e1da2d9c9e887e39459bc1dafa19af2ede676abd2b0fd4a","s":"0xab9f523194411a26ea016bc29627e9458244eeff231a6c177b269331f22a0e7"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x671fc0368792ee4ad2a9909bffbef8a6c90a6751c5fbdf2cf34db8b7cfc10650","s":"0xa3017d994425c7be67a7f30607c9d0d6139ef97577efe1df418443052e0a840"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x1","r":"0x4b9272f7729519d5bf5a0cbe8b641d86a2860920f586fc475a8b7e5e3de1c7e3","s":"0x5cf2da66e064d5a644c829babda93ca1a9a90cc9cd078ffc1e6886c8964ab99d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0x79450484d638cd26659f8b348abe86d36ebe7e2b20453ccd17993c88097e61d1","s":"0x48bb3534bc75cb0f66c010e2e6f274ebacf2f77273c742eab0f302d6002b0a1d"},{"chainId":"0x1a5887710","address":"0x5730525874e27b91760cdb3e062ad1f07f765a13","nonce":"0x0","yParity":"0x0","r":"0xf2eb00af4393794d99e1bdb5aa794109c5009b143c5b9ef7b5f25cb431e4486f","s":"0x3a2acb096c4979b6ebe243ab802565c1ca3bce36d34c352d205096d766dea9fe"}],"v":"0x0","r":"0x77c2f1f466e6cb0c836b6af2c3ae9b51867bafabe2983c5cf1a36a39fef83db4","s":"0x51a0c6d64665801a0b1c9f2c5d98e9bab4bed1bf5cd4f6ceed0ce9ebfe392125","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x944baf51880dec2dffac20795a733beaa8cbaf8f4fa4069d734db4fc0df43292","input":"0x","nonce":"0x124","to":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","transactionIndex":"0x8","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x6dd9fcb081f011480e55b256c8843d8aa4a73f157bb661850ff0ced587fa6bd3","s":"0x33fcd98b05d68689e93f0f4dc644d0dccc058e6215eef58828ef0b6fbf355aea"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xff093565560802560afe3f4a365dd147b6c14a7c4d4236b5eb69be96d1942733","s":"0x4f5c91482ba58beccfdc5e827b59d92655042f4479127f0460bfcdac900d6977"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0xad2818051f023041ed65ba3bb3a531c91018b33985b67ae0cdddaad2a84f314b","s":"0x1bff4f3b5e53798a3dc72ac8eb06c4df82de4b4cd06c8583e1c2167ed881d884"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x659e40f59b329fffb43cac91a41d514fe723618b3743ba60bb6732a09ce3e42c","s":"0x5931bc76e0f6e898d8280023b9cc170b4ca5aa95bd676c963a8f9017e0a8f81c"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x97be95b79e05c135bca45de3be1be6610118446e559804012265dcb5a00048d8","s":"0x642eed1c78e75aa87b84a2c0a6b23e85d758f342d1ce246d62846ee87837a9b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0xfa1e41907a6ddd38b3de57d75e591a0815e235edb8f594298a43afac8b198fd8","s":"0x7e22c62f9e81255d80c0fb59491042fe89a6ea8acfbaa42a92318cf022e9f59b"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x3b81f43cffc258e6786db6f49e327cae4d5187864d88cab50a0e75865e2c13dc","s":"0x1ad669da15ed39a10ca228d152fdc61c2d8189e27023a66f785bd03dee78a923"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x46afeee0da0a1177dcc7d99ea7a7b242ba699e8e7dc1b011a94ff05d415c9183","s":"0x4fd428b80a2fcac859a361279f6a7f42684e3b72303c0fb4aa9e8ae4d78d2eb1"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x1","r":"0x3963b8a049775b83b651ff62520ef775227fdfe5a7f545b7077ab63ea1cd493","s":"0x4207deaefae172e6a33c47903079548cae543c71d31b57c2e63abfaa3611e370"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x842fef4ab07067c98d92015cc2203874b6fb0ac77af34a89b853dcd8bd986dad","s":"0x1a77b21e66e49b39db8bda121dbc65941109b4d43922956c1cfcbdb9715e4110"},{"chainId":"0x1a5887710","address":"0x53927a3fd8c7fa3ea8b9d24c1a1d78ad69448c2b","nonce":"0x0","yParity":"0x0","r":"0x27ffa1c38d942cf973781a509b78052dbf9611849c0a75d9a82d117ec6cb1496","s":"0x7abd6f8c940a11d65a65ed80c3524657358fbc52e73a2c1d003bdbbaf2dff61d"}],"v":"0x0","r":"0x5ed58adc5a0800fb61a7de7fb49d326a0d4d9d4fb7f48b13305ee98b76d3d249","s":"0x2e2f988ab0c142391d5924207a298a552ee821c2d720b6c6e3a1eca5969f234c","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x6e7ff1f5e608f2adbf14a1bb0e342896a4e7db0d1692e2d83544aabe069436c8","input":"0x","nonce":"0x124","to":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","transactionIndex":"0x9","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x71f5dab9c79640d0614f68b4bc48aeae1739cb0828fa41758a7e7dcd84ba6637","s":"0x131230fd8abe8bf20a7ae43aa10454f0b24e9f4e61483a35d04303675fe24b74"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x6e5f7c31b904108691cda599fc68d841e2349116b611f61dc09269f53b73e514","s":"0x180e34a9d12d787176f5b35c7bc8618656fdc3ce30c3e15762c66938d0f9a709"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x1de9055101e4169121140b923d0fd88b2e5762dc07a8ed6c975a02dc5d3e6d7b","s":"0x5ba2dcb548dfca60bea45fde9944a559b595ae0df8cb74eb7efc704418a92c02"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x3f69c3a47358af6416fae244a42122ef451a001febdf273583a4fd2052cc4b91","s":"0x6af4be94470ff163b42313841201c27dc470144d2009259cd2c095a92be075a7"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x9fcd780d44e52971ba4ff67934bca8c3e3be6a1446dbeb2de065265116531231","s":"0x14b199e6eb7893b6d25acb2dd61a0243a71615172f4c2ce9916998cc9dcc63a6"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x21b8436a44096f91f5e86a049105acd3d1078636ab63de41c66fa2f82eabec4a","s":"0x6490235fa59cec9090a0f8604aa41eb5c0b20fba7b0222381a811dc18841b90e"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0x7055abf5fece0f6af0914e82cbc27eca18e65842bcbac1fc55ad1cdbdc39257d","s":"0x1e171c7ab684dcc06f578cd14ee540dd3957a6b9ae9a725229cd2e8716915a57"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x0","r":"0xb8c14ffddb9ed10e0bd93673ce2d18f6f0331b21c9f592a7a7ab275ef975eedf","s":"0x4fcc16b08c11f60498732acf79b68974446bf5cf2c5a92c0f44989f583a7784d"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0xc22279bde1e7392fe4d9c06a195183f95b0a21851e21461fa23c9e4c35b6b80d","s":"0x241aea70adacf51f1df6ba619e2abd4e6a4d79edd409c044895950f050308765"},{"chainId":"0x1a5887710","address":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","nonce":"0x0","yParity":"0x1","r":"0x25adf12af5af8678c2cd27f669441fbdc02ecd617d9a9a545d3dcc450d044597","s":"0x58f99850c42f6932d0d4e3a4451f57ea13d5c6cb0eb5d215e2fd79084867e9c1"}],"v":"0x1","r":"0xb865e8305145843c1c37e53d0c2f9603700adf76673fb9b5e449ef03daf9916","s":"0x75eed1b9a9b78b12782d0c122dd4142e718e80ec9a5282d0066437059f3b32fa","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1bb4dc8e5e6e43b1cec5975247a20b28393db65b12c09993da7cde330b12e505","input":"0x","nonce":"0x124","to":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","transactionIndex":"0xa","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x63ee6586a0f7f87253544c6555b73f601160cf2093163f276a94df4824dddfc7","s":"0x15a53cc110bb6d38266b381b8d37444616f1ae13f0fe3c892e9cd4e32fbcc066"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xb2d55b412817e7dd7798c20ecca23e563cbefe0da79544132fe3090d8fa40810","s":"0x6745de56399ec82d74cfc9c008f44c35bb7d35308bb33a8cf8a5e74fed286fa7"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf579befedfb6885c6e5dbf7b76f8a394c948fe937901611d68c09abf627f4d36","s":"0x4ff96b496176be32f1b901b1c772764bad06443c07626df9b8bdac3fea7bde88"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd476bcc5246d8b00de1a9ee377b239eaebb178a2fc1562aa52ab547dd7b73e6d","s":"0x790a541bd219ee8d89b5f1940b8be3e2f203b9703197ca45a6f3f0305e2bb57f"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x6b7dda671ee44268e43df49ded9d652ff958f40e56090f7a7e569f75852e6d86","s":"0x5d9fc4ca74bd06e0a740ffa0b232fd99a339cd9769ef29f9613d4f92e445f7ca"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0xd5d36921ae371e4e800c3d591bb6ea1c12c3b8e475a369c12cd27403de2cd455","s":"0x2275dce73e580c25be916a4c5977cddd185ff7111b1acb79bdc260691f7f58f8"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x1","r":"0x803656045a9387a874f69ffbd4769a469eaae93fcc4ee8ced7c5eb0dc90ee041","s":"0x16f90c03fa8232ffac123e64b4294c2dd5aa7704dda5319d768c63cd6af00dcf"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x2c08e2d5b396dcdc586a4959337fc21bff6b1a1f6bf931182f135a56c1cd0a48","s":"0x2029fda69775b5d66c6525b2856a98465160f36dfea7c916672777cf90b8c8b6"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xd6580858befbc110bbd5b1d503dc2962b278fd4ad79b9f4419d1a03b641e38a7","s":"0x40c8babcb0f82f89cabac329008c1698a2d92d92da7bde75fb508493fe84a935"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0x3a94ca8871c4051fa5abe3172fa1de359b4a521e44543a6c9461f929c27a12e3","s":"0x35e0c91f1e5f5314b3e9cd64b460904142fc0448ed20d0663f39cfa2f3812add"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf1217f4ef791d8889e3e61dc04d921a989657e941a0530e2dcc24aa917a1dfcd","s":"0x576487f5e47773615a7d5365d03783ad26c20ac737e018dfe0ec7e6c58ad8e82"},{"chainId":"0x1a5887710","address":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","nonce":"0x0","yParity":"0x0","r":"0xf6a227860daf114043df1513b45fce4b80ad9e586002a807358ed4c476561068","s":"0x583fa9a460188c75c9bf81d607146a6805dddecdbe1b4f8f15187eeb978f70be"}],"v":"0x0","r":"0xabb57e0eeb8353dfa360439470fe10baac99831e2cbcfca6f2e3fe7a9fbdb819","s":"0x6cf8ab5fafdc4c3b8f5efd0245090b6a183450d9666ce29297aa86118ed19e88","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xaa1353811eac986e421798c71ce8aa347e85b8bd161ea2ce5d7f2ba92091a27e","input":"0x","nonce":"0x124","to":"0x49aaef49d087856c27523a54e68f5a0b5649c76e","transactionIndex":"0xb","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0xcdd6d1acc2e22cbaea497282e3a23b906b519cdbd70df3a847857885bae9527","s":"0x57855905bff3132a26c933f8d7157444f46fac46562912a06d09c0b0f7c898e0"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x8368c396719ed1d968da7aa320d5ea57a402be7ea799d6ae75f73100e116973d","s":"0x85c06dd3f8e7b796ba647dfbce63360fdf8cc146378e8f8202e528a01044ada"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x1","r":"0x37f200f7115a99491862674c73b4d7d2643b0ff0c6909c42decaea89218203f4","s":"0x571b7f51da0497876a0d8948db5283436a444b0b0dbac3c635f55e31dc9e0176"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0xb5b11efd0a7a6c3678b9f3edd55b1bae695d3457fd6462f3ec8966c7c9843b0e","s":"0x145f337cc0af31974c604a38c0b09dd238808c29c1ae8cdea585d784fed4b868"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x47e102902dda58ed1e52101e68d4c821bb87ffd78b4bda7b03e64626b5c21df4","s":"0x151d7172416cf2a43a8608dbbc6f20969d5d623de98fe9a7059ec89e1735a6db"},{"chainId":"0x1a5887710","address":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","nonce":"0x0","yParity":"0x0","r":"0x3c1423f73dbf041b54c693c0b9785c5b9f5599c6daf133f00c31e8dbd3a091d9","s":"0x13610156ec380447f489a2de283c8d09ccf07f74aaa7a872c7b23c731c0302a2"}],"v":"0x0","r":"0x60108b1e650cd0447d588489757d0c8feb688d1151524d88a468f17d7966bca8","s":"0x5c02442af25ef82919b38682bc5949c6ea8e934ac173eaef5283a5528c96f574","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x4711a02ed9c748cbfbfa536990a0de078d753f96d7b0893283a055d3c522bfd1","input":"0x","nonce":"0x124","to":"0x51f8ed66be313155f74c4bedd3b6642ae3440927","transactionIndex":"0xc","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x15b49afe17b4738354ec6b03f44d22d18a8ee7136865b162779f7beff1c1b345","s":"0x2443fd90df0e948179f7c0a96cd373005e89658d0e83bac5e515c35e2b4f8d77"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x27bb209d55da08ec141cf3f8ee8b1d037301a26faf50e1b928860f96e68f171c","s":"0x7e755fc4d5552955ae486aa5c9cc76b5ca7f9fc713203cb5d8a9d2eec334b6a4"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x53e207f7c204e7fbcb7ddd052e40bc3d019ecd5b36e19843518704a0dba8d60a","s":"0x223787f46e5bf8117aa6bbc51171b9b1e13a4a376eda96884638efe1eeb5a488"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x327496abac7c3b71fdcd3d1591c8e8cf62a3c3de634a791d844b6a35289f86b4","s":"0x34592af26abc82edf701656831a5174caca010426bf0b5d0d6fe7f84d4aff6b0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe320a3ab72023a4b57085c3302e9a0248828ae4fe586e29899785a36bc8cde9b","s":"0x2ea5ae133f80f65349af8044bf12d3309ed138dd849f3af2ec593a0ee31f4448"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x75f10ec9ed7a56424ea287e084476188e8d6f87c0c171fe26c3e0744636ca5f7","s":"0x23a3f014d9b352290cc4af0d6fba1147f33ba2565f4c9412f91747757cb77782"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0x7667f128de6fdb65baa0fd1b5868a84944f661ae02eeb096f89b9f14b2fdfa39","s":"0x4889e1421ecfbd4f67f189b89951a03e311dcce8f36f904437cc99f5dda3b17a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x1c37c1422a423ccb142022bfbb235e60c805b552fdfadc663634a32e934bd4d7","s":"0x13b6864ad1b7c282cc6a352adee2a2c100350de8aa53c72fadde598754b7a8ff"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xea9d49d751511f8c6999ed27adfe086b9ad7e93f6213827de2d21a13a98687ba","s":"0x2e3b3a68e074cf448f02a1bbab63e600c1cca7bd5b2d34a16d04a806a747184a"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xb24820f7d8524c10cd4f2c0038dd26bdb7e9d7fea1aa7714fb242856501f8cbb","s":"0x8dabd79f60dfebd80ec8061079ee327f7ce500a739f012886ef68e703e4c4ae"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x6bff7cf52bcbb336c1dd80c2d725c8519cfc8799b617aa09d41cdab1d86ab0fd","s":"0x6e8a086aacabb637fe15e5712955bb4e8b16e611a3d709d9cf181d1bc5b3ab98"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xf88213aa9f2a19e4ef16ffcd1d74dcf32b53139e861a843f679b4bbf2c65c554","s":"0x1ef0453cf735179feb2fcd304458803c55e910b4901354ab7c4ee8de19490075"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xbf9c75a1d14de399b554a8c405b3b73d8145dbe58a7200a86c171bcf88f6c188","s":"0x600055f2e2b68adcdd8fb4741f1cbe7d5cb5f3c2884c3f898f68622370420ef0"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xda64ba64b0a2d8896d9d51114c50e8c9f827534da21d31fcfc7747d63ee8c452","s":"0x6c4f9c30a2a6f33fbf24e07e0af2a0de07484a1f734ba240125a232ffe3e0a6"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0xa9a95d9ecbb545c606945a4999c3f452f9e3a37ab2edcad77dd890d92209ab98","s":"0x12317d6fd111fb1d57b8c19143a07f8f0e023244286aed2359c724ea642e3a40"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x1","r":"0xe40399fca3c06408cecd4317a62cee48d34f71893a438ae0131a0a4427dc11d4","s":"0x293dacac4693bc1869198967858873e92af6fdac6f753147e4b43acdf0a82f41"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x729105ba2ab019b08928614a03d2e3bff6e1e057542480345737c51153530267","s":"0x5fc451f41bf429beab148f20112eabed20e6c731827a61cb02dd8dedf332307b"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x31dfdace5ec70a790352e1ff787d42479f6168ca2f3a4e9a7439e66d67493c06","s":"0x5cd46bed07d096d62892d18845f5caf03d93532081e90b510e2d36d5e5d0008c"},{"chainId":"0x1a5887710","address":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","nonce":"0x0","yParity":"0x0","r":"0x5843123b7a15b6b530271d22791f867b9ec77e82339b1b9c25ad469ebc2068e4","s":"0x413446693e172888603da736013622e2ce4b9d4ca182f3b27abe10753b8dfc23"}],"v":"0x0","r":"0xf20a90d36ac4def46a5e33661928b258891e7659ee0513bc5de53225bce9b7a9","s":"0x4a2eec78a3a068c264026c5841a1d29f5743701663c2bc6ab0e4872656ef9a08","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xa4641fed13831528152a48bc95e4d785faa40d7a476eb30303929f960ab82973","input":"0x","nonce":"0x124","to":"0x5785f5a7dde457bd8239ac6887ba3743a9b41737","transactionIndex":"0xd","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd38af3fc0d9ecbc09d36815665868b8af51897d1ee23846245773d73d37c248c","s":"0x6e0f8fdb0bae7e07825cef05ab7ec316d3b9e2f87ed3b6bc6bfb48c5e7b7e102"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xbf18aa597ce6d02f35eb1cb65e53f8c3f7f12891fc9a5458d36fffa4d12db8bb","s":"0x32aaa13808a57dca7ab49d384be1f4779a8746eda064ec80f4098de3abd025d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x4c8f477001f3acf9890795429dbeb7e8b74f4f489127268b25d3f29d7d8fb409","s":"0x92071f3a43c2b53eea373b5bc08bb04de84f82812506dd64333a1a16a32798e"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x91d9875d47e8d6947457a30de53b61d4f0b4b9b1c68013a9eafb88b60e16376d","s":"0x402adc53a38e94fb0d38a777e7a29c21ca007430b9e81ce89cf7e5011049f51a"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x93abecf7fd7f76ce13ea5b05baaee40dbd449cc2c84959ca96d24e2882b4d483","s":"0x2e3aeaaa6ade94f95aab0771adb63410246aa387f0fe7285049579a8f7c5f8fb"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x2afb7af537ecf1b6f2c20e8588f69c1d03d82d22ebc558d8f8f104038b2aabc3","s":"0x1836738bf6c97b010c152f686f8276d29b75bc4086a7842c92a50d37fc94f402"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x8a542c8b88993e48cd7c0ab8e09ce31ce15bb1178d60f2791db251eb70ae8126","s":"0x7a966c74d8a2def19e53dc8d0110b8194b1db7d2fb0a601d576a9b1466c8695c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xb7997972083503be32a75655831e9274e843623afeaa4bdeb2cbc42e480b889f","s":"0x46e07e3ed6f4ea6b262e689abbc76c0fb90b0a8e03efdf2fc03148cb4f8e3b0d"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2afa0b20bdde7ce541009da8b6fe8331420ee41b857cdf191366cecdbda58fbd","s":"0x5f8c783730529cc8065b0439e522a5655dfe93f699a18b244b81b164d9611f82"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0xd8b410719586d8930196e06bc2927af076c6021356a8f33967327eec9ae270b3","s":"0x1b97d55395f40c3ca1af140024b44cbdb727c87a9ae335116d8beb74101b338c"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0x994ce9bd84888ec3ac826a169baf082498b34f13597894fb7246a38704cd279b","s":"0x710d5e49ababc49508fb40c61206d77a8f46dd388567be6750df6306499892ed"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x1","r":"0x2acd678201d753b051b30bc09a5e52c5a97e0a370b55a6f811938d7cfcac046a","s":"0x3e5d9db03775362bc24e32783e78477a1460139e93d83a8a433f3ff45d05a666"},{"chainId":"0x1a5887710","address":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","nonce":"0x0","yParity":"0x0","r":"0xc201eb4025dd98ea2f6da59d8bab9ea6ac4cd1ba1c93c918edc33afff09cb675","s":"0x518e34230c495b37bd7eb405ccdf1762551cafebc5c0e8973f78edb943173839"}],"v":"0x1","r":"0xa09ef36a3f76029df001295aff34630b0928d490b6802e69ffb2a033ffba5f1e","s":"0x15c499bfb9d7194df3af663842e17a5baf0b29a9cae1a5658367cfc2ff302f24","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x14389557e091c0fefa0686006faa1d7194692700","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x251174e826344f5f15c1797ec424ec7e5edd7c722f1df4431c457087a43cf975","input":"0x","nonce":"0x124","to":"0x5730525874e27b91760cdb3e062ad1f07f765a13","transactionIndex":"0xe","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x1","r":"0x89cda4eb425c2347a4cb1ad3e847069a18520754d9a31d13d1d8116d7c061b2b","s":"0x47e8cba907e91fbd56ea29b245d7258082a65c376f29eda285415bfda124cd2c"},{"chainId":"0x1a5887710","address":"0x14389557e091c0fefa0686006faa1d7194692700","nonce":"0x0","yParity":"0x0","r":"0xf6e0cdf9821bfdc4a24727dfe8f51219e20445a84e2914a5101cb03492b372a2","s":"0x3390dcec2ed992798d6e53c629edb550cc3f4bf5f8aa1ef1b211909bd567594d"}],"v":"0x0","r":"0x8fdf945627df6289ffaa128aa3bcc9592dc98e131f24995fa23448e435691b1","s":"0x630497b5e8f6015d20e2a982df2836886eb7473ef30a938c5c9f2210a773ab3a","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcfc6618e733ed833f980746c67dd9a41a0065aff63c900aa204dc3641a1d474d","input":"0x","nonce":"0x124","to":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","transactionIndex":"0xf","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x4af5002702f3b0960ee2ddb64f59b7f38bfdfb5a59f6b6233b9bbdbca890d6cd","s":"0x347dec1a5cf6d93e3fca5ec23c2d6cc2132ca8463737ea74222181affc9988c5"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xc22ed4a944e128751ea077286b96eb0ba6f8680cd59bc66b3d9fe4d5fd9016e0","s":"0x1fe088f3a6f8c4a06e4c4a4b9c55a57dc0ca8bef1991d1a59249299c86a725ff"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0xde6027fed51bab62645bcc026548a83e4054499ab56246ed0d5554610303ebc2","s":"0x1ca9dda7999b12f906ff4df57e5c084f0380a28a88db0dc8e72af12c59b10c86"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf48097c784ad0619d411c3eda0b85450244554a618d1eb5aead87071783c558c","s":"0x6169afe8088b34d8f6db42b662db0be5e29c81e7ab4381cfe24dbeb596b6c203"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x3112be718166b136a5cfec5939a53f4fc7058ae51e5b7a79a3e0de61b573d3bc","s":"0x7ec2e8680304d15c648fbd748ac18903a5720f53bf9e3285f0d742d4baad48c8"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x23a3c30fe2dc656c566e6d11690719273fd69bc645724d1e2f513df9a16a4f37","s":"0x299d49f8e373fcf2b2faaebc986b50337bcfdd8a58465d68209a0a2172aaf51e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x0","r":"0x5cf0a3c92292f0476decd4be8806a0887a9b9fcdb78e96bb312095baa4d2fc45","s":"0x75843521e3946d562e3b210e6b41abb7c99a209baefeecb68fddac4e6b794ce2"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xf66b6b9dced6729f69371ce06fdcd9761b230b29cc595e6a79bbcc7bfeea4e34","s":"0x2e3e9cb4f430551f7b5b3cf9a30864ca4d41200482ec66b33202c6ef8ecaba04"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x2d07e9887d7926a3506cc8d0e06748d95b7395227c6142c379e960bafff2685e","s":"0x3cbc6cb189b0847b205e22f5327009eb63edbccbc4fe8dc60bf3fef9b271b549"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xa82230ea185e5bc7909bebca3cca35e6dcd73e787aad0b4fd18b175c66c5cc1b","s":"0x760a356d9b160d213f074f68c0e7612fc891e7cb0fc0ed597ce2c3adcda1049d"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x7b71bf8b814b2e7fa8c632728125fb42a1c303bf29a3ef4469b16b57cfffd5d6","s":"0x6a3050ede7b8e48f45423ab5a2c16907f969c6a710cbeb41c9f80afb2c0a6dae"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x16c7b7c9c9f132daaa4ab5cbe96424dcf48fa05de34fbb2b880aca2010505471","s":"0x4b4649d593cafdb3b294a8268a5b812ac1dbf5ffd7d1859996029373333745be"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x8adb76d659105891cd2b9707467bf1f8c65a41ebce7fa573ae21df99b12521c8","s":"0xcf44d1ba98113c7900f5393db4b00808faa64c344a352ab7e57ff899788dd4e"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x5ecb2d4bfc4cd97fa8f40fbdf6a616f3fae850c2ce37d89781e2cc2ef4f3e024","s":"0x455c283de53c86312d9682c9b94cd11c33b7eb4749cc7963abe022454ec9d490"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x1ccabbbb086cc00bc5a103e8cff7a70df740b8e16649dddd0fa8fd5d04cde03b","s":"0x7e3597704c80aada6b11c11ae7f5fcf5813b7e5d7b5fae29061046a3ff492853"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0x6e9c3b6071b76ba9c9904c78b7dfc0b2b5eea935247f2342829249613fd6caf9","s":"0x216cf4d1072133c5d6498d46962b35f8738db3fd148dc0dc071c1ce09960f43a"},{"chainId":"0x1a5887710","address":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","nonce":"0x0","yParity":"0x1","r":"0xe01e6f1f3012873e9d48882cb0e77ce2e53ad3daf7f0832f8231024dcf7b5129","s":"0x3ac9198aa314a765c698bd9b4e9e97ca7417e0ab40732e8ca6d5beda66063e77"}],"v":"0x1","r":"0x19d61b0a9d615e5332e2b83d968ecebcc3e43f6252303ea69b2b3e19c3d82e29","s":"0x1f4db0a7797135288453cdf109d2ce3a5c0bab1089a5c3f7c0937053eafd2b45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x0667517a45f4dac84446c829438d5bf551823547","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x1ae5a39e360974f6f57c98a7e2c217ea515418d7f7470b2842f277553331ea70","input":"0x","nonce":"0x124","to":"0xdde31aa5d18352412bc18514578e35d4f930330a","transactionIndex":"0x10","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x6c1d7d19a564d3a847357586f3e58ab9dfcc86c3769a385d39d35e9bf1876547","s":"0x4108c7b38c7ad4d7617bd86a0102acc611cd9e6ee145af2f8e85a88780df5548"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x600db7108625909099560397bc83385ccfea450c74de79aaaac28f0170edbb39","s":"0x2605cd1b4f3994b4406902d1d803c5ea4c3bd27d2fdd5507651dc1044edf1244"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x8891e2289260c39f4306d627798617179665df734cc137348b86d98b57a11443","s":"0x58dbf8f904806a17dffd29d9692d481c916de5d03367c9f31750b84ee5ff6ffd"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0x188835843d49cc8ffb125f265d104a44483d39dbbcfdfd0cb0c772be58670502","s":"0x63f1998b149ee8be992276b5f11d488ca606634141def2f9cb2115e58abc924d"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x0","r":"0xb792f15309debbf656854d0318c7c127c793921e8c4abe6ae883848c65a97ce1","s":"0x1a77e6c1c9aa80f3a5dc7b1e9af800ff6c10bc2c2055de292e70d3e3423888b"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0x7da52543c8c2025bdd664c23199a6112a4bf99f3f4117e266287c2ee3a7764cf","s":"0x27f49b1145e505ca5781b95ac3ed8ba500f1872d4c76b1e04a234b6d8c68ea92"},{"chainId":"0x1a5887710","address":"0x0667517a45f4dac84446c829438d5bf551823547","nonce":"0x0","yParity":"0x1","r":"0xffac19da42c808edea3d41075853a5cd0c1d373157dd3fa77b6bbd664eec0824","s":"0x643969db399b3cf17021e783b5417651a00147da14902369344560f3bae95cce"}],"v":"0x1","r":"0xcc20e033b6bbb704c8d013033dc140cb39cd10300f5c4e34ef2f1cb357e98d1c","s":"0x6bf7614fab2ea3e76ab5c0cd090d045ebedb69ee1d75b5ec6ea7c9f3b06a76bd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x3239f090cc1037124e32608a0a3cbdce50b59d4acb030ba39ffed387087b5061","input":"0x","nonce":"0x124","to":"0xe3c1b34e49a78d011a0174ba9c1a38aae8d863a8","transactionIndex":"0x11","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x85e3ae7b28a4221c3cbbef8ae7292f521307be82f7ade40b42c5fd46b8fad104","s":"0x349849ff2419de48bc660b19670e0b9c653c276774fa79ed3d3a127561acb9c9"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x3fa67e094ebfd138b10683116ac6b6c1e99ea7e68475cf80913926cbc2c8d56f","s":"0x5fdc573797413791114cdc05f6aab44c0552a93d4858d375d66f879971236e08"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xb6d9de0ca9fe63a94a0a7e3b815b64e84cdde8ebf651f19809ba2909bb23dd4c","s":"0x1c77da4dc8cdc44f6af146a19ffc93da773f2a1e7b941d4d47518a6d115b2ccb"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x1cdaa21800bc79425cffb56930c8ec3351d1d27ef0cfe7571a87d7066044e835","s":"0x1f2318b2e4552fde26f64df05aab16f8f40746d046f100eba034af099ce3908a"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xab5e7575ca6720de527ff1437073b3c29e81a31b7d68deec840862a3af9b252c","s":"0x4bc515daea374440ad86b13783c4b1442fa5c481c1d3f966aa6137d1d04bbde4"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x5a9ea82ecebe5b2aedc5dbd25d3f0673d7c32cd8efaa3081c18ea99f80ffbd00","s":"0x46858e5d89f77da65bc1f6cf0c187cf3396101cef41d4095cffacb4fb208821f"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x86b4a3df3f08b2dd783a605b257b5538930b650b744edaabbed3fdc572d5fc3e","s":"0x5ed21e1db3e168bfdef32f6ed71ec20766e8e2ec3286cb3db5263691e952fc30"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xd769518bf8947a09ec1729d9966e47efa579fbad4dd4bafbe0f0945f7a02df85","s":"0x451dc81aa426813cbe217b86bf743bd8c17a9d6625b043f40971dc9245f4ac8b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf2078416d387147defe9e588d4bb978ba14f77186985325bad8381c939e0055a","s":"0x32cd85bee47df9b5a0e92c70fc8b6007c3c4d52d0de07f82605c46d45283714b"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf83ac2e43a654f7846cdb2b7a62842fbc87d4b6960dbdb7b14df8f39c3a47fa1","s":"0x714e90d5f38cfb349fa7d7b8c311ec9faeb637a341e9b19b2c028610a83d87cc"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0x779e279529a428ab9519fab51139595e757b58cc109d26fae9762972c82b3167","s":"0x2a84d5206624826f89ed09420a48a1f78e9782bee51f562ac36354f39de478e1"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x0","r":"0xf6fe877cc13968326bb70c7de248936b444aa1159009bf78c7586ef3456e677e","s":"0x3aeb48996ad97edb267a59c2d3138783348df362a3a356f8c547087286786b1d"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x800612502b4292bb9fd852f8edb1bec090ec091317ad60c8462b7205a500465e","s":"0x1921cfcf0889e6bef52a705113714d0a4151c258e49c50dd8c41e81ce49cb816"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0x2832af1b36d7fff8238d66720dee4354acdabcb597af74389e2a237383eb4dcd","s":"0x6b850e9fb4d4f45b1be534fb1c41d2e9fb34c3a3eb2d9ee71161c51ce9ee2719"},{"chainId":"0x1a5887710","address":"0xdc20cf1e74d5c58eb68bd98fada2885802f8b51a","nonce":"0x0","yParity":"0x1","r":"0xa36cd3d8e317c2fb16d6fdfab3f12246e9c3d8cd5df03daf9cc662e79865766a","s":"0x1c020dd9062065e085f30ef98d1533ad8452bdd8d106c9151210b9d09653c6ef"}],"v":"0x1","r":"0x7fffedbc1e27dee4970ae07b6ff94054f13e1827c6ced8eed9be8cbf1bd14bf4","s":"0x47bc1eb3525d9e805943294a80eef0c36e9a2c623272cf1cce18edb2a4b2b302","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbeaafb428bb29faf943f4050258e6f4cfd351b47799c931196766afcc1c473b1","input":"0x","nonce":"0x124","to":"0x12810f397da411f7d3f481fc1fc90cde529d24c2","transactionIndex":"0x12","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xafd84500ba746bd3149116cef1800e8a6b5c22c730a81c98c7fea99df3e37f04","s":"0x62ae783b90dc1c74f8a1583bbacefd927e5189a017ead5f03a9aa17770baeb3"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x57d4b333d6cce5dac434acb06116507d2fd9b11e0054df09e0d3d4388c23b38c","s":"0x543169ac006411fa2f5d2d1ac93784d9ae3768dd125c15592e74efa17e15e8f5"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x7c74db0c9027c5d9fbf01ba6367f983a6a938380073376bb2f3a1a24e65357f0","s":"0xe61a3b2fc68053d069a92b6bc810934cbd4008de34460f639f1b3e9a0fc82c4"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x848f1a9103b9cfad86c3e6d2e40e36ff431c4e8948fecfda69e69c37fa5bf50e","s":"0x6f6f99a1a5ad26324517a939980c8fa06bf5e10fabdf05dc24b56bbf538a75ad"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa31db6b92a29a6077a2d99a95945e097d02972830989a46868f52a696b9318a7","s":"0x442bed56943bc5fb75f6dd1694aa8ec6cfd5ac803e3c4746d6e6759a92073e31"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0xa4e10a3625ed18afc772e495fa50493414e4a9300ca2ab6a7ea9195c578c8e5a","s":"0x67a45755b00cff16b1dc3d774adc92e7a7c133182f743d60562d8d6e740e4694"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x1819ace82a1bed6ac538c4e1acf4946f67f4dada66b34435bf1c2abca6e0101c","s":"0x63ac6ecd8cd95fc7c2a41517f2051e2326ccb7a34fb67c3d6450dd1499146119"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x9a768545d76b1fbc94d5001941003532acc73151b5436ea0f56c91dc65a8bb36","s":"0x4804ce4c01a0a22ade14de7ed423ed809627e45b0c7ed29982bb75982f33c08b"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x19fc3f0ff88c9646cfd568b211d9116b58752cdf6f9c2087e201b31a269e6563","s":"0x12f1ec72b5dcfee614edd802ece0f6292fd491ae317330f70888c430258e200c"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x3b09cd8444c3274255c1d11feea2187f5288482fca2d869f9db1e995bce19e4a","s":"0x41d5422f3de36b63e992233cf4554a7314a46eed8c342f1a73731523ec126866"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x0","r":"0x95718f22bab2f658562f816cff3e7814b5fb10aff2875db9ca63c61c212868ce","s":"0x73c6082d04a77e3fa21e80861c0348b16d8f1020a58ac664401ca0d635ab1e12"},{"chainId":"0x1a5887710","address":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","nonce":"0x0","yParity":"0x1","r":"0x5f0b61b046465366383ecb0772a3c076f5fa66831e92576fda6cec37d3973433","s":"0x453255fe2422328cb222eaadb6fb1ba486df7dc404300f14dfd11f662467fb51"}],"v":"0x1","r":"0xaf4ada6b20e3279dd305cb53553e3241b7d59047b52e812a1696e854c4c375de","s":"0x2f9a95f298737aa73f3388565479f6f1fb7f4a55c6ab279b7a4e4d643acfae2c","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xc71914db9254a81a555383496715cc79cfd85e0c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x99687892078f793a426b27b24571b1e490c98c8b4ad79e0a37c82acfd4261aca","input":"0x","nonce":"0x124","to":"0xd004875a4a9f48a0bdd60e68cb0ec09d90eaf7a6","transactionIndex":"0x13","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x320407c470985065994edddd6fa944b9d581f01fa7e1b75bab6ee3b7cb119cf7","s":"0x5be32bfd1c9cd6942ca5194d7547acdd319c9795f1a85d4b457b9f81c85b2a97"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x6c9eb8b4ad58140dc7cf062c79cc74993c96ddaf96322e6596c1322b65237424","s":"0x25a82a103a030cf356e683dedc52f3afe0adee52b97385a01f4845b7a97a19a4"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf4aa6db0f2eac702055833f49c97ae455693dd8a90ed6805b4b594b7351cbc5e","s":"0x766bc678c6b2c7b7d6dc1cc33788de679806163a97d31b4bb65bf6251147e8eb"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xebdb7024a02ddb38103771ed44b07892479b709ebf8880f1b7071456864e6d0d","s":"0x5ece7846e1c56b1bfae65bdfa3e44fc15816147d4f59379061d16348302f072f"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0xf421c7edb2c13498d6157e2988fc11c6f5838fa1d815f7717c4460ea4699c00f","s":"0x418e2cfe0a05c477ef90e254e8a132e5065ab8846df78dc2ee45b57ecb536a0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x415f560e688c08fd8ac5fe38f2da732f23406957612ac78e53b3a70ef2fa3222","s":"0x6635b7aef3059288c79ee48198451d068d0ec68fb7c00ad5b867fd8b1a11824a"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xe8b3867ecd1a540dff4d3c2d2186c4e0ff9add68fd0b8798d9fb6572ab908acf","s":"0xf394bd92215e273e3d2596ed95c02069a7867f6760feaa6bda33d1dde8b5ff0"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0xcb3e476ba96de1b5f05591e07f0b3fe55af538d5384d75042725740a2fcd446c","s":"0x5b5d98417c9b330c92bb3dd4714b9a5986f801a1c0250982bfd7491e37453f05"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x0","r":"0x56b4dc02146296d589ec766964684c27d2ccf9f95d48bb74710d126c9dbc4bf8","s":"0x28bced0a64513e851e6f4d9e7b19676e7122d01fe0a9c714a5d3f1174a3fac0d"},{"chainId":"0x1a5887710","address":"0xc71914db9254a81a555383496715cc79cfd85e0c","nonce":"0x0","yParity":"0x1","r":"0x6b0283aaf57a0a4f6c1c86c463059218ea99c992b24b31e5129052db33ddd377","s":"0x586015e888050498bd77837597fcd2bc26716dc96b43e2f0e5c9e62058a775af"}],"v":"0x0","r":"0x3be3e48a873bb7805fd4e5b96ecfc72287b38741bee16a0c759e00c43b078a79","s":"0xf2dfd7f9c45465eaf2506b4c26a3667776e894e34196487cac907c1b6628bf6","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x73f245ceca5d8315aa2c7e2923322c106ed7858caa65336477bb10d47f316af5","input":"0x","nonce":"0x124","to":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","transactionIndex":"0x14","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x89a96807d2dfd02cbd0e23ae010e1a64c91e7ae3404e4122b917c09d9f091b2f","s":"0x19381b5a357c6209709e3c3cd9f91c2aff2536714d4ac0875b45ebb5a00585a5"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x46e0dcaece4a468b6b49092d3c0f9d1ff255d3d89ef1fb061d678a05b30dd1b8","s":"0x449469a344936350d3fdca8e73c33ef6c298bbe1f132c34900b7f55dc07a4794"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0x725e78a6fe75202ff0954f3c6d34295a6b42a8eefc8a63e5703b549ce9dd79f4","s":"0x4ae75a4e767fb13ddd3bd186d7253f44ce3131dabad0a32ebd92380c291c87d8"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x0","r":"0xdfb6d37617116343a43ad5128512e112586535203199abeea067c8d8954c8a4b","s":"0x3a9000a0036e86592f84ee42408a60adc022e4688eeced1c0542dbce8fce93cb"},{"chainId":"0x1a5887710","address":"0xbced26637afc642ca6b69093f9a1ef46aaae2748","nonce":"0x0","yParity":"0x1","r":"0x7768555593a0fca44b02e32c20d27118ab4644fca1402c0096937249589dbe66","s":"0x4a205eac4cf2d23f1532bd99845060bed18174c86909dfa7119bf15a0ead9049"}],"v":"0x1","r":"0x382bd0fe9fa3bee706ec591a885d129b41781d44566976fcf55e9c0e4a22f4f6","s":"0x2786048618bd61daa8dab3f029da729539557d65763bbaa542bc42cb05ae5082","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x758f6a2266ea552511d200a190f41c571cf5a2a6651ce6ff38efc83cb608a485","input":"0x","nonce":"0x124","to":"0x854c42e38402dc9db7557cfc061474f06925af45","transactionIndex":"0x15","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x55b56469bfc8538e70107d64166f4690020c139410e68e758880aa28c0a4b555","s":"0x47a8cc4aa3cf2d2b5194a1efc9a2bd4a4f111796f2e02c2f86db5e1e8690eee9"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2791ff79bfc80ccffc5e96f452f6c16acc593d9c3865e21edcb5c8aab85ffca","s":"0x2fd15f05ec11029bd2c1ab61452f3e91c9af07e48f73e5921a995f44706ee7a6"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x30f14b71619d5db2a1d929b36a3d265c759afde418c07a3b13f5ab0866684e7c","s":"0x2cf0d97ccad75624594729226399569cb9804b93c9df819771c319b3927dcd0b"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x70c0756e58985fa36e4ac189f87436cf78b50718a8fa24383ea7ef91a41c7bfa","s":"0x3f27f274095ca8f92fe90150d348fbc6e633a3d68dd18abb7b4370422ce0cf81"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x49ff88942859bf10ddc900b6af41d26e08e30b4892a1d0b2076a3813776c2ac5","s":"0x6f6da0be82e0571c3531b327a59cbd2416833b3bdcbe9787b47702905a1e1831"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x609629a392255c0b2efea27fd7dac1225acf90ab84fe20188ca629927dd4f5e0","s":"0x1eb44d5ddb185be3fc234bc3688803ab7bfbfbebab06dd4de8dc5b27549b8b33"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x2a39851f663a3a25cabfdea2f4a0b2eac146e10b547af46e1c271649cbc77c09","s":"0x558fa2fa34612e79d1f650169edc3a92d335ae3e08554cb288ba8548f3a9fa01"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x91ca20a029881e09978fe208327da5cf70e7ba7a1bd51a048ad239d13ee414a5","s":"0x5ed33f3484853154d83f4aa196f2aa3e00fa328497876bc366f3a26087b92a23"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x0","r":"0x2afb0afcc1bdceb28356328b97eac586219e1aea0c9b16663b3b7ef4a90409c8","s":"0x49a4a17185dec5620637ed4e9e077674bb18ac235179c3b7cf446afca14889b8"},{"chainId":"0x1a5887710","address":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","nonce":"0x0","yParity":"0x1","r":"0x7635ef846db79f5cbb38dafeec56753f983a1fc7133ba3283bac3853c38142d5","s":"0x337dd6e8374335f29e2adfac30899a696645fe57d4a8e760807e2c1fcb4efd16"}],"v":"0x1","r":"0x70131c98ba270fa4863e3f4f5d0ae1c0e014731bc45150aa09cd218b9deb5fd5","s":"0x20581b1fc9dd66ddfdfb79588b0eb0402c80f694d5b5f4a5c8aa8d7fa98cf3c1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xcbbfca7340dd1a6b71b5a2040c140ae3d7630cce91ccaab258f7777cdae75d0a","input":"0x","nonce":"0x124","to":"0x1d009de960092a8ba788d484fbee9b4c7438721d","transactionIndex":"0x16","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xb060c5729337941ed9a27f9b1e20e878a665bc01fabff173c163aacbfd7a7e0c","s":"0x4dac092758c1b42c2780a90b0948f8b1aac633fc0135a7f80391fe0dd662f39c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x35d3bd2259ab04e1907ab967adf3d4062a31ab05549393555acc01930599c18","s":"0x1d0f651a22da4844643c71707162a704b4eb97b035d1282b1e40dd6967fe11a7"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0xd48f3549fa0771dbdd10238eebcd2e76bc107b200cac9dcdc50e440955e6aa16","s":"0x3f04b7b29dfbf13ce692c569d9e98bc30debb75d8ae83bbce010fae62601109c"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x6bbb1c39fb37e82288404d4f53ef6e3bad2f636e466c612c28d4a6b666ffa3b7","s":"0x457b0b8efca84fca6c62464d0423a9cb5e18d9a6d1b5829f88211fe9935c8054"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x0","r":"0x15c27604821cb2a0260c1b6720c9c3a93b4110a94ba37594f7b04293eed29870","s":"0x738a7594ef98f69b1d4d7d92b2325192bfdb433b82fd773d7cce0ed682412792"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x23e061f90068473d0095d481750a129f359b781be3a5c52dfa98af387086b35c","s":"0x5c38467a5e2292f8f6431b49cef14ae9cf761b470f09270621d387b1f8706d2e"},{"chainId":"0x1a5887710","address":"0xb9b25a7c4ae5bf4e87eaa629c2e0d9cfd1b9da27","nonce":"0x0","yParity":"0x1","r":"0x4b8b533f543392692e33913a8e2c1a8d6e0ebaac06a420a96b809d3156fdb45f","s":"0x14e7a0733be1d6f6f2017bb070a4baf35d014771e31301deb3391e6a05716058"}],"v":"0x1","r":"0xcf6d6d78179088824d75956ad7d3b740de9313ecdb554226ef86d528453de0fe","s":"0xe0b87018b53952511d02310d42e7a8e4a30bc8580c3884464097a7a32fe54f9","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x34a8fac6801a269bae275edd41a65156289277edc84a48defb95349893fd8c16","input":"0x","nonce":"0x124","to":"0xdc1d1eab440b44fe6f1b5edee367f3cb07202e29","transactionIndex":"0x17","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x11045048c570b159e6205736b45d23fd82fa53eec296c9efecc8771084500af9","s":"0x8cd193e1e0175c2cd23c87650b1c608ab141fd5d3a25d344a898a90e35ce844"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x1ec3007c0c169fa24b41b5cdffe14c25713971b5a896b3c2722a629582b135f3","s":"0x8a27cbeb4fefaf03f28ba1552c32ce1f8ff3e82130efec0f98804e725d0984c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xc6a21bec802d5b4c05ff17268d7341f066120a8d4ba9b7e4c95803dcd2d1fbf9","s":"0x6b0ee67a03b3474bcd020ab07aa3f2899f049ce4906a417be7393bfcb0fd452b"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xf53521cc7a9c0ad92c570d78594ca1a04682b4067611e0d8e814a94e120f5a35","s":"0x67bff6a9e0f279c88f29bcba610ca0ef907e459fd1152839deeb7cf99514fa2c"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x827844d978fb2afb8322e03f031943140a9906ff6246d189a08661cf6089fce1","s":"0x2f56de9af01b41913ff3cce23c02fd04ffe0bbe090494bc12ee21f3d5c60bf8a"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0x4fc47a88ca11fac3305afa574a17e709ce0cbaa242aa8407beaca8e4a727d5d8","s":"0x3ec68ebb5bd8bfbc1aca853c158e217bdeab5290d99c9dff604a39e82d6a8fb9"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x63827a86a80720bc7ac2a79216da4482272d3f72e7d276b07ec72e0b1904dca7","s":"0x67952954c83a1bc76f891975c4db612e3390abf43039b5ca35f70fbf6428a5b3"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x1","r":"0xb50cab6629c4e355b99f81524f72eb9c2c83bcce600b8bb389bb97cb993ae293","s":"0x24198e35814fbdd30cea47db661f5f5ce6ff2cf360c0cf163808a39a5e7b7fa4"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0x21bea6ce5d680e37dd9ead2c5febf0b0739cdac22c770d6d0cbed769548e71c5","s":"0x50154a3c529f679e57085e9f5d7ae160b6c9ba25943f3becedd158dd460a9891"},{"chainId":"0x1a5887710","address":"0xa8dad6c85f8f6b037c79ec06159546bc3ec12fa2","nonce":"0x0","yParity":"0x0","r":"0xe9850bbd20f0d9fae42b24e104ef211957f7248524a60d7870c8e59dcbac019d","s":"0x17b98abca63a7c95478037d5e87958a87031d14285469b2cf6df54e5c07806ca"}],"v":"0x0","r":"0x72f4f42473bb135569413dd477893a2c56199a58e95b4d02998506ee81ade52d","s":"0x250ded7b0dee527212736239eb6838f9b7eece5d1a39e3f9249140608e2178f7","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xa297e3e19b9f0c10a353e31027c8812524866b17","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x9092064fbe87759718b5dd9b414e65f7e92afb1de022b7da5d4aef5b68ca6b71","input":"0x","nonce":"0x124","to":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","transactionIndex":"0x18","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xf95df0c73a155c4c9cdabeaba002e43a0de56b9c655a43bc74a0e4cc6c11faa4","s":"0x6635c92f8645f01ab004f15ffbab2bbc9b9bd761f821fc7ee067d8c3a5e088a2"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x3162c53b547a316b472b441b77853b7a2dde7dd16051dfe0f4de773ce2cd3078","s":"0x568c20dddf284fd60e0f4dd3243a0a7a5ba763d89ae4b4a18f2df89b7d474927"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xff79d0bb1759104996bd8f586f7d3e3b0f424cf94ce4da70b80fd7a3a4c3970f","s":"0x21187958d69221908a5a2792ce59b5fbb34c593d8730623ecffd86414067b9b9"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x6a27c1e029c59fa3e2eee98114709315b44befe4361db8adf61670c0e6defdd7","s":"0x2ffc8a9d65d881aa0b370dd2782df700a2e7ee4077c7ff002e69376c755bcd13"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x6adcf3f467773dcc4e23be7293d2e89c38c7b5f56ce040c33e3dea328a54cb44","s":"0x467bb825b2a3ce44db986bd3f068dce0cd336bc298fcf6a8bbe55b0678e3ec36"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x4dc925276dbc1309d2cb664c95ee59550146b912439082930b6f3c51545487fb","s":"0x6545589fabe7e2cee44f740f78455f22182d9040b8e6d95d417177588d5fab2a"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xa7a50488500575591b08e7bec3eea67adefbd741cf178b0cc962abbbff528721","s":"0x2cfd4276113884fd5d285ed35ba4fbec4850e8893b09eb4a8536bc27b4758d0c"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x1b27d6b28ae2c41491ed28c233020492563e471834f611e4331d5dd950411754","s":"0x616eec96d984b0ba84e2b913010cf5a342fb501f7571c7cc2849d8b098100ba6"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x9084b96d63fca2d2eac1ec0f9ddf7302d3d491515fb5e1467c306e26da023b2c","s":"0x63bc43aaf840651f78dd9a11f07d9ca73b8b8664c2a7149a895c73141e50286e"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x803fb41064501ff53d117444112314a22c0b5fc6655754bc0a393ddc639bb422","s":"0x3d7691bd669f88ae4abd82f989bae0624fe049abf2ebf324882cdf46ca14c128"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x2a1c8950b0942969d6274a298178fe7299bdf1e43ca32023300e4b82c25e6b35","s":"0x36e09a984e53bd5d3e79e77207bdae71de144ad41388793d36768f7aa90a79db"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0x89907f93c072770bfa2fcb543dc09eaf8bc4c63959fe095f93fd2232ec96feb9","s":"0x6e8445a7bc4cc0c6c2195414e59401fafa9a23d475da09826786df3ef736da6b"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0xc964fb00d980652c5517435dfc32172f04cd31117f6e7f8cced279793de0944a","s":"0x3c0820e869fda57c1727b52f1c0481416d43f009decbd56a2b43f32b035d1ae5"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x1","r":"0x3713c2e2fc71f43b511e6896569df9356dbfde72538f1370bbf608491bd0b89b","s":"0x15ed2b8b4018e84f0d5144aae98e5577395db8290c68eb33efb3656d08b7d082"},{"chainId":"0x1a5887710","address":"0xa297e3e19b9f0c10a353e31027c8812524866b17","nonce":"0x0","yParity":"0x0","r":"0xdb2edeab7f9ec59315b17a0620b61800bdbdb31412c37d1f386436d83c441220","s":"0x734d62dc03bb6b96f072230831113463a1762c5298267c60195ae73e0a3dafb3"}],"v":"0x0","r":"0x86303c179da28981cc8b2b71b2f7b3ba53d8a834e35389c77d196e67d4ab8fcb","s":"0x6a595915136497983bac95329a6713f6b47ad4b9526b6b4c601e76a63c354113","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x0fed73b4d94982b8b359c1d07451da7bb9cde23c61c8dc6dac17a4a69cc0bb45","input":"0x","nonce":"0x124","to":"0xbb6e63008fad34c4582e08b3c82b3b163ece4dfc","transactionIndex":"0x19","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x9d1da0ad529a5dfcf455da954a2e821eeee9b5d347709270dae1e77a8530bb30","s":"0x57472a551c898810ea06b2224e2623ad23b4989798638254af51a894a269f754"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xae62bc1ce0906c1646c34d27b67131c9e8c9d13d62868d2c16585124aaf74b75","s":"0x66782605564f5fd733d9cdec0b47398bc2feacd4ff6c054ba818941ae24b21f9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb292ff047a54cf1153bdf366cea5875dc74a5494e757186e2bca5900c2644eb5","s":"0x62f227ae65d83164ab11c565cc27bb582f592cefdb2965919d5c02df2b8a2172"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x8465235072ba03983403cec4a784336e9a24cbb8401424557d7640ed33ebee1b","s":"0x6ca7c6ae7dd46e39272d5d361840f652140b7f008bb52c0bb7f292369f2f46b9"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x2044d412d537750249ca8a5266590d38a180adc699cdd3a43ea0951f5491dd36","s":"0x29690397d40990bfb60ade2173e2310bb6fe6e0cdb3c87830a30b52d03bed44d"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xf409d31b36d8c2441b05b67a2e91c611b7d9e18a9a4f1361380a00ca8b240b0d","s":"0x69a1628bff55eb1be2134c8f300c922169af39a1dd7d8d6eadd89b78c12bf1f"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x393f7ece3db7f06b55d071803728c462026fb7f74ea8f42572049af82a91dded","s":"0x18ab0e87de56a71596db0a2ff5efda91fdad6dc4085b21eb8488c3c621622c81"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0xb01ad4eed1e05cdca0fb1d5092b21843440dcd8d4077312ab2a8ea7c5986c540","s":"0x175fc8f02114bc5e67002ef82a218f5620e31643ece8c6f44cea0740ffe0833"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xed5df724441bf0d70735f5608f666d6e473f4d387fe25a282130cbe1dcb6886c","s":"0x636ec1e68260f5180b18c26c5459cc402c63526f34ea852e6dd93ee7e4b6b55"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x1436af905af2c3b9093c67758854cde16635a8c07c7c0bc86951f05f5450ce13","s":"0x6cbe0dbe7a53d9a2b115f192169b13681d7bf6df4f4802e44f832ed6a210b3af"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0xcb81c9939ae637b0a4a10ce372f9c73967007badcbc80c3f2ac714914cd18bf8","s":"0x646fb5c03c018f20e54ab6104f98f4b0360610acc4b2c4911b507259d2bc6a88"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x111b0975176a92fe13e02c501d384a7c9d26ebec09d1e3b1eb10af788ef61483","s":"0x1c317e24a97c03bef64e70babb8900e0e2dbbadedf0111040c74058a9bb4c389"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x1","r":"0x6979ad66c764cee9983c766d9afc52b7c0a0aafddbdcd8dfd1368d8ef97ae098","s":"0x4769f289ad6396d212204433b77e332a682c39472e5919450cf7a7ccabd06b9a"},{"chainId":"0x1a5887710","address":"0x9e6708a9d3dfd500d8cae5e95614b37ce11517d7","nonce":"0x0","yParity":"0x0","r":"0x55260a853d700eb2957db104c82892b2b09b6a8040492db769d7da5294ffce68","s":"0x10c7cdcf3985226a92485624ac710f820bc6173f08c67111ea5a0e34ea098d9e"}],"v":"0x1","r":"0x1ec8113238a869aa8d2ca8ecadeb58acdd29ead429e6de1f39392ffb0dfb1ad2","s":"0x6c1ed1fbda0b5c858749d90bd9da1f59de34c893037402bf92e2f707e6a7f3e1","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x854c42e38402dc9db7557cfc061474f06925af45","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x597f6b5e6febb2ad78bbcdd22016396b2890352c241dfe18509661fb4c5f77cd","input":"0x","nonce":"0x124","to":"0x5b961ed8c7ccba36cb021b92de589e96534bee50","transactionIndex":"0x1a","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0x5f81f09d2e53e38384c29fd9e08833b8d208a228e68a6218565f95310fef0f17","s":"0x2d840d00f2a6a31ee1f06323174d19634b77e4afd12468d9c5ce2927a772d34d"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xacc3ead55c5fae9ed9ed4cda995539a0f51ba51b7265307dda7bc8992735b496","s":"0x365dd298b82195e80f2556dd56137954b4f01aeb8a2499718b38dd672c7a74b1"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xf4dbbbbaae759616a1538b68d9c58a0bb60de9d00c897c153d735e4fd441e1c2","s":"0x61328d974c4e289ca63701999892b027be036822c140d109d52af31165f6f9e7"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x1","r":"0xe13c2d7f15307c7a546e4be770eb6306d3d059ceb94a1e30abf32d7ded0779eb","s":"0x52059cc2081c0cd3ff0669efff3054470ce19471a1a88639c7d833be767d025b"},{"chainId":"0x1a5887710","address":"0x854c42e38402dc9db7557cfc061474f06925af45","nonce":"0x0","yParity":"0x0","r":"0xcdb24c124d43d5630f39fc4c25e4007559c50ef5b8bd7c9c550e4df2c3fe4488","s":"0x75a040a060b76e03c15fb3978f350f201fb81101763a219e37b7ff35e46c7fcf"}],"v":"0x1","r":"0x7645d5e55bba9acf3d6e5c70390f8a939d8bbbd9bb30c23653674b120d6c6343","s":"0x5c95c89680bf9d5ba69c756077995628f0523ae3433d5f7942cbc07de4ddcb45","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x39c49544946c502049fd63e4f7217fbdd2a2c224a41d5a65145dbf2248f4d323","input":"0x","nonce":"0x124","to":"0x3964a047c6a19c9605b2f89faa1900d98ac3108c","transactionIndex":"0x1b","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x1ed240dcc6f6afa02ca576cf7123bc12019f889e55dddaeacbffa6c36a0ed7e4","s":"0x430842fcf01cb761e30ed3a9fe3ee3b213e4eac055a65de447e65d5e96d61227"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xd6822962567256c48342a686fc6b03c53a76c5f9f66f66d9b4e982cfba6b26c7","s":"0x124b8d69ef2a9d1283f4408a4e6dd5efd81cf3720fd3d62d6e9e3740766655cd"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xde59e4c2b13c14e67fc098d2f7cd31d29586807ad62c12d4461dc54cf02a71b7","s":"0x74b6b7faa6798fa9fba36f9c1c604fa34f5a2ac760bb044737541dea14649a02"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8addc1ff8e9ba9c2785f1174ca4e7252d1e4a49ecbe7de2ec30e7a3f059118b4","s":"0x7596f0d219135b721594284944fe69bd8aea45ce6423c88db8ad92b1923fb7d2"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x33ac67330b1486808978d5c62a69f807092d60dbe45227939fa23ab907f328fe","s":"0x7a8f6a8faa33011ab8b4f0c883566d7187c0c7a7c7af7771e799ebcb49860406"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc940c8e817544b11f13c442f0b09bca2f874dbe2965070a3621eccdb049ccc28","s":"0x2097a1d5cb803c40047013cb950c6a806323c14674f31015a36131e9b917349e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x904bd058e6ecd2b3d5b28ebe3cc93a01735ff923ddba48050a3140063ce83899","s":"0x55dd12d04531512adb8b4c71990b18759e6eb516df8d64f420de30c166060881"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x785abd7f946005c85c2ef2b10d600c17352675055788c11ad583a2657ba35c0b","s":"0x21f53dd9a3572a8094171d7175a815d5077c5b03041ef7cd3a894482098b03ef"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x441ac343e3fccc2272aa5647d11c2bdb073123d040968d24f87f09d349a300f6","s":"0x464358e128ae12cb8c8af4e0374ed5bf7d4deb683192e1432541db2a7f24d8f"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0xc00b6af3e06ac8ddb68a611e582117c3cdd63592c4f183105bb092ab6a1c216f","s":"0x1a44d0785dc8cb7ffe5bd093b4dfc8b7d3ac680f783a55dec676ba91e1d4fe8a"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x8a22cfe42dca09d25149e71491bb3c847967ded6ed15f366bae49e9d7e53f869","s":"0x48bb749b521bf05b0fc1a6e8fdf8dd8fd648108f8561d76241eb192ce9026e6d"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x1","r":"0x9f8e4a394f9016a6d35385b77ffa2a290f0b60bc62486f433362a4bb98d41ae4","s":"0x1102b36ad4a049149bf1d19d2717a550b058a6d198da97e815fadb2335545d2e"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x521303def7981b14d2fd2b163c3154d8da9abceb3ec6a04ef8475bd1e0822eda","s":"0xaeaab37fe9d16e73547c3331b26a675d5e1452241fa6c6e8ee535bd5b78ec74"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x7784de6fb17f6f5f115b534d71d1b79f492cb5b56ce4f9be908efe39c5af17e7","s":"0x66612018b830550a561463277f4e66527d2b1e744d9d7ad582528c0a4aae054b"},{"chainId":"0x1a5887710","address":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","nonce":"0x0","yParity":"0x0","r":"0x6e1d1d83f7a57536269d84706088c938c0d1c1c7884beec87b7ef9b172ca3b8d","s":"0x35ad2651dd814f3c148fc7f32b860113bf2840976966ddd717e4f6fca327fc8d"}],"v":"0x1","r":"0xcc309e6da75a4df39795191d385aefe548f64ba62dba0616e0150e74f575ce8a","s":"0x32dd4d854ab47e5205fcb05756bfb768148845673be8bc4aa45c2b5645254402","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xd9ed58ee5bc043335f498650333e7d0cb0a0203c0cd5668ec6caabc44a349132","input":"0x","nonce":"0x124","to":"0x82f1b883807036f654dd6ec3bb75f89e6c517f40","transactionIndex":"0x1c","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x639bcbb37acd4798702d1dcea48bd7dfdef1a8d1378c0226f82aa6ae8aeb0d6a","s":"0x55ff1a94f32acde65a6222e3268b1526e88967e1b14f4e1003b92f3a6c03c755"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0x3b74df05c774ceb5870a60d88a11aecc0afb7bd55ff6b91e9e080f4481d6d893","s":"0x4bc7c2215cca20635d9251a9e55098c5e61a6b92a20c1556d4afc1ac63b292dc"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xa79376ac67d5604c0bd127b6f3b9e8a1a93489b3847abdf68b9189ecc4cf5bb5","s":"0x51953ddfdfe7655799924e7e58cd7f293880ea0d04f5981221d2630338c38b20"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0x9fc014d9f71645b7e52ea1f1e9859b61b110d6bb61230a7365f7b1306b9cad39","s":"0xf410ef618aad2f1d2a05464eb2260adbc7952061b5328f460c50005e92eb4a"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x0","r":"0xf48afbe3f1c1dfa9369940b75f10c1cfd3542da3f69adb703c809bafa1e892c4","s":"0x6df3616b09d7ba3e25a8f56ff22d2f93a218ce819e610dc4aaa5307b372e2bd4"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xcf412a533c119271052e1de3aedb7a9f00c8d944ff2320b03ed4813f635ebe11","s":"0x53969863aa3f847c5febea8474c60bdd79d255ddf7b5b2d32b12309f522b3d24"},{"chainId":"0x1a5887710","address":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","nonce":"0x0","yParity":"0x1","r":"0xfdcc83cfa24d123615c9b30b4ba883b3606a305bc063861a0dedbc1e570de461","s":"0x4bea3572d7076226ec55b042bae76f3e8ef9f66deb40e13d6bc9019094a83f7f"}],"v":"0x1","r":"0xb3c97db67122be5011d51eba4289871cd4dbe5f0252e96b1c07edeaf43b4485a","s":"0x4303b1df2ab458f1fb76a296e9fea4453f1643c80906ccbbb4f1552f968a9dd0","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5e3ef44a9a721e7bbe61a5913e0a0e5ab6655bfbfdd005ade79e9623298a9cff","input":"0x","nonce":"0x124","to":"0xc71914db9254a81a555383496715cc79cfd85e0c","transactionIndex":"0x1d","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfc9fec1b3cc9ec8b8bc386154af2c4774d2127fbcb65960701c9b4fbc1fe29d","s":"0x5c9f12fa5dbfdad0c6a164b25a07c5ee12a84d7a4d57233a64319f392eecf6dd"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x7e06432dc4da4fe86ea629ed6adf35c402fac553f34764d6abf366b50b599496","s":"0x26aafacbffe2e2d6e522ebdd2e67d9414e96cdd7b643fa976ea197fa1340ee7a"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xfc3bee6912545b3f5c5c2a799fa8cda2ba57d9fbe671c2fb7ffd82c58880f424","s":"0x18dd846a978d8ed025c3c82eed83e87599699d9c1791a3fb51d6241b008bc6d4"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0x4c7e13757f606286bc88538e737bb213837ee1090b84b48634f67ca5b24e7fd1","s":"0x7d68cd6e06730e40cf4feb86c7912460e68a30f85606f147b77e0f6556b48c83"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xb709bfd0fbe5082811f48d3fdd0f19c8c9f2479acb077bc925b7226ede49e2cf","s":"0x73715cf4159caf76d044c415976702378f3bd210d961a34a97d701392068faec"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0x3daac9f5f5d6a831ec7914a0145e9ed50e1b40d04c757bb1f7be9c36d687a9c3","s":"0x40b56d444ed74d3c6630c81e138f8deabc0079fe07095f96774986bf66d2f50b"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x0","r":"0xfeff58fa33af0c2080f005c10ffb2808186f65a6dd6cb309ed7493afcf8c4c8","s":"0xc539ccd83955545cfa6a6d3d5641bcfb9be5d6f7c470bdea3e5072994c93de2"},{"chainId":"0x1a5887710","address":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","nonce":"0x0","yParity":"0x1","r":"0xeaf90ef23b69b86b711b8bf3fe4aadee523bde275bafb61532a387ab6e36bf83","s":"0x4831fb9da21a53c8f1468ee3f2f52a80e215f8fecbdcbecd2f265cd4f251beb4"}],"v":"0x1","r":"0x27dffa1aa6883d71266ad01305ea7e7eb1b48109e399d6e078b815a0f5668a21","s":"0x42307aea0c7814785df08789fce006dc7ccf3199a05cde3764820b9b9a63339d","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xda089537370f75401a128af4945c9e483752359be57be9d2a1356d5acd61c7ae","input":"0x","nonce":"0x124","to":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","transactionIndex":"0x1e","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x6f0cc48d3c23612ba20d7d827109cc85ecdfdd2c","nonce":"0x0","yParity":"0x1","r":"0x6d293ccb516bf68ed7cff2cab88e814966dd6f6ff36edd714d375fc24546aeba","s":"0x63370c927d6d079139328ea35d674929ab9570ef1bbf68bfa1e9be997a6b0253"}],"v":"0x1","r":"0xc23ff262f8b5c75f6c27b2799ed066aa75d27d69906a40bf5a2e10c0d669ed7f","s":"0x759cfc38fb81281477014189e19f9f165f82a20e56c83f2813ef8e20a744b867","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x96ab4d97881732824d2ad894dacc17a547b2407b7a408e13196f52f4c32c43f9","input":"0x","nonce":"0x124","to":"0x2ff49a62caa9f1fcfe520a8c963b0786583900c3","transactionIndex":"0x1f","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xe83bdf2111be9cf5ada368d13a9182ea6ccae4c92f3bffa560f51c3d3a930171","s":"0x133c0fb709b2e0e5ac59ed5e7229e26a1dbc94f000dab468f98f83c5bcec40f8"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x3635db7b4d54e442ddf0b6311676ce1b4b8cbd059d85f5cd5a177fcf8f8b6ec4","s":"0x1d84e3220067a61ed13b40021ea6b5d002bf38481ede5baa7d48b9c83c5c9708"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x14983e7e498756f561b7c224e03a0a20772050fce83c23ffb8d9f8dd375648b6","s":"0x220267959973e61181dfa9660a82c1d6fd51246406dacf6ab7754d5e80b6413a"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x78827c5f3a34ea9117e33b8618d6fcd869e62fefb88d8f0c76e1f292acf39291","s":"0x73f3e8117aca4288650d6f367106e3edd92f0cc5abad9a2037580e0bcc40887d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xd2b72d68a38c523cf8a2634be33d82f9f5e2591dacc9aa272fae13a4abb5c6c","s":"0x34dd1c56261ee45df20c9d0af4ad5badb9bebfb41bdfb28b19114cb9951f09f4"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc859b5e259d31cbb9a2221dd64e0012da2f45221e1f1c012904604117ab825db","s":"0x40b07e9a173cff09393afae16cda7e3d9d2ce0b8b02599f9dae50a87b5be0f00"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x104e2108d6f5ee9434839a11663c0dab3d6e0f5f8e85f4a5639e0126db91b9d5","s":"0x6049a338cb934f54dd44d87244d727812a3395ff632033e096f5af40183526a9"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0x207114f9ced1345c5be18842807a9286d9c3d25e5016671e007774b611223c65","s":"0x6b73d1d7d135404fd5bb179ff264539858c9b9dffc38035f18684604f3f7849d"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xb07bd17e0787523b5dfa0d87da640440eeddf05972c4322eca921f41de206b0","s":"0x73ddd28e019bf7d46150e6f49d013169cd52c4155c3924c84f21272b493c39ad"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xc7740ea10bb19ff26be23f54d4f6e7557e1b3d2a0e4888c8bc1c72ca91fc14c9","s":"0x2478f830f47050c9a6ae80858762762c70604768a25108047c4937f237d13ae6"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xafd9ed8f3c99596160870a32a551b73fccee63bbe87b2c395777632814fef87e","s":"0x33daac653723a6dd377b03cea0be21b0992b21ebf628a5eaf69ad09a2b61b299"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xfba3146333b5fe1494b4d736be1d77280d7c251843f33298f50f6007d82524f5","s":"0x5b10ba35b11c78805a754491db1db0f6a26fd88f5c79c0652224f47e3ece6bab"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xdff137a6620e6cd1fe2a9a872f8b209af8f2ec52d8a755c6b5ec658b531ebcdd","s":"0x28a032cdace93183789604d878cd057fd4dee8c0d01a99753cd70898843c8291"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0xe772f7a5537803eacc0de4f4f19c2edd94eda1a484cb08421da821334c2ed029","s":"0xbfb2709166e460c9d00352250507422cb3e358d5c4bdc90c377293f8425104b"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x0","r":"0xa29c20d9ad2e3a3fbb28496b6ed573927f9503411716c25c5c0eb4582028299d","s":"0x1ab3de5aec82eabbfb7ae8ccb6dc552eb6c08f86eb4e19a2f698e22c93a6dcf5"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x46147301e5ac0bf4ad4c0114c853be2d3882db0a32d62e5162b913f5c4855df2","s":"0x389fa25d3f272da262be47702f5a8b19c9dccf22fd61590bab2ec37a902dcfa"},{"chainId":"0x1a5887710","address":"0x647e50b5ffb1ea9e410becc1e6c9fc0932762979","nonce":"0x0","yParity":"0x1","r":"0x54314bdd6792e2bc2d1a0c5fc00d6abc73fd4ac2b76100f7f6c3d3707bf39667","s":"0x250f24209069b9c6cbb6098b608ce7c61530370b922c2fa5ebbfb9c0dbc3255c"}],"v":"0x0","r":"0xb5a0ef06b1645b2df6e7deb53591dc306e056e64755d7d01c4a601c4ed636298","s":"0x58606ac4f981eb780687ab1e7ba25371cea0bb48b6f2eac32563c85f62a9e7b0","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x5186ac1b71b7eb0ac92d756d1983b20c3f9de7564a7e96bebc3fb1d79e13cc3b","input":"0x","nonce":"0x124","to":"0x293f2460cebdc5de195af3edc173e5d42b438532","transactionIndex":"0x20","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xd5c27e980b8a1ab07cbe2739badbdba6e7c56c6c8d07aa2120c74c6de6c7cb4b","s":"0x19daa3215e05d602cec58953087b0fcd918a67ef1f3e43e8bece598235a95ee6"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xb15f9ab1b23af74b7d106108f77dd476595309525ed260de3794466257ed5aba","s":"0x17da86d18abf4951027e2848a58a28da1fd568614e0da442f441b73ceaa07882"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0xef22115063dfb780b9bb74afbd3cfc1a986f4a7f49786d1aa8cfbc88ced04f9e","s":"0x5d32e0d218b19bc68037b21a3694fd96cbd0c3ffe419d8d0f12e07933eaab0cd"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x96b21f3a0d6ca839728334af7c63e629e7ef3dd4273b45b19c87d576fff3afa","s":"0x3fd7a11b7ca3a8bf788b019021957371c7c76fdb5f88ab0b39cc2f8e750820b9"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x0","r":"0x53f02d528948075781f2ec67d1dd250ae98562276be499815d7cb1b83465f90d","s":"0x724f85cbba27c80b75036dccc9ea6a805967fea7a0a6ecd0a44ddcd2a8ea5d40"},{"chainId":"0x1a5887710","address":"0x635de8a69fcd8c7bf27f24fcf1c19c489d936780","nonce":"0x0","yParity":"0x1","r":"0xb5b495ce281fa7fef320eb9e8c08bc8109a2a8074d21f875a412345e62407ae","s":"0x3ae22244776c301faa965c23d129e657414bdfeb256c1fca85fd34b792e17fa6"}],"v":"0x0","r":"0x74b60296bbc5cc92ec0a9ffe02a5f2dbf11f09e94da0e3ae63668e0165bfcde9","s":"0x24c588a57cca4a6c509db9488ff17542747245366c84e8d1c2c691d2a8396b81","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xbd789003b95829d7c77c2693e1040a564844da28bb1e918b1d07d35a3d7878bf","input":"0x","nonce":"0x124","to":"0x79bab103e61fa6fe0e48ff21a9f5cd81f9616a8d","transactionIndex":"0x21","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x48826f0d31c55d4a1503a8c5231d2b1df8a61af32b4867b786d87825eedd505","s":"0x7b824aca70c8d966fde0b84d476a2b9fa7dcfd8fac392f53fe50e38ea4c73e61"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x461d5f259bf0a2370455053d26ec36a99a6b5dee48da563e90ff774f0bc9d3d2","s":"0x19a53538afdbdce3536387800cbe04078bb689295bbbe7e234d7b87fce613f14"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0x67d3a60bc88b9892d3b6252f7715cffba4c50aba131cab5fa268ef1ebc490f7d","s":"0x79980999bb402d451a3cf90641e8e8bc79dda5d96833692ead85fcd253de8e23"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x9516a5d7a14931f0070036cc0681017f9801857a7cf5f97e7b87e3f9dfac9edd","s":"0x3abb63aa54907af22fd642184cba1b02bc05722c23ffe6b9c71edb63566bbe3a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x381a81298ddd37b8bbf70291d30da343a6dc8f3743d909b95de85499276748b0","s":"0x771e67447d7886c5e2f1a13c89233b895a234d71e284124e44f93d390afd0566"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xa4a689358968d7d8a7e5aeec98f37069cda073066f1a1273db00fc90e3145519","s":"0x6b9c302206b7a8cab87bc5d4136d988769180a9d9eccb4dc406204ea55a40842"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x2cac5ff558e2706350ff9b70bcb28fc6970dd8632e5103b6fe5e2553fef8b3b8","s":"0x5b5fb6a3a66be0b4c68927f1cc8d1ac52559c5b7f01c038c3db5302853720a9a"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x862c9f862bef2a22bd3677eee58b47ab44f78431d7a8b0b288a5f755df7ce75e","s":"0x10d09f9abbe644ade0db8403b0b1e0cc1a856f1cc9a60eb620df6c3ef3ecc24"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6729c399e6ac51ba508145120653ff6ce66c07b516eba42037a47b02f863e7a8","s":"0x285393c657918ff81ebf4b72edb5be5f903f8e3e22c2d5a4eba85729051fe210"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x4fa026d167a7b7938bcc93151fb9318b2b74ad6a5b87501481727e00c0660687","s":"0x2bd343889ac377e4ddd92bdf20ba883bf3b93a5338ae13caae748f604076c9e5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xc06ae7e692b7b84db56887d6eb9edac7365aef64c18bdd33adee55ab4749dbd","s":"0x7840167e29e0e035b5a25eb49c4cff21ec776038acb812c0c2bbb779e62c3333"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x0","r":"0xd7fca9269c950334cfb87a3393d107784452056ebefe4bb1d937e80a87e5d373","s":"0x225c11d044676c4613cee9c141846bf431cd4f60d92d8ab976030698f9d75585"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0x6495fec7a9e354caeea2bca6874e0cc56c988a310cb791594c2e2cb12a78b6f6","s":"0x6beba6fdb25be4341a98e9aa7853d349efd1f6c1719fd85d786a8249263e9ce5"},{"chainId":"0x1a5887710","address":"0x2ba33a3e8f8a97ba96cfb679e2540dcba0eb5b02","nonce":"0x0","yParity":"0x1","r":"0xbc1f7fc16bfb89fe2fa0b9986d2ada8d1b0274879159b3145789150a156857dc","s":"0x5512237416179e5708ab7303244d072d6a69e31062ba977a21c6c78e7744868d"}],"v":"0x0","r":"0x8c32440547bd878d19978cc4400546e89c4aed37c6ad127cb2ce5e4210aaeb33","s":"0x77651f2cb2e62806d9e243d66656a615a4c6dc5e6c683d6b63a218294041223d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x293f2460cebdc5de195af3edc173e5d42b438532","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x71aa110108e9012a9ef1e54ebba79d4d508fef72b1cf4b4e31b5fa5201832d4e","input":"0x","nonce":"0x124","to":"0x40faebfb743b08d94014bb39a275f5692ba4d9f8","transactionIndex":"0x22","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x1425c0009ff73833611e17204e73816643918800806efbb01f9596285efcbbe7","s":"0x715e0711aebe77d2f7948f21d7a963399ed76df375be44272a08744c8059a298"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xb3bebdd82f299e58aa38522074bae88bcdcfde09b6e6961d79d44a61a3aad9f8","s":"0x12ab97610fef8dec7f390a3f731717d05a6d0b214e4493113d8bc79443ca16b1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x5ceab90370918652b1d148d48274b0d5280f3e4c645d15e4ba3c29ec6f28e357","s":"0x108b0a345d460e0c9d3b152b38ba8881c2ad56aa46fc611819a2d233995e236e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x7d0ad926428abb3c9bd11c4f5e41695201739b35abbe3a689a82a77fb13231d5","s":"0x541a4af7fe44fef23e122e8ee2ee835fca31ca4f6e3c1634a575fbbab4ffe96a"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x2c4257492d50ad959cb660f2e1eda9ddfba313173c2e28cd20b298658e2aa72b","s":"0x37f1abe85b6732baa9e22ebc93af3373dac2cf4ee8d5c0df9cfbe88ef56bc821"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xf9e2aa0d77d4ea79587113fd08656723fbc6891ead834a4b758aae6b7cbf90f4","s":"0x7467c7714f1bbeb2749b6741359cc3c8f2a9f1c7e24bea4e1db811d6e9d2a092"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x77f16aea7405d9ecda17c77bc4b831295f1929639683a32a13b981be2a2afb84","s":"0x6216f87bbbb43e8e45ab1582e7e82ebd2f6996a659f985b95672cab0cbead5ba"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x9832a1c1b5195ceaf4b9ad237121204d99a4811e9007a2200b1e82ff266cca4","s":"0x7a378c113e6a3d86652103b97c4d2a65575d1aade14067d86dafe2e77bbd25b7"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x92c331e4f15b86ccdf1788aff42e6800ad7ca562161e0b2f2d031aca364a52a0","s":"0x33b895dc06d806112756fa89dcbd58e5960896abb031413b4ba6f1f4a85b2892"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x47e985611e3901aad5fd6b8a2c3e3a5c910798799ea87080fc2236c9d49218f5","s":"0xb20550130cb20b73b3606431d70327956aece01702c54fdaa4363858cf053f3"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xc9aa6bcd05029439508d9da688253f61455e50530737b55c9be96d3da3ebbc5a","s":"0x3767a9cb915b9e2d5ee1d8b4367c6d4a7e136515de72ab5f3660a0b9f3e00c27"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0xd5cd750c5293efa983c056a6a81a7a129fba4ec6d47bce3803e56a204e174e54","s":"0x15ccdd950db4abf3ac309b450224d1dce2ff9bc789de2f8d353045afd6be6234"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x6c7ca4936db83c03654a1049bb791c542794b4e41c6c5e67bf43236a71535833","s":"0x29b912714cef0785325f3bc374ac9e78b5434f462495e6a2d4d281147e134aa9"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xd52242b6c0a2b3d383361d1ad43c38807650664a8c683c4bab44c1f6328fea57","s":"0x271b119cebfaaf9564e273b36e56b18f280deb1d50ef08a1285d63eb631622f1"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0xe79170300d94b552137128485b379af15b014e80064cf84bfec9392c25c63e","s":"0x53c77b423edb0ee41ed7ed59f751f423f7c2f0a1661b6fdcd1c9f9837510199e"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x0","r":"0x91bb9192cd43729472d43a9506744052cd645ab21bcca454cbde893f6b3f19e1","s":"0x200eb8ff4e7e09bb713140b3f67edababe9d6ed59a928f385b72b2c60a03a032"},{"chainId":"0x1a5887710","address":"0x293f2460cebdc5de195af3edc173e5d42b438532","nonce":"0x0","yParity":"0x1","r":"0x49a37bb268043bce2c6b8d3b50d6f715059cf63073bae5b92dee918f17404531","s":"0x3b3a44a1c218f26c57e71c1c14b4c8741ac7c7c36ae9e4e32dd392d948c003bd"}],"v":"0x0","r":"0x3c7dfa6b5dd6f0e4d453330de8038be32d6704a29bebbaff664e6485a9fe263a","s":"0x5265140c2318167faeb60eae0c68839343bd5d01453dd6149f1f05d88e2fbe22","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1d009de960092a8ba788d484fbee9b4c7438721d","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0x8e1cd7b74960a4eaa95598461b421eff66443c3c5f88fc29c6e2f43839d72fbf","input":"0x","nonce":"0x124","to":"0x7c14a7de52dda3b778facaf85bb549cac0b0f940","transactionIndex":"0x23","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xecdb53b4a1024ca85086b37e34511569819ecbc33f2c960a2928fd2d1ba9a9b3","s":"0x51546311bf7b54d2a85adb9b08f2ba287e51cace0faeb43fa1d606cb14fd56ab"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xad1708308d9a13630eb39327f96b8af4a3305e0b8aeb5d62d2902b58b4133ac1","s":"0x48b77c7adc95239c400aeb0160ff61530fd919de95632e602d9926e9c1c4f442"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xc97b988b67ad55cf7a63673cba02b942485e2e9f542ad6df6c782a129e10f21c","s":"0x45a67e5530354d6046b76a396384e38ea288447a58afa3110e3f2841e91c9eb2"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x6bb19a57977e46f91ea8e162c51b340cb978fc9b62ee2e66e7f7556171cb9f82","s":"0x6927d7990c269ba457ffa84ce189d596fd410a79ab4881fc5f5e8fc8d6779f6d"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x372470d1f685bfb4a7ef4937511c3dfd80708485875c02fa361b482b2f439557","s":"0x2ade5799a1a9f121517193b0ba99db87c7d172eca6cdfb53c037b2258aa21135"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfb51d2c42edc120fe56609564e92ec30f140bf4056f77b765845fb56c8e6f341","s":"0x2ba7286baca5ed4b90dfebd7bd39000a7563c75756db4f85c0978dbc908d6ba3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xd279a74bf87ba2f14acd8f0bb3fcf818fc49666c775b2cd57c0fc9fe56cdc249","s":"0x32ed9d3907cee8940bc9dd7ae9aa764c8adeae20a3a37a08b35ff6908f935721"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x366e66ba2c7b096f41dc1251c40686ea8fa7d6b83b5a78a5ab05a9c365595a12","s":"0x2eb806154a01cc88744b8294eab3ee5c50b20aabc83eff6be68f8b9ac87550b6"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xfa53403f8118da374d24b670394700a9a3804995c24c21e17525329d3c741c69","s":"0x3512e7b78b3adf8d49b3a7b1644e076b54a1c5dfa7dc9a945d65f3758078c3b9"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0xdcaf740a589fa9a7889ca23be03458ca0d2a922bca77aa6ea24b11cee4412553","s":"0x379dc3b687ba8ef0a966e80e1caedd32302844d54bc2b812640e56d75729b592"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x1","r":"0x7878b6cf5d4548b33339f050397f25fa982c3f680932ac5d89e28d6044e4d223","s":"0x431be9ee89fea4083eb1b48b8ac83ba69c0491f27168a4bdf90f706f9cc56780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xf8ab527fe8dad430700ba5867aa8e1a34bacaefd45e3a1a2665e3240782026cc","s":"0x5f1ace49c2e6e27810b2a98f457432b5e58f8749f23b1b6b8bd3f278a962b780"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0x955a6bd2b8f7cbefe8d4cf01c3321b8991478de22b2d9b458d84fee9a824a6ff","s":"0x5ddd6e2eb7ae2954cdfeda015787fbfc9efafced5afc98cbf5265a1f44300929"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xc5e7e967e2c93003206e1062cc13a19d699934b7254e29a1b244b80775df1ebb","s":"0x7cb86f003dea67728597f0ae720095e2f593ed9e615d12bf13810deaf1a053e3"},{"chainId":"0x1a5887710","address":"0x1d009de960092a8ba788d484fbee9b4c7438721d","nonce":"0x0","yParity":"0x0","r":"0xedf2958228e2ce984313e31754910bbaccf61fb6a3f7ba49cfc4d238345e862f","s":"0x5d74a5132415791b08942ff0707b870376079fbc1323c439213283441d7b1160"}],"v":"0x0","r":"0xa9a6ae3933c5f5b99b67857aef7d3ece85c911c1a107357d46738bf12ddd656a","s":"0x3dc11a9a54774d6ccd67ddd31cd9eb28679529fe6af06c33cc6469989622384d","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","gas":"0xc3500","gasPrice":"0x77359408","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x77359400","hash":"0xe665f3433c951ca6f0cd6717de9a2103a3d5f888aa56780476d87652c13db933","input":"0x","nonce":"0x124","to":"0x0667517a45f4dac84446c829438d5bf551823547","transactionIndex":"0x24","value":"0x4a817c800","type":"0x4","accessList":[],"chainId":"0x1a5887710","authorizationList":[{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xb0a4d13e2c0dd6062934190e764e0b7e31f4686087c2bc73b0fdfa71502db27c","s":"0x4a159aaa3c3f78b1f6a507163f7a6e170bf05853699a2448e9b39e0df5f9c855"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x89705eb6c37858e772ee964d704a1826e71cc02fb711895dc931b5c171fee9b9","s":"0x7f258027f5dc1a4c518fb6560cac7867944f586ec9cb632d4cd0c061cbb80be5"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x50b5376bf91e84a3476d0876da8aa7a8e8640cd41ca3041c2248526459bb5dd7","s":"0xa378dc6e70e93abf251310fea38c01b2703e1eb766f83aedc5c6f0d872d6896"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa1e9eef142eea1d9ccd27499541a3f7af33bff3d31a4ec4fb8ad18f7c91120b","s":"0x18a19bf7c540db1f953afeadc566021a2073dcc96e151e7008641728f8044175"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe08e2b3d66c709106b5d5fa775b5a2fcbcb6e08cf9bb5309ce1037522852dce9","s":"0x5c7178752619a4ad21b6c1b4b40a0e073f99dd01ebd2c5852b39110dfe6a98e0"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0xe239c2a9c490de7814d765e3a0d0f47bd54b5c4006c2c1aa7a5cb96a522b62cf","s":"0x437649fe8e842e7d88eefff3301d76bdd96ac9891b7e72fdfca51b01eed83dea"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782f06379f4971311d05b6a07f06772c56eccd11190896d7fe4475b7007715e6","s":"0xb51a80118ddc8e4e9753a5b1073bf8d05983808f80b8452c2c926e06db7aa92"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0x190373b965c31e10a9cce753c5ed5eb4771581580ef1a5e2dff875372f235ae8","s":"0x505d9fc3665f7a6dff3b20609bab71d906cde01fb777bcaee622224acfde05b1"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x66fd452218a3373cdf138520a821b1ac210aae2be8861370f8bbdb0d30a0dd81","s":"0x25475f5e823b139a6ba0176835eb8edc82767be14ec57da44af02f374d00094a"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x1","r":"0xa095dd56792c466d7b3ef560cd6700681397d4107416db79ed4b7309b99d7afd","s":"0x2e8213744780496d50d60f1e275c250cc1e95190e05762acad6e8ae68dae85bf"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x84f5fb52a1bd74b82c27d357da34f3350376e1d5c748bbe0c4484316ad7c1f93","s":"0x2252e139156f5a12858d47f8d194a269ef3c61f44c962ff52e7cf4c490cfb9d8"},{"chainId":"0x1a5887710","address":"0x1b526b8f1e03e4610ee66419ad12b9f196bd96c1","nonce":"0x0","yParity":"0x0","r":"0x782213ff850398d670fdd6e456d3f7a518532e0795f1ecc44d50930763809fb8","s":"0x503a78b701db1d28a704599368541719c4d3cfc8b73e8be7d9de3f53da9b3a95"}],"v":"0x1","r":"0xc737b52363049f791a207a0fc88fd7ea81598251bf3e8304fd3cc95f04b45499","s":"0x36db01bef8c6d3ad49f79d1328e4ab7a7f5d0c3bd018c32bb1287f9faa2b518b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x4e3d7f35d4d8d2c471c2da522579143ded0c9dde4ea1c2ee515f86754f1f7f53","input":"0x7f4504101dd94d473d6f4a9788ec3b9324281e6c31cf85fdd984781be08bda0b3c6036527f7c47824e363650cd8fb520b7cfbda1e6f84b65b0bac5e8d3be7461cd23bfc4866056527f68019ce8f1f877620feeab27eee757726e14db711f2d40d028b58a10324b4386607652608f609653609460975360f86098536071609953","nonce":"0x382","to":"0x01abea29659e5e97c95107f20bb753cd3e09bbbb","transactionIndex":"0x25","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x013c95c22d3c6bd765712a821b08ec6f727c3ebd9ae66ab80c2f10f6ea485a51"],"v":"0x1","r":"0xc38319750d48ce5ce01c1d859592dbe9725b3f6444fec7d75d9bbbf36884ea46","s":"0x7f2ecc58f1bfa9f77733a6010a2023952051ba48e18a829d1ac875d338b93802","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xf91e74405a2d1786450135920460f4a4cf3ee3d3f2c443f8c07ede1eb449037b","input":"0xf4600060f95d600060b55d7fdc8c5d392fd3cdf4845998b61158fd372e6ba00d2123fe20fdeba31c66586bd76082527fdac0d26d6301f8c5d27a487cc47224b615f4753b3b6020f6f522e37700c78f7660a2527f638867b7c25da0fec6cd6cb80484642fc328ab9255efa82cc246e2bc29186d7d60c2527fe4064c68e42451f5","nonce":"0x383","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x26","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e4f926dc335bf2ac1881a1e0a4e87fc9817689c8ae322e2b06546dc6b4e507"],"v":"0x1","r":"0x635e10a0f3593b8e908c4ef490eada430171b07f8fbd3f6e9264600e1deaf34a","s":"0x4718fc67ecdbf1717830df73acc6e31bbf7ea6460e3a6d2533e2e6d4b073314b","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x72e88529e8541b54356737cd6ac7d193d1fb47a1a06bd05734cb7316628f9291","input":"0x60e6497f97cf8da4c4ffd9275cf07b4969c5f06102400cacc9444dc2ed40a4b95b4c1c0960ac52609460cc5360b960cd53601360ce53600d60cf5360a860d053604060d153601360d25360f160d353607860d45360b360d553601e60d653607d60d753609b60d853603460d953607260da5360ef60db5360bb60dc5360bc60dd","nonce":"0x384","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x27","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x017d3b3a04cdded9035dd0c886c427cd23374ffdb65e5a0c2890d3d88f32c0cd"],"v":"0x1","r":"0x88c85d5702c708480ec1deb36f1f959d94205364f571a15a278359ace171fafb","s":"0x2b0f7e43fdfbdee7a36f725f7033f207a449696a66a2782bd84f0bbb835c8807","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x9ae3d4a0cac29e3282de68c9be082d8de2e426043ffccf73d3d85e7438830d85","input":"0x609763ce8242a353605663ce8242a453604563ce8242a553600063ce8242a65360d563ce8242a75360f163ce8242a8537f73764bb123a25b5d5602644fefaf149d037bd43c25899a03676602fcde9ae252607952605960995360c4609a536012609b5360da609c536039609d536070609e5323600060cb5d7ff4c43cae738591","nonce":"0x385","to":"0x7a40026a3b9a41754a95eec8c92c6b99886f440c","transactionIndex":"0x28","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01f42bc0613d994dff0c63884f74cbf6a4d9bdfcddd8290dec62f8523e5417f5"],"v":"0x0","r":"0x9c6e40ed154f497d39872c10e31c4d7b57a3a1b56a9e3fc216fa24f90015bcd2","s":"0x46993d82ff68fa4d57109bb5c901cf011065ab23b85898d553748df790f6bf19","yParity":"0x0"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x1d3903f025928fd26aa342f6677e5027886c634d1af7116ec49eefcc9a06242d","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x386","to":"0x09fc772d0857550724b07b850a4323f39112aaaa","transactionIndex":"0x29","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0128ff42601c64b028404114c257fa554b2972f5586a1bad0c0fb1f9abde5dd3","0x0168dae2848c0731e74be3718137a78596e1fe5b3bcb27f769acc34ccf9547b6"],"v":"0x1","r":"0xf962b1186bbb76051e35ac3852101204040cbb2f606d237ef9f63d7149e5565f","s":"0x46c70b6c68b7c7df34b05fcc3e5ba1145b118d9091bf04923c72889ea2cd1dfd","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x9eb31fb94ce5111e2a04cb9d156b513887ccbd00","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0xa95b2b346c1dfc18ee5e960f8797112c51c1ebfc449b79b3c258ecddc4162595","input":"0x51601d4960e4607253607f6073536079607453604660755360e5607653602f60775360cb607853602660795360ec607a53600060f95d7f41b90bd19ea4485561d41dc475d907d998a2a5747d33281d563bbb04881b7f36602f527ff027aa96cbdd11078584d625d43ecd90470416bca35b7f5dd7df3e7db1f0bfc4604f527f3e","nonce":"0x387","to":"0xc92d81729f35231d2cbddf1664e70691d1f8b389","transactionIndex":"0x2a","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0124d35a551ced9320cb1e36d1b6135b433e37782f17f9e4cd4cd0481ff69ccf"],"v":"0x1","r":"0xe27cb11819d48227ae9c5c7dc0244ec2cf1264324519401868c888fa12b0f5f","s":"0x104a33a96115659d0223755d741cd0ee8bc70055be86b5d31035dbf26ea87bcf","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0xdc07c60993cf689438b8c85f86b0ed938dca77ea","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x3d012b0819c6846b10449300a35777d1339070539eafc6603432f49fe9ef357f","input":"0x6000603f5d600060675d7ff8be64b5f996351c8fae8b538661f73d365776ea65bb4308895112386ce885ea60d8527f57518b95fbaee3694b3cf3fc3267ae5856c6782d7176ca28ca5dcbaa7b600e3360f8527f20665b8964a6307ab36fc24f1ac3e1ca966cf4597fcff39fd82975978ef37ebd610118527f3869374f5e3d88cc","nonce":"0xcda","to":"0x056a78e32e992cdb7a24eb405ce6e92eb1d7e177","transactionIndex":"0x2b","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x0145278fec3b40013f973bccecae5432fbdc1e482f58699d93989724ea69cebe"],"v":"0x1","r":"0x6cba5340d5963219def0e84f07320f2de95566bdd4c520fc33cf71348925bb61","s":"0x69a232c70e01dd35b8e6968238b6a82b66cf1aaa2e17a7afc6ea1840758a5642","yParity":"0x1"},{"blockHash":"0x7d51bcfe073187596fb1f7fa82318339029937dafc7cb85bdbe0dd3796477f8f","blockNumber":"0x57d8","from":"0x4dc4ec6ac43c8c45777292db987203c0248e17b7","gas":"0x186a0","gasPrice":"0x77359408","maxFeePerGas":"0x77359408","maxPriorityFeePerGas":"0x77359400","maxFeePerBlobGas":"0xf4240","hash":"0x0628c063b4290de6eab4b6772a800215ec0e75476a9d081c114956fcc085827c","input":"0x600060af5d7fe2bbd47b2086e4cd6d29e9e3681b7dd9ad913b6e53fc4a10490fe640b7db402d60be5260d760de53607160df5360be60e053609c60e15360d460e25360fe60e35360c960e453603360e55360d960e653608160e753605860e85360ae60e953608a60ea53604f60eb53600060ec53608f60ed53609e60ee5360a6","nonce":"0xddd","to":"0x0000000000000000000000000000000000000000","transactionIndex":"0x2c","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1a5887710","blobVersionedHashes":["0x01e712f72b9f5b03cadb98dbe6892166d78051671fa088e955ef8fc8252fe753"],"v":"0x0","r":"0x276a245882a4df9bbbc5e021f382274948419eabee4ffb945ec522fa064e10a5","s":"0x3f3138d798bcf9388688817f36e63d9e0cccfaedbf3aa934afbd68931d3ff35b","yParity":"0x0"}],"transactionsRoot":"0xc5864e1053c5147b97d8f3edff27fb0c0b4e1536756ed1e3634536465ab7bd78","uncles":[],"withdrawals":[],"withdrawalsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var block spec.Block
+			require.NoError(t, json.Unmarshal(test.input, &block))
+
+			err := block.VerifyTransactionsRoot()
+			if test.transactionsErr != "" {
+				require.EqualError(t, err, test.transactionsErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = block.VerifyWithdrawalsRoot()
+			if test.withdrawalsErr != "" {
+				require.EqualError(t, err, test.withdrawalsErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlockVerifyReceiptsRoot(t *testing.T) {
+	var berlinReceipt spec.TransactionReceipt
+	require.NoError(t, json.Unmarshal([]byte(`{"blockHash":"0x249ea54eada07708b29d7c424b8466dec9f1d98067b0be1b89c7ee660cca858d","blockNumber":"0xb542","contractAddress":"0x9a049f5d18c239efaa258af9f3e7002949a977a0","cumulativeGasUsed":"0x5dc0","from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gasUsed":"0x5dc0","logs":[],"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","status":"0x0","to":null,"transactionHash":"0x6c929e1c3d860ee225d7f3a7addf9e3f740603d243260536dfa2f3cf02b51de4","transactionIndex":"0x0","type":"0x0"}`), &berlinReceipt))
+	var londonReceipt spec.TransactionReceipt
+	require.NoError(t, json.Unmarshal([]byte(`{"blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","blockNumber":"0xdc5deb","contractAddress":null,"cumulativeGasUsed":"0x10b8b8","effectiveGasPrice":"0x40eb6e398","from":"0x3e74d7a29db9c136fff150ac61a3ff6e56818774","gasUsed":"0x168c0","logs":[{"address":"0x40875223d61a688954263892d0f76c94fd6b3d4a","topics":["0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62","0x0000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6e","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x13","removed":false},{"address":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000003041"],"data":"0x","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x14","removed":false}],"logsBloom":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000","status":"0x1","to":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","type":"0x2"}`), &londonReceipt))
+
+	tests := []struct {
+		name         string
+		receiptsRoot []byte
+		receipts     []*spec.TransactionReceipt
+		err          string
+	}{
+		{
+			name:         "Empty",
+			receiptsRoot: byteslice("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"),
+		},
+		{
+			name:         "ReceiptMissing",
+			receiptsRoot: byteslice("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"),
+			receipts:     []*spec.TransactionReceipt{nil},
+			err:          "receipt 0 missing",
+		},
+		{
+			name:         "Single",
+			receiptsRoot: byteslice("0x2a9d658db100323061efd21ed4b56c7e5ed49cc1875f3cca93591da2e2f5bba9"),
+			receipts:     []*spec.TransactionReceipt{&londonReceipt},
+		},
+		{
+			name:         "Multiple",
+			receiptsRoot: byteslice("0x65f902aba2bbf46708a771209fd8bd78add32823f6384dca3f12cfd674928bca"),
+			receipts:     []*spec.TransactionReceipt{&berlinReceipt, &londonReceipt},
+		},
+		{
+			name:         "Mismatch",
+			receiptsRoot: byteslice("0x65f902aba2bbf46708a771209fd8bd78add32823f6384dca3f12cfd674928bca"),
+			receipts:     []*spec.TransactionReceipt{&londonReceipt, &berlinReceipt},
+			err:          "receipts root mismatch: block reports 0x65f902aba2bbf46708a771209fd8bd78add32823f6384dca3f12cfd674928bca, computed ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := &spec.Block{
+				Fork: spec.ForkLondon,
+				London: &spec.LondonBlock{
+					ReceiptsRoot: types.Root(test.receiptsRoot),
+				},
+			}
+			err := block.VerifyReceiptsRoot(test.receipts)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -126,6 +126,13 @@ func (t *CancunTransactionReceipt) String() string {
 	return string(bytes.TrimSuffix(data, []byte("\n")))
 }
 
+// MarshalRLP returns an RLP representation of the transaction receipt.
+// Receipts of typed transactions are returned as an RLP byte string containing
+// their EIP-2718 envelope.
+func (t *CancunTransactionReceipt) MarshalRLP() ([]byte, error) {
+	return receiptRLP(t.Type, t.Status, t.CumulativeGasUsed, t.LogsBloom, t.Logs)
+}
+
 func (t *CancunTransactionReceipt) unpack(data *cancunTransactionReceiptJSON) error {
 	var (
 		err     error
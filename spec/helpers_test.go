@@ -37,3 +37,13 @@ func byteslice(input string) []byte {
 	}
 	return res
 }
+
+func versionedHash(input string) types.VersionedHash {
+	tmp, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	res := types.VersionedHash{}
+	copy(res[:], tmp)
+	return res
+}
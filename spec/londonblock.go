@@ -68,7 +68,7 @@ type londonBlockJSON struct {
 	ParentHash       string         `json:"parentHash"`
 	ReceiptsRoot     string         `json:"receiptsRoot"`
 	SHA3Uncles       string         `json:"sha3Uncles"`
-	Size             string         `json:"size"`
+	Size             string         `json:"size,omitempty"`
 	StateRoot        string         `json:"stateRoot"`
 	Timestamp        string         `json:"timestamp"`
 	TotalDifficulty  string         `json:"totalDifficulty"`
@@ -99,7 +99,7 @@ func (b *LondonBlock) MarshalJSON() ([]byte, error) {
 		ParentHash:       util.MarshalByteArray(b.ParentHash[:]),
 		ReceiptsRoot:     util.MarshalByteArray(b.ReceiptsRoot[:]),
 		SHA3Uncles:       util.MarshalByteArray(b.SHA3Uncles),
-		Size:             util.MarshalUint32(b.Size),
+		Size:             util.MarshalNullableUint32(b.Size),
 		StateRoot:        util.MarshalByteArray(b.StateRoot[:]),
 		Timestamp:        fmt.Sprintf("%#x", b.Timestamp.Unix()),
 		TotalDifficulty:  util.MarshalBigInt(b.TotalDifficulty),
@@ -265,17 +265,16 @@ func (b *LondonBlock) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "sha3 uncles invalid")
 	}
 
-	if data.Size == "" {
-		return errors.New("size missing")
-	}
+	// Size is not supplied for block headers.
+	if data.Size != "" {
+		tmp, err = strconv.ParseUint(util.PreUnmarshalHexString(data.Size), 16, 32)
+		if err != nil {
+			return errors.Wrap(err, "size invalid")
+		}
 
-	tmp, err = strconv.ParseUint(util.PreUnmarshalHexString(data.Size), 16, 32)
-	if err != nil {
-		return errors.Wrap(err, "size invalid")
+		b.Size = uint32(tmp)
 	}
 
-	b.Size = uint32(tmp)
-
 	if data.StateRoot == "" {
 		return errors.New("state root missing")
 	}
@@ -344,3 +343,35 @@ func (b *LondonBlock) String() string {
 
 	return string(bytes.TrimSuffix(data, []byte("\n")))
 }
+
+// HeaderRLP returns the RLP encoding of the block header.
+func (b *LondonBlock) HeaderRLP() ([]byte, error) {
+	return headerRLP(&blockHeader{
+		ParentHash:       b.ParentHash,
+		SHA3Uncles:       b.SHA3Uncles,
+		Miner:            b.Miner,
+		StateRoot:        b.StateRoot,
+		TransactionsRoot: b.TransactionsRoot,
+		ReceiptsRoot:     b.ReceiptsRoot,
+		LogsBloom:        b.LogsBloom,
+		Difficulty:       b.Difficulty,
+		Number:           b.Number,
+		GasLimit:         b.GasLimit,
+		GasUsed:          b.GasUsed,
+		Timestamp:        b.Timestamp,
+		ExtraData:        b.ExtraData,
+		MixHash:          b.MixHash,
+		Nonce:            b.Nonce,
+		BaseFeePerGas:    &b.BaseFeePerGas,
+	})
+}
+
+// ComputeHash computes the hash of the block from its header.
+func (b *LondonBlock) ComputeHash() (types.Hash, error) {
+	header, err := b.HeaderRLP()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return util.Keccak256(header), nil
+}
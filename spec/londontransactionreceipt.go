@@ -115,6 +115,13 @@ func (t *LondonTransactionReceipt) String() string {
 	return string(bytes.TrimSuffix(data, []byte("\n")))
 }
 
+// MarshalRLP returns an RLP representation of the transaction receipt.
+// Receipts of typed transactions are returned as an RLP byte string containing
+// their EIP-2718 envelope.
+func (t *LondonTransactionReceipt) MarshalRLP() ([]byte, error) {
+	return receiptRLP(t.Type, t.Status, t.CumulativeGasUsed, t.LogsBloom, t.Logs)
+}
+
 func (t *LondonTransactionReceipt) unpack(data *londonTransactionReceiptJSON) error {
 	var err error
 
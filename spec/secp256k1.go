@@ -0,0 +1,99 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/pkg/errors"
+)
+
+// privateKeyLength is the length of a secp256k1 private key.
+const privateKeyLength = 32
+
+// secp256k1HalfN is half of the order of the secp256k1 curve, used to check for malleable signatures.
+var secp256k1HalfN = new(big.Int).Rsh(secp256k1.S256().N, 1)
+
+// signHash signs the hash with the private key, returning the R and S
+// values of the signature along with its recovery ID.
+func signHash(hash types.Hash, privateKey []byte) (*big.Int, *big.Int, byte, error) {
+	if len(privateKey) != privateKeyLength {
+		return nil, nil, 0, errors.New("private key incorrect length")
+	}
+
+	key := secp256k1.PrivKeyFromBytes(privateKey)
+	if key.Key.IsZero() {
+		return nil, nil, 0, errors.New("private key invalid")
+	}
+
+	// Compact signatures are of the form [27 + recovery ID][R][S].
+	sig := ecdsa.SignCompact(key, hash[:], false)
+	recoveryID := sig[0] - 27
+	r := new(big.Int).SetBytes(sig[1:33])
+	s := new(big.Int).SetBytes(sig[33:65])
+
+	return r, s, recoveryID, nil
+}
+
+// privateKeyToAddress returns the address corresponding to the private key.
+func privateKeyToAddress(privateKey []byte) types.Address {
+	return pubKeyToAddress(secp256k1.PrivKeyFromBytes(privateKey).PubKey())
+}
+
+// pubKeyToAddress returns the address corresponding to the public key.
+func pubKeyToAddress(pubKey *secp256k1.PublicKey) types.Address {
+	// Address is the last 20 bytes of the hash of the uncompressed key, without its prefix.
+	hash := util.Keccak256(pubKey.SerializeUncompressed()[1:])
+
+	var address types.Address
+	copy(address[:], hash[12:])
+
+	return address
+}
+
+// recoverAddress recovers the address that generated the signature for the hash.
+func recoverAddress(hash types.Hash, r *big.Int, s *big.Int, recoveryID byte, requireLowS bool) (types.Address, error) {
+	if r == nil || s == nil {
+		return types.Address{}, errors.New("signature missing")
+	}
+
+	if recoveryID > 1 {
+		return types.Address{}, errors.New("signature recovery ID invalid")
+	}
+
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return types.Address{}, errors.New("signature values invalid")
+	}
+
+	if requireLowS && s.Cmp(secp256k1HalfN) > 0 {
+		return types.Address{}, errors.New("signature S value too high")
+	}
+
+	// Compact signatures are of the form [27 + recovery ID][R][S].
+	sig := make([]byte, 65)
+	sig[0] = 27 + recoveryID
+	r.FillBytes(sig[1:33])
+	s.FillBytes(sig[33:65])
+
+	pubKey, _, err := ecdsa.RecoverCompact(sig, hash[:])
+	if err != nil {
+		return types.Address{}, errors.Wrap(err, "failed to recover public key")
+	}
+
+	return pubKeyToAddress(pubKey), nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// Sender recovers the sender of the transaction from its signature.
+// If chainID is nil then the chain ID is obtained from the transaction itself.
+func Sender(tx *Transaction, chainID *big.Int) (types.Address, error) {
+	if tx == nil {
+		return types.Address{}, errors.New("no transaction specified")
+	}
+
+	if chainID == nil {
+		var err error
+
+		chainID, err = transactionChainID(tx)
+		if err != nil {
+			return types.Address{}, err
+		}
+	}
+
+	return LatestSigner(chainID).Sender(tx)
+}
+
+// VerifySender checks that the sender recovered from the signature of the
+// transaction matches the sender reported by the transaction.
+// If chainID is nil then the chain ID is obtained from the transaction itself.
+func VerifySender(tx *Transaction, chainID *big.Int) error {
+	sender, err := Sender(tx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to recover sender")
+	}
+
+	if sender != tx.From() {
+		return fmt.Errorf("sender mismatch: transaction reports %s, signature recovers %s", tx.From(), sender)
+	}
+
+	return nil
+}
+
+// transactionChainID obtains the chain ID from the transaction.
+func transactionChainID(tx *Transaction) (*big.Int, error) {
+	if tx.Type != TransactionType0 {
+		chainID := tx.ChainID()
+		if chainID == nil {
+			return nil, errors.New("transaction chain ID missing")
+		}
+
+		return chainID, nil
+	}
+
+	v := tx.V()
+	if v == nil {
+		return nil, errors.New("signature missing")
+	}
+
+	if v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0 {
+		// No replay protection, so no chain ID.
+		return new(big.Int), nil
+	}
+
+	chainID, _, err := eip155ChainIDAndRecoveryID(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainID, nil
+}
@@ -251,7 +251,6 @@ func TestShanghaiBlockJSON(t *testing.T) {
 		{
 			name:  "SizeMissing",
 			input: []byte(`{"baseFeePerGas":"0x2bf3d74bc7","difficulty":"0x26ef28d3882645","extraData":"0x486976656f6e20686b","gasLimit":"0x1c9c380","gasUsed":"0x5e5cd8","hash":"0x2dd77ae9da8e661c8c6c263651971f4adcbbc5bd2eefa18d3646f603fb79501f","logsBloom":"0x382052020800021414804040a208102000806c00010460008007020c2832010308010010009026a00508118000494140030200900908641d093400405262000c050000404040a5684980516f834040606a052008080804c01000301080460441809004080242201040f010902010891063000418183a804442040090400801064000010dc020200408d019280580040404a2002721a484780d1288c088910cc0270ac44902002803146040c28010084060000011a00648a0090006428000010080510422000000408000a081224210f40c0860800020221522c008818100200040193021090810a0020200601024500604288020011424400408a09000040210","miner":"0x1ad91ee08f21be3de0ba2ba6918e714da6b45836","mixHash":"0x15be7bda2fd66ef3c634bab035a8454365ed31d090dd99c76ac1910151ba2b9c","nonce":"0x9ed675789be2ead0","number":"0xcf6d38","parentHash":"0x3560ee45703a5c4d352b1d6d8f3c642d3f1db6c56b9db3069e9121ad63a241c7","receiptsRoot":"0x55411c42653944bbadad219f8ded587754015f1a584822fd4ed2a0d7e09db364","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","stateRoot":"0x825f84a5098241041e4e45bde4c1cdab05de1aaf3be13d5cff14d5495a2561fb","timestamp":"0x618cd7ce","totalDifficulty":"0x73d8abc71b22d49d973","transactions":[],"transactionsRoot":"0x4bff2452c0f0ad55fe59b70187d74eed6416d6176e77ec40a93e55bfd8e5d549","uncles":[],"withdrawals":[{"index":"0x1676b","validatorIndex":"0x13a","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x1676c","validatorIndex":"0x13b","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x1676d","validatorIndex":"0x13c","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x1676e","validatorIndex":"0x13d","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x1676f","validatorIndex":"0x13e","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x16770","validatorIndex":"0x13f","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x16771","validatorIndex":"0x140","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0xaf9cafd91b000"},{"index":"0x16772","validatorIndex":"0x141","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3b6742bf3ee00"},{"index":"0x16773","validatorIndex":"0x142","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x4316c458dea00"},{"index":"0x16774","validatorIndex":"0x143","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x2f85ceff65800"},{"index":"0x16775","validatorIndex":"0x144","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3726a1c7a2a00"},{"index":"0x16776","validatorIndex":"0x145","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x2f85ceff65800"},{"index":"0x16777","validatorIndex":"0x146","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x3726a1c7a2a00"},{"index":"0x16778","validatorIndex":"0x147","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x2f85ceff65800"},{"index":"0x16779","validatorIndex":"0x148","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x2f85ceff65800"},{"index":"0x1677a","validatorIndex":"0x149","address":"0x388ea662ef2c223ec0b047d41bf3c0f362142ad5","amount":"0x2f85ceff65800"}],"withdrawalsRoot":"0x3cf26a2ffbc696238a1abb6292f16743a3ee22e3058c4f7d8072dded303badd3"}`),
-			err:   "size missing",
 		},
 		{
 			name:  "SizeWrongType",
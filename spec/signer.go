@@ -0,0 +1,317 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// Signer signs transactions for a specific chain.
+type Signer interface {
+	// ChainID returns the ID of the chain for which the signer operates.
+	ChainID() *big.Int
+
+	// SigningHash returns the hash that is signed to authorize the transaction.
+	SigningHash(tx *Transaction) (types.Hash, error)
+
+	// Sign signs the transaction with the supplied private key, setting its signature.
+	Sign(tx *Transaction, privateKey []byte) error
+
+	// Sender recovers the sender of the transaction from its signature.
+	Sender(tx *Transaction) (types.Address, error)
+}
+
+// signer is the implementation of Signer.
+// Each signer supports all transaction types up to and including maxType.
+type signer struct {
+	chainID *big.Int
+	maxType TransactionType
+}
+
+// NewEIP155Signer returns a signer for replay-protected type 0 transactions.
+func NewEIP155Signer(chainID *big.Int) Signer {
+	return newSigner(chainID, TransactionType0)
+}
+
+// NewEIP2930Signer returns a signer for transactions up to and including type 1.
+func NewEIP2930Signer(chainID *big.Int) Signer {
+	return newSigner(chainID, TransactionType1)
+}
+
+// NewEIP1559Signer returns a signer for transactions up to and including type 2.
+func NewEIP1559Signer(chainID *big.Int) Signer {
+	return newSigner(chainID, TransactionType2)
+}
+
+// NewEIP4844Signer returns a signer for transactions up to and including type 3.
+func NewEIP4844Signer(chainID *big.Int) Signer {
+	return newSigner(chainID, TransactionType3)
+}
+
+// NewEIP7702Signer returns a signer for transactions up to and including type 4.
+func NewEIP7702Signer(chainID *big.Int) Signer {
+	return newSigner(chainID, TransactionType4)
+}
+
+// LatestSigner returns a signer that supports all known transaction types.
+func LatestSigner(chainID *big.Int) Signer {
+	return NewEIP7702Signer(chainID)
+}
+
+func newSigner(chainID *big.Int, maxType TransactionType) *signer {
+	s := &signer{
+		chainID: new(big.Int),
+		maxType: maxType,
+	}
+	if chainID != nil {
+		s.chainID.Set(chainID)
+	}
+
+	return s
+}
+
+// ChainID returns the ID of the chain for which the signer operates.
+func (s *signer) ChainID() *big.Int {
+	return new(big.Int).Set(s.chainID)
+}
+
+// SigningHash returns the hash that is signed to authorize the transaction.
+func (s *signer) SigningHash(tx *Transaction) (types.Hash, error) {
+	if err := s.checkTransaction(tx); err != nil {
+		return types.Hash{}, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+
+	switch tx.Type {
+	case TransactionType0:
+		// EIP-155 appends the chain ID and two empty values to the unsigned fields.
+		tx.Type0Transaction.rlpFields(buf)
+		util.RLPBigInt(buf, s.chainID)
+		util.RLPNil(buf)
+		util.RLPNil(buf)
+
+		return legacySigningHash(buf.Bytes()), nil
+	case TransactionType1:
+		tx.Type1Transaction.rlpFields(buf)
+	case TransactionType2:
+		tx.Type2Transaction.rlpFields(buf)
+	case TransactionType3:
+		tx.Type3Transaction.rlpFields(buf)
+	case TransactionType4:
+		tx.Type4Transaction.rlpFields(buf)
+	default:
+		return types.Hash{}, fmt.Errorf("unhandled transaction type %v", tx.Type)
+	}
+
+	return typedSigningHash(tx.Type, buf.Bytes()), nil
+}
+
+// Sign signs the transaction with the supplied private key, setting its signature.
+// The sender of the transaction is also set to the address of the private key.
+func (s *signer) Sign(tx *Transaction, privateKey []byte) error {
+	hash, err := s.SigningHash(tx)
+	if err != nil {
+		return err
+	}
+
+	r, sigS, recoveryID, err := signHash(hash, privateKey)
+	if err != nil {
+		return err
+	}
+
+	from := privateKeyToAddress(privateKey)
+	// Typed transactions use the recovery ID directly as the Y parity.
+	v := big.NewInt(int64(recoveryID))
+
+	switch tx.Type {
+	case TransactionType0:
+		// EIP-155 encodes the chain ID into V.
+		v.Add(v, new(big.Int).Mul(s.chainID, big.NewInt(2)))
+		v.Add(v, big.NewInt(35))
+		tx.Type0Transaction.ChainID = s.ChainID()
+		tx.Type0Transaction.From = from
+		tx.Type0Transaction.V = v
+		tx.Type0Transaction.R = r
+		tx.Type0Transaction.S = sigS
+	case TransactionType1:
+		tx.Type1Transaction.From = from
+		tx.Type1Transaction.V = v
+		tx.Type1Transaction.R = r
+		tx.Type1Transaction.S = sigS
+	case TransactionType2:
+		tx.Type2Transaction.From = from
+		tx.Type2Transaction.V = v
+		tx.Type2Transaction.R = r
+		tx.Type2Transaction.S = sigS
+	case TransactionType3:
+		tx.Type3Transaction.From = from
+		tx.Type3Transaction.V = v
+		tx.Type3Transaction.R = r
+		tx.Type3Transaction.S = sigS
+	case TransactionType4:
+		tx.Type4Transaction.From = from
+		tx.Type4Transaction.V = v
+		tx.Type4Transaction.R = r
+		tx.Type4Transaction.S = sigS
+	default:
+		return fmt.Errorf("unhandled transaction type %v", tx.Type)
+	}
+
+	return nil
+}
+
+// Sender recovers the sender of the transaction from its signature.
+func (s *signer) Sender(tx *Transaction) (types.Address, error) {
+	if err := s.checkTransaction(tx); err != nil {
+		return types.Address{}, err
+	}
+
+	v := tx.V()
+	if v == nil {
+		return types.Address{}, errors.New("signature missing")
+	}
+
+	if tx.Type != TransactionType0 {
+		if v.Cmp(big.NewInt(1)) > 0 || v.Sign() < 0 {
+			return types.Address{}, errors.New("signature Y parity invalid")
+		}
+
+		hash, err := s.SigningHash(tx)
+		if err != nil {
+			return types.Address{}, err
+		}
+
+		return recoverAddress(hash, tx.R(), tx.S(), byte(v.Uint64()), true)
+	}
+
+	if v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0 {
+		// Transaction without replay protection; chain ID is not part of the signing hash.
+		buf := bytes.NewBuffer(make([]byte, 0, 1024))
+		tx.Type0Transaction.rlpFields(buf)
+
+		// Transactions prior to the homestead fork may have high S values, so allow them here.
+		return recoverAddress(legacySigningHash(buf.Bytes()), tx.R(), tx.S(), byte(v.Uint64()-27), false)
+	}
+
+	chainID, recoveryID, err := eip155ChainIDAndRecoveryID(v)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	if chainID.Cmp(s.chainID) != 0 {
+		return types.Address{}, fmt.Errorf("transaction chain ID %v does not match signer chain ID %v", chainID, s.chainID)
+	}
+
+	hash, err := s.SigningHash(tx)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	return recoverAddress(hash, tx.R(), tx.S(), recoveryID, true)
+}
+
+// eip155ChainIDAndRecoveryID obtains the chain ID and recovery ID from an EIP-155 V value.
+func eip155ChainIDAndRecoveryID(v *big.Int) (*big.Int, byte, error) {
+	if v.Cmp(big.NewInt(35)) < 0 {
+		return nil, 0, errors.New("signature V invalid")
+	}
+
+	// V is chainID * 2 + 35 + recovery ID.
+	tmp := new(big.Int).Sub(v, big.NewInt(35))
+	recoveryID := byte(tmp.Bit(0))
+	chainID := tmp.Rsh(tmp, 1)
+
+	return chainID, recoveryID, nil
+}
+
+// checkTransaction ensures that the signer is able to operate on the transaction.
+func (s *signer) checkTransaction(tx *Transaction) error {
+	if tx == nil {
+		return errors.New("no transaction specified")
+	}
+
+	if tx.Type > s.maxType {
+		return fmt.Errorf("transaction type %v not supported by signer", tx.Type)
+	}
+
+	var chainID *big.Int
+
+	switch tx.Type {
+	case TransactionType0:
+		if tx.Type0Transaction == nil {
+			return errors.New("no type 0 transaction specified")
+		}
+		// Type 0 transactions obtain their chain ID from the signer.
+		return nil
+	case TransactionType1:
+		if tx.Type1Transaction == nil {
+			return errors.New("no type 1 transaction specified")
+		}
+
+		chainID = tx.Type1Transaction.ChainID
+	case TransactionType2:
+		if tx.Type2Transaction == nil {
+			return errors.New("no type 2 transaction specified")
+		}
+
+		chainID = tx.Type2Transaction.ChainID
+	case TransactionType3:
+		if tx.Type3Transaction == nil {
+			return errors.New("no type 3 transaction specified")
+		}
+
+		chainID = tx.Type3Transaction.ChainID
+	case TransactionType4:
+		if tx.Type4Transaction == nil {
+			return errors.New("no type 4 transaction specified")
+		}
+
+		chainID = tx.Type4Transaction.ChainID
+	default:
+		return fmt.Errorf("unhandled transaction type %v", tx.Type)
+	}
+
+	if chainID == nil {
+		return errors.New("transaction chain ID missing")
+	}
+
+	if chainID.Cmp(s.chainID) != 0 {
+		return fmt.Errorf("transaction chain ID %v does not match signer chain ID %v", chainID, s.chainID)
+	}
+
+	return nil
+}
+
+// legacySigningHash returns the signing hash for a type 0 transaction given its encoded fields.
+func legacySigningHash(fields []byte) types.Hash {
+	buf := bytes.NewBuffer(make([]byte, 0, len(fields)+9))
+	util.RLPList(buf, fields)
+
+	return util.Keccak256(buf.Bytes())
+}
+
+// typedSigningHash returns the signing hash for a typed transaction given its encoded fields.
+func typedSigningHash(txType TransactionType, fields []byte) types.Hash {
+	buf := bytes.NewBuffer(make([]byte, 0, len(fields)+9))
+	util.RLPList(buf, fields)
+
+	return util.Keccak256([]byte{byte(txType)}, buf.Bytes())
+}
@@ -0,0 +1,246 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/spec"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		signer   spec.Signer
+		tx       *spec.Transaction
+		expected []byte
+		err      string
+	}{
+		{
+			name:   "Nil",
+			signer: spec.LatestSigner(big.NewInt(1)),
+			err:    "no transaction specified",
+		},
+		{
+			name:   "TypeUnsupported",
+			signer: spec.NewEIP155Signer(big.NewInt(1)),
+			tx: &spec.Transaction{
+				Type:             spec.TransactionType2,
+				Type2Transaction: &spec.Type2Transaction{},
+			},
+			err: "transaction type 0x2 not supported by signer",
+		},
+		{
+			name:   "ChainIDMissing",
+			signer: spec.LatestSigner(big.NewInt(1)),
+			tx: &spec.Transaction{
+				Type:             spec.TransactionType2,
+				Type2Transaction: &spec.Type2Transaction{},
+			},
+			err: "transaction chain ID missing",
+		},
+		{
+			name:   "ChainIDMismatch",
+			signer: spec.LatestSigner(big.NewInt(1)),
+			tx: &spec.Transaction{
+				Type: spec.TransactionType2,
+				Type2Transaction: &spec.Type2Transaction{
+					ChainID: big.NewInt(5),
+				},
+			},
+			err: "transaction chain ID 5 does not match signer chain ID 1",
+		},
+		{
+			// Test vector from EIP-155.
+			name:   "EIP155",
+			signer: spec.NewEIP155Signer(big.NewInt(1)),
+			tx: &spec.Transaction{
+				Type: spec.TransactionType0,
+				Type0Transaction: &spec.Type0Transaction{
+					Nonce:    9,
+					GasPrice: 20000000000,
+					Gas:      21000,
+					To:       address("0x3535353535353535353535353535353535353535"),
+					Value:    new(big.Int).SetBytes(byteslice("0x0de0b6b3a7640000")),
+				},
+			},
+			expected: byteslice("0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hash, err := test.signer.SigningHash(test.tx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, hash[:])
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	privateKey := byteslice("0x4646464646464646464646464646464646464646464646464646464646464646")
+
+	tests := []struct {
+		name       string
+		signer     spec.Signer
+		tx         *spec.Transaction
+		privateKey []byte
+		expected   []byte
+		err        string
+	}{
+		{
+			name:       "PrivateKeyShort",
+			signer:     spec.LatestSigner(big.NewInt(1)),
+			privateKey: byteslice("0x4646"),
+			tx: &spec.Transaction{
+				Type:             spec.TransactionType0,
+				Type0Transaction: &spec.Type0Transaction{},
+			},
+			err: "private key incorrect length",
+		},
+		{
+			// Test vector from EIP-155.
+			name:       "EIP155",
+			signer:     spec.NewEIP155Signer(big.NewInt(1)),
+			privateKey: privateKey,
+			tx: &spec.Transaction{
+				Type: spec.TransactionType0,
+				Type0Transaction: &spec.Type0Transaction{
+					Nonce:    9,
+					GasPrice: 20000000000,
+					Gas:      21000,
+					To:       address("0x3535353535353535353535353535353535353535"),
+					Value:    new(big.Int).SetBytes(byteslice("0x0de0b6b3a7640000")),
+				},
+			},
+			expected: byteslice("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"),
+		},
+		{
+			name:       "EIP2930",
+			signer:     spec.NewEIP2930Signer(big.NewInt(1)),
+			privateKey: privateKey,
+			tx: &spec.Transaction{
+				Type: spec.TransactionType1,
+				Type1Transaction: &spec.Type1Transaction{
+					ChainID:  big.NewInt(1),
+					Nonce:    9,
+					GasPrice: 20000000000,
+					Gas:      21000,
+					To:       address("0x3535353535353535353535353535353535353535"),
+					Value:    new(big.Int).SetBytes(byteslice("0x0de0b6b3a7640000")),
+					AccessList: []*spec.AccessListEntry{
+						{
+							Address: byteslice("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"),
+							StorageKeys: [][]byte{
+								byteslice("0x0000000000000000000000000000000000000000000000000000000000000001"),
+							},
+						},
+					},
+				},
+			},
+			expected: byteslice("0x01f8a701098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080f838f794c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2e1a0000000000000000000000000000000000000000000000000000000000000000180a084b12e68f9ae54a36aa025a774a1350508926133fc1977d68ec094c0bec65858a04a12f17d4280594a0ec09c1b4143de63bc5244398e6ddd447c791c011396a0d5"),
+		},
+		{
+			name:       "EIP1559",
+			signer:     spec.NewEIP1559Signer(big.NewInt(1)),
+			privateKey: privateKey,
+			tx: &spec.Transaction{
+				Type: spec.TransactionType2,
+				Type2Transaction: &spec.Type2Transaction{
+					ChainID:              big.NewInt(1),
+					Nonce:                9,
+					MaxPriorityFeePerGas: 1000000000,
+					MaxFeePerGas:         20000000000,
+					Gas:                  21000,
+					To:                   address("0x3535353535353535353535353535353535353535"),
+					Value:                new(big.Int).SetBytes(byteslice("0x0de0b6b3a7640000")),
+					Input:                byteslice("0xdead"),
+				},
+			},
+			expected: byteslice("0x02f8750109843b9aca008504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000082deadc001a0ff248ab8889eb5f6983a754adaaa87b25246762c2c70b2690e9e88207e426027a03d396327195a64ae7ff4512a0852b862d1ead7088c18c7b36e81c02cf4b4fd51"),
+		},
+		{
+			name:       "EIP4844",
+			signer:     spec.NewEIP4844Signer(big.NewInt(1)),
+			privateKey: privateKey,
+			tx: &spec.Transaction{
+				Type: spec.TransactionType3,
+				Type3Transaction: &spec.Type3Transaction{
+					ChainID:              big.NewInt(1),
+					Nonce:                9,
+					MaxPriorityFeePerGas: 1000000000,
+					MaxFeePerGas:         20000000000,
+					MaxFeePerBlobGas:     3000000000,
+					Gas:                  21000,
+					To:                   address("0x3535353535353535353535353535353535353535"),
+					Value:                big.NewInt(0),
+					BlobVersionedHashes: []types.VersionedHash{
+						versionedHash("0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8"),
+					},
+				},
+			},
+			expected: byteslice("0x03f8920109843b9aca008504a817c8008252089435353535353535353535353535353535353535358080c084b2d05e00e1a001a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d801a0ea9d54da7c67a682d0d205021f15444508c7b9935736674147d06b48c28ff531a044091951cf4dfdbba598b62b958e57ac6ecec8b32f48bd0c21652d596368d3ba"),
+		},
+		{
+			name:       "EIP7702",
+			signer:     spec.NewEIP7702Signer(big.NewInt(1)),
+			privateKey: privateKey,
+			tx: &spec.Transaction{
+				Type: spec.TransactionType4,
+				Type4Transaction: &spec.Type4Transaction{
+					ChainID:              big.NewInt(1),
+					Nonce:                9,
+					MaxPriorityFeePerGas: 1000000000,
+					MaxFeePerGas:         20000000000,
+					Gas:                  100000,
+					To:                   address("0x3535353535353535353535353535353535353535"),
+					Value:                big.NewInt(0),
+					AuthorizationList: []*spec.AuthorizationListEntry{
+						{
+							ChainID: big.NewInt(1),
+							Address: *address("0x4242424242424242424242424242424242424242"),
+							Nonce:   10,
+							YParity: big.NewInt(1),
+							R:       new(big.Int).SetBytes(byteslice("0x469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970")),
+							S:       new(big.Int).SetBytes(byteslice("0x595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e")),
+						},
+					},
+				},
+			},
+			expected: byteslice("0x04f8ca0109843b9aca008504a817c800830186a09435353535353535353535353535353535353535358080c0f85cf85a019442424242424242424242424242424242424242420a01a0469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970a0595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e01a010135bb00f212c0e6a3eae1d3fc3c07b93dd295654985997f5bff22779bc0fd8a06a0d6e6b371d176d2fa9c1e646c22c669bc96ca3b120f9eb317d0ec328e391a0"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.signer.Sign(test.tx, test.privateKey)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				res, err := test.tx.MarshalBinary()
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				require.Equal(t, *address("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"), test.tx.From())
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"math/big"
 
 	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
 	"github.com/pkg/errors"
 )
 
@@ -89,6 +90,224 @@ func (t *Transaction) UnmarshalJSON(input []byte) error {
 	return err
 }
 
+// MarshalRLP returns an RLP representation of the transaction.
+// Typed transactions are returned as an RLP byte string containing their
+// EIP-2718 envelope, as they appear in the transaction list of a block.
+func (t *Transaction) MarshalRLP() ([]byte, error) {
+	switch t.Type {
+	case TransactionType0:
+		return t.Type0Transaction.MarshalRLP()
+	case TransactionType1:
+		return t.Type1Transaction.MarshalRLP()
+	case TransactionType2:
+		return t.Type2Transaction.MarshalRLP()
+	case TransactionType3:
+		return t.Type3Transaction.MarshalRLP()
+	case TransactionType4:
+		return t.Type4Transaction.MarshalRLP()
+	default:
+		return nil, fmt.Errorf("unhandled transaction type %v", t.Type)
+	}
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+// This is the RLP list for type 0 transactions, and the EIP-2718 envelope
+// for typed transactions.  It is the form expected by eth_sendRawTransaction.
+func (t *Transaction) MarshalBinary() ([]byte, error) {
+	data, err := t.MarshalRLP()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Type == TransactionType0 {
+		return data, nil
+	}
+
+	return util.NewRLPDecoder(data).Bytes()
+}
+
+// UnmarshalRLP populates the transaction from its RLP representation.
+// Typed transactions can be supplied either as their EIP-2718 envelope
+// or as an RLP byte string containing the envelope.
+// The hash of the transaction is computed from the input.  Values that are
+// not part of the encoding, such as the sender and block information, are
+// left unset; Sender can be used to recover the sender.
+func (t *Transaction) UnmarshalRLP(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("no data")
+	}
+
+	if input[0] >= 0xc0 {
+		// Type 0 transactions are an RLP list.
+		tx := &Type0Transaction{}
+		if err := tx.UnmarshalRLP(input); err != nil {
+			return err
+		}
+
+		t.Type = TransactionType0
+		t.Type0Transaction = tx
+
+		return nil
+	}
+
+	envelope, err := rlpEnvelope(input)
+	if err != nil {
+		return err
+	}
+
+	txType := TransactionType(envelope[0])
+	switch txType {
+	case TransactionType1:
+		tx := &Type1Transaction{}
+		if err := tx.UnmarshalRLP(envelope); err != nil {
+			return err
+		}
+
+		t.Type1Transaction = tx
+	case TransactionType2:
+		tx := &Type2Transaction{}
+		if err := tx.UnmarshalRLP(envelope); err != nil {
+			return err
+		}
+
+		t.Type2Transaction = tx
+	case TransactionType3:
+		tx := &Type3Transaction{}
+		if err := tx.UnmarshalRLP(envelope); err != nil {
+			return err
+		}
+
+		t.Type3Transaction = tx
+	case TransactionType4:
+		tx := &Type4Transaction{}
+		if err := tx.UnmarshalRLP(envelope); err != nil {
+			return err
+		}
+
+		t.Type4Transaction = tx
+	default:
+		return fmt.Errorf("unhandled transaction type %v", txType)
+	}
+
+	t.Type = txType
+
+	return nil
+}
+
+// UnmarshalBinary populates the transaction from its canonical encoding.
+func (t *Transaction) UnmarshalBinary(input []byte) error {
+	return t.UnmarshalRLP(input)
+}
+
+// ComputeHash computes the hash of the transaction from its contents.
+func (t *Transaction) ComputeHash() (types.Hash, error) {
+	switch t.Type {
+	case TransactionType0:
+		return t.Type0Transaction.ComputeHash()
+	case TransactionType1:
+		return t.Type1Transaction.ComputeHash()
+	case TransactionType2:
+		return t.Type2Transaction.ComputeHash()
+	case TransactionType3:
+		return t.Type3Transaction.ComputeHash()
+	case TransactionType4:
+		return t.Type4Transaction.ComputeHash()
+	default:
+		return types.Hash{}, fmt.Errorf("unhandled transaction type %v", t.Type)
+	}
+}
+
+// VerifyHash checks that the hash computed from the contents of the
+// transaction matches the hash reported by the transaction.
+func (t *Transaction) VerifyHash() error {
+	hash, err := t.ComputeHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to compute hash")
+	}
+
+	if hash != t.Hash() {
+		return fmt.Errorf("hash mismatch: transaction reports %#x, computed %#x", t.Hash(), hash)
+	}
+
+	return nil
+}
+
+// rlpEnvelope returns the EIP-2718 envelope of a typed transaction,
+// removing the RLP byte string that wraps it if present.
+func rlpEnvelope(input []byte) ([]byte, error) {
+	if len(input) == 0 {
+		return nil, errors.New("no data")
+	}
+
+	if input[0] < 0x80 {
+		// Already an envelope.
+		return input, nil
+	}
+
+	decoder := util.NewRLPDecoder(input)
+
+	envelope, err := decoder.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transaction envelope")
+	}
+
+	if !decoder.Done() {
+		return nil, errors.New("unexpected data after transaction")
+	}
+
+	if len(envelope) == 0 {
+		return nil, errors.New("transaction envelope empty")
+	}
+
+	return envelope, nil
+}
+
+// typedTransactionRLPFields returns the EIP-2718 envelope of a typed
+// transaction and a decoder for its fields.
+func typedTransactionRLPFields(input []byte, txType TransactionType) ([]byte, *util.RLPDecoder, error) {
+	envelope, err := rlpEnvelope(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if envelope[0] != byte(txType) {
+		return nil, nil, errors.New("type incorrect")
+	}
+
+	decoder := util.NewRLPDecoder(envelope[1:])
+
+	fields, err := decoder.List()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid transaction")
+	}
+
+	if !decoder.Done() {
+		return nil, nil, errors.New("unexpected data after transaction")
+	}
+
+	return envelope, fields, nil
+}
+
+// unpackRLPSignature decodes the V, R and S values of a transaction signature.
+func unpackRLPSignature(decoder *util.RLPDecoder) (*big.Int, *big.Int, *big.Int, error) {
+	v, err := decoder.BigInt()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "v invalid")
+	}
+
+	r, err := decoder.BigInt()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "r invalid")
+	}
+
+	s, err := decoder.BigInt()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "s invalid")
+	}
+
+	return v, r, s, nil
+}
+
 // AccessList returns the access list of the transaction.
 // This value can be nil, if the transaction does not support access lists.
 func (t *Transaction) AccessList() []*AccessListEntry {
@@ -197,6 +416,25 @@ func (t *Transaction) BlockNumber() *uint32 {
 	}
 }
 
+// ChainID returns the chain ID of the transaction.
+// This value can be nil, for example on type 0 transactions without replay protection.
+func (t *Transaction) ChainID() *big.Int {
+	switch t.Type {
+	case TransactionType0:
+		return t.Type0Transaction.ChainID
+	case TransactionType1:
+		return t.Type1Transaction.ChainID
+	case TransactionType2:
+		return t.Type2Transaction.ChainID
+	case TransactionType3:
+		return t.Type3Transaction.ChainID
+	case TransactionType4:
+		return t.Type4Transaction.ChainID
+	default:
+		panic(fmt.Errorf("unhandled transaction type %s", t.Type))
+	}
+}
+
 // From returns the sender of the transaction.
 func (t *Transaction) From() types.Address {
 	switch t.Type {
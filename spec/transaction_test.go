@@ -15,6 +15,7 @@ package spec_test
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/attestantio/go-execution-client/spec"
@@ -162,3 +163,240 @@ func TestUnknownFuncs(t *testing.T) {
 	assert.Panics(t, func() { tx.V() })
 	assert.Panics(t, func() { tx.Value() })
 }
+
+func TestTransactionMarshalBinary(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *spec.Transaction
+		expected []byte
+		err      string
+	}{
+		{
+			name: "TypeUnknown",
+			input: &spec.Transaction{
+				Type: spec.TransactionType(99),
+			},
+			err: "unhandled transaction type unknown",
+		},
+		{
+			name: "Type0",
+			input: &spec.Transaction{
+				Type: spec.TransactionType0,
+				Type0Transaction: &spec.Type0Transaction{
+					Nonce:    124,
+					Gas:      21000,
+					GasPrice: 71026000000,
+					To:       address("0x9ad4c3844d43b21b1ab46a1c13fc9a935211b24b"),
+					Value:    big.NewInt(4261560000000000),
+					V:        new(big.Int).SetBytes(byteslice("0x25")),
+					R:        new(big.Int).SetBytes(byteslice("0x716cce912eb8d2127408b2aefc083f0bd6f4dcee3b04b7db00cf82f93741e927")),
+					S:        new(big.Int).SetBytes(byteslice("0x36770cdf4d54e67635aeafff71c5d2ce6b05294cc3c69ca4d161de0bf5a4224c")),
+				},
+			},
+			expected: byteslice("0xf86b7c8510897ac080825208949ad4c3844d43b21b1ab46a1c13fc9a935211b24b870f23ddc1fd30008025a0716cce912eb8d2127408b2aefc083f0bd6f4dcee3b04b7db00cf82f93741e927a036770cdf4d54e67635aeafff71c5d2ce6b05294cc3c69ca4d161de0bf5a4224c"),
+		},
+		{
+			name: "Type2",
+			input: &spec.Transaction{
+				Type: spec.TransactionType2,
+				Type2Transaction: &spec.Type2Transaction{
+					ChainID:              new(big.Int).SetBytes(byteslice("0x01")),
+					Nonce:                2599,
+					Gas:                  21000,
+					MaxPriorityFeePerGas: 1250000000,
+					MaxFeePerGas:         122135661622,
+					To:                   address("0xD28085614D0CE92D98FDc1d0cFc10e5fd6da6fbc"),
+					Value:                big.NewInt(283100000000000000),
+					V:                    new(big.Int).SetBytes(byteslice("0x01")),
+					R:                    new(big.Int).SetBytes(byteslice("0x6506a2afd6e0f57b6887d68d089c97adb7af316947e8033af6a29a206fb6bd1d")),
+					S:                    new(big.Int).SetBytes(byteslice("0x347e78edb68ee405f42b818eabbc736d8d4723a403f7c391ea0f8abf780c32f5")),
+				},
+			},
+			expected: byteslice("0x02f87501820a27844a817c80851c6fda4c3682520894d28085614d0ce92d98fdc1d0cfc10e5fd6da6fbc8803edc5f337e9c00080c001a06506a2afd6e0f57b6887d68d089c97adb7af316947e8033af6a29a206fb6bd1da0347e78edb68ee405f42b818eabbc736d8d4723a403f7c391ea0f8abf780c32f5"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.input.MarshalBinary()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestTransactionUnmarshalRLP(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected spec.TransactionType
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "no data",
+		},
+		{
+			name:  "TypeUnknown",
+			input: byteslice("0x63c0"),
+			err:   "unhandled transaction type unknown",
+		},
+		{
+			name:  "Truncated",
+			input: byteslice("0x02f87501820a27844a817c80"),
+			err:   "invalid transaction: input too short",
+		},
+		{
+			name:  "TrailingData",
+			input: byteslice("0xf86b7c8510897ac080825208949ad4c3844d43b21b1ab46a1c13fc9a935211b24b870f23ddc1fd30008025a0716cce912eb8d2127408b2aefc083f0bd6f4dcee3b04b7db00cf82f93741e927a036770cdf4d54e67635aeafff71c5d2ce6b05294cc3c69ca4d161de0bf5a4224c00"),
+			err:   "unexpected data after transaction",
+		},
+		{
+			name:  "FieldMissing",
+			input: byteslice("0x02c20109"),
+			err:   "max priority fee per gas invalid: no more items",
+		},
+		{
+			name:  "GasTooLarge",
+			input: byteslice("0xea7c8510897ac0808801000000000000009435353535353535353535353535353535353535358080258080"),
+			err:   "gas invalid: integer too large",
+		},
+		{
+			name:     "Type0",
+			input:    byteslice("0xf86b7c8510897ac080825208949ad4c3844d43b21b1ab46a1c13fc9a935211b24b870f23ddc1fd30008025a0716cce912eb8d2127408b2aefc083f0bd6f4dcee3b04b7db00cf82f93741e927a036770cdf4d54e67635aeafff71c5d2ce6b05294cc3c69ca4d161de0bf5a4224c"),
+			expected: spec.TransactionType0,
+		},
+		{
+			name:     "Type1",
+			input:    byteslice("0x01f8a701098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080f838f794c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2e1a0000000000000000000000000000000000000000000000000000000000000000180a084b12e68f9ae54a36aa025a774a1350508926133fc1977d68ec094c0bec65858a04a12f17d4280594a0ec09c1b4143de63bc5244398e6ddd447c791c011396a0d5"),
+			expected: spec.TransactionType1,
+		},
+		{
+			name:     "Type2",
+			input:    byteslice("0x02f87501820a27844a817c80851c6fda4c3682520894d28085614d0ce92d98fdc1d0cfc10e5fd6da6fbc8803edc5f337e9c00080c001a06506a2afd6e0f57b6887d68d089c97adb7af316947e8033af6a29a206fb6bd1da0347e78edb68ee405f42b818eabbc736d8d4723a403f7c391ea0f8abf780c32f5"),
+			expected: spec.TransactionType2,
+		},
+		{
+			name:     "Type3",
+			input:    byteslice("0x03f8920109843b9aca008504a817c8008252089435353535353535353535353535353535353535358080c084b2d05e00e1a001a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d801a0ea9d54da7c67a682d0d205021f15444508c7b9935736674147d06b48c28ff531a044091951cf4dfdbba598b62b958e57ac6ecec8b32f48bd0c21652d596368d3ba"),
+			expected: spec.TransactionType3,
+		},
+		{
+			name:     "Type4",
+			input:    byteslice("0x04f8ca0109843b9aca008504a817c800830186a09435353535353535353535353535353535353535358080c0f85cf85a019442424242424242424242424242424242424242420a01a0469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970a0595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e01a010135bb00f212c0e6a3eae1d3fc3c07b93dd295654985997f5bff22779bc0fd8a06a0d6e6b371d176d2fa9c1e646c22c669bc96ca3b120f9eb317d0ec328e391a0"),
+			expected: spec.TransactionType4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tx spec.Transaction
+			err := tx.UnmarshalRLP(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, tx.Type)
+
+				// Ensure that the transaction re-encodes to the same data.
+				res, err := tx.MarshalBinary()
+				require.NoError(t, err)
+				require.Equal(t, test.input, res)
+
+				// Ensure that the block body form also decodes.
+				rlp, err := tx.MarshalRLP()
+				require.NoError(t, err)
+				var tx2 spec.Transaction
+				require.NoError(t, tx2.UnmarshalRLP(rlp))
+				require.Equal(t, tx, tx2)
+			}
+		})
+	}
+}
+
+func TestTransactionComputeHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+	}{
+		{
+			// Test vector from EIP-155.
+			name:     "Type0",
+			input:    byteslice("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"),
+			expected: byteslice("0x33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788"),
+		},
+		{
+			name:     "Type1",
+			input:    byteslice("0x01f8a701098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080f838f794c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2e1a0000000000000000000000000000000000000000000000000000000000000000180a084b12e68f9ae54a36aa025a774a1350508926133fc1977d68ec094c0bec65858a04a12f17d4280594a0ec09c1b4143de63bc5244398e6ddd447c791c011396a0d5"),
+			expected: byteslice("0x4424cfc8b435f3cb4d2af0d768d32a73a7e4616623973109d48b2890c986d289"),
+		},
+		{
+			name:     "Type2",
+			input:    byteslice("0x02f8750109843b9aca008504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000082deadc001a0ff248ab8889eb5f6983a754adaaa87b25246762c2c70b2690e9e88207e426027a03d396327195a64ae7ff4512a0852b862d1ead7088c18c7b36e81c02cf4b4fd51"),
+			expected: byteslice("0x4d2b6b53acdc3791039f69e68ee3d94ef16d3d592e91b1ecfe5fdca6ce638a22"),
+		},
+		{
+			name:     "Type3",
+			input:    byteslice("0x03f8920109843b9aca008504a817c8008252089435353535353535353535353535353535353535358080c084b2d05e00e1a001a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d801a0ea9d54da7c67a682d0d205021f15444508c7b9935736674147d06b48c28ff531a044091951cf4dfdbba598b62b958e57ac6ecec8b32f48bd0c21652d596368d3ba"),
+			expected: byteslice("0x44379769e4e5e1e52c8033e7a17ef8cf9abadf3898c631e578aaa9a27ad1b742"),
+		},
+		{
+			name:     "Type4",
+			input:    byteslice("0x04f8ca0109843b9aca008504a817c800830186a09435353535353535353535353535353535353535358080c0f85cf85a019442424242424242424242424242424242424242420a01a0469539c3ffaed3018e6bd98328f06fb567b4c143e224a54e971cdbd1cc2fe970a0595db889971ae593f1cc783c6aec7407034882e5bbb4560660652a32f9c3232e01a010135bb00f212c0e6a3eae1d3fc3c07b93dd295654985997f5bff22779bc0fd8a06a0d6e6b371d176d2fa9c1e646c22c669bc96ca3b120f9eb317d0ec328e391a0"),
+			expected: byteslice("0x6166ca1702b82867e71241d5c7187bcab9634c4f5078366d5d6e9aded4eab977"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tx spec.Transaction
+			require.NoError(t, tx.UnmarshalRLP(test.input))
+			hash := tx.Hash()
+			require.Equal(t, test.expected, hash[:])
+
+			hash, err := tx.ComputeHash()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, hash[:])
+		})
+	}
+}
+
+func TestTransactionVerifyHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Type0",
+			input: []byte(`{"blockHash":"0x4e3a3754410177e6937ef1f84bba68ea139e8d1a2258c5f85db9f1cd715a1bdd","blockNumber":"0xb443","from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gas":"0x5208","gasPrice":"0x2d79883d2000","hash":"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060","input":"0x","nonce":"0x0","r":"0x88ff6cf0fefd94db46111149ae4bfc179e9b94721fffd821d38d16464b3f71d0","s":"0x45e0aff800961cfce805daef7016b9b675c137a6a41a548f7b60a3484c06a33a","to":"0x5df9b87991262f6ba471f09758cde1c0fc1de734","transactionIndex":"0x0","type":"0x0","v":"0x1c","value":"0x7a69"}`),
+		},
+		{
+			name:  "Type2",
+			input: []byte(`{"accessList":[{"address":"0xceff51756c56ceffca006cd410b03ffc46dd3a58","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000008","0x0000000000000000000000000000000000000000000000000000000000000009","0x0000000000000000000000000000000000000000000000000000000000000007","0x000000000000000000000000000000000000000000000000000000000000000a","0x000000000000000000000000000000000000000000000000000000000000000c","0x0000000000000000000000000000000000000000000000000000000000000006"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x96693869bd9caff1b0916c4e11fe79467174d34d9d4aad910b52d5a6333d2192","0x30bd84b96629f958113934633d3bd1b64c3d259a85c57ceac65da8c5ec9bf3a7"]},{"address":"0xf424018c3d4473e014c1def44171772059f2d720","storageKeys":[]},{"address":"0x2260fac5e5542a773aa44fbcfedf7c193bc2c599","storageKeys":["0x14ac31782b75d0b8926aa44db6f6caf09df8257632d9c607f23247258a0f6d0b","0x631603854828263717f78aebdf44701ab316ae3c9d57ea74169f98df3affd293","0x0000000000000000000000000000000000000000000000000000000000000005","0xf7c84b5d1f3a0563cd20b346c00dcaaaf749870e80d340ce8dc6213863709a60"]}],"blockHash":"0xb257c7e147b9dc4a5d9868522f7a7ff0949cd0a11231dd1f2ebe3f66c40982dd","blockNumber":"0xcff0c8","chainId":"0x1","from":"0x9ce3ce3978cbee75df235a499503d719da697ceb","gas":"0x61a80","gasPrice":"0x1b301f66ae","hash":"0x3eb2f1bc543ced1004437c75ba58f2bd03b7b3592ef96da57808e9460c18cd01","input":"0x1cff79cd000000000000000000000000f424018c3d4473e014c1def44171772059f2d720000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001042fdc7315000000000000000000000000ceff51756c56ceffca006cd410b03ffc46dd3a580000000000000000000000002260fac5e5542a773aa44fbcfedf7c193bc2c59900000000000000000000000056178a0d5f301baf6cf3e1cd53d9863437345bf900000000000000000000000000000000000000000000000000000004f74ba90000000000000000000000000000000000000000000012a25c7039192ee0d8a8000000000000000000000000000000000000000001c67aff541cec1086a24edef4000000000000000000000000000000000000000000000000000000006193d9fe330000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","maxFeePerGas":"0x1dd855f4d2","maxPriorityFeePerGas":"0x0","nonce":"0x8d89","r":"0x979132c4f106dfc44eb3ea9e24e654a6d73fd8f9081daa2b0576b6ec8bbe6e79","s":"0x461093462822fd309b9d20add4886fc4a76a190c97cb0779a26475cefbd81bce","to":"0xa57bd00134b2850b2a1c55860c9e9ea100fdd6cf","transactionIndex":"0x2","type":"0x2","v":"0x1","value":"0x0","yParity":"0x1"}`),
+		},
+		{
+			name:  "Type2Mismatch",
+			input: []byte(`{"accessList":[{"address":"0xceff51756c56ceffca006cd410b03ffc46dd3a58","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000008","0x0000000000000000000000000000000000000000000000000000000000000009","0x0000000000000000000000000000000000000000000000000000000000000007","0x000000000000000000000000000000000000000000000000000000000000000a","0x000000000000000000000000000000000000000000000000000000000000000c","0x0000000000000000000000000000000000000000000000000000000000000006"]},{"address":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","storageKeys":["0x96693869bd9caff1b0916c4e11fe79467174d34d9d4aad910b52d5a6333d2192","0x30bd84b96629f958113934633d3bd1b64c3d259a85c57ceac65da8c5ec9bf3a7"]},{"address":"0xf424018c3d4473e014c1def44171772059f2d720","storageKeys":[]},{"address":"0x2260fac5e5542a773aa44fbcfedf7c193bc2c599","storageKeys":["0x14ac31782b75d0b8926aa44db6f6caf09df8257632d9c607f23247258a0f6d0b","0x631603854828263717f78aebdf44701ab316ae3c9d57ea74169f98df3affd293","0x0000000000000000000000000000000000000000000000000000000000000005","0xf7c84b5d1f3a0563cd20b346c00dcaaaf749870e80d340ce8dc6213863709a60"]}],"blockHash":"0xb257c7e147b9dc4a5d9868522f7a7ff0949cd0a11231dd1f2ebe3f66c40982dd","blockNumber":"0xcff0c8","chainId":"0x1","from":"0x9ce3ce3978cbee75df235a499503d719da697ceb","gas":"0x61a80","gasPrice":"0x1b301f66ae","hash":"0x3eb2f1bc543ced1004437c75ba58f2bd03b7b3592ef96da57808e9460c18cd02","input":"0x1cff79cd000000000000000000000000f424018c3d4473e014c1def44171772059f2d720000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001042fdc7315000000000000000000000000ceff51756c56ceffca006cd410b03ffc46dd3a580000000000000000000000002260fac5e5542a773aa44fbcfedf7c193bc2c59900000000000000000000000056178a0d5f301baf6cf3e1cd53d9863437345bf900000000000000000000000000000000000000000000000000000004f74ba90000000000000000000000000000000000000000000012a25c7039192ee0d8a8000000000000000000000000000000000000000001c67aff541cec1086a24edef4000000000000000000000000000000000000000000000000000000006193d9fe330000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","maxFeePerGas":"0x1dd855f4d2","maxPriorityFeePerGas":"0x0","nonce":"0x8d89","r":"0x979132c4f106dfc44eb3ea9e24e654a6d73fd8f9081daa2b0576b6ec8bbe6e79","s":"0x461093462822fd309b9d20add4886fc4a76a190c97cb0779a26475cefbd81bce","to":"0xa57bd00134b2850b2a1c55860c9e9ea100fdd6cf","transactionIndex":"0x2","type":"0x2","v":"0x1","value":"0x0","yParity":"0x1"}`),
+			err:   "hash mismatch: transaction reports 0x3eb2f1bc543ced1004437c75ba58f2bd03b7b3592ef96da57808e9460c18cd02, computed 0x3eb2f1bc543ced1004437c75ba58f2bd03b7b3592ef96da57808e9460c18cd01",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tx spec.Transaction
+			require.NoError(t, json.Unmarshal(test.input, &tx))
+			err := tx.VerifyHash()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
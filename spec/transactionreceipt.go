@@ -20,6 +20,7 @@ import (
 	"math/big"
 
 	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
 	"github.com/pkg/errors"
 )
 
@@ -76,6 +77,76 @@ func (t *TransactionReceipt) UnmarshalJSON(input []byte) error {
 	return err
 }
 
+// MarshalRLP returns an RLP representation of the transaction receipt.
+// Receipts of typed transactions are returned as an RLP byte string containing
+// their EIP-2718 envelope.
+func (t *TransactionReceipt) MarshalRLP() ([]byte, error) {
+	switch t.Fork {
+	case ForkBerlin:
+		return t.BerlinTransactionReceipt.MarshalRLP()
+	case ForkLondon, ForkShanghai:
+		return t.LondonTransactionReceipt.MarshalRLP()
+	case ForkCancun:
+		return t.CancunTransactionReceipt.MarshalRLP()
+	default:
+		return nil, fmt.Errorf("unhandled transaction receipt fork %v", t.Fork)
+	}
+}
+
+// MarshalBinary returns the consensus encoding of the transaction receipt.
+// This is the RLP list for receipts of type 0 transactions, and the EIP-2718
+// envelope for receipts of typed transactions.  It is the form used in the
+// receipts trie of a block.
+func (t *TransactionReceipt) MarshalBinary() ([]byte, error) {
+	data, err := t.MarshalRLP()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Type() == TransactionType0 {
+		return data, nil
+	}
+
+	return util.NewRLPDecoder(data).Bytes()
+}
+
+// receiptRLP returns the RLP representation of a receipt given its consensus fields.
+func receiptRLP(txType TransactionType,
+	status uint32,
+	cumulativeGasUsed uint32,
+	logsBloom []byte,
+	logs []*BerlinTransactionEvent,
+) (
+	[]byte,
+	error,
+) {
+	if len(logsBloom) != logsBloomLength {
+		return nil, errors.New("logs bloom incorrect length")
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	util.RLPUint64(buf, uint64(status))
+	util.RLPUint64(buf, uint64(cumulativeGasUsed))
+	util.RLPBytes(buf, logsBloom)
+	rlpLogs(buf, logs)
+
+	res := bytes.NewBuffer(make([]byte, 0, buf.Len()+10))
+	util.RLPList(res, buf.Bytes())
+
+	if txType == TransactionType0 {
+		return res.Bytes(), nil
+	}
+
+	// EIP-2718 definition.
+	envelope := make([]byte, 0, res.Len()+1)
+	envelope = append(envelope, byte(txType))
+	envelope = append(envelope, res.Bytes()...)
+	res.Reset()
+	util.RLPBytes(res, envelope)
+
+	return res.Bytes(), nil
+}
+
 // BlobGasPrice returns the blob gas price of the transaction receipt.
 func (t *TransactionReceipt) BlobGasPrice() *big.Int {
 	switch t.Fork {
@@ -125,3 +125,40 @@ func TestTransactionReceiptUnknownFuncs(t *testing.T) {
 	assert.Panics(t, func() { receipt.TransactionIndex() })
 	assert.Panics(t, func() { receipt.Type() })
 }
+
+func TestTransactionReceiptMarshalRLP(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+	}{
+		{
+			name:     "Type0",
+			input:    []byte(`{"blockHash":"0x249ea54eada07708b29d7c424b8466dec9f1d98067b0be1b89c7ee660cca858d","blockNumber":"0xb542","contractAddress":"0x9a049f5d18c239efaa258af9f3e7002949a977a0","cumulativeGasUsed":"0x5dc0","from":"0xa1e4380a3b1f749673e270229993ee55f35663b4","gasUsed":"0x5dc0","logs":[],"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","status":"0x0","to":null,"transactionHash":"0x6c929e1c3d860ee225d7f3a7addf9e3f740603d243260536dfa2f3cf02b51de4","transactionIndex":"0x0","type":"0x0"}`),
+			expected: append(append(byteslice("0xf9010880825dc0b90100"), make([]byte, 256)...), 0xc0),
+		},
+		{
+			name:     "Type2",
+			input:    []byte(`{"blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","blockNumber":"0xdc5deb","contractAddress":null,"cumulativeGasUsed":"0x10b8b8","effectiveGasPrice":"0x40eb6e398","from":"0x3e74d7a29db9c136fff150ac61a3ff6e56818774","gasUsed":"0x168c0","logs":[{"address":"0x40875223d61a688954263892d0f76c94fd6b3d4a","topics":["0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62","0x0000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6e","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x13","removed":false},{"address":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774","0x0000000000000000000000000000000000000000000000000000000000003041"],"data":"0x","blockNumber":"0xdc5deb","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","blockHash":"0x8b3b6628fdc8861f23da314edd29d4ea88eea42b82fff6708a4be4b6ef87d296","logIndex":"0x14","removed":false}],"logsBloom":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000","status":"0x1","to":"0x0000000005756b5a03e751bd0280e3a55bc05b6e","transactionHash":"0x27d0399f69000e21f2709cbba95582c0dbcab40cb71e4b3cab7ee8f6b1febfef","transactionIndex":"0x9","type":"0x2"}`),
+			expected: byteslice("0x02f90288018310b8b8b90100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400001000000000000008000000020000000000000000000000000080008000000000000000000000000000000000000000000000208000000000000000008000000000000000000000000900000000000000000000000000000000000000000000000000000000080000000000002000000000000000000000000000000000000000000000080000000000000000000000000020000000000000008000000000000000000080000028000000000200000000004000000000000000000000020000000000000000000000a0000000000f9017df8dd9440875223d61a688954263892d0f76c94fd6b3d4af884a0c3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62a00000000000000000000000000000000005756b5a03e751bd0280e3a55bc05b6ea00000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774a00000000000000000000000000000000000000000000000000000000000000000b84000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001f89c940000000005756b5a03e751bd0280e3a55bc05b6ef884a0ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa00000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000003e74d7a29db9c136fff150ac61a3ff6e56818774a0000000000000000000000000000000000000000000000000000000000000304180"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var receipt spec.TransactionReceipt
+			require.NoError(t, json.Unmarshal(test.input, &receipt))
+			binary, err := receipt.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, binary)
+			rlp, err := receipt.MarshalRLP()
+			require.NoError(t, err)
+			if receipt.Type() == spec.TransactionType0 {
+				require.Equal(t, binary, rlp)
+			} else {
+				// Typed receipts are wrapped in an RLP byte string.
+				require.Equal(t, binary, rlp[len(rlp)-len(binary):])
+			}
+		})
+	}
+}
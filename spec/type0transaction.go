@@ -145,32 +145,108 @@ func (t *Type0Transaction) MarshalRLP() ([]byte, error) {
 	bufB := bytes.NewBuffer(make([]byte, 0, 1024))
 
 	// Transaction data.
-	util.RLPUint64(bufA, t.Nonce)
-	util.RLPUint64(bufA, t.GasPrice)
-	util.RLPUint64(bufA, uint64(t.Gas))
+	t.rlpFields(bufA)
+
+	// Signature.
+	util.RLPBigInt(bufA, t.V)
+	util.RLPBigInt(bufA, t.R)
+	util.RLPBigInt(bufA, t.S)
+
+	util.RLPList(bufB, bufA.Bytes())
+
+	return bufB.Bytes(), nil
+}
+
+// ComputeHash computes the hash of the transaction from its contents.
+func (t *Type0Transaction) ComputeHash() (types.Hash, error) {
+	data, err := t.MarshalRLP()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return util.Keccak256(data), nil
+}
+
+// rlpFields appends the RLP encoding of the unsigned fields of the transaction to the buffer.
+func (t *Type0Transaction) rlpFields(buf *bytes.Buffer) {
+	util.RLPUint64(buf, t.Nonce)
+	util.RLPUint64(buf, t.GasPrice)
+	util.RLPUint64(buf, uint64(t.Gas))
 
 	if t.To != nil {
-		util.RLPAddress(bufA, *t.To)
+		util.RLPAddress(buf, *t.To)
 	} else {
-		util.RLPNil(bufA)
+		util.RLPNil(buf)
 	}
 
-	if t.Value != nil {
-		util.RLPBytes(bufA, t.Value.Bytes())
-	} else {
-		util.RLPNil(bufA)
+	util.RLPBigInt(buf, t.Value)
+	util.RLPBytes(buf, t.Input)
+}
+
+// UnmarshalRLP populates the transaction from its RLP representation.
+func (t *Type0Transaction) UnmarshalRLP(input []byte) error {
+	decoder := util.NewRLPDecoder(input)
+
+	fields, err := decoder.List()
+	if err != nil {
+		return errors.Wrap(err, "invalid transaction")
 	}
 
-	util.RLPBytes(bufA, t.Input)
+	if !decoder.Done() {
+		return errors.New("unexpected data after transaction")
+	}
 
-	// Signature.
-	util.RLPBytes(bufA, t.V.Bytes())
-	util.RLPBytes(bufA, t.R.Bytes())
-	util.RLPBytes(bufA, t.S.Bytes())
+	t.Nonce, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "nonce invalid")
+	}
 
-	util.RLPList(bufB, bufA.Bytes())
+	t.GasPrice, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "gas price invalid")
+	}
 
-	return bufB.Bytes(), nil
+	t.Gas, err = fields.Uint32()
+	if err != nil {
+		return errors.Wrap(err, "gas invalid")
+	}
+
+	t.To, err = fields.OptionalAddress()
+	if err != nil {
+		return errors.Wrap(err, "to invalid")
+	}
+
+	t.Value, err = fields.BigInt()
+	if err != nil {
+		return errors.Wrap(err, "value invalid")
+	}
+
+	t.Input, err = fields.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "input invalid")
+	}
+
+	t.V, t.R, t.S, err = unpackRLPSignature(fields)
+	if err != nil {
+		return err
+	}
+
+	if !fields.Done() {
+		return errors.New("unexpected data in transaction")
+	}
+
+	t.Hash = util.Keccak256(input)
+
+	// Replay-protected transactions encode the chain ID in V.
+	t.ChainID = nil
+	if t.V.Cmp(big.NewInt(35)) >= 0 {
+		t.ChainID, _, err = eip155ChainIDAndRecoveryID(t.V)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 //nolint:gocyclo
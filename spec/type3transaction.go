@@ -190,64 +190,155 @@ func (t *Type3Transaction) MarshalRLP() ([]byte, error) {
 	bufB := bytes.NewBuffer(make([]byte, 0, 1024))
 
 	// Transaction data.
-	// Need to include blob versioned hashes in future.
-	util.RLPBytes(bufA, t.ChainID.Bytes())
-	util.RLPUint64(bufA, t.Nonce)
-	util.RLPUint64(bufA, t.MaxPriorityFeePerGas)
-	util.RLPUint64(bufA, t.MaxFeePerBlobGas)
-	util.RLPUint64(bufA, t.MaxFeePerGas)
-	util.RLPUint64(bufA, uint64(t.Gas))
+	t.rlpFields(bufA)
+
+	// Signature.
+	util.RLPBigInt(bufA, t.V)
+	util.RLPBigInt(bufA, t.R)
+	util.RLPBigInt(bufA, t.S)
+
+	// EIP-2718 definition.
+	if err := bufB.WriteByte(0x03); err != nil {
+		return nil, err
+	}
+
+	util.RLPList(bufB, bufA.Bytes())
+	bufA.Reset()
+	util.RLPBytes(bufA, bufB.Bytes())
+
+	return bufA.Bytes(), nil
+}
+
+// ComputeHash computes the hash of the transaction from its contents.
+// This is the hash of the EIP-2718 envelope of the transaction.
+func (t *Type3Transaction) ComputeHash() (types.Hash, error) {
+	data, err := t.MarshalRLP()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	envelope, err := rlpEnvelope(data)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return util.Keccak256(envelope), nil
+}
+
+// rlpFields appends the RLP encoding of the unsigned fields of the transaction to the buffer.
+func (t *Type3Transaction) rlpFields(buf *bytes.Buffer) {
+	util.RLPBigInt(buf, t.ChainID)
+	util.RLPUint64(buf, t.Nonce)
+	util.RLPUint64(buf, t.MaxPriorityFeePerGas)
+	util.RLPUint64(buf, t.MaxFeePerGas)
+	util.RLPUint64(buf, uint64(t.Gas))
 
 	if t.To != nil {
-		util.RLPAddress(bufA, *t.To)
+		util.RLPAddress(buf, *t.To)
 	} else {
-		util.RLPNil(bufA)
+		util.RLPNil(buf)
 	}
 
-	if t.Value != nil {
-		util.RLPBytes(bufA, t.Value.Bytes())
-	} else {
-		util.RLPNil(bufA)
+	util.RLPBigInt(buf, t.Value)
+	util.RLPBytes(buf, t.Input)
+	rlpAccessList(buf, t.AccessList)
+	util.RLPUint64(buf, t.MaxFeePerBlobGas)
+
+	hashesBuf := bytes.NewBuffer(make([]byte, 0, len(t.BlobVersionedHashes)*(types.VersionedHashLength+1)))
+	for _, versionedHash := range t.BlobVersionedHashes {
+		util.RLPBytes(hashesBuf, versionedHash[:])
 	}
 
-	util.RLPBytes(bufA, t.Input)
+	util.RLPList(buf, hashesBuf.Bytes())
+}
 
-	if len(t.AccessList) != 0 {
-		entryBuf := bytes.NewBuffer(make([]byte, 0, 1024))
-		addressBuf := bytes.NewBuffer(make([]byte, 0, 20))
+// UnmarshalRLP populates the transaction from its RLP representation.
+// The input can be either the EIP-2718 envelope or an RLP byte string containing it.
+func (t *Type3Transaction) UnmarshalRLP(input []byte) error {
+	envelope, fields, err := typedTransactionRLPFields(input, TransactionType3)
+	if err != nil {
+		return err
+	}
 
-		for _, accessListEntry := range t.AccessList {
-			util.RLPBytes(entryBuf, accessListEntry.Address)
+	t.ChainID, err = fields.BigInt()
+	if err != nil {
+		return errors.Wrap(err, "chain id invalid")
+	}
 
-			for _, key := range accessListEntry.StorageKeys {
-				util.RLPBytes(addressBuf, key)
-			}
+	t.Nonce, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "nonce invalid")
+	}
 
-			util.RLPList(entryBuf, addressBuf.Bytes())
-			addressBuf.Reset()
-			util.RLPList(bufB, entryBuf.Bytes())
-			entryBuf.Reset()
-		}
+	t.MaxPriorityFeePerGas, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "max priority fee per gas invalid")
 	}
 
-	util.RLPList(bufA, bufB.Bytes())
-	bufB.Reset()
+	t.MaxFeePerGas, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "max fee per gas invalid")
+	}
 
-	// Signature.
-	util.RLPBytes(bufA, t.V.Bytes())
-	util.RLPBytes(bufA, t.R.Bytes())
-	util.RLPBytes(bufA, t.S.Bytes())
+	t.Gas, err = fields.Uint32()
+	if err != nil {
+		return errors.Wrap(err, "gas invalid")
+	}
 
-	// EIP-2718 definition.
-	if err := bufB.WriteByte(0x03); err != nil {
-		return nil, err
+	t.To, err = fields.OptionalAddress()
+	if err != nil {
+		return errors.Wrap(err, "to invalid")
 	}
 
-	util.RLPList(bufB, bufA.Bytes())
-	bufA.Reset()
-	util.RLPBytes(bufA, bufB.Bytes())
+	t.Value, err = fields.BigInt()
+	if err != nil {
+		return errors.Wrap(err, "value invalid")
+	}
 
-	return bufA.Bytes(), nil
+	t.Input, err = fields.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "input invalid")
+	}
+
+	t.AccessList, err = unpackRLPAccessList(fields)
+	if err != nil {
+		return errors.Wrap(err, "access list invalid")
+	}
+
+	t.MaxFeePerBlobGas, err = fields.Uint64()
+	if err != nil {
+		return errors.Wrap(err, "max fee per blob gas invalid")
+	}
+
+	hashesDecoder, err := fields.List()
+	if err != nil {
+		return errors.Wrap(err, "blob versioned hashes invalid")
+	}
+
+	t.BlobVersionedHashes = make([]types.VersionedHash, 0)
+	for !hashesDecoder.Done() {
+		hash, err := hashesDecoder.FixedBytes(types.VersionedHashLength)
+		if err != nil {
+			return errors.Wrap(err, "blob versioned hash invalid")
+		}
+
+		var versionedHash types.VersionedHash
+		copy(versionedHash[:], hash)
+		t.BlobVersionedHashes = append(t.BlobVersionedHashes, versionedHash)
+	}
+
+	t.V, t.R, t.S, err = unpackRLPSignature(fields)
+	if err != nil {
+		return err
+	}
+
+	if !fields.Done() {
+		return errors.New("unexpected data in transaction")
+	}
+
+	t.Hash = util.Keccak256(envelope)
+
+	return nil
 }
 
 //nolint:gocyclo
@@ -72,6 +72,20 @@ func (w *Withdrawal) String() string {
 	return string(bytes.TrimSuffix(data, []byte("\n")))
 }
 
+// MarshalRLP returns an RLP representation of the withdrawal.
+func (w *Withdrawal) MarshalRLP() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	util.RLPUint64(buf, w.Index)
+	util.RLPUint64(buf, w.ValidatorIndex)
+	util.RLPAddress(buf, w.Address)
+	util.RLPBigInt(buf, w.Amount)
+
+	res := bytes.NewBuffer(make([]byte, 0, buf.Len()+9))
+	util.RLPList(res, buf.Bytes())
+
+	return res.Bytes(), nil
+}
+
 func (w *Withdrawal) unpack(data *withdrawalJSON) error {
 	var (
 		err     error
@@ -0,0 +1,227 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/pkg/errors"
+)
+
+// VerifyProof verifies a Merkle proof for the given key against the root of a trie.
+// The proof is the list of encoded nodes on the path from the root to the key,
+// as returned by eth_getProof.
+//
+// If the proof is valid the value for the key is returned.  A nil value means
+// that the proof shows that the key is not present in the trie.
+func VerifyProof(root types.Root, key []byte, proof [][]byte) ([]byte, error) {
+	if root == EmptyRoot {
+		// Nothing is present in an empty trie.
+		return nil, nil
+	}
+
+	nodes := make(map[types.Hash][]byte, len(proof))
+	for _, node := range proof {
+		nodes[util.Keccak256(node)] = node
+	}
+
+	path := toNibbles(key)
+	hash := types.Hash(root)
+
+	node, exists := nodes[hash]
+	if !exists {
+		return nil, fmt.Errorf("proof node %#x missing", hash)
+	}
+
+	for {
+		value, child, remaining, err := walkNode(node, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid proof node %#x", util.Keccak256(node))
+		}
+
+		switch {
+		case value != nil:
+			return value, nil
+		case child == nil:
+			// Path diverges from the trie, so the key is not present.
+			return nil, nil
+		case len(child) == len(hash):
+			hash = types.Hash(child)
+
+			node, exists = nodes[hash]
+			if !exists {
+				return nil, fmt.Errorf("proof node %#x missing", hash)
+			}
+		default:
+			// Child is embedded in its parent.
+			node = child
+		}
+
+		path = remaining
+	}
+}
+
+// walkNode follows the path through a single node.
+// It returns the value if the path ends at the node, otherwise the reference
+// to the child node and the remaining path.  If the path is not present then
+// both value and child are nil.
+func walkNode(node []byte, path []byte) ([]byte, []byte, []byte, error) {
+	decoder, err := util.NewRLPDecoder(node).List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	items := make([][]byte, 0, 17)
+	for !decoder.Done() {
+		item, err := decoder.Raw()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	switch len(items) {
+	case 17:
+		// Branch node.
+		if len(path) == 0 {
+			value, err := nodeValue(items[16])
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			return value, nil, nil, nil
+		}
+
+		child, err := nodeReference(items[path[0]])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return nil, child, path[1:], nil
+	case 2:
+		// Leaf or extension node.
+		encodedPath, err := util.NewRLPDecoder(items[0]).Bytes()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		nodePath, isLeaf, err := decodeHexPrefix(encodedPath)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if isLeaf {
+			if !bytes.Equal(nodePath, path) {
+				return nil, nil, nil, nil
+			}
+
+			value, err := nodeValue(items[1])
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			return value, nil, nil, nil
+		}
+
+		if !bytes.HasPrefix(path, nodePath) {
+			return nil, nil, nil, nil
+		}
+
+		child, err := nodeReference(items[1])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if child == nil {
+			return nil, nil, nil, errors.New("extension node without child")
+		}
+
+		return nil, child, path[len(nodePath):], nil
+	default:
+		return nil, nil, nil, fmt.Errorf("unexpected number of items %d", len(items))
+	}
+}
+
+// nodeValue decodes the value held in a node, returning nil if there is no value.
+func nodeValue(item []byte) ([]byte, error) {
+	value, err := util.NewRLPDecoder(item).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	return value, nil
+}
+
+// nodeReference decodes the reference to a child node.
+// This is either the hash of the child, or the child itself if it encodes to
+// less than 32 bytes.  A nil reference means that there is no child.
+func nodeReference(item []byte) ([]byte, error) {
+	decoder := util.NewRLPDecoder(item)
+	if decoder.NextIsList() {
+		if len(item) >= 32 {
+			return nil, errors.New("embedded node too large")
+		}
+
+		return item, nil
+	}
+
+	reference, err := decoder.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(reference) {
+	case 0:
+		return nil, nil
+	case len(types.Hash{}):
+		return reference, nil
+	default:
+		return nil, fmt.Errorf("invalid node reference length %d", len(reference))
+	}
+}
+
+// decodeHexPrefix decodes a compact encoded path, returning its nibbles and
+// if it is the path of a leaf node.
+func decodeHexPrefix(input []byte) ([]byte, bool, error) {
+	if len(input) == 0 {
+		return nil, false, errors.New("path missing")
+	}
+
+	flag := input[0] >> 4
+	if flag > 3 {
+		return nil, false, errors.New("invalid path flag")
+	}
+
+	nibbles := toNibbles(input)
+	if flag&1 == 1 {
+		// Odd length; first nibble is held in the flag byte.
+		nibbles = nibbles[1:]
+	} else {
+		if input[0]&0x0f != 0 {
+			return nil, false, errors.New("invalid path padding")
+		}
+
+		nibbles = nibbles[2:]
+	}
+
+	return nibbles, flag&2 == 2, nil
+}
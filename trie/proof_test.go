@@ -0,0 +1,149 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/trie"
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// hexToBytes is a helper to create a byte slice given a hex string.
+func hexToBytes(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// hexToRoot is a helper to create a root given a hex string.
+func hexToRoot(input string) types.Root {
+	return types.Root(hexToBytes(input))
+}
+
+func TestVerifyProof(t *testing.T) {
+	dogsRoot := hexToRoot("0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3")
+	dogsRootNode := hexToBytes("0xe5831646f6a0db6ae1fda66890f6693f36560d36b4dca68b4d838f17016b151efe1d4c95c453")
+	dogsBranchNode := hexToBytes("0xf83b8080808080ca20887265696e6465657280a037efd11993cb04a54048c25320e9f29c50a432d28afdf01598b2978ce1ca3068808080808080808080")
+	dogsLeafNode := hexToBytes("0xe4808080808080ce89376c6573776f72746883636174808080808080808080857075707079")
+
+	accountRoot := hexToRoot("0x8a357e31cda2adff4cd0faccb72bc92d39fed3816bdb8c17d8c5f093e120cc4a")
+	accountProof := [][]byte{
+		hexToBytes("0xf90211a0fde4153e807b79e1e1324cff719ec1d995c193beb463a7647923c3dac12086a4a0fcbb817ac20784309dce64eb1aa21463d86ec4bd91f4055848e19d63b4945d26a090bcfbf533c9da807bebca4bc4d8a00debae45e7cae4ab2e7616e1dcd1aa1917a0a6ce2dbe06dabb5fdbee5d1d92613969260ac8900d2830d892c0bb5558ce9781a0c451f253e7f826d895268c30ab04d17649cde48c5a964556dec55f7e3c4c9db9a018d27bc2a44879ce4b7780416c222b06ca498a8a14f4f1561b4d747df87d2acda0d5482d64496b633c198b87d5fbd991832db61f916a578658d834ad32516877e4a09d90ed5fe0ae75f27110e3b19b295603bf61ae07e739087c02d82e3eb1629962a083b5a657f78ed394b28c7ff89a547aa0fcbf2dbf43af5c75a1e8314124b3f16fa07d726c208c1cb822911d496551f56c4f90a57ac50c97f53ef50ab2b5495fa5d0a04fb86ab888f124a32d15adcf58c83027d15113bc1e6d6696b0fb1e34b7d772b1a05304b96809057a33fb280bae3ce4d3c76e5e627889ab59ae14cbd82d0a5d0b3aa02a9948926968e9851f5df97600559fd965194553a806eaa609b18d6a1f88df32a037f1f0d15943e093e9812b41f30d35cb7f05666162121c836e8bdb8908c8c5b0a0109baf83e5e2bf2d28a54df00f640cedbdd869e935e445aeeb8200a3062ea97ba0c966d5923f6fe29fb31c6019ec821b11aa9034bf4a7ae249287126ddfa5693c180"),
+		hexToBytes("0xf8718080a029ec2bb4b0f6288f48e185f053d3db8940e12e5f7c0947343bb66a16bed7668ca0916b0cf2e7bd901e6d77abc0d66ca8629f3087a5808d1ec0be873ed499786cd28080808080808080808080a0ccbea74c6d8a8166092f0377a20ccbd71879e55913b56ced8adaaf7ad55deede80"),
+		hexToBytes("0xf86da020c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0b84af8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"),
+	}
+	// Key is the hash of address 0x1111111111111111111111111111111111111111.
+	accountKey := hexToBytes("0xe2c07404b8c1df4c46226425cac68c28d27a766bbddce62309f36724839b22c0")
+
+	tests := []struct {
+		name     string
+		root     types.Root
+		key      []byte
+		proof    [][]byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "EmptyRoot",
+			root: trie.EmptyRoot,
+			key:  []byte("dog"),
+		},
+		{
+			name: "RootMissing",
+			root: dogsRoot,
+			key:  []byte("dog"),
+			err:  "proof node 0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3 missing",
+		},
+		{
+			name:     "EmbeddedLeaf",
+			root:     dogsRoot,
+			key:      []byte("doe"),
+			proof:    [][]byte{dogsRootNode, dogsBranchNode},
+			expected: []byte("reindeer"),
+		},
+		{
+			name:     "BranchValue",
+			root:     dogsRoot,
+			key:      []byte("dog"),
+			proof:    [][]byte{dogsRootNode, dogsBranchNode, dogsLeafNode},
+			expected: []byte("puppy"),
+		},
+		{
+			name:     "EmbeddedChild",
+			root:     dogsRoot,
+			key:      []byte("dogglesworth"),
+			proof:    [][]byte{dogsRootNode, dogsBranchNode, dogsLeafNode},
+			expected: []byte("cat"),
+		},
+		{
+			name:  "AbsentLeafMismatch",
+			root:  dogsRoot,
+			key:   []byte("dogg"),
+			proof: [][]byte{dogsRootNode, dogsBranchNode, dogsLeafNode},
+		},
+		{
+			name:  "AbsentExtensionMismatch",
+			root:  dogsRoot,
+			key:   []byte("cat"),
+			proof: [][]byte{dogsRootNode},
+		},
+		{
+			name:  "NodeMissing",
+			root:  dogsRoot,
+			key:   []byte("dog"),
+			proof: [][]byte{dogsRootNode, dogsLeafNode},
+			err:   "proof node 0xdb6ae1fda66890f6693f36560d36b4dca68b4d838f17016b151efe1d4c95c453 missing",
+		},
+		{
+			name:     "Account",
+			root:     accountRoot,
+			key:      accountKey,
+			proof:    accountProof,
+			expected: hexToBytes("0xf8480584075bcd15a05a370f6f23729d62469250d0c95a9caf2a15b76425febf4e188423fd945e33eda007ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d"),
+		},
+		{
+			name:  "AccountWrongRoot",
+			root:  dogsRoot,
+			key:   accountKey,
+			proof: accountProof,
+			err:   "proof node 0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3 missing",
+		},
+		{
+			name:  "InvalidNode",
+			root:  hexToRoot("0x8d3fc38cad3086ab848b1d779e0a7c77b164869d4546e1b6b9a4feb717dd8686"),
+			key:   []byte("dog"),
+			proof: [][]byte{hexToBytes("0xc3010203")},
+			err:   "invalid proof node 0x8d3fc38cad3086ab848b1d779e0a7c77b164869d4546e1b6b9a4feb717dd8686: unexpected number of items 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := trie.VerifyProof(test.root, test.key, test.proof)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trie provides root calculations for Merkle Patricia tries.
+package trie
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/attestantio/go-execution-client/util"
+)
+
+// EmptyRoot is the root of a trie with no entries.
+var EmptyRoot = types.Root{
+	0x56, 0xe8, 0x1f, 0x17, 0x1b, 0xcc, 0x55, 0xa6, 0xff, 0x83, 0x45, 0xe6, 0x92, 0xc0, 0xf8, 0x6e,
+	0x5b, 0x48, 0xe0, 0x1b, 0x99, 0x6c, 0xad, 0xc0, 0x01, 0x62, 0x2f, 0xb5, 0xe3, 0x63, 0xb4, 0x21,
+}
+
+// Trie is an in-memory Merkle Patricia trie, used to calculate roots.
+type Trie struct {
+	entries map[string][]byte
+}
+
+// entry is a key/value pair in the trie, with the key held as nibbles.
+type entry struct {
+	key   []byte
+	value []byte
+}
+
+// New creates a new empty trie.
+func New() *Trie {
+	return &Trie{
+		entries: make(map[string][]byte),
+	}
+}
+
+// Put sets the value for the given key, replacing any existing value.
+// Putting an empty value removes the key from the trie.
+func (t *Trie) Put(key []byte, value []byte) {
+	if len(value) == 0 {
+		delete(t.entries, string(key))
+
+		return
+	}
+
+	t.entries[string(key)] = bytes.Clone(value)
+}
+
+// Root returns the root of the trie.
+func (t *Trie) Root() types.Root {
+	if len(t.entries) == 0 {
+		return EmptyRoot
+	}
+
+	entries := make([]*entry, 0, len(t.entries))
+	for key, value := range t.entries {
+		entries = append(entries, &entry{
+			key:   toNibbles([]byte(key)),
+			value: value,
+		})
+	}
+
+	sort.Slice(entries, func(i int, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	return types.Root(util.Keccak256(encodeNode(entries, 0)))
+}
+
+// OrderedRoot returns the root of a trie where the key of each value is
+// the RLP encoding of its index.  This is the form used for the
+// transactions, receipts and withdrawals roots of a block.
+func OrderedRoot(values [][]byte) types.Root {
+	t := New()
+	key := bytes.NewBuffer(make([]byte, 0, 9))
+
+	for i, value := range values {
+		key.Reset()
+		util.RLPUint64(key, uint64(i))
+		t.Put(key.Bytes(), value)
+	}
+
+	return t.Root()
+}
+
+// encodeNode returns the RLP encoding of the node containing the given
+// entries, all of which share the first depth nibbles of their keys.
+func encodeNode(entries []*entry, depth int) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	res := bytes.NewBuffer(make([]byte, 0, 1024))
+
+	if len(entries) == 1 {
+		// Leaf node.
+		util.RLPBytes(buf, hexPrefix(entries[0].key[depth:], true))
+		util.RLPBytes(buf, entries[0].value)
+		util.RLPList(res, buf.Bytes())
+
+		return res.Bytes()
+	}
+
+	if prefixLen := commonPrefixLength(entries, depth); prefixLen > 0 {
+		// Extension node.
+		util.RLPBytes(buf, hexPrefix(entries[0].key[depth:depth+prefixLen], false))
+		writeReference(buf, encodeNode(entries, depth+prefixLen))
+		util.RLPList(res, buf.Bytes())
+
+		return res.Bytes()
+	}
+
+	// Branch node.
+	var value []byte
+
+	start := 0
+	if len(entries[0].key) == depth {
+		// Entries are sorted, so a key that ends at this node is first.
+		value = entries[0].value
+		start = 1
+	}
+
+	for nibble := byte(0); nibble < 16; nibble++ {
+		end := start
+		for end < len(entries) && entries[end].key[depth] == nibble {
+			end++
+		}
+
+		if end == start {
+			util.RLPNil(buf)
+		} else {
+			writeReference(buf, encodeNode(entries[start:end], depth+1))
+		}
+
+		start = end
+	}
+
+	util.RLPBytes(buf, value)
+	util.RLPList(res, buf.Bytes())
+
+	return res.Bytes()
+}
+
+// writeReference writes the reference to a child node.
+// Nodes that encode to less than 32 bytes are embedded directly, otherwise the hash of the node is used.
+func writeReference(buf *bytes.Buffer, node []byte) {
+	if len(node) < 32 {
+		buf.Write(node)
+
+		return
+	}
+
+	hash := util.Keccak256(node)
+	util.RLPBytes(buf, hash[:])
+}
+
+// commonPrefixLength returns the number of nibbles after depth that are common to the keys of all entries.
+func commonPrefixLength(entries []*entry, depth int) int {
+	first := entries[0].key[depth:]
+	length := len(first)
+
+	for _, entry := range entries[1:] {
+		key := entry.key[depth:]
+		if len(key) < length {
+			length = len(key)
+		}
+
+		for i := range length {
+			if key[i] != first[i] {
+				length = i
+
+				break
+			}
+		}
+	}
+
+	return length
+}
+
+// toNibbles splits a key into its nibbles.
+func toNibbles(key []byte) []byte {
+	res := make([]byte, len(key)*2)
+	for i, b := range key {
+		res[i*2] = b >> 4
+		res[i*2+1] = b & 0x0f
+	}
+
+	return res
+}
+
+// hexPrefix returns the compact encoding of a path of nibbles, as defined
+// in appendix C of the yellow paper.
+func hexPrefix(nibbles []byte, leaf bool) []byte {
+	var flag byte
+	if leaf {
+		flag = 2
+	}
+
+	res := make([]byte, len(nibbles)/2+1)
+	if len(nibbles)%2 == 1 {
+		// Odd length; first nibble is held in the flag byte.
+		res[0] = (flag+1)<<4 | nibbles[0]
+		nibbles = nibbles[1:]
+	} else {
+		res[0] = flag << 4
+	}
+
+	for i := 0; i < len(nibbles); i += 2 {
+		res[i/2+1] = nibbles[i]<<4 | nibbles[i+1]
+	}
+
+	return res
+}
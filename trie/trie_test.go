@@ -0,0 +1,129 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  [][2]string
+		expected string
+	}{
+		{
+			name:     "Empty",
+			expected: "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+		},
+		{
+			name: "Dogs",
+			entries: [][2]string{
+				{"doe", "reindeer"},
+				{"dog", "puppy"},
+				{"dogglesworth", "cat"},
+			},
+			expected: "0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3",
+		},
+		{
+			name: "Puppy",
+			entries: [][2]string{
+				{"do", "verb"},
+				{"horse", "stallion"},
+				{"doge", "coin"},
+				{"dog", "puppy"},
+			},
+			expected: "0x5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84",
+		},
+		{
+			name: "Replaced",
+			entries: [][2]string{
+				{"do", "noun"},
+				{"horse", "stallion"},
+				{"doge", "coin"},
+				{"dog", "puppy"},
+				{"do", "verb"},
+			},
+			expected: "0x5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84",
+		},
+		{
+			name: "Removed",
+			entries: [][2]string{
+				{"do", "verb"},
+				{"horse", "stallion"},
+				{"doge", "coin"},
+				{"dog", "puppy"},
+				{"cat", "kitten"},
+				{"cat", ""},
+			},
+			expected: "0x5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := trie.New()
+			for _, entry := range test.entries {
+				tr.Put([]byte(entry[0]), []byte(entry[1]))
+			}
+			root := tr.Root()
+			require.Equal(t, test.expected, fmt.Sprintf("%#x", root[:]))
+		})
+	}
+}
+
+func TestOrderedRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    int
+		expected string
+	}{
+		{
+			name:     "Empty",
+			items:    0,
+			expected: "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+		},
+		{
+			name:     "Single",
+			items:    1,
+			expected: "0xd8e1d787ba669ab3e739ffd426a5f222f7f6c1aca0a963dc5448cbde40adbf3f",
+		},
+		{
+			name:     "Three",
+			items:    3,
+			expected: "0xf618bff049d8d18ab163292fd18d7e1e3ef7ab086ffa9a5963da628ba0de5c77",
+		},
+		{
+			// More than 128 items, so keys have different lengths.
+			name:     "Many",
+			items:    200,
+			expected: "0x15ca3ca0b80b5e947a483bf8d14c8528f5de012418f2ba64fa9fc1d542f28a6d",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values := make([][]byte, test.items)
+			for i := range values {
+				values[i] = []byte(fmt.Sprintf("value %d", i))
+			}
+			root := trie.OrderedRoot(values)
+			require.Equal(t, test.expected, fmt.Sprintf("%#x", root[:]))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"github.com/attestantio/go-execution-client/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// Keccak256 returns the Keccak-256 hash of the supplied data.
+func Keccak256(data ...[]byte) types.Hash {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, item := range data {
+		_, _ = hasher.Write(item)
+	}
+
+	var hash types.Hash
+	copy(hash[:], hasher.Sum(nil))
+
+	return hash
+}
@@ -0,0 +1,53 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeccak256 tests the Keccak-256 hash function.
+func TestKeccak256(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]byte
+		expected string
+	}{
+		{
+			name:     "Empty",
+			expected: "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		},
+		{
+			name:     "Single",
+			input:    [][]byte{[]byte("hello")},
+			expected: "1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8",
+		},
+		{
+			name:     "Multiple",
+			input:    [][]byte{[]byte("hel"), []byte("lo")},
+			expected: "1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hash := util.Keccak256(test.input...)
+			require.Equal(t, test.expected, hex.EncodeToString(hash[:]))
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"math/big"
+	"strconv"
+
+	"github.com/attestantio/go-execution-client/types"
+	"github.com/pkg/errors"
+)
+
+// Decodings are based upon the rules at https://eth.wiki/en/fundamentals/rlp
+
+// RLPDecoder decodes a sequence of RLP items.
+type RLPDecoder struct {
+	data []byte
+	pos  int
+}
+
+// NewRLPDecoder creates a decoder for the RLP items in the input.
+func NewRLPDecoder(input []byte) *RLPDecoder {
+	return &RLPDecoder{
+		data: input,
+	}
+}
+
+// Done returns true if all items have been decoded.
+func (d *RLPDecoder) Done() bool {
+	return d.pos >= len(d.data)
+}
+
+// Remaining returns the number of bytes remaining to be decoded.
+func (d *RLPDecoder) Remaining() int {
+	return len(d.data) - d.pos
+}
+
+// NextIsList returns true if the next item is a list.
+func (d *RLPDecoder) NextIsList() bool {
+	return !d.Done() && d.data[d.pos] >= 0xc0
+}
+
+// Bytes decodes the next item as a byte string.
+func (d *RLPDecoder) Bytes() ([]byte, error) {
+	content, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(content), nil
+}
+
+// FixedBytes decodes the next item as a byte string of the given length.
+func (d *RLPDecoder) FixedBytes(length int) ([]byte, error) {
+	content, err := d.fixedBytes(length)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(content), nil
+}
+
+// Address decodes the next item as an address.
+func (d *RLPDecoder) Address() (types.Address, error) {
+	var res types.Address
+
+	content, err := d.fixedBytes(types.AddressLength)
+	if err != nil {
+		return res, err
+	}
+
+	copy(res[:], content)
+
+	return res, nil
+}
+
+// OptionalAddress decodes the next item as an address, returning nil if the item is empty.
+func (d *RLPDecoder) OptionalAddress() (*types.Address, error) {
+	content, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return nil, nil
+	}
+
+	if len(content) != types.AddressLength {
+		return nil, errors.Errorf("expected %d bytes, found %d", types.AddressLength, len(content))
+	}
+
+	var res types.Address
+	copy(res[:], content)
+
+	return &res, nil
+}
+
+// Hash decodes the next item as a hash.
+func (d *RLPDecoder) Hash() (types.Hash, error) {
+	var res types.Hash
+
+	content, err := d.fixedBytes(len(res))
+	if err != nil {
+		return res, err
+	}
+
+	copy(res[:], content)
+
+	return res, nil
+}
+
+// Uint64 decodes the next item as an unsigned 64-bit integer.
+func (d *RLPDecoder) Uint64() (uint64, error) {
+	content, err := d.integer(8)
+	if err != nil {
+		return 0, err
+	}
+
+	var res uint64
+	for _, b := range content {
+		res = res<<8 | uint64(b)
+	}
+
+	return res, nil
+}
+
+// Uint32 decodes the next item as an unsigned 32-bit integer.
+func (d *RLPDecoder) Uint32() (uint32, error) {
+	content, err := d.integer(4)
+	if err != nil {
+		return 0, err
+	}
+
+	var res uint32
+	for _, b := range content {
+		res = res<<8 | uint32(b)
+	}
+
+	return res, nil
+}
+
+// BigInt decodes the next item as an unsigned big integer.
+func (d *RLPDecoder) BigInt() (*big.Int, error) {
+	content, err := d.integer(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(content), nil
+}
+
+// List decodes the next item as a list, returning a decoder for the items in the list.
+// Items in the list are decoded lazily, so errors within the list are only
+// returned when the list decoder is used.
+func (d *RLPDecoder) List() (*RLPDecoder, error) {
+	isList, content, err := d.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isList {
+		return nil, errors.New("expected list, found byte string")
+	}
+
+	return NewRLPDecoder(content), nil
+}
+
+// Raw returns the full encoding of the next item, including its header.
+// The returned slice references the input.
+func (d *RLPDecoder) Raw() ([]byte, error) {
+	start := d.pos
+	if _, _, err := d.next(); err != nil {
+		return nil, err
+	}
+
+	return d.data[start:d.pos], nil
+}
+
+// bytes decodes the next item as a byte string, referencing the input.
+func (d *RLPDecoder) bytes() ([]byte, error) {
+	isList, content, err := d.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if isList {
+		return nil, errors.New("expected byte string, found list")
+	}
+
+	return content, nil
+}
+
+// fixedBytes decodes the next item as a byte string of the given length, referencing the input.
+func (d *RLPDecoder) fixedBytes(length int) ([]byte, error) {
+	content, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) != length {
+		return nil, errors.Errorf("expected %d bytes, found %d", length, len(content))
+	}
+
+	return content, nil
+}
+
+// integer decodes the next item as a canonical unsigned integer of at most maxLen bytes.
+func (d *RLPDecoder) integer(maxLen int) ([]byte, error) {
+	content, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > maxLen {
+		return nil, errors.New("integer too large")
+	}
+
+	if len(content) > 0 && content[0] == 0x00 {
+		return nil, errors.New("integer has leading zero bytes")
+	}
+
+	return content, nil
+}
+
+// next decodes the next item, returning if it is a list and its content.
+func (d *RLPDecoder) next() (bool, []byte, error) {
+	if d.Done() {
+		return false, nil, errors.New("no more items")
+	}
+
+	prefix := d.data[d.pos]
+
+	switch {
+	case prefix < 0x80:
+		// Single byte.
+		d.pos++
+
+		return false, d.data[d.pos-1 : d.pos], nil
+	case prefix <= 0xb7:
+		// Short string.
+		length := int(prefix - 0x80)
+
+		content, err := d.content(1, length)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if length == 1 && content[0] < 0x80 {
+			return false, nil, errors.New("non-canonical single byte encoding")
+		}
+
+		return false, content, nil
+	case prefix < 0xc0:
+		// Long string.
+		content, err := d.longContent(int(prefix - 0xb7))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return false, content, nil
+	case prefix <= 0xf7:
+		// Short list.
+		content, err := d.content(1, int(prefix-0xc0))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return true, content, nil
+	default:
+		// Long list.
+		content, err := d.longContent(int(prefix - 0xf7))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return true, content, nil
+	}
+}
+
+// longContent returns the content of an item with a multi-byte length.
+func (d *RLPDecoder) longContent(lengthOfLength int) ([]byte, error) {
+	if d.Remaining() < 1+lengthOfLength {
+		return nil, errors.New("input too short")
+	}
+
+	lengthBytes := d.data[d.pos+1 : d.pos+1+lengthOfLength]
+	if lengthBytes[0] == 0x00 {
+		return nil, errors.New("non-canonical length encoding")
+	}
+
+	if lengthOfLength > strconv.IntSize/8 {
+		return nil, errors.New("length too large")
+	}
+
+	var length uint64
+	for _, b := range lengthBytes {
+		length = length<<8 | uint64(b)
+	}
+
+	if length < 56 {
+		return nil, errors.New("non-canonical length encoding")
+	}
+
+	// Check the length before conversion, as it may not fit in an int.
+	if length > uint64(d.Remaining()-1-lengthOfLength) {
+		return nil, errors.New("input too short")
+	}
+
+	return d.content(1+lengthOfLength, int(length))
+}
+
+// content returns the content of an item given its header and content lengths.
+func (d *RLPDecoder) content(headerLength int, length int) ([]byte, error) {
+	if d.Remaining() < headerLength+length {
+		return nil, errors.New("input too short")
+	}
+
+	start := d.pos + headerLength
+	d.pos = start + length
+
+	return d.data[start:d.pos], nil
+}
@@ -0,0 +1,215 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRLPDecoderBytes tests decoding RLP byte strings.
+func TestRLPDecoderBytes(t *testing.T) {
+	lorem := []byte("Lorem ipsum dolor sit amet, consectetur adipisicing elit")
+
+	tests := []struct {
+		name     string
+		input    []byte
+		expected []byte
+		err      string
+	}{
+		{
+			name: "Missing",
+			err:  "no more items",
+		},
+		{
+			name:     "Empty",
+			input:    []byte{0x80},
+			expected: []byte{},
+		},
+		{
+			name:     "Dog",
+			input:    []byte{0x83, 'd', 'o', 'g'},
+			expected: []byte("dog"),
+		},
+		{
+			name:     "SingleByte",
+			input:    []byte{0x0f},
+			expected: []byte{0x0f},
+		},
+		{
+			name:  "SingleByteNonCanonical",
+			input: []byte{0x81, 0x0f},
+			err:   "non-canonical single byte encoding",
+		},
+		{
+			name:     "Lorem",
+			input:    append([]byte{0xb8, 0x38}, lorem...),
+			expected: lorem,
+		},
+		{
+			name:  "LongLengthNonCanonical",
+			input: []byte{0xb8, 0x03, 'd', 'o', 'g'},
+			err:   "non-canonical length encoding",
+		},
+		{
+			name:  "LongLengthLeadingZero",
+			input: append([]byte{0xb9, 0x00, 0x38}, lorem...),
+			err:   "non-canonical length encoding",
+		},
+		{
+			name:  "Short",
+			input: []byte{0x83, 'd', 'o'},
+			err:   "input too short",
+		},
+		{
+			name:  "LongLengthShort",
+			input: []byte{0xbb, 0xff, 0xff, 0xff, 0xff, 'd', 'o', 'g'},
+			err:   "input too short",
+		},
+		{
+			name:  "List",
+			input: []byte{0xc0},
+			err:   "expected byte string, found list",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := util.NewRLPDecoder(test.input).Bytes()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+// TestRLPDecoderUint64 tests decoding RLP integers.
+func TestRLPDecoderUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected uint64
+		err      string
+	}{
+		{
+			name:     "Zero",
+			input:    []byte{0x80},
+			expected: 0,
+		},
+		{
+			name:     "15",
+			input:    []byte{0x0f},
+			expected: 15,
+		},
+		{
+			name:     "1024",
+			input:    []byte{0x82, 0x04, 0x00},
+			expected: 1024,
+		},
+		{
+			name:     "Max",
+			input:    []byte{0x88, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			expected: 0xffffffffffffffff,
+		},
+		{
+			name:  "Overflow",
+			input: []byte{0x89, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err:   "integer too large",
+		},
+		{
+			name:  "LeadingZero",
+			input: []byte{0x82, 0x00, 0x80},
+			err:   "integer has leading zero bytes",
+		},
+		{
+			name:  "ZeroByte",
+			input: []byte{0x00},
+			err:   "integer has leading zero bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := util.NewRLPDecoder(test.input).Uint64()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+// TestRLPDecoderList tests decoding nested RLP lists.
+func TestRLPDecoderList(t *testing.T) {
+	// [ [], [[]], [ [], [[]] ] ]
+	input := []byte{0xc7, 0xc0, 0xc1, 0xc0, 0xc3, 0xc0, 0xc1, 0xc0}
+
+	decoder := util.NewRLPDecoder(input)
+	require.True(t, decoder.NextIsList())
+	list, err := decoder.List()
+	require.NoError(t, err)
+	require.True(t, decoder.Done())
+
+	items := 0
+	for !list.Done() {
+		_, err := list.List()
+		require.NoError(t, err)
+		items++
+	}
+	require.Equal(t, 3, items)
+
+	_, err = util.NewRLPDecoder([]byte{0x83, 'd', 'o', 'g'}).List()
+	require.EqualError(t, err, "expected list, found byte string")
+}
+
+// TestRLPDecoderRoundTrip tests decoding the output of the RLP encoders.
+func TestRLPDecoderRoundTrip(t *testing.T) {
+	value, success := new(big.Int).SetString("115792089237316195423570985008687907852837564279074904382605163141518161494337", 10)
+	require.True(t, success)
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	util.RLPUint64(buf, 12345)
+	util.RLPBigInt(buf, value)
+	util.RLPNil(buf)
+	util.RLPBytes(buf, []byte("cat"))
+
+	decoder := util.NewRLPDecoder(buf.Bytes())
+
+	num, err := decoder.Uint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12345), num)
+
+	bigNum, err := decoder.BigInt()
+	require.NoError(t, err)
+	require.Equal(t, value, bigNum)
+
+	address, err := decoder.OptionalAddress()
+	require.NoError(t, err)
+	require.Nil(t, address)
+
+	raw, err := decoder.Raw()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x83, 'c', 'a', 't'}, raw)
+
+	require.True(t, decoder.Done())
+}
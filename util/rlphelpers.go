@@ -16,6 +16,7 @@ package util
 import (
 	"io"
 	"math"
+	"math/big"
 
 	"github.com/attestantio/go-execution-client/types"
 )
@@ -288,6 +289,18 @@ func RLPAddress(buf io.Writer, input types.Address) {
 	_, _ = buf.Write(input[:])
 }
 
+// RLPBigInt appends the RLP encoding of a big integer to the buffer.
+// A nil input is encoded as zero.
+func RLPBigInt(buf io.Writer, input *big.Int) {
+	if input == nil {
+		_, _ = buf.Write(singleBytes[0x80])
+
+		return
+	}
+
+	RLPBytes(buf, input.Bytes())
+}
+
 // RLPBytes appends the RLP encoding of the input to the buffer.
 func RLPBytes(buf io.Writer, input []byte) {
 	if len(input) == 0 {
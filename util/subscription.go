@@ -13,7 +13,200 @@
 
 package util
 
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrSubscriptionOverflow is reported by a subscription when a notification
+// is dropped because the consumer's channel is full.
+var ErrSubscriptionOverflow = errors.New("subscription channel full; notification dropped")
+
+// SubscriptionState is the state of a subscription.
+type SubscriptionState int
+
+const (
+	// SubscriptionStateUnknown is an unknown state.
+	SubscriptionStateUnknown SubscriptionState = iota
+	// SubscriptionStateConnected is the state when the subscription is first established.
+	SubscriptionStateConnected
+	// SubscriptionStateDisconnected is the state when the connection to the
+	// execution client has been lost, and the subscription is being re-established.
+	SubscriptionStateDisconnected
+	// SubscriptionStateReconnected is the state when the subscription has been
+	// re-established.  Notifications issued whilst disconnected are not received.
+	SubscriptionStateReconnected
+	// SubscriptionStateClosed is the state when the subscription has ended.
+	SubscriptionStateClosed
+)
+
+var subscriptionStateStrings = [...]string{
+	"unknown",
+	"connected",
+	"disconnected",
+	"reconnected",
+	"closed",
+}
+
+// String returns the string representation of the state.
+func (s SubscriptionState) String() string {
+	if s < 0 || int(s) >= len(subscriptionStateStrings) {
+		return subscriptionStateStrings[0]
+	}
+
+	return subscriptionStateStrings[s]
+}
+
+// OverflowPolicy defines what happens to a notification when the consumer's
+// channel is full.
+type OverflowPolicy int
+
+const (
+	// OverflowPolicyBlock waits for space in the channel.  Whilst waiting no
+	// further notifications are read from the execution client.
+	OverflowPolicyBlock OverflowPolicy = iota
+	// OverflowPolicyDropNewest drops the notification.
+	OverflowPolicyDropNewest
+	// OverflowPolicyDropOldest drops the oldest item in the channel to make
+	// space for the notification.
+	OverflowPolicyDropOldest
+)
+
+// SubscriptionEvent is a change in the state of a subscription.
+type SubscriptionEvent struct {
+	State SubscriptionState
+	// Err is the error that caused the change in state, if any.
+	Err error
+}
+
+// subscriptionBufferSize is the number of lifecycle events and errors held for a subscriber.
+const subscriptionBufferSize = 16
+
 // Subscription contains a subscription.
 type Subscription struct {
-	ID []byte
+	mu          sync.RWMutex
+	id          []byte
+	events      chan *SubscriptionEvent
+	errs        chan error
+	done        chan struct{}
+	closed      bool
+	unsubscribe func(ctx context.Context) error
+}
+
+// NewSubscription creates a new subscription with the given ID.
+// The unsubscribe function is called by Unsubscribe(), and should end the
+// subscription.
+func NewSubscription(id []byte, unsubscribe func(ctx context.Context) error) *Subscription {
+	return &Subscription{
+		id:          bytes.Clone(id),
+		events:      make(chan *SubscriptionEvent, subscriptionBufferSize),
+		errs:        make(chan error, subscriptionBufferSize),
+		done:        make(chan struct{}),
+		unsubscribe: unsubscribe,
+	}
+}
+
+// ID returns the current ID of the subscription.
+// The ID changes if the subscription is re-established.
+func (s *Subscription) ID() []byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return bytes.Clone(s.id)
+}
+
+// Events returns the channel on which changes in the state of the subscription
+// are sent.  The channel is closed when the subscription ends.
+//
+// Events are dropped rather than block the subscription if the channel is full.
+func (s *Subscription) Events() <-chan *SubscriptionEvent {
+	return s.events
+}
+
+// Err returns the channel on which errors encountered by the subscription are
+// sent, for example loss of the connection or notifications that cannot be
+// processed.  The subscription continues after an error is sent.  The channel
+// is closed when the subscription ends.
+//
+// Errors are dropped rather than block the subscription if the channel is full.
+func (s *Subscription) Err() <-chan error {
+	return s.errs
+}
+
+// Done returns a channel that is closed when the subscription ends.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Unsubscribe ends the subscription.
+// It returns once the execution client has acknowledged the request, or the
+// context is done.  The subscription ends even if an error is returned.
+func (s *Subscription) Unsubscribe(ctx context.Context) error {
+	if s.unsubscribe == nil {
+		s.Notify(SubscriptionStateClosed, nil)
+
+		return nil
+	}
+
+	return s.unsubscribe(ctx)
+}
+
+// SetID sets the ID of the subscription.
+// This is for use by providers when the subscription is re-established.
+func (s *Subscription) SetID(id []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.id = bytes.Clone(id)
+}
+
+// Notify sends a change in the state of the subscription to its events channel.
+// A closed state ends the subscription.
+// This is for use by providers.  It returns false if the event could not be
+// delivered, either because the channel is full or the subscription is closed.
+func (s *Subscription) Notify(state SubscriptionState, err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed || s.events == nil {
+		return false
+	}
+
+	sent := true
+	select {
+	case s.events <- &SubscriptionEvent{State: state, Err: err}:
+	default:
+		sent = false
+	}
+
+	if state == SubscriptionStateClosed {
+		s.closed = true
+		close(s.events)
+		close(s.errs)
+		close(s.done)
+	}
+
+	return sent
+}
+
+// ReportError sends an error to the subscription's error channel.
+// This is for use by providers.  It returns false if the error could not be
+// delivered, either because the channel is full or the subscription is closed.
+func (s *Subscription) ReportError(err error) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed || s.errs == nil {
+		return false
+	}
+
+	select {
+	case s.errs <- err:
+		return true
+	default:
+		return false
+	}
 }
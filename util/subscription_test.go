@@ -0,0 +1,148 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-execution-client/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubscriptionEvents tests the lifecycle events of a subscription.
+func TestSubscriptionEvents(t *testing.T) {
+	sub := util.NewSubscription([]byte{0x01}, nil)
+	require.Equal(t, []byte{0x01}, sub.ID())
+
+	require.True(t, sub.Notify(util.SubscriptionStateConnected, nil))
+	disconnectErr := errors.New("connection lost")
+	require.True(t, sub.Notify(util.SubscriptionStateDisconnected, disconnectErr))
+	sub.SetID([]byte{0x02})
+	require.True(t, sub.Notify(util.SubscriptionStateReconnected, nil))
+	require.Equal(t, []byte{0x02}, sub.ID())
+	require.True(t, sub.Notify(util.SubscriptionStateClosed, nil))
+
+	// Notifications after closure are ignored.
+	require.False(t, sub.Notify(util.SubscriptionStateConnected, nil))
+
+	events := make([]*util.SubscriptionEvent, 0)
+	for event := range sub.Events() {
+		events = append(events, event)
+	}
+	require.Equal(t, []*util.SubscriptionEvent{
+		{State: util.SubscriptionStateConnected},
+		{State: util.SubscriptionStateDisconnected, Err: disconnectErr},
+		{State: util.SubscriptionStateReconnected},
+		{State: util.SubscriptionStateClosed},
+	}, events)
+}
+
+// TestSubscriptionEventsFull tests that a full events channel does not block.
+func TestSubscriptionEventsFull(t *testing.T) {
+	sub := util.NewSubscription(nil, nil)
+
+	dropped := false
+	for range 100 {
+		if !sub.Notify(util.SubscriptionStateDisconnected, nil) {
+			dropped = true
+
+			break
+		}
+	}
+	require.True(t, dropped)
+}
+
+// TestSubscriptionStateString tests the string representation of subscription states.
+func TestSubscriptionStateString(t *testing.T) {
+	require.Equal(t, "connected", util.SubscriptionStateConnected.String())
+	require.Equal(t, "closed", util.SubscriptionStateClosed.String())
+	require.Equal(t, "unknown", util.SubscriptionState(99).String())
+}
+
+// TestSubscriptionErr tests the errors reported by a subscription.
+func TestSubscriptionErr(t *testing.T) {
+	sub := util.NewSubscription(nil, nil)
+
+	require.True(t, sub.ReportError(util.ErrSubscriptionOverflow))
+	require.True(t, sub.Notify(util.SubscriptionStateClosed, nil))
+	require.False(t, sub.ReportError(util.ErrSubscriptionOverflow))
+
+	errs := make([]error, 0)
+	for err := range sub.Err() {
+		errs = append(errs, err)
+	}
+	require.Equal(t, []error{util.ErrSubscriptionOverflow}, errs)
+}
+
+// TestSubscriptionUnsubscribe tests unsubscribing from a subscription.
+func TestSubscriptionUnsubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		unsubscribe func(sub **util.Subscription) func(context.Context) error
+		err         string
+	}{
+		{
+			name: "NoFunction",
+		},
+		{
+			name: "Function",
+			unsubscribe: func(sub **util.Subscription) func(context.Context) error {
+				return func(context.Context) error {
+					(*sub).Notify(util.SubscriptionStateClosed, nil)
+
+					return nil
+				}
+			},
+		},
+		{
+			name: "FunctionFails",
+			unsubscribe: func(sub **util.Subscription) func(context.Context) error {
+				return func(context.Context) error {
+					(*sub).Notify(util.SubscriptionStateClosed, nil)
+
+					return errors.New("failed")
+				}
+			},
+			err: "failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var sub *util.Subscription
+			var unsubscribe func(context.Context) error
+			if test.unsubscribe != nil {
+				unsubscribe = test.unsubscribe(&sub)
+			}
+			sub = util.NewSubscription([]byte{0x01}, unsubscribe)
+
+			err := sub.Unsubscribe(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			select {
+			case <-sub.Done():
+			default:
+				require.Fail(t, "subscription not done")
+			}
+		})
+	}
+}